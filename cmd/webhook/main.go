@@ -31,6 +31,7 @@ import (
 	"github.com/tektoncd/pipeline/pkg/apis/resolution"
 	resolutionv1alpha1 "github.com/tektoncd/pipeline/pkg/apis/resolution/v1alpha1"
 	resolutionv1beta1 "github.com/tektoncd/pipeline/pkg/apis/resolution/v1beta1"
+	pipelinewebhook "github.com/tektoncd/pipeline/pkg/webhook"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -142,15 +143,30 @@ func newConfigValidationController(name string) func(context.Context, configmap.
 
 			// The configmaps to validate.
 			configmap.Constructors{
-				logging.ConfigMapName():                   logging.NewConfigFromConfigMap,
-				defaultconfig.GetDefaultsConfigName():     defaultconfig.NewDefaultsFromConfigMap,
-				pkgleaderelection.ConfigMapName():         pkgleaderelection.NewConfigFromConfigMap,
-				defaultconfig.GetFeatureFlagsConfigName(): defaultconfig.NewFeatureFlagsFromConfigMap,
+				logging.ConfigMapName():                         logging.NewConfigFromConfigMap,
+				defaultconfig.GetDefaultsConfigName():           defaultconfig.NewDefaultsFromConfigMap,
+				pkgleaderelection.ConfigMapName():               pkgleaderelection.NewConfigFromConfigMap,
+				defaultconfig.GetFeatureFlagsConfigName():       defaultconfig.NewFeatureFlagsFromConfigMap,
+				defaultconfig.GetSubstitutionPolicyConfigName(): defaultconfig.NewSubstitutionPolicyFromConfigMap,
 			},
 		)
 	}
 }
 
+func newPipelineRunLabelsAdmissionController(name string) func(context.Context, configmap.Watcher) *controller.Impl {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		return pipelinewebhook.NewPipelineRunLabelsAdmissionController(ctx,
+
+			// Name of the pipelinerun labels webhook, it is based on the value of the environment variable WEBHOOK_ADMISSION_CONTROLLER_NAME
+			// default is "labels.webhook.pipeline.tekton.dev"
+			strings.Join([]string{"labels", name}, "."),
+
+			// The path on which to serve the webhook.
+			"/pipelinerun-labels",
+		)
+	}
+}
+
 func newConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	var (
 		v1alpha1GroupVersion           = v1alpha1.SchemeGroupVersion.Version
@@ -284,6 +300,7 @@ func main() {
 		newDefaultingAdmissionController(webhookName),
 		newValidationAdmissionController(webhookName),
 		newConfigValidationController(webhookName),
+		newPipelineRunLabelsAdmissionController(webhookName),
 		newConversionController,
 	)
 }
@@ -24,6 +24,7 @@ import (
 	_ "github.com/tektoncd/plumbing"
 	_ "github.com/tektoncd/plumbing/cmd/combine"
 	_ "github.com/tektoncd/plumbing/scripts"
+	_ "k8s.io/code-generator/cmd/applyconfiguration-gen"
 	_ "k8s.io/code-generator/cmd/client-gen"
 	_ "k8s.io/code-generator/cmd/deepcopy-gen"
 	_ "k8s.io/code-generator/cmd/defaulter-gen"
@@ -37,6 +37,18 @@ bash ${REPO_ROOT_DIR}/hack/generate-groups.sh "deepcopy,client,informer,lister"
   github.com/tektoncd/pipeline/pkg/client github.com/tektoncd/pipeline/pkg/apis \
   "pipeline:v1alpha1,v1beta1,v1" \
   --go-header-file ${REPO_ROOT_DIR}/hack/boilerplate/boilerplate.go.txt
+# This generates the apply configurations of the pipeline package (v1beta1 and v1), with the types they embed
+# from knative and the resource package. The embedded Kubernetes types are mapped to the apply configurations
+# of client-go.
+${PREFIX}/applyconfiguration-gen \
+  --external-applyconfigurations k8s.io/apimachinery/pkg/runtime.TypeMeta:k8s.io/client-go/applyconfigurations/meta/v1,k8s.io/api/core/v1.ContainerState:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.ContainerStateWaiting:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.ContainerStateRunning:k8s.io/client-go/applyconfigurations/core/v1,k8s.io/api/core/v1.ContainerStateTerminated:k8s.io/client-go/applyconfigurations/core/v1 \
+  --output-dir ${REPO_ROOT_DIR}/pkg/client/applyconfiguration \
+  --output-pkg github.com/tektoncd/pipeline/pkg/client/applyconfiguration \
+  --go-header-file ${REPO_ROOT_DIR}/hack/boilerplate/boilerplate.go.txt \
+  github.com/tektoncd/pipeline/pkg/apis/pipeline/v1 \
+  github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1 \
+  knative.dev/pkg/apis/duck/v1 \
+  github.com/tektoncd/pipeline/pkg/apis/resource/v1alpha1
 # This generates deepcopy,client,informer and lister for the resolution package (v1alpha1, v1beta1)
 bash ${REPO_ROOT_DIR}/hack/generate-groups.sh "deepcopy,client,informer,lister" \
   github.com/tektoncd/pipeline/pkg/client/resolution github.com/tektoncd/pipeline/pkg/apis \
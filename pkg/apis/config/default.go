@@ -44,6 +44,8 @@ const (
 	DefaultCloudEventSinkValue = ""
 	// DefaultMaxMatrixCombinationsCount is used when no max matrix combinations count is specified.
 	DefaultMaxMatrixCombinationsCount = 256
+	// DefaultPipelineRunHistoryLimit is used when no PipelineRun history limit is specified. 0 keeps all the PipelineRuns.
+	DefaultPipelineRunHistoryLimit = 0
 	// DefaultResolverTypeValue is used when no default resolver type is specified
 	DefaultResolverTypeValue = ""
 	// default resource requirements, will be applied to all the containers, which has empty resource requirements
@@ -54,6 +56,9 @@ const (
 	// Default maximum resolution timeout used by the resolution controller before timing out when exceeded
 	DefaultMaximumResolutionTimeout = 1 * time.Minute
 
+	// DefaultPipelineRunCleanupTimeout is how long the deletion of a PipelineRun waits for its cleanup finally tasks
+	DefaultPipelineRunCleanupTimeout = 1 * time.Minute
+
 	defaultTimeoutMinutesKey                = "default-timeout-minutes"
 	defaultServiceAccountKey                = "default-service-account"
 	defaultManagedByLabelValueKey           = "default-managed-by-label-value"
@@ -67,6 +72,8 @@ const (
 	defaultContainerResourceRequirementsKey = "default-container-resource-requirements"
 	defaultImagePullBackOffTimeout          = "default-imagepullbackoff-timeout"
 	defaultMaximumResolutionTimeout         = "default-maximum-resolution-timeout"
+	defaultPipelineRunHistoryLimitKey       = "default-pipelinerun-history-limit"
+	defaultPipelineRunCleanupTimeoutKey     = "default-pipelinerun-cleanup-timeout"
 )
 
 // DefaultConfig holds all the default configurations for the config.
@@ -88,6 +95,8 @@ type Defaults struct {
 	DefaultContainerResourceRequirements map[string]corev1.ResourceRequirements
 	DefaultImagePullBackOffTimeout       time.Duration
 	DefaultMaximumResolutionTimeout      time.Duration
+	DefaultPipelineRunHistoryLimit       int
+	DefaultPipelineRunCleanupTimeout     time.Duration
 }
 
 // GetDefaultsConfigName returns the name of the configmap containing all
@@ -120,6 +129,8 @@ func (cfg *Defaults) Equals(other *Defaults) bool {
 		other.DefaultResolverType == cfg.DefaultResolverType &&
 		other.DefaultImagePullBackOffTimeout == cfg.DefaultImagePullBackOffTimeout &&
 		other.DefaultMaximumResolutionTimeout == cfg.DefaultMaximumResolutionTimeout &&
+		other.DefaultPipelineRunHistoryLimit == cfg.DefaultPipelineRunHistoryLimit &&
+		other.DefaultPipelineRunCleanupTimeout == cfg.DefaultPipelineRunCleanupTimeout &&
 		reflect.DeepEqual(other.DefaultForbiddenEnv, cfg.DefaultForbiddenEnv)
 }
 
@@ -134,6 +145,8 @@ func NewDefaultsFromMap(cfgMap map[string]string) (*Defaults, error) {
 		DefaultResolverType:               DefaultResolverTypeValue,
 		DefaultImagePullBackOffTimeout:    DefaultImagePullBackOffTimeout,
 		DefaultMaximumResolutionTimeout:   DefaultMaximumResolutionTimeout,
+		DefaultPipelineRunHistoryLimit:    DefaultPipelineRunHistoryLimit,
+		DefaultPipelineRunCleanupTimeout:  DefaultPipelineRunCleanupTimeout,
 	}
 
 	if defaultTimeoutMin, ok := cfgMap[defaultTimeoutMinutesKey]; ok {
@@ -220,6 +233,22 @@ func NewDefaultsFromMap(cfgMap map[string]string) (*Defaults, error) {
 		tc.DefaultMaximumResolutionTimeout = timeout
 	}
 
+	if defaultPipelineRunHistoryLimit, ok := cfgMap[defaultPipelineRunHistoryLimitKey]; ok {
+		limit, err := strconv.ParseInt(defaultPipelineRunHistoryLimit, 10, 0)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("failed parsing default config %q", defaultPipelineRunHistoryLimitKey)
+		}
+		tc.DefaultPipelineRunHistoryLimit = int(limit)
+	}
+
+	if defaultPipelineRunCleanupTimeout, ok := cfgMap[defaultPipelineRunCleanupTimeoutKey]; ok {
+		timeout, err := time.ParseDuration(defaultPipelineRunCleanupTimeout)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("failed parsing default config %q", defaultPipelineRunCleanupTimeoutKey)
+		}
+		tc.DefaultPipelineRunCleanupTimeout = timeout
+	}
+
 	return &tc, nil
 }
 
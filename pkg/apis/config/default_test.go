@@ -46,6 +46,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultResolverType:               "git",
 				DefaultImagePullBackOffTimeout:    time.Duration(5) * time.Second,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 			},
 			fileName: config.GetDefaultsConfigName(),
 		},
@@ -67,6 +68,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultMaxMatrixCombinationsCount: 256,
 				DefaultImagePullBackOffTimeout:    0,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 			},
 			fileName: "config-defaults-with-pod-template",
 		},
@@ -91,6 +93,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultMaxMatrixCombinationsCount: 256,
 				DefaultImagePullBackOffTimeout:    0,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 			},
 		},
 		{
@@ -104,6 +107,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultMaxMatrixCombinationsCount: 256,
 				DefaultImagePullBackOffTimeout:    0,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 			},
 		},
 		{
@@ -120,6 +124,42 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultManagedByLabelValue:        config.DefaultManagedByLabelValue,
 				DefaultImagePullBackOffTimeout:    0,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
+			},
+		},
+		{
+			expectedError: true,
+			fileName:      "config-defaults-pipelinerun-history-limit-err",
+		},
+		{
+			expectedError: false,
+			fileName:      "config-defaults-pipelinerun-history-limit",
+			expectedConfig: &config.Defaults{
+				DefaultTimeoutMinutes:             60,
+				DefaultServiceAccount:             "default",
+				DefaultManagedByLabelValue:        config.DefaultManagedByLabelValue,
+				DefaultMaxMatrixCombinationsCount: 256,
+				DefaultImagePullBackOffTimeout:    0,
+				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
+				DefaultPipelineRunHistoryLimit:    5,
+			},
+		},
+		{
+			expectedError: true,
+			fileName:      "config-defaults-pipelinerun-cleanup-timeout-err",
+		},
+		{
+			expectedError: false,
+			fileName:      "config-defaults-pipelinerun-cleanup-timeout",
+			expectedConfig: &config.Defaults{
+				DefaultTimeoutMinutes:             60,
+				DefaultServiceAccount:             "default",
+				DefaultManagedByLabelValue:        config.DefaultManagedByLabelValue,
+				DefaultMaxMatrixCombinationsCount: 256,
+				DefaultImagePullBackOffTimeout:    0,
+				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  30 * time.Second,
 			},
 		},
 		{
@@ -133,6 +173,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultForbiddenEnv:               []string{"TEKTON_POWER_MODE", "TEST_ENV", "TEST_TEKTON"},
 				DefaultImagePullBackOffTimeout:    time.Duration(15) * time.Second,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 			},
 		},
 		{
@@ -146,6 +187,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultContainerResourceRequirements: map[string]corev1.ResourceRequirements{},
 				DefaultImagePullBackOffTimeout:       0,
 				DefaultMaximumResolutionTimeout:      1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:     1 * time.Minute,
 			},
 		},
 		{
@@ -162,6 +204,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultMaxMatrixCombinationsCount: 256,
 				DefaultImagePullBackOffTimeout:    0,
 				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 				DefaultContainerResourceRequirements: map[string]corev1.ResourceRequirements{
 					config.ResourceRequirementDefaultContainerKey: {
 						Requests: corev1.ResourceList{
@@ -219,6 +262,7 @@ func TestNewDefaultsFromEmptyConfigMap(t *testing.T) {
 		DefaultMaxMatrixCombinationsCount: 256,
 		DefaultImagePullBackOffTimeout:    0,
 		DefaultMaximumResolutionTimeout:   1 * time.Minute,
+		DefaultPipelineRunCleanupTimeout:  1 * time.Minute,
 	}
 	verifyConfigFileWithExpectedConfig(t, DefaultsConfigEmptyName, expectedConfig)
 }
@@ -389,19 +433,23 @@ func TestEquals(t *testing.T) {
 		{
 			name: "different default maximum resolution timeout",
 			left: &config.Defaults{
-				DefaultMaximumResolutionTimeout: 10 * time.Minute,
+				DefaultMaximumResolutionTimeout:  10 * time.Minute,
+				DefaultPipelineRunCleanupTimeout: 1 * time.Minute,
 			},
 			right: &config.Defaults{
-				DefaultMaximumResolutionTimeout: 20 * time.Minute,
+				DefaultMaximumResolutionTimeout:  20 * time.Minute,
+				DefaultPipelineRunCleanupTimeout: 1 * time.Minute,
 			},
 			expected: false,
 		}, {
 			name: "same default maximum resolution timeout",
 			left: &config.Defaults{
-				DefaultMaximumResolutionTimeout: 10 * time.Minute,
+				DefaultMaximumResolutionTimeout:  10 * time.Minute,
+				DefaultPipelineRunCleanupTimeout: 1 * time.Minute,
 			},
 			right: &config.Defaults{
-				DefaultMaximumResolutionTimeout: 10 * time.Minute,
+				DefaultMaximumResolutionTimeout:  10 * time.Minute,
+				DefaultPipelineRunCleanupTimeout: 1 * time.Minute,
 			},
 			expected: true,
 		},
@@ -103,6 +103,8 @@ const (
 	EnableParamEnum = "enable-param-enum"
 	// EnableConciseResolverSyntax is the flag to enable concise resolver syntax
 	EnableConciseResolverSyntax = "enable-concise-resolver-syntax"
+	// EnableAuditContextVariables is the flag to enable the PipelineRun creationTimestamp and generation context variables
+	EnableAuditContextVariables = "enable-audit-context-variables"
 	// EnableKubernetesSidecar is the flag to enable kubernetes sidecar support
 	EnableKubernetesSidecar = "enable-kubernetes-sidecar"
 	// DefaultEnableKubernetesSidecar is the default value for EnableKubernetesSidecar
@@ -170,6 +172,13 @@ var (
 		Stability: AlphaAPIFields,
 		Enabled:   DefaultAlphaFeatureEnabled,
 	}
+
+	// DefaultEnableAuditContextVariables is the default PerFeatureFlag value for EnableAuditContextVariables
+	DefaultEnableAuditContextVariables = PerFeatureFlag{
+		Name:      EnableAuditContextVariables,
+		Stability: AlphaAPIFields,
+		Enabled:   DefaultAlphaFeatureEnabled,
+	}
 )
 
 // FeatureFlags holds the features configurations
@@ -204,6 +213,7 @@ type FeatureFlags struct {
 	DisableInlineSpec           string `json:"disableInlineSpec,omitempty"`
 	EnableConciseResolverSyntax bool   `json:"enableConciseResolverSyntax,omitempty"`
 	EnableKubernetesSidecar     bool   `json:"enableKubernetesSidecar,omitempty"`
+	EnableAuditContextVariables bool   `json:"enableAuditContextVariables,omitempty"`
 }
 
 // GetFeatureFlagsConfigName returns the name of the configmap containing all
@@ -308,6 +318,9 @@ func NewFeatureFlagsFromMap(cfgMap map[string]string) (*FeatureFlags, error) {
 	if err := setFeature(EnableKubernetesSidecar, DefaultEnableKubernetesSidecar, &tc.EnableKubernetesSidecar); err != nil {
 		return nil, err
 	}
+	if err := setPerFeatureFlag(EnableAuditContextVariables, DefaultEnableAuditContextVariables, &tc.EnableAuditContextVariables); err != nil {
+		return nil, err
+	}
 
 	return &tc, nil
 }
@@ -34,6 +34,7 @@ func TestStoreLoadWithContext(t *testing.T) {
 	spireConfig := test.ConfigMapFromTestFile(t, "config-spire")
 	eventsConfig := test.ConfigMapFromTestFile(t, "config-events")
 	tracingConfig := test.ConfigMapFromTestFile(t, "config-tracing")
+	substitutionPolicyConfig := test.ConfigMapFromTestFile(t, "config-substitution-policy")
 
 	expectedDefaults, _ := config.NewDefaultsFromConfigMap(defaultConfig)
 	expectedFeatures, _ := config.NewFeatureFlagsFromConfigMap(featuresConfig)
@@ -41,14 +42,16 @@ func TestStoreLoadWithContext(t *testing.T) {
 	expectedSpireConfig, _ := config.NewSpireConfigFromConfigMap(spireConfig)
 	expectedEventsConfig, _ := config.NewEventsFromConfigMap(eventsConfig)
 	expectedTracingConfig, _ := config.NewTracingFromConfigMap(tracingConfig)
+	expectedSubstitutionPolicy, _ := config.NewSubstitutionPolicyFromConfigMap(substitutionPolicyConfig)
 
 	expected := &config.Config{
-		Defaults:     expectedDefaults,
-		FeatureFlags: expectedFeatures,
-		Metrics:      metrics,
-		SpireConfig:  expectedSpireConfig,
-		Events:       expectedEventsConfig,
-		Tracing:      expectedTracingConfig,
+		Defaults:           expectedDefaults,
+		FeatureFlags:       expectedFeatures,
+		Metrics:            metrics,
+		SpireConfig:        expectedSpireConfig,
+		Events:             expectedEventsConfig,
+		Tracing:            expectedTracingConfig,
+		SubstitutionPolicy: expectedSubstitutionPolicy,
 	}
 
 	store := config.NewStore(logtesting.TestLogger(t))
@@ -58,6 +61,7 @@ func TestStoreLoadWithContext(t *testing.T) {
 	store.OnConfigChanged(spireConfig)
 	store.OnConfigChanged(eventsConfig)
 	store.OnConfigChanged(tracingConfig)
+	store.OnConfigChanged(substitutionPolicyConfig)
 
 	cfg := config.FromContext(store.ToContext(context.Background()))
 
@@ -68,12 +72,13 @@ func TestStoreLoadWithContext(t *testing.T) {
 
 func TestStoreLoadWithContext_Empty(t *testing.T) {
 	want := &config.Config{
-		Defaults:     config.DefaultConfig.DeepCopy(),
-		FeatureFlags: config.DefaultFeatureFlags.DeepCopy(),
-		Metrics:      config.DefaultMetrics.DeepCopy(),
-		SpireConfig:  config.DefaultSpire.DeepCopy(),
-		Events:       config.DefaultEvents.DeepCopy(),
-		Tracing:      config.DefaultTracing.DeepCopy(),
+		Defaults:           config.DefaultConfig.DeepCopy(),
+		FeatureFlags:       config.DefaultFeatureFlags.DeepCopy(),
+		Metrics:            config.DefaultMetrics.DeepCopy(),
+		SpireConfig:        config.DefaultSpire.DeepCopy(),
+		Events:             config.DefaultEvents.DeepCopy(),
+		Tracing:            config.DefaultTracing.DeepCopy(),
+		SubstitutionPolicy: config.DefaultSubstitutionPolicy.DeepCopy(),
 	}
 
 	store := config.NewStore(logtesting.TestLogger(t))
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	allowedFieldsKey          = "allowed-fields"
+	deniedVariablePatternsKey = "denied-variable-patterns"
+)
+
+var (
+	// SubstitutionPolicyFields are the fields of the steps, step template and sidecars of a Task which
+	// can be listed in the allowed fields of a SubstitutionPolicy.
+	SubstitutionPolicyFields = []string{
+		"steps[].image", "steps[].script", "steps[].command", "steps[].args", "steps[].env", "steps[].workingDir",
+		"stepTemplate.image", "stepTemplate.command", "stepTemplate.args", "stepTemplate.env", "stepTemplate.workingDir",
+		"sidecars[].image", "sidecars[].script", "sidecars[].command", "sidecars[].args", "sidecars[].env", "sidecars[].workingDir",
+	}
+
+	// DefaultSubstitutionPolicy is the default SubstitutionPolicy, which does not deny any substitution.
+	DefaultSubstitutionPolicy, _ = NewSubstitutionPolicyFromMap(map[string]string{})
+)
+
+// SubstitutionPolicy holds the substitution policy configurations. The variables matching one of the
+// DeniedVariablePatterns can only be substituted in the AllowedFields, e.g. to prevent
+// $(context.pipelineRun.name) from being substituted in the scripts of the steps.
+// +k8s:deepcopy-gen=true
+type SubstitutionPolicy struct {
+	// AllowedFields are the fields, among SubstitutionPolicyFields, in which the denied variables can
+	// still be substituted.
+	AllowedFields []string
+	// DeniedVariablePatterns are the regular expressions matching the denied variables, e.g.
+	// ^context\.pipelineRun\.name$ for $(context.pipelineRun.name).
+	DeniedVariablePatterns []string
+}
+
+// GetSubstitutionPolicyConfigName returns the name of the configmap containing the substitution policy.
+func GetSubstitutionPolicyConfigName() string {
+	if e := os.Getenv("CONFIG_SUBSTITUTION_POLICY_NAME"); e != "" {
+		return e
+	}
+	return "config-substitution-policy"
+}
+
+// NewSubstitutionPolicyFromMap returns a SubstitutionPolicy given a map corresponding to a ConfigMap.
+// The allowed fields and the denied variable patterns are listed one per line.
+func NewSubstitutionPolicyFromMap(cfgMap map[string]string) (*SubstitutionPolicy, error) {
+	policy := SubstitutionPolicy{
+		AllowedFields:          splitLines(cfgMap[allowedFieldsKey]),
+		DeniedVariablePatterns: splitLines(cfgMap[deniedVariablePatternsKey]),
+	}
+	for _, field := range policy.AllowedFields {
+		if !slices.Contains(SubstitutionPolicyFields, field) {
+			return nil, fmt.Errorf("invalid %s %q, must be one of %s", allowedFieldsKey, field, strings.Join(SubstitutionPolicyFields, ", "))
+		}
+	}
+	for _, pattern := range policy.DeniedVariablePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", deniedVariablePatternsKey, pattern, err)
+		}
+	}
+	return &policy, nil
+}
+
+// NewSubstitutionPolicyFromConfigMap returns a SubstitutionPolicy for the given configmap
+func NewSubstitutionPolicyFromConfigMap(config *corev1.ConfigMap) (*SubstitutionPolicy, error) {
+	return NewSubstitutionPolicyFromMap(config.Data)
+}
+
+// Check returns an error if the variable, e.g. context.pipelineRun.name, is denied in the field, e.g. steps[].script.
+func (p *SubstitutionPolicy) Check(field, variable string) error {
+	if p == nil || slices.Contains(p.AllowedFields, field) {
+		return nil
+	}
+	for _, pattern := range p.DeniedVariablePatterns {
+		// the patterns are validated when the policy is created
+		if re, err := regexp.Compile(pattern); err == nil && re.MatchString(variable) {
+			return fmt.Errorf("substituting $(%s) in %s is denied by the substitution policy", variable, field)
+		}
+	}
+	return nil
+}
+
+// Equals returns true if two SubstitutionPolicies are identical
+func (p *SubstitutionPolicy) Equals(other *SubstitutionPolicy) bool {
+	if p == nil && other == nil {
+		return true
+	}
+
+	if p == nil || other == nil {
+		return false
+	}
+
+	return slices.Equal(p.AllowedFields, other.AllowedFields) &&
+		slices.Equal(p.DeniedVariablePatterns, other.DeniedVariablePatterns)
+}
+
+// splitLines returns the non-empty lines of s, without leading and trailing white spaces.
+func splitLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
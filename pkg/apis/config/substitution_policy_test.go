@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	test "github.com/tektoncd/pipeline/pkg/reconciler/testing"
+	"github.com/tektoncd/pipeline/test/diff"
+)
+
+func TestNewSubstitutionPolicyFromConfigMap(t *testing.T) {
+	for _, tc := range []struct {
+		description    string
+		expectedConfig *config.SubstitutionPolicy
+		expectedError  bool
+		fileName       string
+	}{{
+		description: "get substitution policy config name",
+		expectedConfig: &config.SubstitutionPolicy{
+			AllowedFields:          []string{"steps[].env", "sidecars[].env"},
+			DeniedVariablePatterns: []string{`^context\.pipelineRun\.name$`, `^context\.taskRun\.`},
+		},
+		fileName: config.GetSubstitutionPolicyConfigName(),
+	}, {
+		description:    "test defaults",
+		expectedConfig: config.DefaultSubstitutionPolicy,
+		fileName:       "config-substitution-policy-empty",
+	}, {
+		description:   "invalid allowed field",
+		expectedError: true,
+		fileName:      "config-substitution-policy-invalid-field",
+	}, {
+		description:   "invalid denied variable pattern",
+		expectedError: true,
+		fileName:      "config-substitution-policy-invalid-pattern",
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			cm := test.ConfigMapFromTestFile(t, tc.fileName)
+			policy, err := config.NewSubstitutionPolicyFromConfigMap(cm)
+			if d := cmp.Diff(tc.expectedError, err != nil); d != "" {
+				t.Errorf("Diff(-want,+got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.expectedConfig, policy); d != "" {
+				t.Errorf("Diff(-want,+got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestSubstitutionPolicyCheck(t *testing.T) {
+	policy := &config.SubstitutionPolicy{
+		AllowedFields:          []string{"steps[].env"},
+		DeniedVariablePatterns: []string{`^context\.pipelineRun\.name$`},
+	}
+	for _, tc := range []struct {
+		name     string
+		policy   *config.SubstitutionPolicy
+		field    string
+		variable string
+		wantErr  string
+	}{{
+		name:     "denied variable in a denied field",
+		policy:   policy,
+		field:    "steps[].script",
+		variable: "context.pipelineRun.name",
+		wantErr:  "substituting $(context.pipelineRun.name) in steps[].script is denied by the substitution policy",
+	}, {
+		name:     "denied variable in an allowed field",
+		policy:   policy,
+		field:    "steps[].env",
+		variable: "context.pipelineRun.name",
+	}, {
+		name:     "variable not denied",
+		policy:   policy,
+		field:    "steps[].script",
+		variable: "context.pipelineRun.namespace",
+	}, {
+		name:     "nil policy",
+		field:    "steps[].script",
+		variable: "context.pipelineRun.name",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Check(tc.field, tc.variable)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", tc.wantErr)
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestSubstitutionPolicyEquals(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		left     *config.SubstitutionPolicy
+		right    *config.SubstitutionPolicy
+		expected bool
+	}{{
+		name:     "left and right nil",
+		expected: true,
+	}, {
+		name:     "left nil",
+		right:    &config.SubstitutionPolicy{},
+		expected: false,
+	}, {
+		name:     "different patterns",
+		left:     &config.SubstitutionPolicy{DeniedVariablePatterns: []string{"^context\\."}},
+		right:    &config.SubstitutionPolicy{DeniedVariablePatterns: []string{"^params\\."}},
+		expected: false,
+	}, {
+		name: "identical",
+		left: &config.SubstitutionPolicy{
+			AllowedFields:          []string{"steps[].env"},
+			DeniedVariablePatterns: []string{"^context\\."},
+		},
+		right: &config.SubstitutionPolicy{
+			AllowedFields:          []string{"steps[].env"},
+			DeniedVariablePatterns: []string{"^context\\."},
+		},
+		expected: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.left.Equals(tc.right); got != tc.expected {
+				t.Errorf("Equals() = %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
@@ -119,6 +119,32 @@ func (in *Metrics) DeepCopy() *Metrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubstitutionPolicy) DeepCopyInto(out *SubstitutionPolicy) {
+	*out = *in
+	if in.AllowedFields != nil {
+		in, out := &in.AllowedFields, &out.AllowedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedVariablePatterns != nil {
+		in, out := &in.DeniedVariablePatterns, &out.DeniedVariablePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubstitutionPolicy.
+func (in *SubstitutionPolicy) DeepCopy() *SubstitutionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SubstitutionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tracing) DeepCopyInto(out *Tracing) {
 	*out = *in
@@ -55,6 +55,38 @@ const (
 	// MemberOfLabelKey is used as the label identifier for a PipelineTask
 	// Set to Tasks/Finally depending on the position of the PipelineTask
 	MemberOfLabelKey = GroupName + "/memberOf"
+
+	// PruneHistoryAnnotationKey is used as the annotation identifier for a Pipeline whose finished
+	// PipelineRuns are pruned beyond the default-pipelinerun-history-limit
+	PruneHistoryAnnotationKey = GroupName + "/prune-history"
+
+	// CleanupLabelKey is used as the label identifier for the finally tasks which run even when their
+	// PipelineRun is deleted before completing
+	CleanupLabelKey = GroupName + "/cleanup"
+
+	// PipelineRunCleanupFinalizer is the finalizer holding the deletion of a PipelineRun until its cleanup
+	// finally tasks are done or the default-pipelinerun-cleanup-timeout elapsed
+	PipelineRunCleanupFinalizer = GroupName + "/pipelinerun-cleanup"
+
+	// PropagateLabelsAnnotationKey is used as the annotation identifier for a Pipeline that only propagates
+	// the comma-separated list of labels it contains to its PipelineRuns
+	PropagateLabelsAnnotationKey = GroupName + "/propagate-labels"
+
+	// PipelineLabelsPropagatedAnnotationKey is used as the annotation identifier for a PipelineRun whose
+	// Pipeline labels were propagated by the webhook when it was created
+	PipelineLabelsPropagatedAnnotationKey = GroupName + "/pipeline-labels-propagated"
+
+	// ParamHashesAnnotationKey is used as the annotation identifier for the hashes of the params of a
+	// PipelineRun, to detect the updates of its params
+	ParamHashesAnnotationKey = GroupName + "/param-hashes"
+
+	// ParamConsumersAnnotationKey is used as the annotation identifier for the names of the PipelineTasks which
+	// reference each param of a PipelineRun, to reject the updates of the params used by the started PipelineTasks
+	ParamConsumersAnnotationKey = GroupName + "/param-consumers"
+
+	// StoreResolvedSpecAnnotationKey is used as the annotation identifier for storing the PipelineSpec of a
+	// PipelineRun after all the substitutions in its status, when set to "true"
+	StoreResolvedSpecAnnotationKey = GroupName + "/store-resolved-spec"
 )
 
 var (
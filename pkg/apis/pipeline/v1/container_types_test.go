@@ -79,6 +79,56 @@ func TestStepGetVarSubstitutionExpressions(t *testing.T) {
 	}
 }
 
+func TestStepTemplateGetVarSubstitutionExpressions(t *testing.T) {
+	s := StepTemplate{
+		Image:           "$(tasks.task1.results.imageResult)",
+		ImagePullPolicy: corev1.PullPolicy("$(tasks.task1.results.imagePullPolicy)"),
+		WorkingDir:      "$(tasks.task1.results.workingDir)",
+		Command: []string{
+			"$(tasks.task2.results.command[*])",
+		},
+		Args: []string{
+			"$(tasks.task2.results.args[*])",
+		},
+		Env: []corev1.EnvVar{
+			{
+				Name:  "env1",
+				Value: "$(tasks.task2.results.env1)",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key: "$(tasks.task2.results.secretKeyRef)",
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "$(tasks.task2.results.secretNameRef)",
+						},
+					},
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						Key: "$(tasks.task2.results.configMapKeyRef)",
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "$(tasks.task2.results.configMapNameRef)",
+						},
+					},
+				},
+			},
+		},
+	}
+	subRefExpressions := s.GetVarSubstitutionExpressions()
+	wantRefExpressions := []string{
+		"tasks.task1.results.imageResult",
+		"tasks.task1.results.imagePullPolicy",
+		"tasks.task1.results.workingDir",
+		"tasks.task2.results.command[*]",
+		"tasks.task2.results.args[*]",
+		"tasks.task2.results.env1",
+		"tasks.task2.results.secretKeyRef",
+		"tasks.task2.results.secretNameRef",
+		"tasks.task2.results.configMapKeyRef",
+		"tasks.task2.results.configMapNameRef",
+	}
+	if d := cmp.Diff(wantRefExpressions, subRefExpressions); d != "" {
+		t.Fatalf("Unexpected result (-want, +got): %s", d)
+	}
+}
+
 func TestSidecarGetVarSubstitutionExpressions(t *testing.T) {
 	s := Sidecar{
 		Name:            "$(tasks.task1.results.sidecarName)",
@@ -925,6 +925,15 @@ func TestPipelineTask_CountCombinations(t *testing.T) {
 				Name: "version", Value: v1.ParamValue{StringVal: "$(tasks.platforms.results.str[*])"}},
 			}},
 		want: 3,
+	}, {
+		name: "object parameter does not generate combinations",
+		matrix: &v1.Matrix{
+			Params: v1.Params{{
+				Name: "foo", Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"foo", "bar"}},
+			}, {
+				Name: "obj", Value: v1.ParamValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{"key1": "a", "key2": "b", "key3": "c"}},
+			}}},
+		want: 2,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
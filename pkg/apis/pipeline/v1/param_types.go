@@ -26,6 +26,7 @@ import (
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/utils/strings/slices"
 	"knative.dev/pkg/apis"
 )
@@ -61,6 +62,23 @@ type ParamSpec struct {
 	// If Enum is not set, no input validation is performed for the param.
 	// +optional
 	Enum []string `json:"enum,omitempty"`
+	// Deprecated marks the parameter as deprecated, e.g. when it is renamed. A
+	// PipelineRun providing a deprecated parameter of its Pipeline gets a warning.
+	// +optional
+	Deprecated *ParamDeprecation `json:"deprecated,omitempty"`
+}
+
+// ParamDeprecation describes the deprecation of a parameter.
+type ParamDeprecation struct {
+	// Message tells the users what to do instead of providing the parameter,
+	// e.g. "use newParam instead".
+	// +optional
+	Message string `json:"message,omitempty"`
+	// RemovedIn is the version of the Pipeline in which the parameter is removed.
+	// The PipelineRuns providing the parameter fail once the version in the
+	// app.kubernetes.io/version label of the Pipeline is the same or later.
+	// +optional
+	RemovedIn string `json:"removedIn,omitempty"`
 }
 
 // ParamSpecs is a list of ParamSpec
@@ -174,6 +192,36 @@ func (ps ParamSpecs) validateParamEnums(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+// validateParamDeprecations validates that the versions in which the deprecated params are removed can be parsed
+func (ps ParamSpecs) validateParamDeprecations() *apis.FieldError {
+	var errs *apis.FieldError
+	for _, p := range ps {
+		if p.Deprecated == nil || p.Deprecated.RemovedIn == "" {
+			continue
+		}
+		if _, err := version.ParseGeneric(p.Deprecated.RemovedIn); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(p.Deprecated.RemovedIn, "deprecated.removedIn", "removedIn must be a version, e.g. v1.5").ViaKey(p.Name))
+		}
+	}
+	return errs
+}
+
+// DeprecationWarning returns the warning given to the users providing the param when it is deprecated, and an
+// empty string otherwise.
+func (pp *ParamSpec) DeprecationWarning() string {
+	if pp.Deprecated == nil {
+		return ""
+	}
+	warning := fmt.Sprintf("parameter %q is deprecated", pp.Name)
+	if pp.Deprecated.RemovedIn != "" {
+		warning += " and will be removed in " + pp.Deprecated.RemovedIn
+	}
+	if pp.Deprecated.Message != "" {
+		warning += ": " + pp.Deprecated.Message
+	}
+	return warning
+}
+
 // findDups returns the duplicate element in the given slice
 func findDups(vals []string) sets.String {
 	seen := sets.String{}
@@ -291,6 +339,26 @@ func (ps Params) ExtractParamArrayLengths() map[string]int {
 	return arrayParamsLengths
 }
 
+// Merge returns a copy of the Params in which the Params of other replace the Params with the same
+// name, e.g. the run-time params overriding the defaults. The Params of other which are not in ps are
+// appended in order.
+func (ps Params) Merge(other Params) Params {
+	merged := ps.DeepCopy()
+	index := make(map[string]int, len(merged))
+	for i, p := range merged {
+		index[p.Name] = i
+	}
+	for _, p := range other.DeepCopy() {
+		if i, ok := index[p.Name]; ok {
+			merged[i] = p
+			continue
+		}
+		index[p.Name] = len(merged)
+		merged = append(merged, p)
+	}
+	return merged
+}
+
 // validateDuplicateParameters checks if a parameter with the same name is defined more than once
 func (ps Params) validateDuplicateParameters() (errs *apis.FieldError) {
 	taskParamNames := sets.NewString()
@@ -313,6 +381,41 @@ func (ps Params) ReplaceVariables(stringReplacements map[string]string, arrayRep
 	return params
 }
 
+// ConvertToReplacementMaps returns the string, array and object replacements for the values of the Params. Each
+// Param is referenced with each of the patterns, e.g. "params.%s" or "params[%q]", formatted with its name. The
+// elements of array Params are also referenced by index, e.g. params.foo[0], and the keys of object Params
+// individually, e.g. params.foo.bar.
+func (ps Params) ConvertToReplacementMaps(patterns []string) (map[string]string, map[string][]string, map[string]map[string]string) {
+	stringReplacements := map[string]string{}
+	arrayReplacements := map[string][]string{}
+	objectReplacements := map[string]map[string]string{}
+	for _, p := range ps {
+		switch p.Value.Type {
+		case ParamTypeArray:
+			for _, pattern := range patterns {
+				for i := range len(p.Value.ArrayVal) {
+					stringReplacements[fmt.Sprintf(pattern+"[%d]", p.Name, i)] = p.Value.ArrayVal[i]
+				}
+				arrayReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.ArrayVal
+			}
+		case ParamTypeObject:
+			for _, pattern := range patterns {
+				objectReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.ObjectVal
+			}
+			for k, v := range p.Value.ObjectVal {
+				stringReplacements[fmt.Sprintf("%s.%s.%s", ParamsPrefix, p.Name, k)] = v
+			}
+		case ParamTypeString:
+			fallthrough
+		default:
+			for _, pattern := range patterns {
+				stringReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.StringVal
+			}
+		}
+	}
+	return stringReplacements, arrayReplacements, objectReplacements
+}
+
 // ExtractDefaultParamArrayLengths extract and return the lengths of all array params
 // Example of returned value: {"a-array-params": 2,"b-array-params": 2 }
 func (ps ParamSpecs) ExtractDefaultParamArrayLengths() map[string]int {
@@ -293,6 +293,16 @@ func TestParamValues_ApplyReplacements(t *testing.T) {
 			"key1": "foo",
 			"key2": "bar",
 		}),
+	}, {
+		name: "multi-line and delimiter-containing replacements are substituted once",
+		args: args{
+			input: v1.NewStructuredValues("$(first)\n$(second)"),
+			stringReplacements: map[string]string{
+				"first":  "-----BEGIN CERTIFICATE-----\nMIIB)\n-----END CERTIFICATE-----",
+				"second": "$(first) $(",
+			},
+		},
+		expectedOutput: v1.NewStructuredValues("-----BEGIN CERTIFICATE-----\nMIIB)\n-----END CERTIFICATE-----\n$(first) $("),
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -612,6 +622,84 @@ func TestExtractParamArrayLengths(t *testing.T) {
 	}
 }
 
+func TestParams_Merge(t *testing.T) {
+	tcs := []struct {
+		name  string
+		ps    v1.Params
+		other v1.Params
+		want  v1.Params
+	}{{
+		name: "nothing to merge",
+		ps:   v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("bar")}},
+		want: v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("bar")}},
+	}, {
+		name:  "other overrides params with the same name",
+		ps:    v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("default")}, {Name: "list", Value: *v1.NewStructuredValues("a", "b")}},
+		other: v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("provided")}},
+		want:  v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("provided")}, {Name: "list", Value: *v1.NewStructuredValues("a", "b")}},
+	}, {
+		name:  "params only in other are appended in order",
+		ps:    v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("bar")}},
+		other: v1.Params{{Name: "obj", Value: *v1.NewObject(map[string]string{"k": "v"})}, {Name: "baz", Value: *v1.NewStructuredValues("qux")}},
+		want: v1.Params{
+			{Name: "foo", Value: *v1.NewStructuredValues("bar")},
+			{Name: "obj", Value: *v1.NewObject(map[string]string{"k": "v"})},
+			{Name: "baz", Value: *v1.NewStructuredValues("qux")},
+		},
+	}, {
+		name:  "empty params",
+		other: v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("bar")}},
+		want:  v1.Params{{Name: "foo", Value: *v1.NewStructuredValues("bar")}},
+	}}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			before := tc.ps.DeepCopy()
+			got := tc.ps.Merge(tc.other)
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("Merge() %s", diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff(before, tc.ps); d != "" {
+				t.Errorf("Merge() modified the params %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestParams_ConvertToReplacementMaps(t *testing.T) {
+	params := v1.Params{
+		{Name: "str", Value: *v1.NewStructuredValues("a")},
+		{Name: "arr", Value: *v1.NewStructuredValues("b", "c")},
+		{Name: "obj", Value: *v1.NewObject(map[string]string{"key": "d"})},
+	}
+	wantStrings := map[string]string{
+		"params.str":         "a",
+		"params[\"str\"]":    "a",
+		"params.arr[0]":      "b",
+		"params.arr[1]":      "c",
+		"params[\"arr\"][0]": "b",
+		"params[\"arr\"][1]": "c",
+		"params.obj.key":     "d",
+	}
+	wantArrays := map[string][]string{
+		"params.arr":      {"b", "c"},
+		"params[\"arr\"]": {"b", "c"},
+	}
+	wantObjects := map[string]map[string]string{
+		"params.obj":      {"key": "d"},
+		"params[\"obj\"]": {"key": "d"},
+	}
+	gotStrings, gotArrays, gotObjects := params.ConvertToReplacementMaps([]string{"params.%s", "params[%q]"})
+	if d := cmp.Diff(wantStrings, gotStrings); d != "" {
+		t.Errorf("ConvertToReplacementMaps() string replacements %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(wantArrays, gotArrays); d != "" {
+		t.Errorf("ConvertToReplacementMaps() array replacements %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(wantObjects, gotObjects); d != "" {
+		t.Errorf("ConvertToReplacementMaps() object replacements %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestExtractDefaultParamArrayLengths(t *testing.T) {
 	tcs := []struct {
 		name   string
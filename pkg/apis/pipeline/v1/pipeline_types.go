@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"strings"
+
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/internal/checksum"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
@@ -150,6 +152,13 @@ type PipelineResult struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value ResultValue `json:"value"`
+
+	// Default is the value the result takes if a task it references was skipped
+	// and so did not produce the referenced result.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Default *ParamValue `json:"default,omitempty"`
 }
 
 // PipelineTaskMetadata contains the labels or annotations for an EmbeddedTask
@@ -223,6 +232,13 @@ type PipelineTask struct {
 	// +listType=atomic
 	RunAfter []string `json:"runAfter,omitempty"`
 
+	// DependsOnResults is the list of results of other PipelineTasks, in the form
+	// <pipelineTask>.<result>, that this Task depends on without consuming them.
+	// The Task is executed after the PipelineTasks producing these results.
+	// +optional
+	// +listType=atomic
+	DependsOnResults []string `json:"dependsOnResults,omitempty"`
+
 	// Parameters declares parameters passed to this task.
 	// +optional
 	// +listType=atomic
@@ -243,13 +259,20 @@ type PipelineTask struct {
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 
+	// TimeoutString is the time after which the TaskRun times out, expressed as a
+	// string that may reference params and results, e.g. $(tasks.profile.results.timeout).
+	// Variables are substituted and the value is parsed into Timeout before the
+	// TaskRun is created. It cannot be combined with Timeout.
+	// +optional
+	TimeoutString string `json:"timeoutString,omitempty"`
+
 	// PipelineRef is a reference to a pipeline definition
-	// Note: PipelineRef is in preview mode and not yet supported
+	// Note: PipelineRef is in preview mode, the pipeline is run in child PipelineRuns
 	// +optional
 	PipelineRef *PipelineRef `json:"pipelineRef,omitempty"`
 
 	// PipelineSpec is a specification of a pipeline
-	// Note: PipelineSpec is in preview mode and not yet supported
+	// Note: PipelineSpec is in preview mode, the pipeline is run in child PipelineRuns
 	// Specifying PipelineSpec can be disabled by setting
 	// `disable-inline-spec` feature flag.
 	// See Pipeline.spec (API version: tekton.dev/v1)
@@ -301,6 +324,12 @@ func (pt PipelineTask) Deps() []string {
 		deps.Insert(runAfter)
 	}
 
+	// add any new dependents from dependsOnResults - result dependency without substitution
+	for _, dependsOnResult := range pt.DependsOnResults {
+		taskName, _, _ := strings.Cut(dependsOnResult, ".")
+		deps.Insert(taskName)
+	}
+
 	return deps.List()
 }
 
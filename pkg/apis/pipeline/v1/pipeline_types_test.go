@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -156,6 +157,159 @@ func TestPipelineTask_OnError(t *testing.T) {
 	}
 }
 
+func TestPipelineTask_ValidateTimeoutString(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             PipelineTask
+		expectedError *apis.FieldError
+		wc            func(context.Context) context.Context
+	}{{
+		name: "valid duration",
+		p: PipelineTask{
+			Name:          "foo",
+			TimeoutString: "1h30m",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "result reference",
+		p: PipelineTask{
+			Name:          "foo",
+			TimeoutString: "$(tasks.profile.results.timeout)",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "param reference",
+		p: PipelineTask{
+			Name:          "foo",
+			TimeoutString: "$(params.timeout)m",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "invalid duration",
+		p: PipelineTask{
+			Name:          "foo",
+			TimeoutString: "forever",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrInvalidValue(`"forever" is not a valid duration: time: invalid duration "forever"`, "timeoutString"),
+		wc:            cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "negative duration",
+		p: PipelineTask{
+			Name:          "foo",
+			TimeoutString: "-1h",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrInvalidValue("-1h should be >= 0", "timeoutString"),
+		wc:            cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "timeout and timeoutString - failure",
+		p: PipelineTask{
+			Name:          "foo",
+			Timeout:       &metav1.Duration{Duration: time.Hour},
+			TimeoutString: "1h",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrMultipleOneOf("timeout", "timeoutString"),
+		wc:            cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "setting timeoutString in beta API version - failure",
+		p: PipelineTask{
+			Name:          "foo",
+			TimeoutString: "1h",
+			TaskRef:       &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrGeneric("timeoutString requires \"enable-api-fields\" feature gate to be \"alpha\" but it is \"beta\""),
+		wc:            cfgtesting.EnableBetaAPIFields,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.wc != nil {
+				ctx = tt.wc(ctx)
+			}
+			err := tt.p.Validate(ctx)
+			if tt.expectedError == nil {
+				if err != nil {
+					t.Errorf("PipelineTask.Validate() returned error for valid pipeline task: %v", err)
+				}
+			} else {
+				if err == nil {
+					t.Error("PipelineTask.Validate() did not return error for invalid pipeline task with timeoutString")
+				}
+				if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+					t.Errorf("PipelineTask.Validate() errors diff %s", diff.PrintWantGot(d))
+				}
+			}
+		})
+	}
+}
+
+func TestPipelineTask_ValidateDependsOnResults(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             PipelineTask
+		expectedError *apis.FieldError
+		wc            func(context.Context) context.Context
+	}{{
+		name: "valid result dependencies",
+		p: PipelineTask{
+			Name:             "foo",
+			DependsOnResults: []string{"build.digest", "scan.report.json"},
+			TaskRef:          &TaskRef{Name: "foo"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "missing result name",
+		p: PipelineTask{
+			Name:             "foo",
+			DependsOnResults: []string{"build.digest", "build"},
+			TaskRef:          &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrInvalidValue(`"build" must be of the form <pipelineTask>.<result>`, "dependsOnResults[1]"),
+		wc:            cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "missing pipeline task name",
+		p: PipelineTask{
+			Name:             "foo",
+			DependsOnResults: []string{".digest"},
+			TaskRef:          &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrInvalidValue(`".digest" must be of the form <pipelineTask>.<result>`, "dependsOnResults[0]"),
+		wc:            cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "setting dependsOnResults in beta API version - failure",
+		p: PipelineTask{
+			Name:             "foo",
+			DependsOnResults: []string{"build.digest"},
+			TaskRef:          &TaskRef{Name: "foo"},
+		},
+		expectedError: apis.ErrGeneric("dependsOnResults requires \"enable-api-fields\" feature gate to be \"alpha\" but it is \"beta\""),
+		wc:            cfgtesting.EnableBetaAPIFields,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.wc(context.Background())
+			err := tt.p.Validate(ctx)
+			if tt.expectedError == nil {
+				if err != nil {
+					t.Errorf("PipelineTask.Validate() returned error for valid pipeline task: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("PipelineTask.Validate() did not return error for invalid pipeline task with dependsOnResults")
+			}
+			if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+				t.Errorf("PipelineTask.Validate() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestPipelineTask_ValidateRefOrSpec(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -769,6 +923,15 @@ func TestPipelineTaskList_Deps(t *testing.T) {
 		expectedDeps: map[string][]string{
 			"task-2": {"task-1"},
 		},
+	}, {
+		name: "valid pipeline with result deps - dependsOnResults",
+		tasks: []PipelineTask{
+			{Name: "task-1"},
+			{Name: "task-2", DependsOnResults: []string{"task-1.result", "task-1.other-result"}},
+		},
+		expectedDeps: map[string][]string{
+			"task-2": {"task-1"},
+		},
 	}, {
 		name: "valid pipeline with Task Results deps",
 		tasks: []PipelineTask{{
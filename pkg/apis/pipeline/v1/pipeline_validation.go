@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/tektoncd/pipeline/internal/artifactref"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
@@ -77,16 +78,34 @@ func (ps *PipelineSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	errs = errs.Also(ValidatePipelineTasks(ctx, ps.Tasks, ps.Finally))
 	// Validate the pipeline task graph
 	errs = errs.Also(validateGraph(ps.Tasks))
+	errs = errs.Also(validateDependsOnResultsReferences(ps.Tasks))
+	if err := ValidateParamResultCycles(ps); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "tasks"))
+	}
 	// The parameter variables should be valid
 	errs = errs.Also(ValidatePipelineParameterVariables(ctx, ps.Tasks, ps.Params).ViaField("tasks"))
 	errs = errs.Also(ValidatePipelineParameterVariables(ctx, ps.Finally, ps.Params).ViaField("finally"))
-	errs = errs.Also(validatePipelineContextVariables(ps.Tasks).ViaField("tasks"))
-	errs = errs.Also(validatePipelineContextVariables(ps.Finally).ViaField("finally"))
+	errs = errs.Also(validatePipelineContextVariables(ctx, ps.Tasks).ViaField("tasks"))
+	errs = errs.Also(validatePipelineContextVariables(ctx, ps.Finally).ViaField("finally"))
 	errs = errs.Also(validateExecutionStatusVariables(ps.Tasks, ps.Finally))
 	// Validate the pipeline's workspaces.
 	errs = errs.Also(validatePipelineWorkspacesDeclarations(ps.Workspaces))
 	// Validate the pipeline's results
 	errs = errs.Also(validatePipelineResults(ps.Results, ps.Tasks, ps.Finally))
+	// The syntax of the variables in params, when expressions and results should be valid
+	errs = errs.Also(validateSubstitutionSyntaxInPipelineTasks(ps.Tasks).ViaField("tasks"))
+	errs = errs.Also(validateSubstitutionSyntaxInPipelineTasks(ps.Finally).ViaField("finally"))
+	for i, result := range ps.Results {
+		expressions, _ := result.GetVarSubstitutionExpressions()
+		errs = errs.Also(validateSubstitutionSyntax(expressions).ViaFieldIndex("results", i))
+	}
+	warnings, err := ValidatePipelineResultReferences(ps)
+	if err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "results"))
+	}
+	for _, warning := range warnings {
+		errs = errs.Also(apis.ErrGeneric(warning, "results").At(apis.WarningLevel))
+	}
 	errs = errs.Also(validateTasksAndFinallySection(ps))
 	errs = errs.Also(validateFinalTasks(ps.Tasks, ps.Finally))
 	errs = errs.Also(validateWhenExpressions(ctx, ps.Tasks, ps.Finally))
@@ -183,6 +202,9 @@ func (pt PipelineTask) Validate(ctx context.Context) (errs *apis.FieldError) {
 	errs = errs.Also(pt.validateEnabledInlineSpec(ctx))
 
 	errs = errs.Also(pt.validateEmbeddedOrType())
+
+	errs = errs.Also(pt.validateTimeoutString(ctx))
+	errs = errs.Also(pt.validateDependsOnResults(ctx))
 	// taskKinds contains the kinds when the apiVersion is not set, they are not custom tasks,
 	// if apiVersion is set they are custom tasks.
 	taskKinds := map[TaskKind]bool{
@@ -222,6 +244,43 @@ func (pt PipelineTask) ValidateOnError(ctx context.Context) (errs *apis.FieldErr
 	return errs
 }
 
+// validateTimeoutString validates the TimeoutString field of a PipelineTask. Values
+// containing variable references are validated once substituted at runtime.
+func (pt PipelineTask) validateTimeoutString(ctx context.Context) (errs *apis.FieldError) {
+	if pt.TimeoutString == "" {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "timeoutString", config.AlphaAPIFields))
+	if pt.Timeout != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("timeout", "timeoutString"))
+	}
+	if VariableSubstitutionRegex.MatchString(pt.TimeoutString) {
+		return errs
+	}
+	d, err := time.ParseDuration(pt.TimeoutString)
+	if err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%q is not a valid duration: %v", pt.TimeoutString, err), "timeoutString"))
+	} else if d < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s should be >= 0", pt.TimeoutString), "timeoutString"))
+	}
+	return errs
+}
+
+// validateDependsOnResults validates the DependsOnResults field of a PipelineTask, each
+// entry being a reference to a result of another PipelineTask of the form <pipelineTask>.<result>.
+func (pt PipelineTask) validateDependsOnResults(ctx context.Context) (errs *apis.FieldError) {
+	if len(pt.DependsOnResults) == 0 {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "dependsOnResults", config.AlphaAPIFields))
+	for i, dependsOnResult := range pt.DependsOnResults {
+		if taskName, resultName, ok := strings.Cut(dependsOnResult, "."); !ok || taskName == "" || resultName == "" {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%q must be of the form <pipelineTask>.<result>", dependsOnResult), "").ViaFieldIndex("dependsOnResults", i))
+		}
+	}
+	return errs
+}
+
 func (pt *PipelineTask) validateMatrix(ctx context.Context) (errs *apis.FieldError) {
 	if pt.IsMatrixed() {
 		// This is a beta feature and will fail validation if it's used in a pipeline spec
@@ -446,6 +505,7 @@ func ValidatePipelineParameterVariables(ctx context.Context, tasks []PipelineTas
 	errs = errs.Also(ValidateParameterTypes(ctx, params).ViaField("params"))
 	errs = errs.Also(params.ValidateNoDuplicateNames())
 	errs = errs.Also(params.validateParamEnums(ctx).ViaField("params"))
+	errs = errs.Also(params.validateParamDeprecations().ViaField("params"))
 	for i, task := range tasks {
 		errs = errs.Also(task.Params.validateDuplicateParameters().ViaField("params").ViaIndex(i))
 	}
@@ -463,17 +523,31 @@ func validatePipelineParametersVariables(tasks []PipelineTask, prefix string, pa
 	return errs
 }
 
-func validatePipelineContextVariables(tasks []PipelineTask) *apis.FieldError {
+// auditContextVariableNames are the $(context.pipelineRun.*) variables gated behind
+// the "enable-audit-context-variables" feature flag.
+var auditContextVariableNames = sets.NewString("creationTimestamp", "generation")
+
+// statusContextVariableNames are the $(context.pipelineRun.*) variables exposing the
+// PipelineRun status, which are only available in finally tasks.
+var statusContextVariableNames = sets.NewString("startTime", "completionTime")
+
+func validatePipelineContextVariables(ctx context.Context, tasks []PipelineTask) *apis.FieldError {
 	pipelineRunContextNames := sets.NewString().Insert(
 		"name",
 		"namespace",
 		"uid",
-	)
+		"labels",
+		"annotations",
+		"serviceAccountName",
+	).Union(auditContextVariableNames).Union(statusContextVariableNames)
 	pipelineContextNames := sets.NewString().Insert(
 		"name",
+		"labels",
+		"annotations",
 	)
 	pipelineTaskContextNames := sets.NewString().Insert(
 		"retries",
+		"serviceAccountName",
 	)
 	var paramValues []string
 	for _, task := range tasks {
@@ -482,6 +556,21 @@ func validatePipelineContextVariables(tasks []PipelineTask) *apis.FieldError {
 	errs := validatePipelineContextVariablesInParamValues(paramValues, "context\\.pipelineRun", pipelineRunContextNames).
 		Also(validatePipelineContextVariablesInParamValues(paramValues, "context\\.pipeline", pipelineContextNames)).
 		Also(validatePipelineContextVariablesInParamValues(paramValues, "context\\.pipelineTask", pipelineTaskContextNames))
+	if !config.FromContextOrDefaults(ctx).FeatureFlags.EnableAuditContextVariables {
+		errs = errs.Also(validateAuditContextVariablesDisabled(paramValues))
+	}
+	return errs
+}
+
+// validateAuditContextVariablesDisabled returns an error if any of the param values reference
+// $(context.pipelineRun.creationTimestamp) or $(context.pipelineRun.generation).
+func validateAuditContextVariablesDisabled(paramValues []string) (errs *apis.FieldError) {
+	for _, paramValue := range paramValues {
+		vs, _, _ := substitution.ExtractVariablesFromString(paramValue, "context\\.pipelineRun")
+		if auditContextVariableNames.HasAny(vs...) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("feature flag %s should be set to true to use $(context.pipelineRun.creationTimestamp) or $(context.pipelineRun.generation)", config.EnableAuditContextVariables), "value"))
+		}
+	}
 	return errs
 }
 
@@ -502,10 +591,14 @@ func (pt *PipelineTask) extractAllParams() Params {
 	return allParams
 }
 
-// GetVarSubstitutionExpressions extract all values between the parameters "$(" and ")" of steps and sidecars
+// GetVarSubstitutionExpressions extract all values between the parameters "$(" and ")" of steps, the step
+// template and sidecars
 func (pt *PipelineTask) GetVarSubstitutionExpressions() []string {
 	var allExpressions []string
 	if pt.TaskSpec != nil {
+		if pt.TaskSpec.StepTemplate != nil {
+			allExpressions = append(allExpressions, pt.TaskSpec.StepTemplate.GetVarSubstitutionExpressions()...)
+		}
 		for _, step := range pt.TaskSpec.Steps {
 			stepExpressions := step.GetVarSubstitutionExpressions()
 			allExpressions = append(allExpressions, stepExpressions...)
@@ -518,12 +611,20 @@ func (pt *PipelineTask) GetVarSubstitutionExpressions() []string {
 	return allExpressions
 }
 
-// containsExecutionStatusRef checks if a specified param has a reference to execution status or reason
-// $(tasks.<task-name>.status), $(tasks.status), or $(tasks.<task-name>.reason)
+// executionStatusSuffixes are the suffixes of the references to the execution status, reason, failure
+// message, start or completion time or pod name of a pipeline task, e.g. $(tasks.<task-name>.status)
+var executionStatusSuffixes = []string{".status", ".reason", ".message", ".startTime", ".completionTime", ".podName"}
+
+// containsExecutionStatusRef checks if a specified param has a reference to execution status, reason, message,
+// times or pod name $(tasks.<task-name>.status), $(tasks.status), $(tasks.<task-name>.reason),
+// $(tasks.<task-name>.message), $(tasks.<task-name>.startTime), $(tasks.<task-name>.completionTime) or
+// $(tasks.<task-name>.podName)
 func containsExecutionStatusRef(p string) bool {
 	if strings.HasPrefix(p, "tasks.") {
-		if strings.HasSuffix(p, ".status") || strings.HasSuffix(p, ".reason") {
-			return true
+		for _, suffix := range executionStatusSuffixes {
+			if strings.HasSuffix(p, suffix) {
+				return true
+			}
 		}
 	}
 	return false
@@ -590,9 +691,24 @@ func validateContainsExecutionStatusVariablesDisallowed(expressions []string, pa
 		errs = errs.Also(apis.ErrInvalidValue("pipeline tasks can not refer to execution status"+
 			" of any other pipeline task or aggregate status of tasks", path))
 	}
+	if containsStatusContextReferences(expressions) {
+		errs = errs.Also(apis.ErrInvalidValue("pipeline tasks can not refer to $(context.pipelineRun.startTime)"+
+			" or $(context.pipelineRun.completionTime), they are only available in finally tasks", path))
+	}
 	return errs
 }
 
+// containsStatusContextReferences checks if any of the expressions is a reference to the
+// PipelineRun status - $(context.pipelineRun.startTime) or $(context.pipelineRun.completionTime)
+func containsStatusContextReferences(expressions []string) bool {
+	for _, e := range expressions {
+		if name, ok := strings.CutPrefix(e, "context.pipelineRun."); ok && statusContextVariableNames.Has(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func containsExecutionStatusReferences(expressions []string) bool {
 	// validate tasks.pipelineTask.status/tasks.status if this expression is not a result reference
 	if !LooksLikeContainsResultRefs(expressions) {
@@ -615,16 +731,15 @@ func validateExecutionStatusVariablesExpressions(expressions []string, ptNames s
 			if expression == PipelineTasksAggregateStatus {
 				continue
 			}
-			// check if it contains context variable accessing execution status - $(tasks.taskname.status) | $(tasks.taskname.reason)
+			// check if it contains context variable accessing execution status - $(tasks.taskname.status) |
+			// $(tasks.taskname.reason) | $(tasks.taskname.message)
 			if containsExecutionStatusRef(expression) {
 				var pt string
-				if strings.HasSuffix(expression, ".status") {
-					// strip tasks. and .status from tasks.taskname.status to further verify task name
-					pt = strings.TrimSuffix(strings.TrimPrefix(expression, "tasks."), ".status")
-				}
-				if strings.HasSuffix(expression, ".reason") {
-					// strip tasks. and .reason from tasks.taskname.reason to further verify task name
-					pt = strings.TrimSuffix(strings.TrimPrefix(expression, "tasks."), ".reason")
+				for _, suffix := range executionStatusSuffixes {
+					if strings.HasSuffix(expression, suffix) {
+						// strip tasks. and the suffix from e.g. tasks.taskname.status to further verify task name
+						pt = strings.TrimSuffix(strings.TrimPrefix(expression, "tasks."), suffix)
+					}
 				}
 				// report an error if the task name does not exist in the list of dag tasks
 				if !ptNames.Has(pt) {
@@ -654,6 +769,47 @@ func filter(arr []string, cond func(string) bool) []string {
 	return result
 }
 
+// validateSubstitutionSyntaxInPipelineTasks validates the syntax of the variables referenced in the params,
+// the matrix and the when expressions of the pipeline tasks with substitution.ValidateSubstitutionSyntax.
+// The variables are the ones matched by VariableSubstitutionRegex, e.g. "$(tasks.build.results)" or
+// "$(params..name)", whose dot-separated segments may be empty or incomplete.
+func validateSubstitutionSyntaxInPipelineTasks(tasks []PipelineTask) (errs *apis.FieldError) {
+	for i, pt := range tasks {
+		var taskErrs *apis.FieldError
+		for _, param := range pt.Params {
+			expressions, _ := param.GetVarSubstitutionExpressions()
+			taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldKey("params", param.Name))
+		}
+		if pt.Matrix != nil {
+			for _, param := range pt.Matrix.Params {
+				expressions, _ := param.GetVarSubstitutionExpressions()
+				taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldKey("params", param.Name).ViaField("matrix"))
+			}
+			for j, include := range pt.Matrix.Include {
+				for _, param := range include.Params {
+					expressions, _ := param.GetVarSubstitutionExpressions()
+					taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldKey("params", param.Name).ViaFieldIndex("include", j).ViaField("matrix"))
+				}
+			}
+		}
+		for j, we := range pt.When {
+			expressions, _ := we.GetVarSubstitutionExpressions()
+			taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldIndex("when", j))
+		}
+		errs = errs.Also(taskErrs.ViaIndex(i))
+	}
+	return errs
+}
+
+func validateSubstitutionSyntax(expressions []string) (errs *apis.FieldError) {
+	for _, expression := range expressions {
+		if err := substitution.ValidateSubstitutionSyntax(expression); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), "value"))
+		}
+	}
+	return errs
+}
+
 // validatePipelineResults ensure that pipeline result variables are properly configured
 func validatePipelineResults(results []PipelineResult, tasks []PipelineTask, finally []PipelineTask) (errs *apis.FieldError) {
 	pipelineTaskNames := getPipelineTasksNames(tasks)
@@ -681,11 +837,62 @@ func validatePipelineResults(results []PipelineResult, tasks []PipelineTask, fin
 			errs = errs.Also(apis.ErrInvalidValue("referencing a nonexistent task",
 				"value").ViaFieldIndex("results", idx))
 		}
+
+		if result.Default != nil {
+			if defaultExpressions, ok := (Param{Value: *result.Default}).GetVarSubstitutionExpressions(); ok {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("default must be a literal value but found expressions %v", defaultExpressions),
+					"default").ViaFieldIndex("results", idx))
+			}
+		}
 	}
 
 	return errs
 }
 
+// Warnings are non-blocking diagnostics found while validating a Pipeline.
+type Warnings []string
+
+// ValidatePipelineResultReferences ensures the task results referenced by the Pipeline's results are
+// declared by the referenced tasks. Only the results of embedded Tasks can be checked: a warning is
+// returned for each result of a Task referenced with taskRef instead. The indexes of the references to
+// the array results of embedded Tasks are validated against their maxItems, a warning is returned if
+// they declare none. References to pipeline tasks which do not exist are reported by
+// validatePipelineResults and are ignored here.
+func ValidatePipelineResultReferences(spec *PipelineSpec) (warnings Warnings, err error) {
+	taskMapping := createTaskMapping(spec.Tasks)
+	for name, pt := range createTaskMapping(spec.Finally) {
+		taskMapping[name] = pt
+	}
+	for _, result := range spec.Results {
+		expressions, _ := result.GetVarSubstitutionExpressions()
+		for _, ref := range NewResultRefs(filter(expressions, resultref.LooksLikeResultRef)) {
+			pt, ok := taskMapping[ref.PipelineTask]
+			if !ok {
+				continue
+			}
+			switch {
+			case pt.TaskRef != nil:
+				warnings = append(warnings, fmt.Sprintf("cannot validate that result %q of pipeline result %q is declared by pipeline task %q since it references a Task", ref.Result, result.Name, ref.PipelineTask))
+			case pt.TaskSpec != nil && !pt.TaskSpec.IsCustomTask():
+				i := slices.IndexFunc(pt.TaskSpec.Results, func(r TaskResult) bool { return r.Name == ref.Result })
+				if i < 0 {
+					return warnings, fmt.Errorf("pipeline result %q references result %q which is not declared by pipeline task %q", result.Name, ref.Result, ref.PipelineTask)
+				}
+				declared := pt.TaskSpec.Results[i]
+				if ref.ResultsIndex == nil || declared.Type != ResultsTypeArray {
+					continue
+				}
+				if declared.MaxItems == nil {
+					warnings = append(warnings, fmt.Sprintf("cannot validate index %d of result %q of pipeline task %q referenced by pipeline result %q since the result declares no maxItems", *ref.ResultsIndex, ref.Result, ref.PipelineTask, result.Name))
+				} else if *ref.ResultsIndex >= *declared.MaxItems {
+					return warnings, fmt.Errorf("pipeline result %q references index %d of result %q of pipeline task %q which declares at most %d items", result.Name, *ref.ResultsIndex, ref.Result, ref.PipelineTask, *declared.MaxItems)
+				}
+			}
+		}
+	}
+	return warnings, nil
+}
+
 // put task names in a set
 func getPipelineTasksNames(pipelineTasks []PipelineTask) sets.String {
 	pipelineTaskNames := make(sets.String)
@@ -733,6 +940,9 @@ func validateFinalTasks(tasks []PipelineTask, finalTasks []PipelineTask) (errs *
 		if len(f.RunAfter) != 0 {
 			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("no runAfter allowed under spec.finally, final task %s has runAfter specified", f.Name), "").ViaFieldIndex("finally", idx))
 		}
+		if len(f.DependsOnResults) != 0 {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("no dependsOnResults allowed under spec.finally, final task %s has dependsOnResults specified", f.Name), "").ViaFieldIndex("finally", idx))
+		}
 	}
 
 	ts := PipelineTaskList(tasks).Names()
@@ -790,6 +1000,26 @@ func validateWhenExpressions(ctx context.Context, tasks []PipelineTask, finalTas
 
 // validateGraph ensures the Pipeline's dependency Graph (DAG) make sense: that there is no dependency
 // cycle or that they rely on values from Tasks that ran previously.
+// validateDependsOnResultsReferences ensures the results the pipeline tasks depend on are declared by the
+// pipeline tasks producing them. Only the results of embedded Tasks can be checked. Dependencies on pipeline
+// tasks which do not exist are reported by validateGraph and are ignored here.
+func validateDependsOnResultsReferences(tasks []PipelineTask) (errs *apis.FieldError) {
+	taskMapping := createTaskMapping(tasks)
+	for idx, t := range tasks {
+		for i, dependsOnResult := range t.DependsOnResults {
+			taskName, resultName, _ := strings.Cut(dependsOnResult, ".")
+			pt, ok := taskMapping[taskName]
+			if !ok || pt.TaskSpec == nil || pt.TaskSpec.IsCustomTask() {
+				continue
+			}
+			if !slices.ContainsFunc(pt.TaskSpec.Results, func(r TaskResult) bool { return r.Name == resultName }) {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("result %q is not declared by pipeline task %q", resultName, taskName), "").ViaFieldIndex("dependsOnResults", i).ViaFieldIndex("tasks", idx))
+			}
+		}
+	}
+	return errs
+}
+
 func validateGraph(tasks []PipelineTask) (errs *apis.FieldError) {
 	if _, err := dag.Build(PipelineTaskList(tasks), PipelineTaskList(tasks).Deps()); err != nil {
 		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "tasks"))
@@ -797,6 +1027,77 @@ func validateGraph(tasks []PipelineTask) (errs *apis.FieldError) {
 	return errs
 }
 
+// ValidateParamResultCycles ensures the task result references in the params of the Pipeline's tasks
+// do not form a cycle, e.g. task "a" consuming a result of task "b" while task "b" consumes a result
+// of task "a". The returned error names the tasks on the cycle.
+func ValidateParamResultCycles(spec *PipelineSpec) error {
+	var tasks []PipelineTask
+	tasks = append(tasks, spec.Tasks...)
+	tasks = append(tasks, spec.Finally...)
+
+	graph := map[string][]string{}
+	for _, pt := range tasks {
+		deps := sets.NewString()
+		for _, ref := range paramResultRefs(pt) {
+			deps.Insert(ref.PipelineTask)
+		}
+		graph[pt.Name] = deps.List()
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range graph[name] {
+			switch state[dep] {
+			case visiting:
+				start := slices.Index(path, dep)
+				cycle := append(slices.Clone(path[start:]), dep)
+				return fmt.Errorf("cycle detected in task result references of params: %s", strings.Join(cycle, " -> "))
+			case unvisited:
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	for _, pt := range tasks {
+		if state[pt.Name] == unvisited {
+			if err := visit(pt.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// paramResultRefs returns the task result references in the params and matrix params of the PipelineTask.
+func paramResultRefs(pt PipelineTask) []*ResultRef {
+	params := slices.Clone(pt.Params)
+	if pt.Matrix != nil {
+		params = append(params, pt.Matrix.Params...)
+		for _, include := range pt.Matrix.Include {
+			params = append(params, include.Params...)
+		}
+	}
+	var refs []*ResultRef
+	for _, p := range params {
+		expressions, _ := p.GetVarSubstitutionExpressions()
+		refs = append(refs, NewResultRefs(expressions)...)
+	}
+	return refs
+}
+
 func validateMatrix(ctx context.Context, tasks []PipelineTask) (errs *apis.FieldError) {
 	for idx, task := range tasks {
 		errs = errs.Also(task.validateMatrix(ctx).ViaIndex(idx))
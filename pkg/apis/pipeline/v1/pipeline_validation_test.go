@@ -1141,7 +1141,56 @@ func TestPipelineSpec_Validate_Failure(t *testing.T) {
 				}},
 			}},
 		},
-		expectedError: *apis.ErrGeneric(`invalid value: couldn't add link between invalid-pipeline-task and : task invalid-pipeline-task depends on  but  wasn't present in Pipeline`, "tasks"),
+		expectedError: *apis.ErrGeneric(`invalid value: couldn't add link between invalid-pipeline-task and : task invalid-pipeline-task depends on  but  wasn't present in Pipeline`, "tasks").Also(
+			apis.ErrInvalidValue(`empty segment in variable "tasks..results.bResult"`, "tasks[0].when[0].value")),
+	}, {
+		name: "invalid pipeline with malformed variables in params and results",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a-task",
+				TaskSpec: &EmbeddedTask{TaskSpec: TaskSpec{
+					Results: []TaskResult{{Name: "digest"}},
+					Steps:   []Step{{Name: "foo", Image: "bar"}},
+				}},
+			}, {
+				Name:    "b-task",
+				TaskRef: &TaskRef{Name: "foo-task"},
+				Params: Params{{
+					Name: "digest", Value: *NewStructuredValues("$(tasks.a-task.results.)"),
+				}},
+			}},
+			Results: []PipelineResult{{
+				Name:  "digest",
+				Value: *NewStructuredValues("$(tasks.a-task.results.digest.)"),
+			}},
+		},
+		expectedError: *apis.ErrInvalidValue(`empty segment in variable "tasks.a-task.results."`, "tasks[1].params[digest].value").Also(
+			apis.ErrInvalidValue(`empty segment in variable "tasks.a-task.results.digest."`, "results[0].value")),
+	}, {
+		name: "invalid pipeline with a missing result name and an empty segment in the variables of the finally tasks",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a-task",
+				TaskSpec: &EmbeddedTask{TaskSpec: TaskSpec{
+					Results: []TaskResult{{Name: "digest"}},
+					Steps:   []Step{{Name: "foo", Image: "bar"}},
+				}},
+			}},
+			Finally: []PipelineTask{{
+				Name:    "b-task",
+				TaskRef: &TaskRef{Name: "foo-task"},
+				Params: Params{{
+					Name: "digest", Value: *NewStructuredValues("$(tasks.a-task.results)"),
+				}},
+				When: WhenExpressions{{
+					Input:    "$(tasks.a-task.results..digest)",
+					Operator: selection.In,
+					Values:   []string{"foo"},
+				}},
+			}},
+		},
+		expectedError: *apis.ErrInvalidValue(`missing result name in variable "tasks.a-task.results"`, "finally[0].params[digest].value").Also(
+			apis.ErrInvalidValue(`empty segment in variable "tasks.a-task.results..digest"`, "finally[0].when[0].value")),
 	}, {
 		name: "invalid pipeline with a pipelineTask having when expression with invalid result reference - referenced task does not exist in the pipeline",
 		ps: &PipelineSpec{
@@ -1495,6 +1544,232 @@ func TestValidateGraph_Failure(t *testing.T) {
 	}
 }
 
+func TestValidateParamResultCycles(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		spec        *PipelineSpec
+		expectedErr string
+	}{{
+		name: "no cycle",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a", TaskRef: &TaskRef{Name: "a-task"},
+			}, {
+				Name: "b", TaskRef: &TaskRef{Name: "b-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.a.results.x)")}},
+			}},
+			Finally: []PipelineTask{{
+				Name: "c", TaskRef: &TaskRef{Name: "c-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.b.results.y)")}},
+			}},
+		},
+	}, {
+		name: "two tasks referencing each other",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a", TaskRef: &TaskRef{Name: "a-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.b.results.y)")}},
+			}, {
+				Name: "b", TaskRef: &TaskRef{Name: "b-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.a.results.x)")}},
+			}},
+		},
+		expectedErr: "cycle detected in task result references of params: a -> b -> a",
+	}, {
+		name: "cycle through matrix params",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a", TaskRef: &TaskRef{Name: "a-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.c.results.z)")}},
+			}, {
+				Name: "b", TaskRef: &TaskRef{Name: "b-task"},
+				Matrix: &Matrix{
+					Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.a.results.x[*])")}},
+				},
+			}, {
+				Name: "c", TaskRef: &TaskRef{Name: "c-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.b.results.y)")}},
+			}},
+		},
+		expectedErr: "cycle detected in task result references of params: a -> c -> b -> a",
+	}, {
+		name: "task referencing its own result",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a", TaskRef: &TaskRef{Name: "a-task"},
+				Params: Params{{Name: "p", Value: *NewStructuredValues("$(tasks.a.results.x)")}},
+			}},
+		},
+		expectedErr: "cycle detected in task result references of params: a -> a",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateParamResultCycles(tc.spec)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("ValidateParamResultCycles() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("ValidateParamResultCycles() did not return an error")
+			}
+			if d := cmp.Diff(tc.expectedErr, err.Error()); d != "" {
+				t.Errorf("ValidateParamResultCycles() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestValidatePipelineResultReferences(t *testing.T) {
+	maxItems := 2
+	embedded := &EmbeddedTask{TaskSpec: TaskSpec{
+		Results: []TaskResult{{Name: "digest"}, {Name: "images", Type: ResultsTypeArray}, {Name: "platforms", Type: ResultsTypeArray, MaxItems: &maxItems}},
+		Steps:   []Step{{Name: "foo", Image: "bar"}},
+	}}
+	for _, tc := range []struct {
+		name             string
+		spec             *PipelineSpec
+		expectedWarnings Warnings
+		expectedErr      string
+	}{{
+		name: "declared results of embedded tasks",
+		spec: &PipelineSpec{
+			Tasks:   []PipelineTask{{Name: "build", TaskSpec: embedded}},
+			Finally: []PipelineTask{{Name: "report", TaskSpec: embedded}},
+			Results: []PipelineResult{{
+				Name:  "digest",
+				Value: *NewStructuredValues("$(tasks.build.results.digest)"),
+			}, {
+				Name:  "images",
+				Value: *NewStructuredValues("$(tasks.build.results.images[*])"),
+			}, {
+				Name:  "report",
+				Value: *NewStructuredValues("$(finally.report.results.digest)"),
+			}},
+		},
+	}, {
+		name: "result of a referenced task",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{Name: "build", TaskRef: &TaskRef{Name: "build-task"}}},
+			Results: []PipelineResult{{
+				Name:  "digest",
+				Value: *NewStructuredValues("$(tasks.build.results.digest)"),
+			}},
+		},
+		expectedWarnings: Warnings{`cannot validate that result "digest" of pipeline result "digest" is declared by pipeline task "build" since it references a Task`},
+	}, {
+		name: "nonexistent pipeline task is ignored",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{Name: "build", TaskSpec: embedded}},
+			Results: []PipelineResult{{
+				Name:  "digest",
+				Value: *NewStructuredValues("$(tasks.missing.results.digest)"),
+			}},
+		},
+	}, {
+		name: "undeclared result of an embedded task",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{Name: "build", TaskSpec: embedded}},
+			Results: []PipelineResult{{
+				Name:  "digest",
+				Value: *NewStructuredValues("$(tasks.build.results.digets)"),
+			}},
+		},
+		expectedErr: `pipeline result "digest" references result "digets" which is not declared by pipeline task "build"`,
+	}, {
+		name: "index within the maxItems of an array result",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{Name: "build", TaskSpec: embedded}},
+			Results: []PipelineResult{{
+				Name:  "platform",
+				Value: *NewStructuredValues("$(tasks.build.results.platforms[1])"),
+			}},
+		},
+	}, {
+		name: "index of an array result without maxItems",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{Name: "build", TaskSpec: embedded}},
+			Results: []PipelineResult{{
+				Name:  "image",
+				Value: *NewStructuredValues("$(tasks.build.results.images[5])"),
+			}},
+		},
+		expectedWarnings: Warnings{`cannot validate index 5 of result "images" of pipeline task "build" referenced by pipeline result "image" since the result declares no maxItems`},
+	}, {
+		name: "index out of the maxItems of an array result",
+		spec: &PipelineSpec{
+			Tasks: []PipelineTask{{Name: "build", TaskSpec: embedded}},
+			Results: []PipelineResult{{
+				Name:  "platform",
+				Value: *NewStructuredValues("$(tasks.build.results.platforms[2])"),
+			}},
+		},
+		expectedErr: `pipeline result "platform" references index 2 of result "platforms" of pipeline task "build" which declares at most 2 items`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := ValidatePipelineResultReferences(tc.spec)
+			if d := cmp.Diff(tc.expectedWarnings, warnings); d != "" {
+				t.Errorf("ValidatePipelineResultReferences() warnings diff %s", diff.PrintWantGot(d))
+			}
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePipelineResultReferences() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("ValidatePipelineResultReferences() did not return an error")
+			}
+			if d := cmp.Diff(tc.expectedErr, err.Error()); d != "" {
+				t.Errorf("ValidatePipelineResultReferences() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestValidateDependsOnResultsReferences(t *testing.T) {
+	embedded := &EmbeddedTask{TaskSpec: TaskSpec{
+		Results: []TaskResult{{Name: "digest"}},
+		Steps:   []Step{{Name: "foo", Image: "bar"}},
+	}}
+	for _, tc := range []struct {
+		name          string
+		tasks         []PipelineTask
+		expectedError *apis.FieldError
+	}{{
+		name: "declared result of an embedded task",
+		tasks: []PipelineTask{
+			{Name: "build", TaskSpec: embedded},
+			{Name: "deploy", TaskRef: &TaskRef{Name: "deploy"}, DependsOnResults: []string{"build.digest"}},
+		},
+	}, {
+		name: "result of a referenced task",
+		tasks: []PipelineTask{
+			{Name: "build", TaskRef: &TaskRef{Name: "build"}},
+			{Name: "deploy", TaskRef: &TaskRef{Name: "deploy"}, DependsOnResults: []string{"build.digest"}},
+		},
+	}, {
+		name: "nonexistent pipeline task is ignored",
+		tasks: []PipelineTask{
+			{Name: "deploy", TaskRef: &TaskRef{Name: "deploy"}, DependsOnResults: []string{"missing.digest"}},
+		},
+	}, {
+		name: "undeclared result of an embedded task",
+		tasks: []PipelineTask{
+			{Name: "build", TaskSpec: embedded},
+			{Name: "deploy", TaskRef: &TaskRef{Name: "deploy"}, DependsOnResults: []string{"build.digest", "build.digets"}},
+		},
+		expectedError: apis.ErrInvalidValue(`result "digets" is not declared by pipeline task "build"`, "tasks[1].dependsOnResults[1]"),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDependsOnResultsReferences(tc.tasks)
+			if d := cmp.Diff(tc.expectedError.Error(), err.Error()); d != "" {
+				t.Errorf("validateDependsOnResultsReferences() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestValidatePipelineResults_Success(t *testing.T) {
 	desc := "valid pipeline with valid pipeline results syntax"
 	results := []PipelineResult{{
@@ -1505,6 +1780,11 @@ func TestValidatePipelineResults_Success(t *testing.T) {
 		Name:        "my-pipeline-object-result",
 		Description: "this is my pipeline result",
 		Value:       *NewStructuredValues("$(tasks.a-task.results.gitrepo.commit)"),
+	}, {
+		Name:        "my-pipeline-result-with-default",
+		Description: "this is my pipeline result",
+		Value:       *NewStructuredValues("$(tasks.a-task.results.output)"),
+		Default:     NewStructuredValues("fallback"),
 	}}
 	if err := validatePipelineResults(results, []PipelineTask{{Name: "a-task"}}, []PipelineTask{}); err != nil {
 		t.Errorf("Pipeline.validatePipelineResults() returned error for valid pipeline: %s: %v", desc, err)
@@ -1553,6 +1833,15 @@ func TestValidatePipelineResults_Failure(t *testing.T) {
 		}},
 		expectedError: *apis.ErrInvalidValue(`expected pipeline results to be task result expressions but an invalid expressions was found`, "results[0].value").Also(
 			apis.ErrInvalidValue("referencing a nonexistent task", "results[0].value")),
+	}, {
+		desc: "invalid pipeline result default with expression",
+		results: []PipelineResult{{
+			Name:        "my-pipeline-result",
+			Description: "this is my pipeline result",
+			Value:       *NewStructuredValues("$(tasks.a-task.results.output)"),
+			Default:     NewStructuredValues("$(params.foo)"),
+		}},
+		expectedError: *apis.ErrInvalidValue(`default must be a literal value but found expressions [params.foo]`, "results[0].default"),
 	}}
 	for _, tt := range tests {
 		err := validatePipelineResults(tt.results, []PipelineTask{{Name: "a-task"}}, []PipelineTask{})
@@ -2486,6 +2775,22 @@ func TestValidatePipelineParameterVariables_Failure(t *testing.T) {
 			Message: "feature flag `enable-param-enum` should be set to true to use Enum",
 			Paths:   []string{"params[param1]"},
 		},
+	}, {
+		name: "param deprecation with an invalid removal version - failure",
+		params: []ParamSpec{{
+			Name:       "param1",
+			Type:       ParamTypeString,
+			Deprecated: &ParamDeprecation{Message: "use param2 instead", RemovedIn: "next"},
+		}},
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+		}},
+		expectedError: apis.FieldError{
+			Message: "invalid value: next",
+			Paths:   []string{"params[param1].deprecated.removedIn"},
+			Details: "removedIn must be a version, e.g. v1.5",
+		},
 	}, {
 		name: "invalid parameter type",
 		params: []ParamSpec{{
@@ -3479,6 +3784,17 @@ func TestValidateFinalTasks_Failure(t *testing.T) {
 			Message: `invalid value: no runAfter allowed under spec.finally, final task final-task has runAfter specified`,
 			Paths:   []string{"finally[0]"},
 		},
+	}, {
+		name: "invalid pipeline with final task specifying dependsOnResults",
+		finalTasks: []PipelineTask{{
+			Name:             "final-task",
+			TaskRef:          &TaskRef{Name: "final-task"},
+			DependsOnResults: []string{"non-final-task.result"},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: no dependsOnResults allowed under spec.finally, final task final-task has dependsOnResults specified`,
+			Paths:   []string{"finally[0]"},
+		},
 	}, {
 		name: "invalid pipeline with final tasks having task results reference from a final task",
 		finalTasks: []PipelineTask{{
@@ -3544,6 +3860,7 @@ func TestContextValid(t *testing.T) {
 	tests := []struct {
 		name  string
 		tasks []PipelineTask
+		wc    func(context.Context) context.Context
 	}{{
 		name: "valid string context variable for Pipeline name",
 		tasks: []PipelineTask{{
@@ -3558,6 +3875,15 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for PipelineRun status",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.startTime) $(context.pipelineRun.completionTime)"},
+			}},
+		}},
 	}, {
 		name: "valid string context variable for PipelineRun name",
 		tasks: []PipelineTask{{
@@ -3614,6 +3940,28 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for PipelineRun labels and annotations",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.labels.app_kubernetes_io_version)"},
+			}, {
+				Name: "b-param", Value: ParamValue{StringVal: "$(context.pipelineRun.annotations.gitops_io_commit-sha)"},
+			}},
+		}},
+	}, {
+		name: "valid string context variables for Pipeline labels and annotations",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipeline.labels.owner-team)"},
+			}, {
+				Name: "b-param", Value: ParamValue{StringVal: "$(context.pipeline.annotations.example_com_sla-tier)"},
+			}},
+		}},
 	}, {
 		name: "valid string context variable for PipelineTask retries",
 		tasks: []PipelineTask{{
@@ -3628,6 +3976,17 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for PipelineRun and PipelineTask service account names",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.serviceAccountName)"},
+			}, {
+				Name: "b-param", Value: ParamValue{StringVal: "$(context.pipelineTask.serviceAccountName)"},
+			}},
+		}},
 	}, {
 		name: "valid array context variable for PipelineTask retries",
 		tasks: []PipelineTask{{
@@ -3676,10 +4035,28 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for PipelineRun creationTimestamp and generation",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.creationTimestamp)"},
+			}, {
+				Name: "b-param", Value: ParamValue{StringVal: "gen-$(context.pipelineRun.generation)"},
+			}},
+		}},
+		wc: func(ctx context.Context) context.Context {
+			return cfgtesting.SetFeatureFlags(ctx, t, map[string]string{"enable-audit-context-variables": "true"})
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := validatePipelineContextVariables(tt.tasks); err != nil {
+			ctx := context.Background()
+			if tt.wc != nil {
+				ctx = tt.wc(ctx)
+			}
+			if err := validatePipelineContextVariables(ctx, tt.tasks); err != nil {
 				t.Errorf("Pipeline.validatePipelineContextVariables() returned error for valid pipeline context variables: %v", err)
 			}
 		})
@@ -3754,6 +4131,16 @@ func TestContextInvalid(t *testing.T) {
 			Message: `non-existent variable in "$(context.pipelineTask.missing-foo)"`,
 			Paths:   []string{"value"},
 		}),
+	}, {
+		name: "invalid string context variable for unsanitized PipelineRun label key",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.labels.app.kubernetes.io/version)"},
+			}},
+		}},
+		expectedError: *apis.ErrGeneric(`Invalid referencing of parameters in "$(context.pipelineRun.labels.app.kubernetes.io/version)"! Only two dot-separated components after the prefix "context\.pipelineRun" are allowed.`, "value"),
 	}, {
 		name: "invalid array context variables for pipeline, pipelineTask and pipelineRun",
 		tasks: []PipelineTask{{
@@ -3828,10 +4215,23 @@ func TestContextInvalid(t *testing.T) {
 			Message: `non-existent variable in "$(context.pipelineTask.missing)"`,
 			Paths:   []string{"value"},
 		}),
+	}, {
+		name: "context variable for PipelineRun creationTimestamp without feature flag",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.creationTimestamp)"},
+			}},
+		}},
+		expectedError: *apis.ErrGeneric("").Also(&apis.FieldError{
+			Message: `feature flag enable-audit-context-variables should be set to true to use $(context.pipelineRun.creationTimestamp) or $(context.pipelineRun.generation)`,
+			Paths:   []string{"value"},
+		}),
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePipelineContextVariables(tt.tasks)
+			err := validatePipelineContextVariables(context.Background(), tt.tasks)
 			if err == nil {
 				t.Errorf("Pipeline.validatePipelineContextVariables() did not return error for invalid pipeline parameters: %s", tt.tasks[0].Params)
 			}
@@ -3860,6 +4260,14 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 				Name: "foo-status", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.status)"},
 			}, {
 				Name: "foo-reason", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.reason)"},
+			}, {
+				Name: "foo-message", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.message)"},
+			}, {
+				Name: "foo-start-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.startTime)"},
+			}, {
+				Name: "foo-completion-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.completionTime)"},
+			}, {
+				Name: "foo-pod-name", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.podName)"},
 			}, {
 				Name: "tasks-status", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.status)"},
 			}},
@@ -3915,6 +4323,31 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 				Name: "foo-status", Value: ParamValue{Type: ParamTypeString, StringVal: "Execution status of $(tasks.taskname) is $(tasks.foo.status)."},
 			}},
 		}},
+	}, {
+		name: "valid string variable in finally accessing PipelineRun status context",
+		tasks: []PipelineTask{{
+			Name: "foo",
+		}},
+		finalTasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "times", Value: ParamValue{Type: ParamTypeString, StringVal: "$(context.pipelineRun.startTime) - $(context.pipelineRun.completionTime)"},
+			}},
+		}},
+	}, {
+		name: "invalid string variable in dag task accessing PipelineRun status context",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "start-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(context.pipelineRun.startTime)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to $(context.pipelineRun.startTime) or $(context.pipelineRun.completionTime), they are only available in finally tasks`,
+			Paths:   []string{"tasks[0].params[start-time].value"},
+		},
 	}, {
 		name: "invalid string variable in dag task accessing pipelineTask status",
 		tasks: []PipelineTask{{
@@ -4079,6 +4512,71 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 			Message: `invalid value: pipeline task notask is not defined in the pipeline`,
 			Paths:   []string{"finally[0].params[notask-status].value"},
 		},
+	}, {
+		name: "invalid string variable in finally accessing missing pipelineTask message",
+		finalTasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "notask-message", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.notask.message)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline task notask is not defined in the pipeline`,
+			Paths:   []string{"finally[0].params[notask-message].value"},
+		},
+	}, {
+		name: "invalid string variable in dag task accessing pipelineTask message",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "bar-message", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.bar.message)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to execution status of any other pipeline task or aggregate status of tasks`,
+			Paths:   []string{"tasks[0].params[bar-message].value"},
+		},
+	}, {
+		name: "invalid string variable in dag task accessing pipelineTask start time",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "bar-start-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.bar.startTime)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to execution status of any other pipeline task or aggregate status of tasks`,
+			Paths:   []string{"tasks[0].params[bar-start-time].value"},
+		},
+	}, {
+		name: "invalid string variable in dag task accessing pipelineTask pod name",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "bar-pod-name", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.bar.podName)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to execution status of any other pipeline task or aggregate status of tasks`,
+			Paths:   []string{"tasks[0].params[bar-pod-name].value"},
+		},
+	}, {
+		name: "invalid string variable in finally accessing missing pipelineTask completion time",
+		finalTasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "notask-completion-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.notask.completionTime)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline task notask is not defined in the pipeline`,
+			Paths:   []string{"finally[0].params[notask-completion-time].value"},
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
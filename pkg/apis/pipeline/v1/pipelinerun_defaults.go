@@ -69,6 +69,10 @@ func (prs *PipelineRunSpec) SetDefaults(ctx context.Context) {
 	defaultPodTemplate := cfg.Defaults.DefaultPodTemplate
 	prs.TaskRunTemplate.PodTemplate = pod.MergePodTemplateWithDefault(prs.TaskRunTemplate.PodTemplate, defaultPodTemplate)
 
+	for i := range prs.ParamDefaults {
+		prs.ParamDefaults[i].SetDefaults(ctx)
+	}
+
 	if prs.PipelineSpec != nil {
 		prs.PipelineSpec.SetDefaults(ctx)
 	}
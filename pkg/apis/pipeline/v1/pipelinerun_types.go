@@ -259,6 +259,18 @@ type PipelineRunSpec struct {
 	// Params is a list of parameter names and values.
 	// +listType=atomic
 	Params Params `json:"params,omitempty"`
+	// ParamDefaults overrides the default values of the params declared in the
+	// Pipeline, without modifying the Pipeline. The values of Params still take
+	// precedence over them.
+	// +optional
+	// +listType=atomic
+	ParamDefaults ParamSpecs `json:"paramDefaults,omitempty"`
+	// ParamSources is a list of params whose values are fetched from Secrets or
+	// ConfigMaps in the namespace of the PipelineRun. Once resolved, they are
+	// handled like the Params.
+	// +optional
+	// +listType=atomic
+	ParamSources []ParamSource `json:"paramSources,omitempty"`
 
 	// Used for cancelling a pipelinerun (and maybe more later on)
 	// +optional
@@ -285,6 +297,25 @@ type PipelineRunSpec struct {
 	TaskRunSpecs []PipelineTaskRunSpec `json:"taskRunSpecs,omitempty"`
 }
 
+// ParamSource is a param whose value is fetched from a Secret or a ConfigMap.
+type ParamSource struct {
+	// Name is the name of the param.
+	Name string `json:"name"`
+	// ValueFrom is the source of the value of the param.
+	ValueFrom ParamValueSource `json:"valueFrom"`
+}
+
+// ParamValueSource represents the source of the value of a ParamSource. Only
+// one of its fields may be set.
+type ParamValueSource struct {
+	// SecretKeyRef selects a key of a Secret in the namespace of the PipelineRun.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the PipelineRun.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
 // TimeoutFields allows granular specification of pipeline, task, and finally timeouts
 type TimeoutFields struct {
 	// Pipeline sets the maximum allowed duration for execution of the entire pipeline. The sum of individual timeouts for tasks and finally must not exceed this value.
@@ -422,6 +453,12 @@ const (
 	PipelineRunReasonCELEvaluationFailed PipelineRunReason = "CELEvaluationFailed"
 	// PipelineRunReasonInvalidParamValue indicates that the PipelineRun Param input value is not allowed.
 	PipelineRunReasonInvalidParamValue PipelineRunReason = "InvalidParamValue"
+	// PipelineRunReasonInvalidParamSource indicates that the value of a PipelineRun ParamSource couldn't be
+	// fetched because the Secret, the ConfigMap or the key it selects doesn't exist.
+	PipelineRunReasonInvalidParamSource PipelineRunReason = "InvalidParamSource"
+	// PipelineRunReasonParameterRemoved indicates that the PipelineRun provides a deprecated parameter
+	// which is removed in the version of its Pipeline.
+	PipelineRunReasonParameterRemoved PipelineRunReason = "ParameterRemoved"
 )
 
 // PipelineTaskOnErrorAnnotation is used to pass the failure strategy to TaskRun pods from PipelineTask OnError field
@@ -522,6 +559,15 @@ type PipelineRunStatusFields struct {
 	// +kubebuilder:validation:Schemaless
 	PipelineSpec *PipelineSpec `json:"pipelineSpec,omitempty"`
 
+	// ResolvedPipelineSpec is the PipelineSpec with all the substitutions applied, including the results and
+	// context variables of the PipelineTasks which have started. It is only stored when the
+	// tekton.dev/store-resolved-spec annotation of the PipelineRun is "true", for debugging.
+	// See Pipeline.spec (API version: tekton.dev/v1)
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	ResolvedPipelineSpec *PipelineSpec `json:"resolvedPipelineSpec,omitempty"`
+
 	// list of tasks that were skipped due to when expressions evaluating to false
 	// +optional
 	// +listType=atomic
@@ -574,6 +620,8 @@ const (
 	GracefullyStoppedSkip SkippingReason = "PipelineRun was gracefully stopped"
 	// MissingResultsSkip means the task was skipped because it's missing necessary results
 	MissingResultsSkip SkippingReason = "Results were missing"
+	// ParentTasksFailedSkip means the task was skipped because it's missing necessary results of a parent task that failed
+	ParentTasksFailedSkip SkippingReason = "Parent Tasks failed"
 	// PipelineTimedOutSkip means the task was skipped because the PipelineRun has passed its overall timeout.
 	PipelineTimedOutSkip SkippingReason = "PipelineRun timeout has been reached"
 	// TasksTimedOutSkip means the task was skipped because the PipelineRun has passed its Timeouts.Tasks.
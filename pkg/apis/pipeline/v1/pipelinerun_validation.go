@@ -18,17 +18,23 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
 	"github.com/tektoncd/pipeline/pkg/internal/resultref"
+	"github.com/tektoncd/pipeline/pkg/substitution"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/webhook/resourcesemantics"
 )
@@ -38,6 +44,41 @@ var (
 	_ resourcesemantics.VerbLimited = (*PipelineRun)(nil)
 )
 
+// contextMetadataKeyInvalidChars matches all characters which are not allowed in the key of a
+// $(context.pipelineRun.labels.<key>) or $(context.pipelineRun.annotations.<key>) variable.
+var contextMetadataKeyInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// ContextMetadataKey returns the key under which a PipelineRun label or annotation is exposed as a
+// $(context.pipelineRun.labels.<key>) or $(context.pipelineRun.annotations.<key>) variable.
+// Every character other than alphanumerics, '-' and '_' is replaced with '_', e.g. the label
+// "app.kubernetes.io/version" is exposed as $(context.pipelineRun.labels.app_kubernetes_io_version).
+func ContextMetadataKey(key string) string {
+	return contextMetadataKeyInvalidChars.ReplaceAllString(key, "_")
+}
+
+// ContextMetadataKeys returns the values of the given labels or annotations keyed by ContextMetadataKey, and the
+// keys of the labels or annotations sharing a sanitized key, e.g. "a.b" and "a/b" both exposed as "a_b", keyed
+// by the sanitized key. The values of these ambiguous keys are left out, so that the substitution doesn't depend
+// on which of the labels or annotations comes last.
+func ContextMetadataKeys(metadata map[string]string) (map[string]string, map[string][]string) {
+	keys := map[string][]string{}
+	for k := range metadata {
+		sanitized := ContextMetadataKey(k)
+		keys[sanitized] = append(keys[sanitized], k)
+	}
+	values := map[string]string{}
+	ambiguous := map[string][]string{}
+	for sanitized, originals := range keys {
+		if len(originals) > 1 {
+			sort.Strings(originals)
+			ambiguous[sanitized] = originals
+			continue
+		}
+		values[sanitized] = metadata[originals[0]]
+	}
+	return values, ambiguous
+}
+
 // SupportedVerbs returns the operations that validation should be called for
 func (pr *PipelineRun) SupportedVerbs() []admissionregistrationv1.OperationType {
 	return []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update}
@@ -51,9 +92,90 @@ func (pr *PipelineRun) Validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(apis.ErrInvalidValue("PipelineRun cannot be Pending after it is started", "spec.status"))
 	}
 
+	if pr.Spec.PipelineSpec != nil {
+		errs = errs.Also(pr.validateContextMetadataVariables().ViaField("spec.pipelineSpec"))
+	}
+
 	return errs.Also(pr.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
 }
 
+// validateContextMetadataVariables validates that the $(context.pipelineRun.labels.<key>) and
+// $(context.pipelineRun.annotations.<key>) variables used in an embedded PipelineSpec refer to labels
+// and annotations which are set on the PipelineRun.
+func (pr *PipelineRun) validateContextMetadataVariables() *apis.FieldError {
+	return ValidateContextMetadataVariables(pr.Spec.PipelineSpec, pr.Labels, pr.Annotations)
+}
+
+// ValidateContextMetadataVariables validates that the $(context.pipelineRun.labels.<key>) and
+// $(context.pipelineRun.annotations.<key>) variables used in the fields of the tasks and finally tasks
+// of the PipelineSpec in which they are substituted refer to the given labels and annotations, and
+// not to a key shared by several of them.
+func ValidateContextMetadataVariables(ps *PipelineSpec, labels, annotations map[string]string) (errs *apis.FieldError) {
+	labelValues, ambiguousLabels := ContextMetadataKeys(labels)
+	annotationValues, ambiguousAnnotations := ContextMetadataKeys(annotations)
+	for _, metadata := range []struct {
+		prefix    string
+		keys      sets.String
+		ambiguous map[string][]string
+	}{{
+		prefix:    "context\\.pipelineRun\\.labels",
+		keys:      sets.StringKeySet(labelValues),
+		ambiguous: ambiguousLabels,
+	}, {
+		prefix:    "context\\.pipelineRun\\.annotations",
+		keys:      sets.StringKeySet(annotationValues),
+		ambiguous: ambiguousAnnotations,
+	}} {
+		for _, tasks := range []struct {
+			field string
+			tasks []PipelineTask
+		}{{"tasks", ps.Tasks}, {"finally", ps.Finally}} {
+			for idx, task := range tasks.tasks {
+				for _, value := range task.contextVariableValues() {
+					errs = errs.Also(validateAmbiguousContextMetadataVariables(value, metadata.prefix, metadata.ambiguous).ViaFieldIndex(tasks.field, idx))
+					errs = errs.Also(substitution.ValidateNoReferencesToUnknownVariablesWithDetail(value, metadata.prefix, metadata.keys.Union(sets.StringKeySet(metadata.ambiguous))).ViaFieldIndex(tasks.field, idx))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// validateAmbiguousContextMetadataVariables returns an error if the value references a label or annotation
+// key shared by several labels or annotations.
+func validateAmbiguousContextMetadataVariables(value, prefix string, ambiguous map[string][]string) (errs *apis.FieldError) {
+	vs, _, _ := substitution.ExtractVariablesFromString(value, prefix)
+	for _, v := range vs {
+		if originals, ok := ambiguous[substitution.TrimArrayIndex(v)]; ok {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("ambiguous variable `%s` in %q, the keys %s are all exposed under it", v, value, strings.Join(originals, ", ")), ""))
+		}
+	}
+	return errs
+}
+
+// contextVariableValues returns the values of the fields of the PipelineTask in which the context variables are
+// substituted: the params, including the ones of the matrix, the when expressions, the display name, the sub
+// paths of the workspaces, the TaskRef and the steps, step template and sidecars of the embedded TaskSpec.
+func (pt *PipelineTask) contextVariableValues() []string {
+	values := pt.extractAllParams().extractValues()
+	for _, we := range pt.When {
+		values = append(values, we.Input, we.CEL)
+		values = append(values, we.Values...)
+	}
+	values = append(values, pt.DisplayName, pt.TimeoutString)
+	for _, ws := range pt.Workspaces {
+		values = append(values, ws.SubPath)
+	}
+	if pt.TaskRef != nil {
+		values = append(values, pt.TaskRef.Name, string(pt.TaskRef.Resolver))
+		values = append(values, pt.TaskRef.Params.extractValues()...)
+	}
+	for _, expression := range pt.GetVarSubstitutionExpressions() {
+		values = append(values, "$("+expression+")")
+	}
+	return values
+}
+
 // Validate pipelinerun spec
 func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// Validate the spec changes
@@ -83,6 +205,9 @@ func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError)
 
 	// Validate PipelineRun parameters
 	errs = errs.Also(ps.validatePipelineRunParameters(ctx))
+	errs = errs.Also(ps.validateParamDefaults(ctx))
+	errs = errs.Also(ps.validateParamSources())
+	errs = errs.Also(ps.validateDeprecatedParams())
 
 	// Validate propagated parameters
 	errs = errs.Also(ps.validateInlineParameters(ctx))
@@ -139,12 +264,15 @@ func (ps *PipelineRunSpec) ValidateUpdate(ctx context.Context) (errs *apis.Field
 	}
 	old := &oldObj.Spec
 
-	// If already in the done state, the spec cannot be modified. Otherwise, only the status field can be modified.
+	// If already in the done state, the spec cannot be modified. Otherwise, only the status and params fields can
+	// be modified, the updated params are used by the PipelineTasks which have not started yet.
 	tips := "Once the PipelineRun is complete, no updates are allowed"
 	if !oldObj.IsDone() {
 		old = old.DeepCopy()
 		old.Status = ps.Status
-		tips = "Once the PipelineRun has started, only status updates are allowed"
+		old.Params = ps.Params
+		tips = "Once the PipelineRun has started, only status and params updates are allowed"
+		errs = errs.Also(validateStartedParamsNotUpdated(oldObj, ps.Params))
 	}
 	if !equality.Semantic.DeepEqual(old, ps) {
 		errs = errs.Also(apis.ErrInvalidValue(tips, ""))
@@ -153,6 +281,129 @@ func (ps *PipelineRunSpec) ValidateUpdate(ctx context.Context) (errs *apis.Field
 	return
 }
 
+// validateStartedParamsNotUpdated validates that the params referenced by the PipelineTasks of the PipelineRun
+// which have started, as recorded by the reconciler in its tekton.dev/param-consumers annotation, are not added,
+// removed or changed. If they are not recorded yet, none of the params can be updated once a PipelineTask started.
+func validateStartedParamsNotUpdated(oldObj *PipelineRun, params Params) (errs *apis.FieldError) {
+	started := sets.NewString()
+	for _, child := range oldObj.Status.ChildReferences {
+		started.Insert(child.PipelineTaskName)
+	}
+	if started.Len() == 0 {
+		return nil
+	}
+	var consumers map[string][]string
+	recorded, ok := oldObj.Annotations[pipeline.ParamConsumersAnnotationKey]
+	if ok && json.Unmarshal([]byte(recorded), &consumers) != nil {
+		ok = false
+	}
+
+	values := map[string]ParamValue{}
+	for _, p := range oldObj.Spec.Params {
+		values[p.Name] = p.Value
+	}
+	updated := sets.NewString()
+	for _, p := range params {
+		if value, found := values[p.Name]; !found || !equality.Semantic.DeepEqual(value, p.Value) {
+			updated.Insert(p.Name)
+		}
+		delete(values, p.Name)
+	}
+	for name := range values {
+		updated.Insert(name)
+	}
+	for _, name := range updated.List() {
+		switch {
+		case !ok:
+			errs = errs.Also(apis.ErrGeneric("the params used by the PipelineTasks which have started are not known yet", "").ViaFieldKey("params", name))
+		case started.HasAny(consumers[name]...):
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("the param is used by the PipelineTasks which have started: %s",
+				strings.Join(started.Intersection(sets.NewString(consumers[name]...)).List(), ", ")), "").ViaFieldKey("params", name))
+		}
+	}
+	return errs
+}
+
+// validateParamDefaults validates that the ParamDefaults have a default value matching their type and are
+// declared once.
+func (ps *PipelineRunSpec) validateParamDefaults(ctx context.Context) (errs *apis.FieldError) {
+	names := sets.NewString()
+	for _, p := range ps.ParamDefaults {
+		if p.Default == nil {
+			errs = errs.Also(apis.ErrMissingField("default").ViaFieldKey("paramDefaults", p.Name))
+		}
+		if names.Has(p.Name) {
+			errs = errs.Also(apis.ErrGeneric("parameter appears more than once", "").ViaFieldKey("paramDefaults", p.Name))
+		}
+		names.Insert(p.Name)
+	}
+	return errs.Also(ValidateParameterTypes(ctx, ps.ParamDefaults).ViaField("paramDefaults"))
+}
+
+// validateParamSources validates that each of the ParamSources selects exactly one key of a Secret or a
+// ConfigMap, and that its name is neither repeated nor provided in the Params.
+func (ps *PipelineRunSpec) validateParamSources() (errs *apis.FieldError) {
+	names := sets.NewString()
+	for _, p := range ps.Params {
+		names.Insert(p.Name)
+	}
+	sourceNames := sets.NewString()
+	for idx, p := range ps.ParamSources {
+		if p.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("paramSources", idx))
+			continue
+		}
+		switch {
+		case sourceNames.Has(p.Name):
+			errs = errs.Also(apis.ErrGeneric("parameter appears more than once", "").ViaFieldKey("paramSources", p.Name))
+		case names.Has(p.Name):
+			errs = errs.Also(apis.ErrGeneric("parameter is also provided in params", "").ViaFieldKey("paramSources", p.Name))
+		}
+		sourceNames.Insert(p.Name)
+
+		var selectorErrs *apis.FieldError
+		switch secret, configMap := p.ValueFrom.SecretKeyRef, p.ValueFrom.ConfigMapKeyRef; {
+		case secret != nil && configMap != nil:
+			selectorErrs = apis.ErrMultipleOneOf("secretKeyRef", "configMapKeyRef")
+		case secret != nil:
+			selectorErrs = validateKeySelector(secret.Name, secret.Key).ViaField("secretKeyRef")
+		case configMap != nil:
+			selectorErrs = validateKeySelector(configMap.Name, configMap.Key).ViaField("configMapKeyRef")
+		default:
+			selectorErrs = apis.ErrMissingOneOf("secretKeyRef", "configMapKeyRef")
+		}
+		errs = errs.Also(selectorErrs.ViaField("valueFrom").ViaFieldKey("paramSources", p.Name))
+	}
+	return errs
+}
+
+// validateDeprecatedParams warns about the params provided by the PipelineRun which are deprecated in its embedded
+// PipelineSpec. The params deprecated in a referenced Pipeline are reported by the reconciler.
+func (ps *PipelineRunSpec) validateDeprecatedParams() (errs *apis.FieldError) {
+	if ps.PipelineSpec == nil {
+		return nil
+	}
+	provided := ps.Params.ExtractNames()
+	for _, p := range ps.PipelineSpec.Params {
+		if warning := p.DeprecationWarning(); warning != "" && provided.Has(p.Name) {
+			errs = errs.Also(apis.ErrGeneric(warning, "").ViaFieldKey("params", p.Name).At(apis.WarningLevel))
+		}
+	}
+	return errs
+}
+
+// validateKeySelector validates that a key selector of a ParamSource sets both the name of the object
+// and the key.
+func validateKeySelector(name, key string) (errs *apis.FieldError) {
+	if name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	}
+	return errs
+}
+
 func (ps *PipelineRunSpec) validatePipelineRunParameters(ctx context.Context) (errs *apis.FieldError) {
 	if len(ps.Params) == 0 {
 		return errs
@@ -173,6 +424,14 @@ func (ps *PipelineRunSpec) validatePipelineRunParameters(ctx context.Context) (e
 						"value").ViaFieldKey("params", param.Name))
 				}
 			}
+			// The creationTimestamp and generation of the PipelineRun are not known yet when
+			// its parameter values are admitted.
+			for _, expression := range expressions {
+				if name, found := strings.CutPrefix(expression, "context.pipelineRun."); found && auditContextVariableNames.Has(name) {
+					errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("cannot use $(%s) as PipelineRun parameter values", expression),
+						"value").ViaFieldKey("params", param.Name))
+				}
+			}
 		}
 	}
 
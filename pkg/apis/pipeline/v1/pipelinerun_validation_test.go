@@ -25,12 +25,14 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/test/diff"
 	corev1 "k8s.io/api/core/v1"
 	corev1resources "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/selection"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -345,6 +347,125 @@ func TestPipelineRun_Invalid(t *testing.T) {
 			Message: "invalid value: PipelineRun cannot be Pending after it is started",
 			Paths:   []string{"spec.status"},
 		},
+	}, {
+		name: "pipelinespec refers to a label which is not set on the pipelinerun",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "pipelinerunname",
+				Labels: map[string]string{"app.kubernetes.io/version": "v1"},
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name:    "echo",
+						TaskRef: &v1.TaskRef{Name: "echo-task"},
+						Params: v1.Params{{
+							Name: "version", Value: *v1.NewStructuredValues("$(context.pipelineRun.labels.app_kubernetes_io_versoin)"),
+						}},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "non-existent variable `app_kubernetes_io_versoin` in \"$(context.pipelineRun.labels.app_kubernetes_io_versoin)\"",
+			Paths:   []string{"spec.pipelineSpec.tasks[0]"},
+		},
+	}, {
+		name: "finally refers to an annotation which is not set on the pipelinerun",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name:    "echo",
+						TaskRef: &v1.TaskRef{Name: "echo-task"},
+					}},
+					Finally: []v1.PipelineTask{{
+						Name:    "notify",
+						TaskRef: &v1.TaskRef{Name: "notify-task"},
+						Params: v1.Params{{
+							Name: "sha", Value: *v1.NewStructuredValues("$(context.pipelineRun.annotations.gitops_io_commit-sha)"),
+						}},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "non-existent variable `gitops_io_commit-sha` in \"$(context.pipelineRun.annotations.gitops_io_commit-sha)\"",
+			Paths:   []string{"spec.pipelineSpec.finally[0]"},
+		},
+	}, {
+		name: "when expression refers to a label which is not set on the pipelinerun",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name:    "echo",
+						TaskRef: &v1.TaskRef{Name: "echo-task"},
+						When: v1.WhenExpressions{{
+							Input:    "$(context.pipelineRun.labels.env)",
+							Operator: selection.In,
+							Values:   []string{"prod"},
+						}},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "non-existent variable `env` in \"$(context.pipelineRun.labels.env)\"",
+			Paths:   []string{"spec.pipelineSpec.tasks[0]"},
+		},
+	}, {
+		name: "step of an embedded taskspec refers to an annotation which is not set on the pipelinerun",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name: "echo",
+						TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+							Steps: []v1.Step{{
+								Name:   "echo",
+								Image:  "busybox",
+								Script: "echo $(context.pipelineRun.annotations.owner)",
+							}},
+						}},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "non-existent variable `owner` in \"$(context.pipelineRun.annotations.owner)\"",
+			Paths:   []string{"spec.pipelineSpec.tasks[0]"},
+		},
+	}, {
+		name: "pipelinespec refers to a label key shared by several labels",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "pipelinerunname",
+				Labels: map[string]string{"a.b": "dot", "a/b": "slash"},
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name:        "echo",
+						DisplayName: "echo $(context.pipelineRun.labels.a_b)",
+						TaskRef:     &v1.TaskRef{Name: "echo-task"},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "ambiguous variable `a_b` in \"echo $(context.pipelineRun.labels.a_b)\", the keys a.b, a/b are all exposed under it",
+			Paths:   []string{"spec.pipelineSpec.tasks[0]"},
+		},
 	}}
 
 	for _, tc := range tests {
@@ -390,6 +511,28 @@ func TestPipelineRun_Validate(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "pipelinespec refers to labels and annotations of the pipelinerun",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pipelinename",
+				Labels:      map[string]string{"app.kubernetes.io/version": "v1"},
+				Annotations: map[string]string{"gitops.io/commit-sha": "abc123"},
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name:    "echo",
+						TaskRef: &v1.TaskRef{Name: "echo-task"},
+						Params: v1.Params{{
+							Name: "version", Value: *v1.NewStructuredValues("$(context.pipelineRun.labels.app_kubernetes_io_version)"),
+						}, {
+							Name: "sha", Value: *v1.NewStructuredValues("$(context.pipelineRun.annotations.gitops_io_commit-sha)"),
+						}},
+					}},
+				},
+			},
+		},
 	}, {
 		name: "array param with pipelinespec and taskspec",
 		pr: v1.PipelineRun{
@@ -1095,6 +1238,155 @@ func TestPipelineRunSpec_Invalidate(t *testing.T) {
 		},
 		withContext: cfgtesting.EnableStableAPIFields,
 		wantErr:     apis.ErrGeneric("computeResources requires \"enable-api-fields\" feature gate to be \"alpha\" or \"beta\" but it is \"stable\"").ViaIndex(0).ViaField("taskRunSpecs"),
+	}, {
+		name: "pipelinerun params reference creationTimestamp context variable",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			Params: v1.Params{{
+				Name:  "created",
+				Value: *v1.NewStructuredValues("$(context.pipelineRun.creationTimestamp)"),
+			}},
+		},
+		withContext: func(ctx context.Context) context.Context {
+			return cfgtesting.SetFeatureFlags(ctx, t, map[string]string{"enable-audit-context-variables": "true"})
+		},
+		wantErr: apis.ErrInvalidValue("cannot use $(context.pipelineRun.creationTimestamp) as PipelineRun parameter values", "params[created].value"),
+	}, {
+		name: "paramDefaults without default value",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamDefaults: v1.ParamSpecs{{
+				Name: "foo",
+				Type: v1.ParamTypeString,
+			}},
+		},
+		wantErr: apis.ErrMissingField("paramDefaults[foo].default"),
+	}, {
+		name: "duplicate paramDefaults",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamDefaults: v1.ParamSpecs{{
+				Name:    "foo",
+				Type:    v1.ParamTypeString,
+				Default: v1.NewStructuredValues("foo"),
+			}, {
+				Name:    "foo",
+				Type:    v1.ParamTypeString,
+				Default: v1.NewStructuredValues("bar"),
+			}},
+		},
+		wantErr: apis.ErrGeneric("parameter appears more than once", "paramDefaults[foo]"),
+	}, {
+		name: "paramDefaults with a default value not matching the type",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamDefaults: v1.ParamSpecs{{
+				Name:    "foo",
+				Type:    v1.ParamTypeString,
+				Default: v1.NewStructuredValues("foo", "bar"),
+			}},
+		},
+		wantErr: &apis.FieldError{
+			Message: `"string" type does not match default value's type: "array"`,
+			Paths:   []string{"paramDefaults.foo.type", "paramDefaults.foo.default.type"},
+		}}, {
+		name: "paramSources without name",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamSources: []v1.ParamSource{{
+				ValueFrom: v1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrMissingField("paramSources[0].name"),
+	}, {
+		name: "paramSources without secretKeyRef nor configMapKeyRef",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamSources: []v1.ParamSource{{
+				Name: "token",
+			}},
+		},
+		wantErr: apis.ErrMissingOneOf("paramSources[token].valueFrom.secretKeyRef", "paramSources[token].valueFrom.configMapKeyRef"),
+	}, {
+		name: "paramSources with both secretKeyRef and configMapKeyRef",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamSources: []v1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrMultipleOneOf("paramSources[token].valueFrom.secretKeyRef", "paramSources[token].valueFrom.configMapKeyRef"),
+	}, {
+		name: "paramSources with a configMapKeyRef without key",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamSources: []v1.ParamSource{{
+				Name: "region",
+				ValueFrom: v1.ParamValueSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrMissingField("paramSources[region].valueFrom.configMapKeyRef.key"),
+	}, {
+		name: "duplicate paramSources",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			ParamSources: []v1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}, {
+				Name: "token",
+				ValueFrom: v1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "other-secret"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrGeneric("parameter appears more than once", "paramSources[token]"),
+	}, {
+		name: "paramSources also provided in params",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "foo"},
+			Params: v1.Params{{
+				Name:  "token",
+				Value: *v1.NewStructuredValues("inline"),
+			}},
+			ParamSources: []v1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrGeneric("parameter is also provided in params", "paramSources[token]"),
 	}}
 
 	for _, ps := range tests {
@@ -1166,6 +1458,41 @@ func TestPipelineRunSpec_Validate(t *testing.T) {
 			}},
 		},
 		withContext: cfgtesting.EnableBetaAPIFields,
+	}, {
+		name: "valid paramDefaults",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+			ParamDefaults: v1.ParamSpecs{{
+				Name:    "foo",
+				Type:    v1.ParamTypeString,
+				Default: v1.NewStructuredValues("foo"),
+			}, {
+				Name:    "bar",
+				Type:    v1.ParamTypeArray,
+				Default: v1.NewStructuredValues("foo", "bar"),
+			}},
+		}}, {
+		name: "valid paramSources",
+		spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+			ParamSources: []v1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}, {
+				Name: "region",
+				ValueFrom: v1.ParamValueSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+						Key:                  "region",
+					},
+				},
+			}},
+		},
 	}}
 
 	for _, ps := range tests {
@@ -1514,6 +1841,54 @@ func TestPipelineRunSpecBetaFeatures(t *testing.T) {
 	}
 }
 
+func TestPipelineRunSpec_Validate_DeprecatedParams(t *testing.T) {
+	pipelineSpec := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{{
+			Name: "image",
+			Type: v1.ParamTypeString,
+		}, {
+			Name:       "old-image",
+			Type:       v1.ParamTypeString,
+			Default:    v1.NewStructuredValues(""),
+			Deprecated: &v1.ParamDeprecation{Message: "use image instead", RemovedIn: "v1.5"},
+		}},
+		Tasks: []v1.PipelineTask{{
+			Name:    "build",
+			TaskRef: &v1.TaskRef{Name: "build"},
+			Params:  v1.Params{{Name: "image", Value: *v1.NewStructuredValues("$(params.image)")}},
+		}},
+	}
+	for _, tc := range []struct {
+		name         string
+		params       v1.Params
+		wantWarnings string
+	}{{
+		name:   "deprecated param not provided",
+		params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app")}},
+	}, {
+		name: "deprecated param provided",
+		params: v1.Params{
+			{Name: "image", Value: *v1.NewStructuredValues("app")},
+			{Name: "old-image", Value: *v1.NewStructuredValues("app")},
+		},
+		wantWarnings: `parameter "old-image" is deprecated and will be removed in v1.5: use image instead: params[old-image]`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := v1.PipelineRunSpec{
+				PipelineSpec: pipelineSpec,
+				Params:       tc.params,
+			}
+			errs := spec.Validate(context.Background())
+			if err := errs.Filter(apis.ErrorLevel); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.wantWarnings, errs.Filter(apis.WarningLevel).Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -1581,6 +1956,155 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 			isCreate:      false,
 			isUpdate:      true,
 			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, params changes",
+			baselinePipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app:v1")}},
+				},
+				Status: v1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+				},
+			},
+			pipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app:v2")}},
+				},
+			},
+			isCreate:      false,
+			isUpdate:      true,
+			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, params used by a started PipelineTask changes",
+			baselinePipelineRun: &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{pipeline.ParamConsumersAnnotationKey: `{"image":["build","deploy"],"registry":["deploy"]}`}},
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{
+						{Name: "image", Value: *v1.NewStructuredValues("app:v1")},
+						{Name: "registry", Value: *v1.NewStructuredValues("quay.io")},
+					},
+				},
+				Status: v1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+					PipelineRunStatusFields: v1.PipelineRunStatusFields{
+						ChildReferences: []v1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			},
+			pipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{
+						{Name: "image", Value: *v1.NewStructuredValues("app:v2")},
+						{Name: "registry", Value: *v1.NewStructuredValues("quay.io")},
+					},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `the param is used by the PipelineTasks which have started: build`,
+				Paths:   []string{"params[image]"},
+			},
+		}, {
+			name: "is update ctx, baseline is unknown, params unused by the started PipelineTasks changes",
+			baselinePipelineRun: &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{pipeline.ParamConsumersAnnotationKey: `{"image":["deploy"],"registry":["build","deploy"]}`}},
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{
+						{Name: "image", Value: *v1.NewStructuredValues("app:v1")},
+						{Name: "registry", Value: *v1.NewStructuredValues("quay.io")},
+					},
+				},
+				Status: v1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+					PipelineRunStatusFields: v1.PipelineRunStatusFields{
+						ChildReferences: []v1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			},
+			pipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{
+						{Name: "image", Value: *v1.NewStructuredValues("app:v2")},
+						{Name: "registry", Value: *v1.NewStructuredValues("quay.io")},
+					},
+				},
+			},
+			isCreate:      false,
+			isUpdate:      true,
+			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, params changes before their consumers are recorded",
+			baselinePipelineRun: &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{
+						{Name: "image", Value: *v1.NewStructuredValues("app:v1")},
+						{Name: "registry", Value: *v1.NewStructuredValues("quay.io")},
+					},
+				},
+				Status: v1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+					PipelineRunStatusFields: v1.PipelineRunStatusFields{
+						ChildReferences: []v1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			},
+			pipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{
+						{Name: "image", Value: *v1.NewStructuredValues("app:v2")},
+						{Name: "registry", Value: *v1.NewStructuredValues("quay.io")},
+					},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `the params used by the PipelineTasks which have started are not known yet`,
+				Paths:   []string{"params[image]"},
+			},
+		}, {
+			name: "is update ctx, baseline is done, params changes",
+			baselinePipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app:v1")}},
+				},
+				Status: v1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue},
+						},
+					},
+				},
+			},
+			pipelineRun: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app:v2")}},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `invalid value: Once the PipelineRun is complete, no updates are allowed`,
+				Paths:   []string{""},
+			},
 		}, {
 			name: "is update ctx, baseline is unknown, timeouts changes",
 			baselinePipelineRun: &v1.PipelineRun{
@@ -1608,7 +2132,7 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 			isCreate: false,
 			isUpdate: true,
 			expectedError: apis.FieldError{
-				Message: `invalid value: Once the PipelineRun has started, only status updates are allowed`,
+				Message: `invalid value: Once the PipelineRun has started, only status and params updates are allowed`,
 				Paths:   []string{""},
 			},
 		}, {
@@ -1639,7 +2163,7 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 			isCreate: false,
 			isUpdate: true,
 			expectedError: apis.FieldError{
-				Message: `invalid value: Once the PipelineRun has started, only status updates are allowed`,
+				Message: `invalid value: Once the PipelineRun has started, only status and params updates are allowed`,
 				Paths:   []string{""},
 			},
 		}, {
@@ -1690,3 +2214,25 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestContextMetadataKeys(t *testing.T) {
+	values, ambiguous := v1.ContextMetadataKeys(map[string]string{
+		"app.kubernetes.io/version": "v1",
+		"a.b":                       "dot",
+		"a/b":                       "slash",
+		"a_b-c":                     "c",
+	})
+	wantValues := map[string]string{
+		"app_kubernetes_io_version": "v1",
+		"a_b-c":                     "c",
+	}
+	if d := cmp.Diff(wantValues, values); d != "" {
+		t.Errorf("ContextMetadataKeys() values %s", diff.PrintWantGot(d))
+	}
+	wantAmbiguous := map[string][]string{
+		"a_b": {"a.b", "a/b"},
+	}
+	if d := cmp.Diff(wantAmbiguous, ambiguous); d != "" {
+		t.Errorf("ContextMetadataKeys() ambiguous keys %s", diff.PrintWantGot(d))
+	}
+}
@@ -874,6 +874,17 @@ func TestPipelineTaskResultRefs(t *testing.T) {
 		},
 		TaskSpec: &v1.EmbeddedTask{
 			TaskSpec: v1.TaskSpec{
+				StepTemplate: &v1.StepTemplate{
+					Env: []corev1.EnvVar{{
+						Name:  "URL",
+						Value: "$(tasks.pt16.results.r16)",
+					}, {
+						Name: "TOKEN",
+						ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+							Key: "$(tasks.pt17.results.r17)",
+						}},
+					}},
+				},
 				Steps: []v1.Step{
 					{
 						Name:            "$(tasks.pt8.results.r8)",
@@ -886,6 +897,7 @@ func TestPipelineTaskResultRefs(t *testing.T) {
 				},
 			},
 		},
+		TimeoutString: "$(tasks.pt15.results.r15)",
 	}
 	refs := v1.PipelineTaskResultRefs(&pt)
 	expectedRefs := []*v1.ResultRef{{
@@ -936,6 +948,15 @@ func TestPipelineTaskResultRefs(t *testing.T) {
 	}, {
 		PipelineTask: "pt14",
 		Result:       "r14",
+	}, {
+		PipelineTask: "pt15",
+		Result:       "r15",
+	}, {
+		PipelineTask: "pt16",
+		Result:       "r16",
+	}, {
+		PipelineTask: "pt17",
+		Result:       "r17",
 	}}
 	if d := cmp.Diff(refs, expectedRefs, cmpopts.SortSlices(lessResultRef)); d != "" {
 		t.Errorf("%v", d)
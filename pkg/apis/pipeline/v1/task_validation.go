@@ -24,6 +24,7 @@ import (
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
+	"github.com/tektoncd/pipeline/pkg/internal/resultref"
 	"github.com/tektoncd/pipeline/pkg/substitution"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -217,6 +218,12 @@ func ValidateVolumes(volumes []corev1.Volume) (errs *apis.FieldError) {
 func (l StepList) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// Task must not have duplicate step names.
 	names := sets.NewString()
+	stepIndexes := map[string]int{}
+	for idx, s := range l {
+		if _, ok := stepIndexes[s.Name]; s.Name != "" && !ok {
+			stepIndexes[s.Name] = idx
+		}
+	}
 	for idx, s := range l {
 		// names cannot be duplicated - checking that Step names are unique
 		if s.Name != "" {
@@ -234,6 +241,37 @@ func (l StepList) Validate(ctx context.Context) (errs *apis.FieldError) {
 		if len(s.When) > 0 {
 			errs = errs.Also(s.When.validate(ctx).ViaIndex(idx))
 		}
+		errs = errs.Also(validateStepResultRefsOrder(s, idx, stepIndexes).ViaIndex(idx))
+	}
+	return errs
+}
+
+// validateStepResultRefsOrder validates that the step results referenced by a step are
+// produced by the steps running before it, since the results of the step itself and of
+// the steps after it are not available yet when it starts.
+func validateStepResultRefsOrder(s Step, idx int, stepIndexes map[string]int) (errs *apis.FieldError) {
+	check := func(value, fieldName string) {
+		for _, ref := range resultref.FindStepResultRefs(value) {
+			if refIdx, ok := stepIndexes[ref.Step]; ok && refIdx >= idx {
+				errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("step result %q of step %q can only be referenced by the steps running after it", ref.Result, ref.Step), fieldName))
+			}
+		}
+	}
+	for i, e := range s.Env {
+		check(e.Value, fmt.Sprintf("env[%d].value", i))
+	}
+	for i, c := range s.Command {
+		check(c, fmt.Sprintf("command[%d]", i))
+	}
+	for i, a := range s.Args {
+		check(a, fmt.Sprintf("args[%d]", i))
+	}
+	for i, we := range s.When {
+		check(we.Input, fmt.Sprintf("when[%d].input", i))
+		for j, v := range we.Values {
+			check(v, fmt.Sprintf("when[%d].values[%d]", i, j))
+		}
+		check(we.CEL, fmt.Sprintf("when[%d].cel", i))
 	}
 	return errs
 }
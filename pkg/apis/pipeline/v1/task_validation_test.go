@@ -272,6 +272,23 @@ func TestTaskSpecValidate(t *testing.T) {
 				WorkingDir: "/foo/bar/src/",
 			}},
 		},
+	}, {
+		name: "valid nested object key template variable",
+		fields: fields{
+			Params: []v1.ParamSpec{{
+				Name: "gitrepo",
+				Type: v1.ParamTypeObject,
+				Properties: map[string]v1.PropertySpec{
+					"url": {},
+				},
+			}},
+			Steps: []v1.Step{{
+				Name:       "do-the-clone",
+				Image:      "some-git-image",
+				Args:       []string{"-host=$(params.gitrepo.url.host)", "-user=$(params.gitrepo.url.auth.user)"},
+				WorkingDir: "/foo/bar/src/",
+			}},
+		},
 	}, {
 		name: "valid star array template variable",
 		fields: fields{
@@ -538,6 +555,23 @@ func TestTaskSpecValidate(t *testing.T) {
 				hello "$(context.taskRun.namespace)"`,
 			}},
 		},
+	}, {
+		name: "step results of a previous step",
+		fields: fields{
+			Steps: []v1.Step{{
+				Name:    "first",
+				Image:   "my-image",
+				Results: []v1.StepResult{{Name: "result"}},
+			}, {
+				Name:  "second",
+				Image: "my-image",
+				Env: []corev1.EnvVar{{
+					Name:  "FIRST",
+					Value: "$(steps.first.results.result)",
+				}},
+				Args: []string{"$(steps.first.results.result)"},
+			}},
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1702,6 +1736,35 @@ func TestTaskSpecValidateErrorWithStepResultRef(t *testing.T) {
 				Message: "stepResult substitutions are only allowed in env, command and args. Found usage in",
 				Paths:   []string{"steps[0].volumeDevices.name", "steps[0].volumeDevices.devicePath"},
 			},
+		}, {
+			name: "Cannot reference the results of a later step",
+			Steps: []v1.Step{{
+				Name:  "first",
+				Image: "my-img",
+				Env: []corev1.EnvVar{{
+					Name:  "NEXT",
+					Value: "$(steps.second.results.resultName)",
+				}},
+				Args: []string{"$(steps.second.results.resultName)"},
+			}, {
+				Name:  "second",
+				Image: "my-img",
+			}},
+			expectedError: apis.FieldError{
+				Message: `step result "resultName" of step "second" can only be referenced by the steps running after it`,
+				Paths:   []string{"steps[0].args[0]", "steps[0].env[0].value"},
+			},
+		}, {
+			name: "Cannot reference the results of the same step",
+			Steps: []v1.Step{{
+				Name:    "first",
+				Image:   "my-img",
+				Command: []string{"$(steps.first.results.resultName)"},
+			}},
+			expectedError: apis.FieldError{
+				Message: `step result "resultName" of step "first" can only be referenced by the steps running after it`,
+				Paths:   []string{"steps[0].command[0]"},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -62,27 +62,53 @@ func (we *WhenExpression) isTrue() bool {
 }
 
 func (we *WhenExpression) applyReplacements(replacements map[string]string, arrayReplacements map[string][]string) WhenExpression {
-	replacedInput := substitution.ApplyReplacements(we.Input, replacements)
-	replacedCEL := substitution.ApplyReplacements(we.CEL, replacements)
-
+	// the array values are expanded before the string replacements are applied so that a WhenExpression
+	// referencing both a string and an array, e.g. a string result in its Input and an array result in
+	// its Values, gets all its references replaced
 	var replacedValues []string
 	for _, val := range we.Values {
-		// arrayReplacements holds a list of array parameters with a pattern - params.arrayParam1
-		// array params are referenced using $(params.arrayParam1[*])
-		// array results are referenced using $(results.resultname[*])
-		// check if the param exist in the arrayReplacements to replace it with a list of values
-		if _, ok := arrayReplacements[fmt.Sprintf("%s.%s", ParamsPrefix, ArrayReference(val))]; ok {
-			replacedValues = append(replacedValues, substitution.ApplyArrayReplacements(val, replacements, arrayReplacements)...)
-		} else if _, ok := arrayReplacements[ResultsArrayReference(val)]; ok {
-			replacedValues = append(replacedValues, substitution.ApplyArrayReplacements(val, replacements, arrayReplacements)...)
+		if expanded, ok := expandArrayValue(val, replacements, arrayReplacements); ok {
+			replacedValues = append(replacedValues, expanded...)
 		} else {
 			replacedValues = append(replacedValues, substitution.ApplyReplacements(val, replacements))
 		}
 	}
+	replacedInput := substitution.ApplyReplacements(we.Input, replacements)
+	replacedCEL := substitution.ApplyReplacements(we.CEL, replacements)
 
 	return WhenExpression{Input: replacedInput, Operator: we.Operator, Values: replacedValues, CEL: replacedCEL}
 }
 
+// ExpandArrayValues returns the Values of the WhenExpression where each value referencing a whole array,
+// such as $(params.foo[*]) or $(tasks.foo.results.bar[*]), is expanded into one value per element of the
+// array found in arrayReplacements. The other values are returned unchanged.
+func (we *WhenExpression) ExpandArrayValues(arrayReplacements map[string][]string) []string {
+	var values []string
+	for _, val := range we.Values {
+		if expanded, ok := expandArrayValue(val, nil, arrayReplacements); ok {
+			values = append(values, expanded...)
+		} else {
+			values = append(values, val)
+		}
+	}
+	return values
+}
+
+// expandArrayValue returns the elements of the array the value references, and whether it references one.
+func expandArrayValue(val string, replacements map[string]string, arrayReplacements map[string][]string) ([]string, bool) {
+	// arrayReplacements holds a list of array parameters with a pattern - params.arrayParam1
+	// array params are referenced using $(params.arrayParam1[*])
+	// array results are referenced using $(results.resultname[*])
+	// check if the param exist in the arrayReplacements to replace it with a list of values
+	if _, ok := arrayReplacements[fmt.Sprintf("%s.%s", ParamsPrefix, ArrayReference(val))]; ok {
+		return substitution.ApplyArrayReplacements(val, replacements, arrayReplacements), true
+	}
+	if _, ok := arrayReplacements[ResultsArrayReference(val)]; ok {
+		return substitution.ApplyArrayReplacements(val, replacements, arrayReplacements), true
+	}
+	return nil, false
+}
+
 // GetVarSubstitutionExpressions extracts all the values between "$(" and ")" in a When Expression
 func (we *WhenExpression) GetVarSubstitutionExpressions() ([]string, bool) {
 	var allExpressions []string
@@ -115,7 +141,8 @@ func (wes WhenExpressions) AllowsExecution(evaluatedCEL map[string]bool) bool {
 }
 
 // ReplaceVariables interpolates variables, such as Parameters and Results, in
-// the Input and Values.
+// the Input and Values. A value that is a whole array reference, such as
+// $(params.foo[*]), is expanded into one value per element of the array.
 func (wes WhenExpressions) ReplaceVariables(replacements map[string]string, arrayReplacements map[string][]string) WhenExpressions {
 	replaced := wes
 	for i := range wes {
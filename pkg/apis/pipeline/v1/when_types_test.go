@@ -371,6 +371,24 @@ func TestApplyReplacements(t *testing.T) {
 			Operator: selection.In,
 			Values:   []string{"dev", "stage", "foo.txt", "readme.md", "test.go"},
 		},
+	}, {
+		name: "replace string result in input and string and array results in values",
+		original: &WhenExpression{
+			Input:    "$(tasks.foo.results.bar)",
+			Operator: selection.NotIn,
+			Values:   []string{"$(tasks.aTask.results.aResult[*])", "$(tasks.foo.results.bar)-suffix", "prod"},
+		},
+		replacements: map[string]string{
+			"tasks.foo.results.bar": "foobar",
+		},
+		arrayReplacements: map[string][]string{
+			"tasks.aTask.results.aResult": {"dev", "stage"},
+		},
+		expected: &WhenExpression{
+			Input:    "foobar",
+			Operator: selection.NotIn,
+			Values:   []string{"dev", "stage", "foobar-suffix", "prod"},
+		},
 	}}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -381,3 +399,36 @@ func TestApplyReplacements(t *testing.T) {
 		})
 	}
 }
+
+func TestWhenExpression_ExpandArrayValues(t *testing.T) {
+	arrayReplacements := map[string][]string{
+		"params.branches":             {"main", "release"},
+		"tasks.aTask.results.aResult": {"dev", "stage"},
+		"tasks.aTask.results.empty":   {},
+	}
+	for _, tc := range []struct {
+		name     string
+		values   []string
+		expected []string
+	}{{
+		name:     "array param and array result expanded",
+		values:   []string{"$(params.branches[*])", "$(tasks.aTask.results.aResult[*])"},
+		expected: []string{"main", "release", "dev", "stage"},
+	}, {
+		name:     "string references and literals left unchanged",
+		values:   []string{"$(params.path)", "$(tasks.aTask.results.aResult[0])", "prod"},
+		expected: []string{"$(params.path)", "$(tasks.aTask.results.aResult[0])", "prod"},
+	}, {
+		name:     "empty array result",
+		values:   []string{"$(tasks.aTask.results.empty[*])"},
+		expected: nil,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			we := WhenExpression{Input: "$(params.path)", Operator: selection.In, Values: tc.values}
+			got := we.ExpandArrayValues(arrayReplacements)
+			if d := cmp.Diff(tc.expected, got); d != "" {
+				t.Errorf("ExpandArrayValues() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
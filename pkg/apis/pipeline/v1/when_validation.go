@@ -78,6 +78,13 @@ func (we *WhenExpression) validateWhenExpressionFields(ctx context.Context) *api
 	if len(we.Values) == 0 {
 		return apis.ErrInvalidValue("expecting non-empty values field", apis.CurrentField)
 	}
+	// whole array references such as $(params.foo[*]) are only expanded in values,
+	// the input must remain a single string
+	for _, expression := range validateString(we.Input) {
+		if strings.HasSuffix(expression, "[*]") {
+			return apis.ErrInvalidValue(fmt.Sprintf("input %q must be a single string and cannot reference a whole array with [*]", we.Input), "input")
+		}
+	}
 	return nil
 }
 
@@ -292,6 +292,39 @@ func (in *Param) DeepCopy() *Param {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamDeprecation) DeepCopyInto(out *ParamDeprecation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamDeprecation.
+func (in *ParamDeprecation) DeepCopy() *ParamDeprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamDeprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamSource) DeepCopyInto(out *ParamSource) {
+	*out = *in
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamSource.
+func (in *ParamSource) DeepCopy() *ParamSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParamSpec) DeepCopyInto(out *ParamSpec) {
 	*out = *in
@@ -312,6 +345,11 @@ func (in *ParamSpec) DeepCopyInto(out *ParamSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Deprecated != nil {
+		in, out := &in.Deprecated, &out.Deprecated
+		*out = new(ParamDeprecation)
+		**out = **in
+	}
 	return
 }
 
@@ -375,6 +413,32 @@ func (in *ParamValue) DeepCopy() *ParamValue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamValueSource) DeepCopyInto(out *ParamValueSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamValueSource.
+func (in *ParamValueSource) DeepCopy() *ParamValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Params) DeepCopyInto(out *Params) {
 	{
@@ -478,6 +542,11 @@ func (in *PipelineRef) DeepCopy() *PipelineRef {
 func (in *PipelineResult) DeepCopyInto(out *PipelineResult) {
 	*out = *in
 	in.Value.DeepCopyInto(&out.Value)
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(ParamValue)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -617,6 +686,20 @@ func (in *PipelineRunSpec) DeepCopyInto(out *PipelineRunSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ParamDefaults != nil {
+		in, out := &in.ParamDefaults, &out.ParamDefaults
+		*out = make(ParamSpecs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ParamSources != nil {
+		in, out := &in.ParamSources, &out.ParamSources
+		*out = make([]ParamSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Timeouts != nil {
 		in, out := &in.Timeouts, &out.Timeouts
 		*out = new(TimeoutFields)
@@ -691,6 +774,11 @@ func (in *PipelineRunStatusFields) DeepCopyInto(out *PipelineRunStatusFields) {
 		*out = new(PipelineSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResolvedPipelineSpec != nil {
+		in, out := &in.ResolvedPipelineSpec, &out.ResolvedPipelineSpec
+		*out = new(PipelineSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SkippedTasks != nil {
 		in, out := &in.SkippedTasks, &out.SkippedTasks
 		*out = make([]SkippedTask, len(*in))
@@ -836,6 +924,11 @@ func (in *PipelineTask) DeepCopyInto(out *PipelineTask) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DependsOnResults != nil {
+		in, out := &in.DependsOnResults, &out.DependsOnResults
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Params != nil {
 		in, out := &in.Params, &out.Params
 		*out = make(Params, len(*in))
@@ -1702,6 +1795,11 @@ func (in *TaskResult) DeepCopyInto(out *TaskResult) {
 			(*out)[key] = val
 		}
 	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int)
+		**out = **in
+	}
 	if in.Value != nil {
 		in, out := &in.Value, &out.Value
 		*out = new(ParamValue)
@@ -31,6 +31,9 @@ func (p ParamSpec) convertTo(ctx context.Context, sink *v1.ParamSpec) {
 	}
 	sink.Description = p.Description
 	sink.Enum = p.Enum
+	if p.Deprecated != nil {
+		sink.Deprecated = &v1.ParamDeprecation{Message: p.Deprecated.Message, RemovedIn: p.Deprecated.RemovedIn}
+	}
 	var properties map[string]v1.PropertySpec
 	if p.Properties != nil {
 		properties = make(map[string]v1.PropertySpec)
@@ -56,6 +59,9 @@ func (p *ParamSpec) convertFrom(ctx context.Context, source v1.ParamSpec) {
 	}
 	p.Description = source.Description
 	p.Enum = source.Enum
+	if source.Deprecated != nil {
+		p.Deprecated = &ParamDeprecation{Message: source.Deprecated.Message, RemovedIn: source.Deprecated.RemovedIn}
+	}
 	var properties map[string]PropertySpec
 	if source.Properties != nil {
 		properties = make(map[string]PropertySpec)
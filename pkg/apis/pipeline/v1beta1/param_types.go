@@ -26,6 +26,7 @@ import (
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/utils/strings/slices"
 	"knative.dev/pkg/apis"
 )
@@ -61,6 +62,23 @@ type ParamSpec struct {
 	// If Enum is not set, no input validation is performed for the param.
 	// +optional
 	Enum []string `json:"enum,omitempty"`
+	// Deprecated marks the parameter as deprecated, e.g. when it is renamed. A
+	// PipelineRun providing a deprecated parameter of its Pipeline gets a warning.
+	// +optional
+	Deprecated *ParamDeprecation `json:"deprecated,omitempty"`
+}
+
+// ParamDeprecation describes the deprecation of a parameter.
+type ParamDeprecation struct {
+	// Message tells the users what to do instead of providing the parameter,
+	// e.g. "use newParam instead".
+	// +optional
+	Message string `json:"message,omitempty"`
+	// RemovedIn is the version of the Pipeline in which the parameter is removed.
+	// The PipelineRuns providing the parameter fail once the version in the
+	// app.kubernetes.io/version label of the Pipeline is the same or later.
+	// +optional
+	RemovedIn string `json:"removedIn,omitempty"`
 }
 
 // ParamSpecs is a list of ParamSpec
@@ -165,6 +183,36 @@ func (ps ParamSpecs) validateParamEnums(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+// validateParamDeprecations validates that the versions in which the deprecated params are removed can be parsed
+func (ps ParamSpecs) validateParamDeprecations() *apis.FieldError {
+	var errs *apis.FieldError
+	for _, p := range ps {
+		if p.Deprecated == nil || p.Deprecated.RemovedIn == "" {
+			continue
+		}
+		if _, err := version.ParseGeneric(p.Deprecated.RemovedIn); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(p.Deprecated.RemovedIn, "deprecated.removedIn", "removedIn must be a version, e.g. v1.5").ViaKey(p.Name))
+		}
+	}
+	return errs
+}
+
+// DeprecationWarning returns the warning given to the users providing the param when it is deprecated, and an
+// empty string otherwise.
+func (pp *ParamSpec) DeprecationWarning() string {
+	if pp.Deprecated == nil {
+		return ""
+	}
+	warning := fmt.Sprintf("parameter %q is deprecated", pp.Name)
+	if pp.Deprecated.RemovedIn != "" {
+		warning += " and will be removed in " + pp.Deprecated.RemovedIn
+	}
+	if pp.Deprecated.Message != "" {
+		warning += ": " + pp.Deprecated.Message
+	}
+	return warning
+}
+
 // findDups returns the duplicate element in the given slice
 func findDups(vals []string) sets.String {
 	seen := sets.String{}
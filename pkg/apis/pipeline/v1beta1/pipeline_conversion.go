@@ -170,6 +170,7 @@ func (pt PipelineTask) convertTo(ctx context.Context, sink *v1.PipelineTask, met
 	sink.OnError = (v1.PipelineTaskOnErrorType)(pt.OnError)
 	sink.Retries = pt.Retries
 	sink.RunAfter = pt.RunAfter
+	sink.DependsOnResults = pt.DependsOnResults
 	sink.Params = nil
 	for _, p := range pt.Params {
 		new := v1.Param{}
@@ -190,6 +191,7 @@ func (pt PipelineTask) convertTo(ctx context.Context, sink *v1.PipelineTask, met
 	}
 
 	sink.Timeout = pt.Timeout
+	sink.TimeoutString = pt.TimeoutString
 	return nil
 }
 
@@ -219,6 +221,7 @@ func (pt *PipelineTask) convertFrom(ctx context.Context, source v1.PipelineTask,
 	pt.OnError = (PipelineTaskOnErrorType)(source.OnError)
 	pt.Retries = source.Retries
 	pt.RunAfter = source.RunAfter
+	pt.DependsOnResults = source.DependsOnResults
 	pt.Params = nil
 	for _, p := range source.Params {
 		new := Param{}
@@ -239,6 +242,7 @@ func (pt *PipelineTask) convertFrom(ctx context.Context, source v1.PipelineTask,
 	}
 
 	pt.Timeout = source.Timeout
+	pt.TimeoutString = source.TimeoutString
 	return nil
 }
 
@@ -312,6 +316,10 @@ func (pr PipelineResult) convertTo(ctx context.Context, sink *v1.PipelineResult)
 	newValue := v1.ParamValue{}
 	pr.Value.convertTo(ctx, &newValue)
 	sink.Value = newValue
+	if pr.Default != nil {
+		sink.Default = &v1.ParamValue{}
+		pr.Default.convertTo(ctx, sink.Default)
+	}
 }
 
 func (pr *PipelineResult) convertFrom(ctx context.Context, source v1.PipelineResult) {
@@ -321,6 +329,10 @@ func (pr *PipelineResult) convertFrom(ctx context.Context, source v1.PipelineRes
 	newValue := ParamValue{}
 	newValue.convertFrom(ctx, source.Value)
 	pr.Value = newValue
+	if source.Default != nil {
+		pr.Default = &ParamValue{}
+		pr.Default.convertFrom(ctx, *source.Default)
+	}
 }
 
 func (ptm PipelineTaskMetadata) convertTo(ctx context.Context, sink *v1.PipelineTaskMetadata) {
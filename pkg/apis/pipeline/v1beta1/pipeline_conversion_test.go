@@ -71,6 +71,11 @@ func TestPipelineConversion(t *testing.T) {
 					Type:        v1beta1.ParamTypeString,
 					Enum:        []string{"v1", "v2"},
 					Description: "My first param",
+				}, {
+					Name:       "param-0",
+					Type:       v1beta1.ParamTypeString,
+					Default:    v1beta1.NewStructuredValues("v1"),
+					Deprecated: &v1beta1.ParamDeprecation{Message: "use param-1 instead", RemovedIn: "v1.5"},
 				}},
 			},
 		},
@@ -169,8 +174,9 @@ func TestPipelineConversion(t *testing.T) {
 						Operator: selection.In,
 						Values:   []string{"foo", "bar"},
 					}},
-					Retries:  1,
-					RunAfter: []string{"task-1"},
+					Retries:          1,
+					RunAfter:         []string{"task-1"},
+					DependsOnResults: []string{"task-1.result-1"},
 					Params: v1beta1.Params{{
 						Name: "param-task-1",
 						Value: v1beta1.ParamValue{
@@ -218,12 +224,17 @@ func TestPipelineConversion(t *testing.T) {
 					Type:        v1beta1.ResultsTypeObject,
 					Description: "this is my pipeline result",
 					Value:       *v1beta1.NewStructuredValues("foo.bar"),
+				}, {
+					Name:    "my-pipeline-result-with-default",
+					Value:   *v1beta1.NewStructuredValues("$(tasks.foo.results.bar)"),
+					Default: v1beta1.NewStructuredValues("baz"),
 				}},
 				Finally: []v1beta1.PipelineTask{{
-					Name:        "final-task",
-					DisplayName: "final-task-display-name",
-					Description: "final-task-description",
-					TaskRef:     &v1beta1.TaskRef{Name: "foo-task"},
+					Name:          "final-task",
+					DisplayName:   "final-task-display-name",
+					Description:   "final-task-description",
+					TaskRef:       &v1beta1.TaskRef{Name: "foo-task"},
+					TimeoutString: "$(params.param-1)",
 				}},
 			},
 		},
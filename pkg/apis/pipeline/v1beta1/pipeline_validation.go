@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tektoncd/pipeline/internal/artifactref"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
@@ -79,16 +80,24 @@ func (ps *PipelineSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	}
 	// Validate the pipeline task graph
 	errs = errs.Also(validateGraph(ps.Tasks))
+	errs = errs.Also(validateDependsOnResultsReferences(ps.Tasks))
 	// The parameter variables should be valid
 	errs = errs.Also(ValidatePipelineParameterVariables(ctx, ps.Tasks, ps.Params).ViaField("tasks"))
 	errs = errs.Also(ValidatePipelineParameterVariables(ctx, ps.Finally, ps.Params).ViaField("finally"))
-	errs = errs.Also(validatePipelineContextVariables(ps.Tasks).ViaField("tasks"))
-	errs = errs.Also(validatePipelineContextVariables(ps.Finally).ViaField("finally"))
+	errs = errs.Also(validatePipelineContextVariables(ctx, ps.Tasks).ViaField("tasks"))
+	errs = errs.Also(validatePipelineContextVariables(ctx, ps.Finally).ViaField("finally"))
 	errs = errs.Also(validateExecutionStatusVariables(ps.Tasks, ps.Finally))
 	// Validate the pipeline's workspaces.
 	errs = errs.Also(validatePipelineWorkspacesDeclarations(ps.Workspaces))
 	// Validate the pipeline's results
 	errs = errs.Also(validatePipelineResults(ps.Results, ps.Tasks, ps.Finally))
+	// The syntax of the variables in params, when expressions and results should be valid
+	errs = errs.Also(validateSubstitutionSyntaxInPipelineTasks(ps.Tasks).ViaField("tasks"))
+	errs = errs.Also(validateSubstitutionSyntaxInPipelineTasks(ps.Finally).ViaField("finally"))
+	for i, result := range ps.Results {
+		expressions, _ := GetVarSubstitutionExpressionsForPipelineResult(result)
+		errs = errs.Also(validateSubstitutionSyntax(expressions).ViaFieldIndex("results", i))
+	}
 	errs = errs.Also(validateTasksAndFinallySection(ps))
 	errs = errs.Also(validateFinalTasks(ps.Tasks, ps.Finally))
 	errs = errs.Also(validateWhenExpressions(ctx, ps.Tasks, ps.Finally))
@@ -185,6 +194,9 @@ func (pt PipelineTask) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	errs = errs.Also(pt.validateEmbeddedOrType())
 
+	errs = errs.Also(pt.validateTimeoutString(ctx))
+	errs = errs.Also(pt.validateDependsOnResults(ctx))
+
 	if pt.Resources != nil {
 		errs = errs.Also(apis.ErrDisallowedFields("resources"))
 	}
@@ -239,6 +251,43 @@ func (pt PipelineTask) validateEnabledInlineSpec(ctx context.Context) (errs *api
 	return errs
 }
 
+// validateTimeoutString validates the TimeoutString field of a PipelineTask. Values
+// containing variable references are validated once substituted at runtime.
+func (pt PipelineTask) validateTimeoutString(ctx context.Context) (errs *apis.FieldError) {
+	if pt.TimeoutString == "" {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "timeoutString", config.AlphaAPIFields))
+	if pt.Timeout != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("timeout", "timeoutString"))
+	}
+	if VariableSubstitutionRegex.MatchString(pt.TimeoutString) {
+		return errs
+	}
+	d, err := time.ParseDuration(pt.TimeoutString)
+	if err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%q is not a valid duration: %v", pt.TimeoutString, err), "timeoutString"))
+	} else if d < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s should be >= 0", pt.TimeoutString), "timeoutString"))
+	}
+	return errs
+}
+
+// validateDependsOnResults validates the DependsOnResults field of a PipelineTask, each
+// entry being a reference to a result of another PipelineTask of the form <pipelineTask>.<result>.
+func (pt PipelineTask) validateDependsOnResults(ctx context.Context) (errs *apis.FieldError) {
+	if len(pt.DependsOnResults) == 0 {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "dependsOnResults", config.AlphaAPIFields))
+	for i, dependsOnResult := range pt.DependsOnResults {
+		if taskName, resultName, ok := strings.Cut(dependsOnResult, "."); !ok || taskName == "" || resultName == "" {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%q must be of the form <pipelineTask>.<result>", dependsOnResult), "").ViaFieldIndex("dependsOnResults", i))
+		}
+	}
+	return errs
+}
+
 func (pt *PipelineTask) validateMatrix(ctx context.Context) (errs *apis.FieldError) {
 	if pt.IsMatrixed() {
 		// This is a beta feature and will fail validation if it's used in a pipeline spec
@@ -438,6 +487,7 @@ func ValidatePipelineParameterVariables(ctx context.Context, tasks []PipelineTas
 	errs = errs.Also(ValidateParameterTypes(ctx, params).ViaField("params"))
 	errs = errs.Also(params.validateNoDuplicateNames())
 	errs = errs.Also(params.validateParamEnums(ctx).ViaField("params"))
+	errs = errs.Also(params.validateParamDeprecations().ViaField("params"))
 	for i, task := range tasks {
 		errs = errs.Also(task.Params.validateDuplicateParameters().ViaField("params").ViaIndex(i))
 	}
@@ -455,17 +505,31 @@ func validatePipelineParametersVariables(tasks []PipelineTask, prefix string, pa
 	return errs
 }
 
-func validatePipelineContextVariables(tasks []PipelineTask) *apis.FieldError {
+// auditContextVariableNames are the $(context.pipelineRun.*) variables gated behind
+// the "enable-audit-context-variables" feature flag.
+var auditContextVariableNames = sets.NewString("creationTimestamp", "generation")
+
+// statusContextVariableNames are the $(context.pipelineRun.*) variables exposing the
+// PipelineRun status, which are only available in finally tasks.
+var statusContextVariableNames = sets.NewString("startTime", "completionTime")
+
+func validatePipelineContextVariables(ctx context.Context, tasks []PipelineTask) *apis.FieldError {
 	pipelineRunContextNames := sets.NewString().Insert(
 		"name",
 		"namespace",
 		"uid",
-	)
+		"labels",
+		"annotations",
+		"serviceAccountName",
+	).Union(auditContextVariableNames).Union(statusContextVariableNames)
 	pipelineContextNames := sets.NewString().Insert(
 		"name",
+		"labels",
+		"annotations",
 	)
 	pipelineTaskContextNames := sets.NewString().Insert(
 		"retries",
+		"serviceAccountName",
 	)
 	var paramValues []string
 	for _, task := range tasks {
@@ -474,6 +538,21 @@ func validatePipelineContextVariables(tasks []PipelineTask) *apis.FieldError {
 	errs := validatePipelineContextVariablesInParamValues(paramValues, "context\\.pipelineRun", pipelineRunContextNames).
 		Also(validatePipelineContextVariablesInParamValues(paramValues, "context\\.pipeline", pipelineContextNames)).
 		Also(validatePipelineContextVariablesInParamValues(paramValues, "context\\.pipelineTask", pipelineTaskContextNames))
+	if !config.FromContextOrDefaults(ctx).FeatureFlags.EnableAuditContextVariables {
+		errs = errs.Also(validateAuditContextVariablesDisabled(paramValues))
+	}
+	return errs
+}
+
+// validateAuditContextVariablesDisabled returns an error if any of the param values reference
+// $(context.pipelineRun.creationTimestamp) or $(context.pipelineRun.generation).
+func validateAuditContextVariablesDisabled(paramValues []string) (errs *apis.FieldError) {
+	for _, paramValue := range paramValues {
+		vs, _, _ := substitution.ExtractVariablesFromString(paramValue, "context\\.pipelineRun")
+		if auditContextVariableNames.HasAny(vs...) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("feature flag %s should be set to true to use $(context.pipelineRun.creationTimestamp) or $(context.pipelineRun.generation)", config.EnableAuditContextVariables), "value"))
+		}
+	}
 	return errs
 }
 
@@ -494,12 +573,20 @@ func (pt *PipelineTask) extractAllParams() Params {
 	return allParams
 }
 
-// containsExecutionStatusRef checks if a specified param has a reference to execution status or reason
-// $(tasks.<task-name>.status), $(tasks.status), or $(tasks.<task-name>.reason)
+// executionStatusSuffixes are the suffixes of the references to the execution status, reason, failure
+// message, start or completion time or pod name of a pipeline task, e.g. $(tasks.<task-name>.status)
+var executionStatusSuffixes = []string{".status", ".reason", ".message", ".startTime", ".completionTime", ".podName"}
+
+// containsExecutionStatusRef checks if a specified param has a reference to execution status, reason, message,
+// times or pod name $(tasks.<task-name>.status), $(tasks.status), $(tasks.<task-name>.reason),
+// $(tasks.<task-name>.message), $(tasks.<task-name>.startTime), $(tasks.<task-name>.completionTime) or
+// $(tasks.<task-name>.podName)
 func containsExecutionStatusRef(p string) bool {
 	if strings.HasPrefix(p, "tasks.") {
-		if strings.HasSuffix(p, ".status") || strings.HasSuffix(p, ".reason") {
-			return true
+		for _, suffix := range executionStatusSuffixes {
+			if strings.HasSuffix(p, suffix) {
+				return true
+			}
 		}
 	}
 	return false
@@ -550,9 +637,24 @@ func validateContainsExecutionStatusVariablesDisallowed(expressions []string, pa
 		errs = errs.Also(apis.ErrInvalidValue("pipeline tasks can not refer to execution status"+
 			" of any other pipeline task or aggregate status of tasks", path))
 	}
+	if containsStatusContextReferences(expressions) {
+		errs = errs.Also(apis.ErrInvalidValue("pipeline tasks can not refer to $(context.pipelineRun.startTime)"+
+			" or $(context.pipelineRun.completionTime), they are only available in finally tasks", path))
+	}
 	return errs
 }
 
+// containsStatusContextReferences checks if any of the expressions is a reference to the
+// PipelineRun status - $(context.pipelineRun.startTime) or $(context.pipelineRun.completionTime)
+func containsStatusContextReferences(expressions []string) bool {
+	for _, e := range expressions {
+		if name, ok := strings.CutPrefix(e, "context.pipelineRun."); ok && statusContextVariableNames.Has(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func containsExecutionStatusReferences(expressions []string) bool {
 	// validate tasks.pipelineTask.status/tasks.status if this expression is not a result reference
 	if !LooksLikeContainsResultRefs(expressions) {
@@ -591,16 +693,15 @@ func validateExecutionStatusVariablesExpressions(expressions []string, ptNames s
 			if expression == PipelineTasksAggregateStatus {
 				continue
 			}
-			// check if it contains context variable accessing execution status - $(tasks.taskname.status) | $(tasks.taskname.reason)
+			// check if it contains context variable accessing execution status - $(tasks.taskname.status) |
+			// $(tasks.taskname.reason) | $(tasks.taskname.message)
 			if containsExecutionStatusRef(expression) {
 				var pt string
-				if strings.HasSuffix(expression, ".status") {
-					// strip tasks. and .status from tasks.taskname.status to further verify task name
-					pt = strings.TrimSuffix(strings.TrimPrefix(expression, "tasks."), ".status")
-				}
-				if strings.HasSuffix(expression, ".reason") {
-					// strip tasks. and .reason from tasks.taskname.reason to further verify task name
-					pt = strings.TrimSuffix(strings.TrimPrefix(expression, "tasks."), ".reason")
+				for _, suffix := range executionStatusSuffixes {
+					if strings.HasSuffix(expression, suffix) {
+						// strip tasks. and the suffix from e.g. tasks.taskname.status to further verify task name
+						pt = strings.TrimSuffix(strings.TrimPrefix(expression, "tasks."), suffix)
+					}
 				}
 				// report an error if the task name does not exist in the list of dag tasks
 				if !ptNames.Has(pt) {
@@ -629,6 +730,47 @@ func filter(arr []string, cond func(string) bool) []string {
 	return result
 }
 
+// validateSubstitutionSyntaxInPipelineTasks validates the syntax of the variables referenced in the params,
+// the matrix and the when expressions of the pipeline tasks with substitution.ValidateSubstitutionSyntax.
+// The variables are the ones matched by VariableSubstitutionRegex, e.g. "$(tasks.build.results)" or
+// "$(params..name)", whose dot-separated segments may be empty or incomplete.
+func validateSubstitutionSyntaxInPipelineTasks(tasks []PipelineTask) (errs *apis.FieldError) {
+	for i, pt := range tasks {
+		var taskErrs *apis.FieldError
+		for _, param := range pt.Params {
+			expressions, _ := GetVarSubstitutionExpressionsForParam(param)
+			taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldKey("params", param.Name))
+		}
+		if pt.Matrix != nil {
+			for _, param := range pt.Matrix.Params {
+				expressions, _ := GetVarSubstitutionExpressionsForParam(param)
+				taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldKey("params", param.Name).ViaField("matrix"))
+			}
+			for j, include := range pt.Matrix.Include {
+				for _, param := range include.Params {
+					expressions, _ := GetVarSubstitutionExpressionsForParam(param)
+					taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldKey("params", param.Name).ViaFieldIndex("include", j).ViaField("matrix"))
+				}
+			}
+		}
+		for j, we := range pt.WhenExpressions {
+			expressions, _ := we.GetVarSubstitutionExpressions()
+			taskErrs = taskErrs.Also(validateSubstitutionSyntax(expressions).ViaFieldIndex("when", j))
+		}
+		errs = errs.Also(taskErrs.ViaIndex(i))
+	}
+	return errs
+}
+
+func validateSubstitutionSyntax(expressions []string) (errs *apis.FieldError) {
+	for _, expression := range expressions {
+		if err := substitution.ValidateSubstitutionSyntax(expression); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), "value"))
+		}
+	}
+	return errs
+}
+
 // validatePipelineResults ensure that pipeline result variables are properly configured
 func validatePipelineResults(results []PipelineResult, tasks []PipelineTask, finally []PipelineTask) (errs *apis.FieldError) {
 	pipelineTaskNames := getPipelineTasksNames(tasks)
@@ -656,6 +798,13 @@ func validatePipelineResults(results []PipelineResult, tasks []PipelineTask, fin
 			errs = errs.Also(apis.ErrInvalidValue("referencing a nonexistent task",
 				"value").ViaFieldIndex("results", idx))
 		}
+
+		if result.Default != nil {
+			if defaultExpressions, ok := GetVarSubstitutionExpressionsForParam(Param{Value: *result.Default}); ok {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("default must be a literal value but found expressions %v", defaultExpressions),
+					"default").ViaFieldIndex("results", idx))
+			}
+		}
 	}
 
 	return errs
@@ -708,6 +857,9 @@ func validateFinalTasks(tasks []PipelineTask, finalTasks []PipelineTask) (errs *
 		if len(f.RunAfter) != 0 {
 			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("no runAfter allowed under spec.finally, final task %s has runAfter specified", f.Name), "").ViaFieldIndex("finally", idx))
 		}
+		if len(f.DependsOnResults) != 0 {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("no dependsOnResults allowed under spec.finally, final task %s has dependsOnResults specified", f.Name), "").ViaFieldIndex("finally", idx))
+		}
 	}
 
 	ts := PipelineTaskList(tasks).Names()
@@ -766,6 +918,33 @@ func validateWhenExpressions(ctx context.Context, tasks []PipelineTask, finalTas
 // validateGraph ensures the Pipeline's dependency Graph (DAG) make sense: that there is no dependency
 // cycle or that they rely on values from Tasks that ran previously, and that the PipelineResource
 // is actually an output of the Task it should come from.
+// validateDependsOnResultsReferences ensures the results the pipeline tasks depend on are declared by the
+// pipeline tasks producing them. Only the results of embedded Tasks can be checked. Dependencies on pipeline
+// tasks which do not exist are reported by validateGraph and are ignored here.
+func validateDependsOnResultsReferences(tasks []PipelineTask) (errs *apis.FieldError) {
+	taskMapping := createTaskMapping(tasks)
+	for idx, t := range tasks {
+		for i, dependsOnResult := range t.DependsOnResults {
+			taskName, resultName, _ := strings.Cut(dependsOnResult, ".")
+			pt, ok := taskMapping[taskName]
+			if !ok || pt.TaskSpec == nil || pt.TaskSpec.IsCustomTask() {
+				continue
+			}
+			declared := false
+			for _, r := range pt.TaskSpec.Results {
+				if r.Name == resultName {
+					declared = true
+					break
+				}
+			}
+			if !declared {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("result %q is not declared by pipeline task %q", resultName, taskName), "").ViaFieldIndex("dependsOnResults", i).ViaFieldIndex("tasks", idx))
+			}
+		}
+	}
+	return errs
+}
+
 func validateGraph(tasks []PipelineTask) (errs *apis.FieldError) {
 	if _, err := dag.Build(PipelineTaskList(tasks), PipelineTaskList(tasks).Deps()); err != nil {
 		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "tasks"))
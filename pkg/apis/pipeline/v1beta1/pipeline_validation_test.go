@@ -1018,7 +1018,41 @@ func TestPipelineSpec_Validate_Failure(t *testing.T) {
 				}},
 			}},
 		},
-		expectedError: *apis.ErrGeneric(`invalid value: couldn't add link between invalid-pipeline-task and : task invalid-pipeline-task depends on  but  wasn't present in Pipeline`, "tasks"),
+		expectedError: *apis.ErrGeneric(`invalid value: couldn't add link between invalid-pipeline-task and : task invalid-pipeline-task depends on  but  wasn't present in Pipeline`, "tasks").Also(
+			apis.ErrInvalidValue(`empty segment in variable "tasks..results.bResult"`, "tasks[0].when[0].value")),
+	}, {
+		name: "invalid pipeline with malformed variables in params, when expressions and results",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name: "a-task",
+				TaskSpec: &EmbeddedTask{TaskSpec: TaskSpec{
+					Results: []TaskResult{{Name: "digest"}},
+					Steps:   []Step{{Name: "foo", Image: "bar"}},
+				}},
+			}, {
+				Name:    "b-task",
+				TaskRef: &TaskRef{Name: "foo-task"},
+				Params: Params{{
+					Name: "digest", Value: *NewStructuredValues("$(tasks.a-task.results)"),
+				}},
+			}},
+			Finally: []PipelineTask{{
+				Name:    "c-task",
+				TaskRef: &TaskRef{Name: "foo-task"},
+				WhenExpressions: WhenExpressions{{
+					Input:    "$(tasks.a-task.results.digest.)",
+					Operator: selection.In,
+					Values:   []string{"foo"},
+				}},
+			}},
+			Results: []PipelineResult{{
+				Name:  "digest",
+				Value: *NewStructuredValues("$(tasks.a-task.results..digest)"),
+			}},
+		},
+		expectedError: *apis.ErrInvalidValue(`missing result name in variable "tasks.a-task.results"`, "tasks[1].params[digest].value").Also(
+			apis.ErrInvalidValue(`empty segment in variable "tasks.a-task.results.digest."`, "finally[0].when[0].value")).Also(
+			apis.ErrInvalidValue(`empty segment in variable "tasks.a-task.results..digest"`, "results[0].value")),
 	}, {
 		name: "invalid pipeline with a pipelineTask having when expression with invalid result reference - referenced task does not exist in the pipeline",
 		ps: &PipelineSpec{
@@ -1423,6 +1457,11 @@ func TestValidatePipelineResults_Success(t *testing.T) {
 		Name:        "my-pipeline-object-result",
 		Description: "this is my pipeline result",
 		Value:       *NewStructuredValues("$(tasks.a-task.results.gitrepo.commit)"),
+	}, {
+		Name:        "my-pipeline-result-with-default",
+		Description: "this is my pipeline result",
+		Value:       *NewStructuredValues("$(tasks.a-task.results.output)"),
+		Default:     NewStructuredValues("fallback"),
 	}}
 	if err := validatePipelineResults(results, []PipelineTask{{Name: "a-task"}}, []PipelineTask{}); err != nil {
 		t.Errorf("Pipeline.validatePipelineResults() returned error for valid pipeline: %s: %v", desc, err)
@@ -1471,6 +1510,15 @@ func TestValidatePipelineResults_Failure(t *testing.T) {
 		}},
 		expectedError: *apis.ErrInvalidValue(`expected pipeline results to be task result expressions but an invalid expressions was found`, "results[0].value").Also(
 			apis.ErrInvalidValue("referencing a nonexistent task", "results[0].value")),
+	}, {
+		desc: "invalid pipeline result default with expression",
+		results: []PipelineResult{{
+			Name:        "my-pipeline-result",
+			Description: "this is my pipeline result",
+			Value:       *NewStructuredValues("$(tasks.a-task.results.output)"),
+			Default:     NewStructuredValues("$(params.foo)"),
+		}},
+		expectedError: *apis.ErrInvalidValue(`default must be a literal value but found expressions [params.foo]`, "results[0].default"),
 	}}
 	for _, tt := range tests {
 		err := validatePipelineResults(tt.results, []PipelineTask{{Name: "a-task"}}, []PipelineTask{})
@@ -2405,6 +2453,22 @@ func TestValidatePipelineParameterVariables_Failure(t *testing.T) {
 				Message: "feature flag `enable-param-enum` should be set to true to use Enum",
 				Paths:   []string{"params[param1]"},
 			},
+		}, {
+			name: "param deprecation with an invalid removal version - failure",
+			params: []ParamSpec{{
+				Name:       "param1",
+				Type:       ParamTypeString,
+				Deprecated: &ParamDeprecation{Message: "use param2 instead", RemovedIn: "next"},
+			}},
+			tasks: []PipelineTask{{
+				Name:    "foo",
+				TaskRef: &TaskRef{Name: "foo-task"},
+			}},
+			expectedError: apis.FieldError{
+				Message: "invalid value: next",
+				Paths:   []string{"params[param1].deprecated.removedIn"},
+				Details: "removedIn must be a version, e.g. v1.5",
+			},
 		}, {
 			name: "invalid parameter type",
 			params: []ParamSpec{{
@@ -3463,6 +3527,7 @@ func TestContextValid(t *testing.T) {
 	tests := []struct {
 		name  string
 		tasks []PipelineTask
+		wc    func(context.Context) context.Context
 	}{{
 		name: "valid string context variable for Pipeline name",
 		tasks: []PipelineTask{{
@@ -3477,6 +3542,15 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for PipelineRun status",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.startTime) $(context.pipelineRun.completionTime)"},
+			}},
+		}},
 	}, {
 		name: "valid string context variable for PipelineRun name",
 		tasks: []PipelineTask{{
@@ -3533,6 +3607,17 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for Pipeline labels and annotations",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipeline.labels.owner-team)"},
+			}, {
+				Name: "b-param", Value: ParamValue{StringVal: "$(context.pipeline.annotations.example_com_sla-tier)"},
+			}},
+		}},
 	}, {
 		name: "valid string context variable for PipelineTask retries",
 		tasks: []PipelineTask{{
@@ -3595,10 +3680,28 @@ func TestContextValid(t *testing.T) {
 				}},
 			},
 		}},
+	}, {
+		name: "valid string context variables for PipelineRun creationTimestamp and generation",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.creationTimestamp)"},
+			}, {
+				Name: "b-param", Value: ParamValue{StringVal: "gen-$(context.pipelineRun.generation)"},
+			}},
+		}},
+		wc: func(ctx context.Context) context.Context {
+			return cfgtesting.SetFeatureFlags(ctx, t, map[string]string{"enable-audit-context-variables": "true"})
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := validatePipelineContextVariables(tt.tasks); err != nil {
+			ctx := context.Background()
+			if tt.wc != nil {
+				ctx = tt.wc(ctx)
+			}
+			if err := validatePipelineContextVariables(ctx, tt.tasks); err != nil {
 				t.Errorf("Pipeline.validatePipelineContextVariables() returned error for valid pipeline context variables: %v", err)
 			}
 		})
@@ -3747,10 +3850,23 @@ func TestContextInvalid(t *testing.T) {
 			Message: `non-existent variable in "$(context.pipelineTask.missing)"`,
 			Paths:   []string{"value"},
 		}),
+	}, {
+		name: "context variable for PipelineRun creationTimestamp without feature flag",
+		tasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "a-param", Value: ParamValue{StringVal: "$(context.pipelineRun.creationTimestamp)"},
+			}},
+		}},
+		expectedError: *apis.ErrGeneric("").Also(&apis.FieldError{
+			Message: `feature flag enable-audit-context-variables should be set to true to use $(context.pipelineRun.creationTimestamp) or $(context.pipelineRun.generation)`,
+			Paths:   []string{"value"},
+		}),
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePipelineContextVariables(tt.tasks)
+			err := validatePipelineContextVariables(context.Background(), tt.tasks)
 			if err == nil {
 				t.Errorf("Pipeline.validatePipelineContextVariables() did not return error for invalid pipeline parameters: %s", tt.tasks[0].Params)
 			}
@@ -3779,6 +3895,14 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 				Name: "foo-status", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.status)"},
 			}, {
 				Name: "foo-reason", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.reason)"},
+			}, {
+				Name: "foo-message", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.message)"},
+			}, {
+				Name: "foo-start-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.startTime)"},
+			}, {
+				Name: "foo-completion-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.completionTime)"},
+			}, {
+				Name: "foo-pod-name", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.foo.podName)"},
 			}, {
 				Name: "tasks-status", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.status)"},
 			}},
@@ -3834,6 +3958,31 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 				Name: "foo-status", Value: ParamValue{Type: ParamTypeString, StringVal: "Execution status of $(tasks.taskname) is $(tasks.foo.status)."},
 			}},
 		}},
+	}, {
+		name: "valid string variable in finally accessing PipelineRun status context",
+		tasks: []PipelineTask{{
+			Name: "foo",
+		}},
+		finalTasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "times", Value: ParamValue{Type: ParamTypeString, StringVal: "$(context.pipelineRun.startTime) - $(context.pipelineRun.completionTime)"},
+			}},
+		}},
+	}, {
+		name: "invalid string variable in dag task accessing PipelineRun status context",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "start-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(context.pipelineRun.startTime)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to $(context.pipelineRun.startTime) or $(context.pipelineRun.completionTime), they are only available in finally tasks`,
+			Paths:   []string{"tasks[0].params[start-time].value"},
+		},
 	}, {
 		name: "invalid string variable in dag task accessing pipelineTask status",
 		tasks: []PipelineTask{{
@@ -3998,6 +4147,71 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 			Message: `invalid value: pipeline task notask is not defined in the pipeline`,
 			Paths:   []string{"finally[0].params[notask-status].value"},
 		},
+	}, {
+		name: "invalid string variable in finally accessing missing pipelineTask message",
+		finalTasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "notask-message", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.notask.message)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline task notask is not defined in the pipeline`,
+			Paths:   []string{"finally[0].params[notask-message].value"},
+		},
+	}, {
+		name: "invalid string variable in dag task accessing pipelineTask message",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "bar-message", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.bar.message)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to execution status of any other pipeline task or aggregate status of tasks`,
+			Paths:   []string{"tasks[0].params[bar-message].value"},
+		},
+	}, {
+		name: "invalid string variable in dag task accessing pipelineTask start time",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "bar-start-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.bar.startTime)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to execution status of any other pipeline task or aggregate status of tasks`,
+			Paths:   []string{"tasks[0].params[bar-start-time].value"},
+		},
+	}, {
+		name: "invalid string variable in dag task accessing pipelineTask pod name",
+		tasks: []PipelineTask{{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "foo-task"},
+			Params: Params{{
+				Name: "bar-pod-name", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.bar.podName)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline tasks can not refer to execution status of any other pipeline task or aggregate status of tasks`,
+			Paths:   []string{"tasks[0].params[bar-pod-name].value"},
+		},
+	}, {
+		name: "invalid string variable in finally accessing missing pipelineTask completion time",
+		finalTasks: []PipelineTask{{
+			Name:    "bar",
+			TaskRef: &TaskRef{Name: "bar-task"},
+			Params: Params{{
+				Name: "notask-completion-time", Value: ParamValue{Type: ParamTypeString, StringVal: "$(tasks.notask.completionTime)"},
+			}},
+		}},
+		expectedError: apis.FieldError{
+			Message: `invalid value: pipeline task notask is not defined in the pipeline`,
+			Paths:   []string{"finally[0].params[notask-completion-time].value"},
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
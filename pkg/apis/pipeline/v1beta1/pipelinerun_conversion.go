@@ -68,6 +68,16 @@ func (prs PipelineRunSpec) ConvertTo(ctx context.Context, sink *v1.PipelineRunSp
 		p.convertTo(ctx, &new)
 		sink.Params = append(sink.Params, new)
 	}
+	sink.ParamDefaults = nil
+	for _, p := range prs.ParamDefaults {
+		new := v1.ParamSpec{}
+		p.convertTo(ctx, &new)
+		sink.ParamDefaults = append(sink.ParamDefaults, new)
+	}
+	sink.ParamSources = nil
+	for _, p := range prs.ParamSources {
+		sink.ParamSources = append(sink.ParamSources, v1.ParamSource{Name: p.Name, ValueFrom: v1.ParamValueSource(p.ValueFrom)})
+	}
 	sink.Status = v1.PipelineRunSpecStatus(prs.Status)
 	if prs.Timeouts != nil {
 		sink.Timeouts = &v1.TimeoutFields{}
@@ -133,6 +143,16 @@ func (prs *PipelineRunSpec) ConvertFrom(ctx context.Context, source *v1.Pipeline
 		new.ConvertFrom(ctx, p)
 		prs.Params = append(prs.Params, new)
 	}
+	prs.ParamDefaults = nil
+	for _, p := range source.ParamDefaults {
+		new := ParamSpec{}
+		new.convertFrom(ctx, p)
+		prs.ParamDefaults = append(prs.ParamDefaults, new)
+	}
+	prs.ParamSources = nil
+	for _, p := range source.ParamSources {
+		prs.ParamSources = append(prs.ParamSources, ParamSource{Name: p.Name, ValueFrom: ParamValueSource(p.ValueFrom)})
+	}
 	prs.ServiceAccountName = source.TaskRunTemplate.ServiceAccountName
 	prs.Status = PipelineRunSpecStatus(source.Status)
 	if source.Timeouts != nil {
@@ -232,6 +252,13 @@ func (prs *PipelineRunStatus) convertTo(ctx context.Context, sink *v1.PipelineRu
 			return err
 		}
 	}
+	if prs.ResolvedPipelineSpec != nil {
+		sink.ResolvedPipelineSpec = &v1.PipelineSpec{}
+		err := prs.ResolvedPipelineSpec.ConvertTo(ctx, sink.ResolvedPipelineSpec, meta)
+		if err != nil {
+			return err
+		}
+	}
 	sink.SkippedTasks = nil
 	for _, st := range prs.SkippedTasks {
 		new := v1.SkippedTask{}
@@ -271,6 +298,14 @@ func (prs *PipelineRunStatus) convertFrom(ctx context.Context, source *v1.Pipeli
 		}
 		prs.PipelineSpec = &newPipelineSpec
 	}
+	if source.ResolvedPipelineSpec != nil {
+		newResolvedPipelineSpec := PipelineSpec{}
+		err := newResolvedPipelineSpec.ConvertFrom(ctx, source.ResolvedPipelineSpec, meta)
+		if err != nil {
+			return err
+		}
+		prs.ResolvedPipelineSpec = &newResolvedPipelineSpec
+	}
 	prs.SkippedTasks = nil
 	for _, st := range source.SkippedTasks {
 		new := SkippedTask{}
@@ -236,6 +236,28 @@ func TestPipelineRunConversion(t *testing.T) {
 					Name:  "bar",
 					Value: *v1beta1.NewStructuredValues("value"),
 				}},
+				ParamDefaults: v1beta1.ParamSpecs{{
+					Name:    "baz",
+					Type:    v1beta1.ParamTypeString,
+					Default: v1beta1.NewStructuredValues("default"),
+				}},
+				ParamSources: []v1beta1.ParamSource{{
+					Name: "token",
+					ValueFrom: v1beta1.ParamValueSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+							Key:                  "token",
+						},
+					},
+				}, {
+					Name: "region",
+					ValueFrom: v1beta1.ParamValueSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+							Key:                  "region",
+						},
+					},
+				}},
 				ServiceAccountName: "test-sa",
 				Status:             v1beta1.PipelineRunSpecStatusPending,
 				Timeouts: &v1beta1.TimeoutFields{
@@ -336,6 +358,15 @@ func TestPipelineRunConversion(t *testing.T) {
 							},
 						}},
 					},
+					ResolvedPipelineSpec: &v1beta1.PipelineSpec{
+						Tasks: []v1beta1.PipelineTask{{
+							Name: "mytask",
+							TaskRef: &v1beta1.TaskRef{
+								Name: "mytask",
+							},
+							Params: v1beta1.Params{{Name: "image", Value: *v1beta1.NewStructuredValues("app")}},
+						}},
+					},
 					SkippedTasks: []v1beta1.SkippedTask{
 						{
 							Name:   "skipped-1",
@@ -18,11 +18,14 @@ package v1beta1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
 	"github.com/tektoncd/pipeline/pkg/internal/resultref"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -56,9 +59,25 @@ func (pr *PipelineRun) Validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(apis.ErrInvalidValue("PipelineRun cannot be Pending after it is started", "spec.status"))
 	}
 
+	if pr.Spec.PipelineSpec != nil {
+		errs = errs.Also(pr.validateContextMetadataVariables(ctx).ViaField("spec.pipelineSpec"))
+	}
+
 	return errs.Also(pr.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
 }
 
+// validateContextMetadataVariables validates that the $(context.pipelineRun.labels.<key>) and
+// $(context.pipelineRun.annotations.<key>) variables used in an embedded PipelineSpec refer to labels
+// and annotations which are set on the PipelineRun, as for the v1 PipelineRuns.
+func (pr *PipelineRun) validateContextMetadataVariables(ctx context.Context) *apis.FieldError {
+	ps := &v1.PipelineSpec{}
+	if err := pr.Spec.PipelineSpec.ConvertTo(ctx, ps, &pr.ObjectMeta); err != nil {
+		// the PipelineSpec which can't be converted is reported by its own validation
+		return nil
+	}
+	return v1.ValidateContextMetadataVariables(ps, pr.Labels, pr.Annotations)
+}
+
 // Validate pipelinerun spec
 func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// Validate the spec changes
@@ -88,6 +107,9 @@ func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError)
 
 	// Validate PipelineRun parameters
 	errs = errs.Also(ps.validatePipelineRunParameters(ctx))
+	errs = errs.Also(ps.validateParamDefaults(ctx))
+	errs = errs.Also(ps.validateParamSources())
+	errs = errs.Also(ps.validateDeprecatedParams())
 
 	// Validate propagated parameters
 	errs = errs.Also(ps.validateInlineParameters(ctx))
@@ -160,12 +182,15 @@ func (ps *PipelineRunSpec) ValidateUpdate(ctx context.Context) (errs *apis.Field
 	}
 	old := &oldObj.Spec
 
-	// If already in the done state, the spec cannot be modified. Otherwise, only the status field can be modified.
+	// If already in the done state, the spec cannot be modified. Otherwise, only the status and params fields can
+	// be modified, the updated params are used by the PipelineTasks which have not started yet.
 	tips := "Once the PipelineRun is complete, no updates are allowed"
 	if !oldObj.IsDone() {
 		old = old.DeepCopy()
 		old.Status = ps.Status
-		tips = "Once the PipelineRun has started, only status updates are allowed"
+		old.Params = ps.Params
+		tips = "Once the PipelineRun has started, only status and params updates are allowed"
+		errs = errs.Also(validateStartedParamsNotUpdated(oldObj, ps.Params))
 	}
 	if !equality.Semantic.DeepEqual(old, ps) {
 		errs = errs.Also(apis.ErrInvalidValue(tips, ""))
@@ -174,6 +199,129 @@ func (ps *PipelineRunSpec) ValidateUpdate(ctx context.Context) (errs *apis.Field
 	return
 }
 
+// validateStartedParamsNotUpdated validates that the params referenced by the PipelineTasks of the PipelineRun
+// which have started, as recorded by the reconciler in its tekton.dev/param-consumers annotation, are not added,
+// removed or changed. If they are not recorded yet, none of the params can be updated once a PipelineTask started.
+func validateStartedParamsNotUpdated(oldObj *PipelineRun, params Params) (errs *apis.FieldError) {
+	started := sets.NewString()
+	for _, child := range oldObj.Status.ChildReferences {
+		started.Insert(child.PipelineTaskName)
+	}
+	if started.Len() == 0 {
+		return nil
+	}
+	var consumers map[string][]string
+	recorded, ok := oldObj.Annotations[pipeline.ParamConsumersAnnotationKey]
+	if ok && json.Unmarshal([]byte(recorded), &consumers) != nil {
+		ok = false
+	}
+
+	values := map[string]ParamValue{}
+	for _, p := range oldObj.Spec.Params {
+		values[p.Name] = p.Value
+	}
+	updated := sets.NewString()
+	for _, p := range params {
+		if value, found := values[p.Name]; !found || !equality.Semantic.DeepEqual(value, p.Value) {
+			updated.Insert(p.Name)
+		}
+		delete(values, p.Name)
+	}
+	for name := range values {
+		updated.Insert(name)
+	}
+	for _, name := range updated.List() {
+		switch {
+		case !ok:
+			errs = errs.Also(apis.ErrGeneric("the params used by the PipelineTasks which have started are not known yet", "").ViaFieldKey("params", name))
+		case started.HasAny(consumers[name]...):
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("the param is used by the PipelineTasks which have started: %s",
+				strings.Join(started.Intersection(sets.NewString(consumers[name]...)).List(), ", ")), "").ViaFieldKey("params", name))
+		}
+	}
+	return errs
+}
+
+// validateParamDefaults validates that the ParamDefaults have a default value matching their type and are
+// declared once.
+func (ps *PipelineRunSpec) validateParamDefaults(ctx context.Context) (errs *apis.FieldError) {
+	names := sets.NewString()
+	for _, p := range ps.ParamDefaults {
+		if p.Default == nil {
+			errs = errs.Also(apis.ErrMissingField("default").ViaFieldKey("paramDefaults", p.Name))
+		}
+		if names.Has(p.Name) {
+			errs = errs.Also(apis.ErrGeneric("parameter appears more than once", "").ViaFieldKey("paramDefaults", p.Name))
+		}
+		names.Insert(p.Name)
+	}
+	return errs.Also(ValidateParameterTypes(ctx, ps.ParamDefaults).ViaField("paramDefaults"))
+}
+
+// validateParamSources validates that each of the ParamSources selects exactly one key of a Secret or a
+// ConfigMap, and that its name is neither repeated nor provided in the Params.
+func (ps *PipelineRunSpec) validateParamSources() (errs *apis.FieldError) {
+	names := sets.NewString()
+	for _, p := range ps.Params {
+		names.Insert(p.Name)
+	}
+	sourceNames := sets.NewString()
+	for idx, p := range ps.ParamSources {
+		if p.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("paramSources", idx))
+			continue
+		}
+		switch {
+		case sourceNames.Has(p.Name):
+			errs = errs.Also(apis.ErrGeneric("parameter appears more than once", "").ViaFieldKey("paramSources", p.Name))
+		case names.Has(p.Name):
+			errs = errs.Also(apis.ErrGeneric("parameter is also provided in params", "").ViaFieldKey("paramSources", p.Name))
+		}
+		sourceNames.Insert(p.Name)
+
+		var selectorErrs *apis.FieldError
+		switch secret, configMap := p.ValueFrom.SecretKeyRef, p.ValueFrom.ConfigMapKeyRef; {
+		case secret != nil && configMap != nil:
+			selectorErrs = apis.ErrMultipleOneOf("secretKeyRef", "configMapKeyRef")
+		case secret != nil:
+			selectorErrs = validateKeySelector(secret.Name, secret.Key).ViaField("secretKeyRef")
+		case configMap != nil:
+			selectorErrs = validateKeySelector(configMap.Name, configMap.Key).ViaField("configMapKeyRef")
+		default:
+			selectorErrs = apis.ErrMissingOneOf("secretKeyRef", "configMapKeyRef")
+		}
+		errs = errs.Also(selectorErrs.ViaField("valueFrom").ViaFieldKey("paramSources", p.Name))
+	}
+	return errs
+}
+
+// validateDeprecatedParams warns about the params provided by the PipelineRun which are deprecated in its embedded
+// PipelineSpec. The params deprecated in a referenced Pipeline are reported by the reconciler.
+func (ps *PipelineRunSpec) validateDeprecatedParams() (errs *apis.FieldError) {
+	if ps.PipelineSpec == nil {
+		return nil
+	}
+	provided := ps.Params.ExtractNames()
+	for _, p := range ps.PipelineSpec.Params {
+		if warning := p.DeprecationWarning(); warning != "" && provided.Has(p.Name) {
+			errs = errs.Also(apis.ErrGeneric(warning, "").ViaFieldKey("params", p.Name).At(apis.WarningLevel))
+		}
+	}
+	return errs
+}
+
+// validateKeySelector validates that a key selector of a ParamSource sets both the name of the object
+// and the key.
+func validateKeySelector(name, key string) (errs *apis.FieldError) {
+	if name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	}
+	return errs
+}
+
 func (ps *PipelineRunSpec) validatePipelineRunParameters(ctx context.Context) (errs *apis.FieldError) {
 	if len(ps.Params) == 0 {
 		return errs
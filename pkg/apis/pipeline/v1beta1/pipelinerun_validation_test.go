@@ -25,12 +25,14 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"github.com/tektoncd/pipeline/test/diff"
 	corev1 "k8s.io/api/core/v1"
 	corev1resources "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/selection"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -522,6 +524,54 @@ func TestPipelineRun_Invalid(t *testing.T) {
 		},
 		want: &apis.FieldError{Message: "must not set the field(s)", Paths: []string{"spec.pipelineRef.bundle"}},
 		wc:   apis.WithinCreate,
+	}, {
+		name: "pipelinespec refers to a label which is not set on the pipelinerun",
+		pr: v1beta1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "pipelinerunname",
+				Labels: map[string]string{"app.kubernetes.io/version": "v1"},
+			},
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineSpec: &v1beta1.PipelineSpec{
+					Tasks: []v1beta1.PipelineTask{{
+						Name:    "echo",
+						TaskRef: &v1beta1.TaskRef{Name: "echo-task"},
+						Params: v1beta1.Params{{
+							Name: "version", Value: *v1beta1.NewStructuredValues("$(context.pipelineRun.labels.app_kubernetes_io_versoin)"),
+						}},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "non-existent variable `app_kubernetes_io_versoin` in \"$(context.pipelineRun.labels.app_kubernetes_io_versoin)\"",
+			Paths:   []string{"spec.pipelineSpec.tasks[0]"},
+		},
+	}, {
+		name: "pipelinespec refers to an annotation key shared by several annotations",
+		pr: v1beta1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pipelinerunname",
+				Annotations: map[string]string{"a.b": "dot", "a/b": "slash"},
+			},
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineSpec: &v1beta1.PipelineSpec{
+					Tasks: []v1beta1.PipelineTask{{
+						Name:    "echo",
+						TaskRef: &v1beta1.TaskRef{Name: "echo-task"},
+						WhenExpressions: v1beta1.WhenExpressions{{
+							Input:    "$(context.pipelineRun.annotations.a_b)",
+							Operator: selection.In,
+							Values:   []string{"dot"},
+						}},
+					}},
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "ambiguous variable `a_b` in \"$(context.pipelineRun.annotations.a_b)\", the keys a.b, a/b are all exposed under it",
+			Paths:   []string{"spec.pipelineSpec.tasks[0]"},
+		},
 	}}
 
 	for _, tc := range tests {
@@ -1244,6 +1294,142 @@ func TestPipelineRunSpec_Invalidate(t *testing.T) {
 		},
 		withContext: cfgtesting.EnableStableAPIFields,
 		wantErr:     apis.ErrGeneric("computeResources requires \"enable-api-fields\" feature gate to be \"alpha\" or \"beta\" but it is \"stable\"").ViaIndex(0).ViaField("taskRunSpecs"),
+	}, {
+		name: "paramDefaults without default value",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamDefaults: v1beta1.ParamSpecs{{
+				Name: "foo",
+				Type: v1beta1.ParamTypeString,
+			}},
+		},
+		wantErr: apis.ErrMissingField("paramDefaults[foo].default"),
+	}, {
+		name: "duplicate paramDefaults",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamDefaults: v1beta1.ParamSpecs{{
+				Name:    "foo",
+				Type:    v1beta1.ParamTypeString,
+				Default: v1beta1.NewStructuredValues("foo"),
+			}, {
+				Name:    "foo",
+				Type:    v1beta1.ParamTypeString,
+				Default: v1beta1.NewStructuredValues("bar"),
+			}},
+		},
+		wantErr: apis.ErrGeneric("parameter appears more than once", "paramDefaults[foo]"),
+	}, {
+		name: "paramDefaults with a default value not matching the type",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamDefaults: v1beta1.ParamSpecs{{
+				Name:    "foo",
+				Type:    v1beta1.ParamTypeString,
+				Default: v1beta1.NewStructuredValues("foo", "bar"),
+			}},
+		},
+		wantErr: &apis.FieldError{
+			Message: `"string" type does not match default value's type: "array"`,
+			Paths:   []string{"paramDefaults.foo.type", "paramDefaults.foo.default.type"},
+		}}, {
+		name: "paramSources without name",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamSources: []v1beta1.ParamSource{{
+				ValueFrom: v1beta1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrMissingField("paramSources[0].name"),
+	}, {
+		name: "paramSources without secretKeyRef nor configMapKeyRef",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamSources: []v1beta1.ParamSource{{
+				Name: "token",
+			}},
+		},
+		wantErr: apis.ErrMissingOneOf("paramSources[token].valueFrom.secretKeyRef", "paramSources[token].valueFrom.configMapKeyRef"),
+	}, {
+		name: "paramSources with both secretKeyRef and configMapKeyRef",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamSources: []v1beta1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1beta1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrMultipleOneOf("paramSources[token].valueFrom.secretKeyRef", "paramSources[token].valueFrom.configMapKeyRef"),
+	}, {
+		name: "paramSources with a configMapKeyRef without key",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamSources: []v1beta1.ParamSource{{
+				Name: "region",
+				ValueFrom: v1beta1.ParamValueSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrMissingField("paramSources[region].valueFrom.configMapKeyRef.key"),
+	}, {
+		name: "duplicate paramSources",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			ParamSources: []v1beta1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1beta1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}, {
+				Name: "token",
+				ValueFrom: v1beta1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "other-secret"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrGeneric("parameter appears more than once", "paramSources[token]"),
+	}, {
+		name: "paramSources also provided in params",
+		spec: v1beta1.PipelineRunSpec{
+			PipelineRef: &v1beta1.PipelineRef{Name: "foo"},
+			Params: v1beta1.Params{{
+				Name:  "token",
+				Value: *v1beta1.NewStructuredValues("inline"),
+			}},
+			ParamSources: []v1beta1.ParamSource{{
+				Name: "token",
+				ValueFrom: v1beta1.ParamValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+						Key:                  "token",
+					},
+				},
+			}},
+		},
+		wantErr: apis.ErrGeneric("parameter is also provided in params", "paramSources[token]"),
 	}}
 
 	for _, ps := range tests {
@@ -1761,6 +1947,155 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 			isCreate:      false,
 			isUpdate:      true,
 			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, params changes",
+			baselinePipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{{Name: "image", Value: *v1beta1.NewStructuredValues("app:v1")}},
+				},
+				Status: v1beta1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+				},
+			},
+			pipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{{Name: "image", Value: *v1beta1.NewStructuredValues("app:v2")}},
+				},
+			},
+			isCreate:      false,
+			isUpdate:      true,
+			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, params used by a started PipelineTask changes",
+			baselinePipelineRun: &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{pipeline.ParamConsumersAnnotationKey: `{"image":["build","deploy"],"registry":["deploy"]}`}},
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{
+						{Name: "image", Value: *v1beta1.NewStructuredValues("app:v1")},
+						{Name: "registry", Value: *v1beta1.NewStructuredValues("quay.io")},
+					},
+				},
+				Status: v1beta1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+					PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+						ChildReferences: []v1beta1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			},
+			pipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{
+						{Name: "image", Value: *v1beta1.NewStructuredValues("app:v2")},
+						{Name: "registry", Value: *v1beta1.NewStructuredValues("quay.io")},
+					},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `the param is used by the PipelineTasks which have started: build`,
+				Paths:   []string{"params[image]"},
+			},
+		}, {
+			name: "is update ctx, baseline is unknown, params unused by the started PipelineTasks changes",
+			baselinePipelineRun: &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{pipeline.ParamConsumersAnnotationKey: `{"image":["deploy"],"registry":["build","deploy"]}`}},
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{
+						{Name: "image", Value: *v1beta1.NewStructuredValues("app:v1")},
+						{Name: "registry", Value: *v1beta1.NewStructuredValues("quay.io")},
+					},
+				},
+				Status: v1beta1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+					PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+						ChildReferences: []v1beta1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			},
+			pipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{
+						{Name: "image", Value: *v1beta1.NewStructuredValues("app:v2")},
+						{Name: "registry", Value: *v1beta1.NewStructuredValues("quay.io")},
+					},
+				},
+			},
+			isCreate:      false,
+			isUpdate:      true,
+			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, params changes before their consumers are recorded",
+			baselinePipelineRun: &v1beta1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{
+						{Name: "image", Value: *v1beta1.NewStructuredValues("app:v1")},
+						{Name: "registry", Value: *v1beta1.NewStructuredValues("quay.io")},
+					},
+				},
+				Status: v1beta1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+					PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+						ChildReferences: []v1beta1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			},
+			pipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{
+						{Name: "image", Value: *v1beta1.NewStructuredValues("app:v2")},
+						{Name: "registry", Value: *v1beta1.NewStructuredValues("quay.io")},
+					},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `the params used by the PipelineTasks which have started are not known yet`,
+				Paths:   []string{"params[image]"},
+			},
+		}, {
+			name: "is update ctx, baseline is done, params changes",
+			baselinePipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{{Name: "image", Value: *v1beta1.NewStructuredValues("app:v1")}},
+				},
+				Status: v1beta1.PipelineRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue},
+						},
+					},
+				},
+			},
+			pipelineRun: &v1beta1.PipelineRun{
+				Spec: v1beta1.PipelineRunSpec{
+					Params: v1beta1.Params{{Name: "image", Value: *v1beta1.NewStructuredValues("app:v2")}},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `invalid value: Once the PipelineRun is complete, no updates are allowed`,
+				Paths:   []string{""},
+			},
 		}, {
 			name: "is update ctx, baseline is unknown, timeouts changes",
 			baselinePipelineRun: &v1beta1.PipelineRun{
@@ -1788,7 +2123,7 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 			isCreate: false,
 			isUpdate: true,
 			expectedError: apis.FieldError{
-				Message: `invalid value: Once the PipelineRun has started, only status updates are allowed`,
+				Message: `invalid value: Once the PipelineRun has started, only status and params updates are allowed`,
 				Paths:   []string{""},
 			},
 		}, {
@@ -1819,7 +2154,7 @@ func TestPipelineRunSpec_ValidateUpdate(t *testing.T) {
 			isCreate: false,
 			isUpdate: true,
 			expectedError: apis.FieldError{
-				Message: `invalid value: Once the PipelineRun has started, only status updates are allowed`,
+				Message: `invalid value: Once the PipelineRun has started, only status and params updates are allowed`,
 				Paths:   []string{""},
 			},
 		}, {
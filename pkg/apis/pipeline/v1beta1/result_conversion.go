@@ -26,6 +26,7 @@ func (r TaskResult) convertTo(ctx context.Context, sink *v1.TaskResult) {
 	sink.Name = r.Name
 	sink.Type = v1.ResultsType(r.Type)
 	sink.Description = r.Description
+	sink.MaxItems = r.MaxItems
 	if r.Properties != nil {
 		properties := make(map[string]v1.PropertySpec)
 		for k, v := range r.Properties {
@@ -43,6 +44,7 @@ func (r *TaskResult) convertFrom(ctx context.Context, source v1.TaskResult) {
 	r.Name = source.Name
 	r.Type = ResultsType(source.Type)
 	r.Description = source.Description
+	r.MaxItems = source.MaxItems
 	if source.Properties != nil {
 		properties := make(map[string]PropertySpec)
 		for k, v := range source.Properties {
@@ -29,6 +29,11 @@ type TaskResult struct {
 	// +optional
 	Properties map[string]PropertySpec `json:"properties,omitempty"`
 
+	// MaxItems is the maximum number of items of an array result. The indexes of the references
+	// to the result in the results of a Pipeline are validated against it.
+	// +optional
+	MaxItems *int `json:"maxItems,omitempty"`
+
 	// Description is a human-readable description of the result
 	// +optional
 	Description string `json:"description,omitempty"`
@@ -39,6 +39,14 @@ func (tr TaskResult) Validate(ctx context.Context) (errs *apis.FieldError) {
 	case tr.Type != ResultsTypeString:
 		errs = errs.Also(apis.ErrInvalidValue(tr.Type, "type", "type must be string"))
 	}
+	if tr.MaxItems != nil {
+		switch {
+		case tr.Type != ResultsTypeArray:
+			errs = errs.Also(apis.ErrGeneric("maxItems can only be set for array results", "maxItems"))
+		case *tr.MaxItems < 0:
+			errs = errs.Also(apis.ErrInvalidValue(*tr.MaxItems, "maxItems", "maxItems must not be negative"))
+		}
+	}
 	return errs.Also(tr.validateValue(ctx))
 }
 
@@ -29,6 +29,7 @@ import (
 )
 
 func TestResultsValidate(t *testing.T) {
+	maxItems := 3
 	tests := []struct {
 		name   string
 		Result v1beta1.TaskResult
@@ -52,6 +53,13 @@ func TestResultsValidate(t *testing.T) {
 			Type:        v1beta1.ResultsTypeArray,
 			Description: "my great result",
 		},
+	}, {
+		name: "valid result type array with maxItems",
+		Result: v1beta1.TaskResult{
+			Name:     "MY-RESULT",
+			Type:     v1beta1.ResultsTypeArray,
+			MaxItems: &maxItems,
+		},
 	}, {
 		name: "valid result type object",
 		Result: v1beta1.TaskResult{
@@ -72,6 +80,7 @@ func TestResultsValidate(t *testing.T) {
 }
 
 func TestResultsValidateError(t *testing.T) {
+	maxItems, negativeMaxItems := 3, -1
 	tests := []struct {
 		name          string
 		Result        v1beta1.TaskResult
@@ -88,6 +97,29 @@ func TestResultsValidateError(t *testing.T) {
 			Paths:   []string{"type"},
 			Details: "type must be string",
 		},
+	}, {
+		name: "maxItems for a string result",
+		Result: v1beta1.TaskResult{
+			Name:     "MY-RESULT",
+			Type:     v1beta1.ResultsTypeString,
+			MaxItems: &maxItems,
+		},
+		expectedError: apis.FieldError{
+			Message: "maxItems can only be set for array results",
+			Paths:   []string{"maxItems"},
+		},
+	}, {
+		name: "negative maxItems",
+		Result: v1beta1.TaskResult{
+			Name:     "MY-RESULT",
+			Type:     v1beta1.ResultsTypeArray,
+			MaxItems: &negativeMaxItems,
+		},
+		expectedError: apis.FieldError{
+			Message: "invalid value: -1",
+			Paths:   []string{"maxItems"},
+			Details: "maxItems must not be negative",
+		},
 	}, {
 		name: "invalid object properties type",
 		Result: v1beta1.TaskResult{
@@ -62,6 +62,9 @@ spec:
   - name: result-1
     type: string
     description: a result
+  - name: result-2
+    type: array
+    maxItems: 3
 `
 	multiStepTaskYAML := `
 metadata:
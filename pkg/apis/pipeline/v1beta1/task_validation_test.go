@@ -277,6 +277,23 @@ func TestTaskSpecValidate(t *testing.T) {
 				WorkingDir: "/foo/bar/src/",
 			}},
 		},
+	}, {
+		name: "valid nested object key template variable",
+		fields: fields{
+			Params: []v1beta1.ParamSpec{{
+				Name: "gitrepo",
+				Type: v1beta1.ParamTypeObject,
+				Properties: map[string]v1beta1.PropertySpec{
+					"url": {},
+				},
+			}},
+			Steps: []v1beta1.Step{{
+				Name:       "do-the-clone",
+				Image:      "some-git-image",
+				Args:       []string{"-host=$(params.gitrepo.url.host)", "-user=$(params.gitrepo.url.auth.user)"},
+				WorkingDir: "/foo/bar/src/",
+			}},
+		},
 	}, {
 		name: "valid star array template variable",
 		fields: fields{
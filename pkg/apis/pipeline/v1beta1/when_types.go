@@ -115,7 +115,8 @@ func (wes WhenExpressions) AllowsExecution(evaluatedCEL map[string]bool) bool {
 }
 
 // ReplaceVariables interpolates variables, such as Parameters and Results, in
-// the Input and Values.
+// the Input and Values. A value that is a whole array reference, such as
+// $(params.foo[*]), is expanded into one value per element of the array.
 func (wes WhenExpressions) ReplaceVariables(replacements map[string]string, arrayReplacements map[string][]string) WhenExpressions {
 	replaced := wes
 	for i := range wes {
@@ -53,6 +53,13 @@ func TestWhenExpressions_Valid(t *testing.T) {
 			Operator: selection.In,
 			Values:   []string{""},
 		}},
+	}, {
+		name: "whole array param reference in values",
+		wes: []WhenExpression{{
+			Input:    "$(params.branch)",
+			Operator: selection.In,
+			Values:   []string{"$(params.allowedValues[*])", "main"},
+		}},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +103,20 @@ func TestWhenExpressions_Invalid(t *testing.T) {
 	}, {
 		name: "missing when expression",
 		wes:  []WhenExpression{{}},
+	}, {
+		name: "whole array param reference in input",
+		wes: []WhenExpression{{
+			Input:    "$(params.branches[*])",
+			Operator: selection.In,
+			Values:   []string{"main"},
+		}},
+	}, {
+		name: "whole array result reference in input",
+		wes: []WhenExpression{{
+			Input:    "$(tasks.foo.results.bar[*])",
+			Operator: selection.In,
+			Values:   []string{"main"},
+		}},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// StatusApplyConfiguration represents a declarative configuration of the Status type for use
+// with apply.
+type StatusApplyConfiguration struct {
+	ObservedGeneration *int64             `json:"observedGeneration,omitempty"`
+	Conditions         *duckv1.Conditions `json:"conditions,omitempty"`
+	Annotations        map[string]string  `json:"annotations,omitempty"`
+}
+
+// StatusApplyConfiguration constructs a declarative configuration of the Status type for use with
+// apply.
+func Status() *StatusApplyConfiguration {
+	return &StatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *StatusApplyConfiguration) WithObservedGeneration(value int64) *StatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *StatusApplyConfiguration) WithConditions(value duckv1.Conditions) *StatusApplyConfiguration {
+	b.Conditions = &value
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *StatusApplyConfiguration) WithAnnotations(entries map[string]string) *StatusApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
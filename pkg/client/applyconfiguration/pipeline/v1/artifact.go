@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ArtifactApplyConfiguration represents a declarative configuration of the Artifact type for use
+// with apply.
+type ArtifactApplyConfiguration struct {
+	Name        *string                           `json:"name,omitempty"`
+	Values      []ArtifactValueApplyConfiguration `json:"values,omitempty"`
+	BuildOutput *bool                             `json:"buildOutput,omitempty"`
+}
+
+// ArtifactApplyConfiguration constructs a declarative configuration of the Artifact type for use with
+// apply.
+func Artifact() *ArtifactApplyConfiguration {
+	return &ArtifactApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ArtifactApplyConfiguration) WithName(value string) *ArtifactApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValues adds the given value to the Values field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Values field.
+func (b *ArtifactApplyConfiguration) WithValues(values ...*ArtifactValueApplyConfiguration) *ArtifactApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithValues")
+		}
+		b.Values = append(b.Values, *values[i])
+	}
+	return b
+}
+
+// WithBuildOutput sets the BuildOutput field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BuildOutput field is set to the value of the last call.
+func (b *ArtifactApplyConfiguration) WithBuildOutput(value bool) *ArtifactApplyConfiguration {
+	b.BuildOutput = &value
+	return b
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ChildStatusReferenceApplyConfiguration represents a declarative configuration of the ChildStatusReference type for use
+// with apply.
+type ChildStatusReferenceApplyConfiguration struct {
+	metav1.TypeMetaApplyConfiguration `json:",inline"`
+	Name                              *string                            `json:"name,omitempty"`
+	DisplayName                       *string                            `json:"displayName,omitempty"`
+	PipelineTaskName                  *string                            `json:"pipelineTaskName,omitempty"`
+	WhenExpressions                   []WhenExpressionApplyConfiguration `json:"whenExpressions,omitempty"`
+}
+
+// ChildStatusReferenceApplyConfiguration constructs a declarative configuration of the ChildStatusReference type for use with
+// apply.
+func ChildStatusReference() *ChildStatusReferenceApplyConfiguration {
+	return &ChildStatusReferenceApplyConfiguration{}
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *ChildStatusReferenceApplyConfiguration) WithAPIVersion(value string) *ChildStatusReferenceApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *ChildStatusReferenceApplyConfiguration) WithKind(value string) *ChildStatusReferenceApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ChildStatusReferenceApplyConfiguration) WithName(value string) *ChildStatusReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *ChildStatusReferenceApplyConfiguration) WithDisplayName(value string) *ChildStatusReferenceApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithPipelineTaskName sets the PipelineTaskName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineTaskName field is set to the value of the last call.
+func (b *ChildStatusReferenceApplyConfiguration) WithPipelineTaskName(value string) *ChildStatusReferenceApplyConfiguration {
+	b.PipelineTaskName = &value
+	return b
+}
+
+// WithWhenExpressions adds the given value to the WhenExpressions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WhenExpressions field.
+func (b *ChildStatusReferenceApplyConfiguration) WithWhenExpressions(values ...*WhenExpressionApplyConfiguration) *ChildStatusReferenceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWhenExpressions")
+		}
+		b.WhenExpressions = append(b.WhenExpressions, *values[i])
+	}
+	return b
+}
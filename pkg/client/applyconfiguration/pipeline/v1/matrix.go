@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// MatrixApplyConfiguration represents a declarative configuration of the Matrix type for use
+// with apply.
+type MatrixApplyConfiguration struct {
+	Params  *pipelinev1.Params            `json:"params,omitempty"`
+	Include *pipelinev1.IncludeParamsList `json:"include,omitempty"`
+}
+
+// MatrixApplyConfiguration constructs a declarative configuration of the Matrix type for use with
+// apply.
+func Matrix() *MatrixApplyConfiguration {
+	return &MatrixApplyConfiguration{}
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *MatrixApplyConfiguration) WithParams(value pipelinev1.Params) *MatrixApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithInclude sets the Include field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Include field is set to the value of the last call.
+func (b *MatrixApplyConfiguration) WithInclude(value pipelinev1.IncludeParamsList) *MatrixApplyConfiguration {
+	b.Include = &value
+	return b
+}
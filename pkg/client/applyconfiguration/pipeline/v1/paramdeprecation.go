@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ParamDeprecationApplyConfiguration represents a declarative configuration of the ParamDeprecation type for use
+// with apply.
+type ParamDeprecationApplyConfiguration struct {
+	Message   *string `json:"message,omitempty"`
+	RemovedIn *string `json:"removedIn,omitempty"`
+}
+
+// ParamDeprecationApplyConfiguration constructs a declarative configuration of the ParamDeprecation type for use with
+// apply.
+func ParamDeprecation() *ParamDeprecationApplyConfiguration {
+	return &ParamDeprecationApplyConfiguration{}
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ParamDeprecationApplyConfiguration) WithMessage(value string) *ParamDeprecationApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithRemovedIn sets the RemovedIn field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemovedIn field is set to the value of the last call.
+func (b *ParamDeprecationApplyConfiguration) WithRemovedIn(value string) *ParamDeprecationApplyConfiguration {
+	b.RemovedIn = &value
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// PipelineResultApplyConfiguration represents a declarative configuration of the PipelineResult type for use
+// with apply.
+type PipelineResultApplyConfiguration struct {
+	Name        *string                 `json:"name,omitempty"`
+	Type        *pipelinev1.ResultsType `json:"type,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Value       *pipelinev1.ParamValue  `json:"value,omitempty"`
+	Default     *pipelinev1.ParamValue  `json:"default,omitempty"`
+}
+
+// PipelineResultApplyConfiguration constructs a declarative configuration of the PipelineResult type for use with
+// apply.
+func PipelineResult() *PipelineResultApplyConfiguration {
+	return &PipelineResultApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PipelineResultApplyConfiguration) WithName(value string) *PipelineResultApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *PipelineResultApplyConfiguration) WithType(value pipelinev1.ResultsType) *PipelineResultApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *PipelineResultApplyConfiguration) WithDescription(value string) *PipelineResultApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *PipelineResultApplyConfiguration) WithValue(value pipelinev1.ParamValue) *PipelineResultApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithDefault sets the Default field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Default field is set to the value of the last call.
+func (b *PipelineResultApplyConfiguration) WithDefault(value pipelinev1.ParamValue) *PipelineResultApplyConfiguration {
+	b.Default = &value
+	return b
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	duckv1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/duck/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apisduckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// PipelineRunStatusApplyConfiguration represents a declarative configuration of the PipelineRunStatus type for use
+// with apply.
+type PipelineRunStatusApplyConfiguration struct {
+	duckv1.StatusApplyConfiguration           `json:",inline"`
+	PipelineRunStatusFieldsApplyConfiguration `json:",inline"`
+}
+
+// PipelineRunStatusApplyConfiguration constructs a declarative configuration of the PipelineRunStatus type for use with
+// apply.
+func PipelineRunStatus() *PipelineRunStatusApplyConfiguration {
+	return &PipelineRunStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithObservedGeneration(value int64) *PipelineRunStatusApplyConfiguration {
+	b.StatusApplyConfiguration.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithConditions(value apisduckv1.Conditions) *PipelineRunStatusApplyConfiguration {
+	b.StatusApplyConfiguration.Conditions = &value
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *PipelineRunStatusApplyConfiguration) WithAnnotations(entries map[string]string) *PipelineRunStatusApplyConfiguration {
+	if b.StatusApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.StatusApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.StatusApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithStartTime(value metav1.Time) *PipelineRunStatusApplyConfiguration {
+	b.PipelineRunStatusFieldsApplyConfiguration.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *PipelineRunStatusApplyConfiguration {
+	b.PipelineRunStatusFieldsApplyConfiguration.CompletionTime = &value
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *PipelineRunStatusApplyConfiguration) WithResults(values ...*PipelineRunResultApplyConfiguration) *PipelineRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.PipelineRunStatusFieldsApplyConfiguration.Results = append(b.PipelineRunStatusFieldsApplyConfiguration.Results, *values[i])
+	}
+	return b
+}
+
+// WithPipelineSpec sets the PipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineSpec field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunStatusApplyConfiguration {
+	b.PipelineRunStatusFieldsApplyConfiguration.PipelineSpec = value
+	return b
+}
+
+// WithResolvedPipelineSpec sets the ResolvedPipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolvedPipelineSpec field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithResolvedPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunStatusApplyConfiguration {
+	b.PipelineRunStatusFieldsApplyConfiguration.ResolvedPipelineSpec = value
+	return b
+}
+
+// WithSkippedTasks adds the given value to the SkippedTasks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SkippedTasks field.
+func (b *PipelineRunStatusApplyConfiguration) WithSkippedTasks(values ...*SkippedTaskApplyConfiguration) *PipelineRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSkippedTasks")
+		}
+		b.PipelineRunStatusFieldsApplyConfiguration.SkippedTasks = append(b.PipelineRunStatusFieldsApplyConfiguration.SkippedTasks, *values[i])
+	}
+	return b
+}
+
+// WithChildReferences adds the given value to the ChildReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ChildReferences field.
+func (b *PipelineRunStatusApplyConfiguration) WithChildReferences(values ...*ChildStatusReferenceApplyConfiguration) *PipelineRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChildReferences")
+		}
+		b.PipelineRunStatusFieldsApplyConfiguration.ChildReferences = append(b.PipelineRunStatusFieldsApplyConfiguration.ChildReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinallyStartTime sets the FinallyStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FinallyStartTime field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithFinallyStartTime(value metav1.Time) *PipelineRunStatusApplyConfiguration {
+	b.PipelineRunStatusFieldsApplyConfiguration.FinallyStartTime = &value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *PipelineRunStatusApplyConfiguration) WithProvenance(value *ProvenanceApplyConfiguration) *PipelineRunStatusApplyConfiguration {
+	b.PipelineRunStatusFieldsApplyConfiguration.Provenance = value
+	return b
+}
+
+// WithSpanContext puts the entries into the SpanContext field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SpanContext field,
+// overwriting an existing map entries in SpanContext field with the same key.
+func (b *PipelineRunStatusApplyConfiguration) WithSpanContext(entries map[string]string) *PipelineRunStatusApplyConfiguration {
+	if b.PipelineRunStatusFieldsApplyConfiguration.SpanContext == nil && len(entries) > 0 {
+		b.PipelineRunStatusFieldsApplyConfiguration.SpanContext = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.PipelineRunStatusFieldsApplyConfiguration.SpanContext[k] = v
+	}
+	return b
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineRunStatusFieldsApplyConfiguration represents a declarative configuration of the PipelineRunStatusFields type for use
+// with apply.
+type PipelineRunStatusFieldsApplyConfiguration struct {
+	StartTime            *metav1.Time                             `json:"startTime,omitempty"`
+	CompletionTime       *metav1.Time                             `json:"completionTime,omitempty"`
+	Results              []PipelineRunResultApplyConfiguration    `json:"results,omitempty"`
+	PipelineSpec         *PipelineSpecApplyConfiguration          `json:"pipelineSpec,omitempty"`
+	ResolvedPipelineSpec *PipelineSpecApplyConfiguration          `json:"resolvedPipelineSpec,omitempty"`
+	SkippedTasks         []SkippedTaskApplyConfiguration          `json:"skippedTasks,omitempty"`
+	ChildReferences      []ChildStatusReferenceApplyConfiguration `json:"childReferences,omitempty"`
+	FinallyStartTime     *metav1.Time                             `json:"finallyStartTime,omitempty"`
+	Provenance           *ProvenanceApplyConfiguration            `json:"provenance,omitempty"`
+	SpanContext          map[string]string                        `json:"spanContext,omitempty"`
+}
+
+// PipelineRunStatusFieldsApplyConfiguration constructs a declarative configuration of the PipelineRunStatusFields type for use with
+// apply.
+func PipelineRunStatusFields() *PipelineRunStatusFieldsApplyConfiguration {
+	return &PipelineRunStatusFieldsApplyConfiguration{}
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithStartTime(value metav1.Time) *PipelineRunStatusFieldsApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithCompletionTime(value metav1.Time) *PipelineRunStatusFieldsApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithResults(values ...*PipelineRunResultApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
+
+// WithPipelineSpec sets the PipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineSpec field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	b.PipelineSpec = value
+	return b
+}
+
+// WithResolvedPipelineSpec sets the ResolvedPipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolvedPipelineSpec field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithResolvedPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	b.ResolvedPipelineSpec = value
+	return b
+}
+
+// WithSkippedTasks adds the given value to the SkippedTasks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SkippedTasks field.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithSkippedTasks(values ...*SkippedTaskApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSkippedTasks")
+		}
+		b.SkippedTasks = append(b.SkippedTasks, *values[i])
+	}
+	return b
+}
+
+// WithChildReferences adds the given value to the ChildReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ChildReferences field.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithChildReferences(values ...*ChildStatusReferenceApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChildReferences")
+		}
+		b.ChildReferences = append(b.ChildReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinallyStartTime sets the FinallyStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FinallyStartTime field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithFinallyStartTime(value metav1.Time) *PipelineRunStatusFieldsApplyConfiguration {
+	b.FinallyStartTime = &value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithProvenance(value *ProvenanceApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	b.Provenance = value
+	return b
+}
+
+// WithSpanContext puts the entries into the SpanContext field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SpanContext field,
+// overwriting an existing map entries in SpanContext field with the same key.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithSpanContext(entries map[string]string) *PipelineRunStatusFieldsApplyConfiguration {
+	if b.SpanContext == nil && len(entries) > 0 {
+		b.SpanContext = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SpanContext[k] = v
+	}
+	return b
+}
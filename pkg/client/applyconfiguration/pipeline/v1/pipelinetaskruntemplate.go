@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	pod "github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+)
+
+// PipelineTaskRunTemplateApplyConfiguration represents a declarative configuration of the PipelineTaskRunTemplate type for use
+// with apply.
+type PipelineTaskRunTemplateApplyConfiguration struct {
+	PodTemplate        *pod.Template `json:"podTemplate,omitempty"`
+	ServiceAccountName *string       `json:"serviceAccountName,omitempty"`
+}
+
+// PipelineTaskRunTemplateApplyConfiguration constructs a declarative configuration of the PipelineTaskRunTemplate type for use with
+// apply.
+func PipelineTaskRunTemplate() *PipelineTaskRunTemplateApplyConfiguration {
+	return &PipelineTaskRunTemplateApplyConfiguration{}
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *PipelineTaskRunTemplateApplyConfiguration) WithPodTemplate(value pod.Template) *PipelineTaskRunTemplateApplyConfiguration {
+	b.PodTemplate = &value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *PipelineTaskRunTemplateApplyConfiguration) WithServiceAccountName(value string) *PipelineTaskRunTemplateApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
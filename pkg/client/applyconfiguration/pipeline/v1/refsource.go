@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RefSourceApplyConfiguration represents a declarative configuration of the RefSource type for use
+// with apply.
+type RefSourceApplyConfiguration struct {
+	URI        *string           `json:"uri,omitempty"`
+	Digest     map[string]string `json:"digest,omitempty"`
+	EntryPoint *string           `json:"entryPoint,omitempty"`
+}
+
+// RefSourceApplyConfiguration constructs a declarative configuration of the RefSource type for use with
+// apply.
+func RefSource() *RefSourceApplyConfiguration {
+	return &RefSourceApplyConfiguration{}
+}
+
+// WithURI sets the URI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URI field is set to the value of the last call.
+func (b *RefSourceApplyConfiguration) WithURI(value string) *RefSourceApplyConfiguration {
+	b.URI = &value
+	return b
+}
+
+// WithDigest puts the entries into the Digest field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Digest field,
+// overwriting an existing map entries in Digest field with the same key.
+func (b *RefSourceApplyConfiguration) WithDigest(entries map[string]string) *RefSourceApplyConfiguration {
+	if b.Digest == nil && len(entries) > 0 {
+		b.Digest = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Digest[k] = v
+	}
+	return b
+}
+
+// WithEntryPoint sets the EntryPoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntryPoint field is set to the value of the last call.
+func (b *RefSourceApplyConfiguration) WithEntryPoint(value string) *RefSourceApplyConfiguration {
+	b.EntryPoint = &value
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// SkippedTaskApplyConfiguration represents a declarative configuration of the SkippedTask type for use
+// with apply.
+type SkippedTaskApplyConfiguration struct {
+	Name            *string                            `json:"name,omitempty"`
+	Reason          *pipelinev1.SkippingReason         `json:"reason,omitempty"`
+	WhenExpressions []WhenExpressionApplyConfiguration `json:"whenExpressions,omitempty"`
+}
+
+// SkippedTaskApplyConfiguration constructs a declarative configuration of the SkippedTask type for use with
+// apply.
+func SkippedTask() *SkippedTaskApplyConfiguration {
+	return &SkippedTaskApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SkippedTaskApplyConfiguration) WithName(value string) *SkippedTaskApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *SkippedTaskApplyConfiguration) WithReason(value pipelinev1.SkippingReason) *SkippedTaskApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithWhenExpressions adds the given value to the WhenExpressions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WhenExpressions field.
+func (b *SkippedTaskApplyConfiguration) WithWhenExpressions(values ...*WhenExpressionApplyConfiguration) *SkippedTaskApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWhenExpressions")
+		}
+		b.WhenExpressions = append(b.WhenExpressions, *values[i])
+	}
+	return b
+}
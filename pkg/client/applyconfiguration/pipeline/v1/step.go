@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StepApplyConfiguration represents a declarative configuration of the Step type for use
+// with apply.
+type StepApplyConfiguration struct {
+	Name             *string                             `json:"name,omitempty"`
+	Image            *string                             `json:"image,omitempty"`
+	Command          []string                            `json:"command,omitempty"`
+	Args             []string                            `json:"args,omitempty"`
+	WorkingDir       *string                             `json:"workingDir,omitempty"`
+	EnvFrom          []corev1.EnvFromSource              `json:"envFrom,omitempty"`
+	Env              []corev1.EnvVar                     `json:"env,omitempty"`
+	ComputeResources *corev1.ResourceRequirements        `json:"computeResources,omitempty"`
+	VolumeMounts     []corev1.VolumeMount                `json:"volumeMounts,omitempty"`
+	VolumeDevices    []corev1.VolumeDevice               `json:"volumeDevices,omitempty"`
+	ImagePullPolicy  *corev1.PullPolicy                  `json:"imagePullPolicy,omitempty"`
+	SecurityContext  *corev1.SecurityContext             `json:"securityContext,omitempty"`
+	Script           *string                             `json:"script,omitempty"`
+	Timeout          *metav1.Duration                    `json:"timeout,omitempty"`
+	Workspaces       []WorkspaceUsageApplyConfiguration  `json:"workspaces,omitempty"`
+	OnError          *pipelinev1.OnErrorType             `json:"onError,omitempty"`
+	StdoutConfig     *StepOutputConfigApplyConfiguration `json:"stdoutConfig,omitempty"`
+	StderrConfig     *StepOutputConfigApplyConfiguration `json:"stderrConfig,omitempty"`
+	Ref              *RefApplyConfiguration              `json:"ref,omitempty"`
+	Params           *pipelinev1.Params                  `json:"params,omitempty"`
+	Results          []StepResultApplyConfiguration      `json:"results,omitempty"`
+	When             *pipelinev1.WhenExpressions         `json:"when,omitempty"`
+}
+
+// StepApplyConfiguration constructs a declarative configuration of the Step type for use with
+// apply.
+func Step() *StepApplyConfiguration {
+	return &StepApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithName(value string) *StepApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithImage(value string) *StepApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *StepApplyConfiguration) WithCommand(values ...string) *StepApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *StepApplyConfiguration) WithArgs(values ...string) *StepApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithWorkingDir(value string) *StepApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *StepApplyConfiguration) WithEnvFrom(values ...corev1.EnvFromSource) *StepApplyConfiguration {
+	for i := range values {
+		b.EnvFrom = append(b.EnvFrom, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *StepApplyConfiguration) WithEnv(values ...corev1.EnvVar) *StepApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithComputeResources sets the ComputeResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ComputeResources field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithComputeResources(value corev1.ResourceRequirements) *StepApplyConfiguration {
+	b.ComputeResources = &value
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *StepApplyConfiguration) WithVolumeMounts(values ...corev1.VolumeMount) *StepApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithVolumeDevices adds the given value to the VolumeDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeDevices field.
+func (b *StepApplyConfiguration) WithVolumeDevices(values ...corev1.VolumeDevice) *StepApplyConfiguration {
+	for i := range values {
+		b.VolumeDevices = append(b.VolumeDevices, values[i])
+	}
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithImagePullPolicy(value corev1.PullPolicy) *StepApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithSecurityContext(value corev1.SecurityContext) *StepApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
+// WithScript sets the Script field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Script field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithScript(value string) *StepApplyConfiguration {
+	b.Script = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithTimeout(value metav1.Duration) *StepApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *StepApplyConfiguration) WithWorkspaces(values ...*WorkspaceUsageApplyConfiguration) *StepApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithOnError sets the OnError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnError field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithOnError(value pipelinev1.OnErrorType) *StepApplyConfiguration {
+	b.OnError = &value
+	return b
+}
+
+// WithStdoutConfig sets the StdoutConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StdoutConfig field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithStdoutConfig(value *StepOutputConfigApplyConfiguration) *StepApplyConfiguration {
+	b.StdoutConfig = value
+	return b
+}
+
+// WithStderrConfig sets the StderrConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StderrConfig field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithStderrConfig(value *StepOutputConfigApplyConfiguration) *StepApplyConfiguration {
+	b.StderrConfig = value
+	return b
+}
+
+// WithRef sets the Ref field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ref field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithRef(value *RefApplyConfiguration) *StepApplyConfiguration {
+	b.Ref = value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithParams(value pipelinev1.Params) *StepApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *StepApplyConfiguration) WithResults(values ...*StepResultApplyConfiguration) *StepApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
+
+// WithWhen sets the When field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the When field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithWhen(value pipelinev1.WhenExpressions) *StepApplyConfiguration {
+	b.When = &value
+	return b
+}
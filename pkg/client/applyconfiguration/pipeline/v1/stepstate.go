@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// StepStateApplyConfiguration represents a declarative configuration of the StepState type for use
+// with apply.
+type StepStateApplyConfiguration struct {
+	corev1.ContainerStateApplyConfiguration `json:",inline"`
+	Name                                    *string                           `json:"name,omitempty"`
+	Container                               *string                           `json:"container,omitempty"`
+	ImageID                                 *string                           `json:"imageID,omitempty"`
+	Results                                 []TaskRunResultApplyConfiguration `json:"results,omitempty"`
+	Provenance                              *ProvenanceApplyConfiguration     `json:"provenance,omitempty"`
+	TerminationReason                       *string                           `json:"terminationReason,omitempty"`
+	Inputs                                  []ArtifactApplyConfiguration      `json:"inputs,omitempty"`
+	Outputs                                 []ArtifactApplyConfiguration      `json:"outputs,omitempty"`
+}
+
+// StepStateApplyConfiguration constructs a declarative configuration of the StepState type for use with
+// apply.
+func StepState() *StepStateApplyConfiguration {
+	return &StepStateApplyConfiguration{}
+}
+
+// WithWaiting sets the Waiting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Waiting field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithWaiting(value *corev1.ContainerStateWaitingApplyConfiguration) *StepStateApplyConfiguration {
+	b.ContainerStateApplyConfiguration.Waiting = value
+	return b
+}
+
+// WithRunning sets the Running field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Running field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithRunning(value *corev1.ContainerStateRunningApplyConfiguration) *StepStateApplyConfiguration {
+	b.ContainerStateApplyConfiguration.Running = value
+	return b
+}
+
+// WithTerminated sets the Terminated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Terminated field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithTerminated(value *corev1.ContainerStateTerminatedApplyConfiguration) *StepStateApplyConfiguration {
+	b.ContainerStateApplyConfiguration.Terminated = value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithName(value string) *StepStateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithContainer sets the Container field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Container field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithContainer(value string) *StepStateApplyConfiguration {
+	b.Container = &value
+	return b
+}
+
+// WithImageID sets the ImageID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImageID field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithImageID(value string) *StepStateApplyConfiguration {
+	b.ImageID = &value
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *StepStateApplyConfiguration) WithResults(values ...*TaskRunResultApplyConfiguration) *StepStateApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithProvenance(value *ProvenanceApplyConfiguration) *StepStateApplyConfiguration {
+	b.Provenance = value
+	return b
+}
+
+// WithTerminationReason sets the TerminationReason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TerminationReason field is set to the value of the last call.
+func (b *StepStateApplyConfiguration) WithTerminationReason(value string) *StepStateApplyConfiguration {
+	b.TerminationReason = &value
+	return b
+}
+
+// WithInputs adds the given value to the Inputs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Inputs field.
+func (b *StepStateApplyConfiguration) WithInputs(values ...*ArtifactApplyConfiguration) *StepStateApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithInputs")
+		}
+		b.Inputs = append(b.Inputs, *values[i])
+	}
+	return b
+}
+
+// WithOutputs adds the given value to the Outputs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Outputs field.
+func (b *StepStateApplyConfiguration) WithOutputs(values ...*ArtifactApplyConfiguration) *StepStateApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOutputs")
+		}
+		b.Outputs = append(b.Outputs, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StepTemplateApplyConfiguration represents a declarative configuration of the StepTemplate type for use
+// with apply.
+type StepTemplateApplyConfiguration struct {
+	Image            *string                      `json:"image,omitempty"`
+	Command          []string                     `json:"command,omitempty"`
+	Args             []string                     `json:"args,omitempty"`
+	WorkingDir       *string                      `json:"workingDir,omitempty"`
+	EnvFrom          []corev1.EnvFromSource       `json:"envFrom,omitempty"`
+	Env              []corev1.EnvVar              `json:"env,omitempty"`
+	ComputeResources *corev1.ResourceRequirements `json:"computeResources,omitempty"`
+	VolumeMounts     []corev1.VolumeMount         `json:"volumeMounts,omitempty"`
+	VolumeDevices    []corev1.VolumeDevice        `json:"volumeDevices,omitempty"`
+	ImagePullPolicy  *corev1.PullPolicy           `json:"imagePullPolicy,omitempty"`
+	SecurityContext  *corev1.SecurityContext      `json:"securityContext,omitempty"`
+}
+
+// StepTemplateApplyConfiguration constructs a declarative configuration of the StepTemplate type for use with
+// apply.
+func StepTemplate() *StepTemplateApplyConfiguration {
+	return &StepTemplateApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithImage(value string) *StepTemplateApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *StepTemplateApplyConfiguration) WithCommand(values ...string) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *StepTemplateApplyConfiguration) WithArgs(values ...string) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithWorkingDir(value string) *StepTemplateApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *StepTemplateApplyConfiguration) WithEnvFrom(values ...corev1.EnvFromSource) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.EnvFrom = append(b.EnvFrom, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *StepTemplateApplyConfiguration) WithEnv(values ...corev1.EnvVar) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithComputeResources sets the ComputeResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ComputeResources field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithComputeResources(value corev1.ResourceRequirements) *StepTemplateApplyConfiguration {
+	b.ComputeResources = &value
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *StepTemplateApplyConfiguration) WithVolumeMounts(values ...corev1.VolumeMount) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithVolumeDevices adds the given value to the VolumeDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeDevices field.
+func (b *StepTemplateApplyConfiguration) WithVolumeDevices(values ...corev1.VolumeDevice) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.VolumeDevices = append(b.VolumeDevices, values[i])
+	}
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithImagePullPolicy(value corev1.PullPolicy) *StepTemplateApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithSecurityContext(value corev1.SecurityContext) *StepTemplateApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
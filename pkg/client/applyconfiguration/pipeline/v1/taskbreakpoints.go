@@ -0,0 +1,50 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TaskBreakpointsApplyConfiguration represents a declarative configuration of the TaskBreakpoints type for use
+// with apply.
+type TaskBreakpointsApplyConfiguration struct {
+	OnFailure   *string  `json:"onFailure,omitempty"`
+	BeforeSteps []string `json:"beforeSteps,omitempty"`
+}
+
+// TaskBreakpointsApplyConfiguration constructs a declarative configuration of the TaskBreakpoints type for use with
+// apply.
+func TaskBreakpoints() *TaskBreakpointsApplyConfiguration {
+	return &TaskBreakpointsApplyConfiguration{}
+}
+
+// WithOnFailure sets the OnFailure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnFailure field is set to the value of the last call.
+func (b *TaskBreakpointsApplyConfiguration) WithOnFailure(value string) *TaskBreakpointsApplyConfiguration {
+	b.OnFailure = &value
+	return b
+}
+
+// WithBeforeSteps adds the given value to the BeforeSteps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BeforeSteps field.
+func (b *TaskBreakpointsApplyConfiguration) WithBeforeSteps(values ...string) *TaskBreakpointsApplyConfiguration {
+	for i := range values {
+		b.BeforeSteps = append(b.BeforeSteps, values[i])
+	}
+	return b
+}
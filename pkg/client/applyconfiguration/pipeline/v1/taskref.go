@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// TaskRefApplyConfiguration represents a declarative configuration of the TaskRef type for use
+// with apply.
+type TaskRefApplyConfiguration struct {
+	Name                           *string              `json:"name,omitempty"`
+	Kind                           *pipelinev1.TaskKind `json:"kind,omitempty"`
+	APIVersion                     *string              `json:"apiVersion,omitempty"`
+	*ResolverRefApplyConfiguration `json:"ResolverRef,omitempty"`
+}
+
+// TaskRefApplyConfiguration constructs a declarative configuration of the TaskRef type for use with
+// apply.
+func TaskRef() *TaskRefApplyConfiguration {
+	return &TaskRefApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TaskRefApplyConfiguration) WithName(value string) *TaskRefApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *TaskRefApplyConfiguration) WithKind(value pipelinev1.TaskKind) *TaskRefApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *TaskRefApplyConfiguration) WithAPIVersion(value string) *TaskRefApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
+
+// WithResolver sets the Resolver field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resolver field is set to the value of the last call.
+func (b *TaskRefApplyConfiguration) WithResolver(value pipelinev1.ResolverName) *TaskRefApplyConfiguration {
+	b.ensureResolverRefApplyConfigurationExists()
+	b.ResolverRefApplyConfiguration.Resolver = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *TaskRefApplyConfiguration) WithParams(value pipelinev1.Params) *TaskRefApplyConfiguration {
+	b.ensureResolverRefApplyConfigurationExists()
+	b.ResolverRefApplyConfiguration.Params = &value
+	return b
+}
+
+func (b *TaskRefApplyConfiguration) ensureResolverRefApplyConfigurationExists() {
+	if b.ResolverRefApplyConfiguration == nil {
+		b.ResolverRefApplyConfiguration = &ResolverRefApplyConfiguration{}
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TaskRunSidecarSpecApplyConfiguration represents a declarative configuration of the TaskRunSidecarSpec type for use
+// with apply.
+type TaskRunSidecarSpecApplyConfiguration struct {
+	Name             *string                      `json:"name,omitempty"`
+	ComputeResources *corev1.ResourceRequirements `json:"computeResources,omitempty"`
+}
+
+// TaskRunSidecarSpecApplyConfiguration constructs a declarative configuration of the TaskRunSidecarSpec type for use with
+// apply.
+func TaskRunSidecarSpec() *TaskRunSidecarSpecApplyConfiguration {
+	return &TaskRunSidecarSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TaskRunSidecarSpecApplyConfiguration) WithName(value string) *TaskRunSidecarSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithComputeResources sets the ComputeResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ComputeResources field is set to the value of the last call.
+func (b *TaskRunSidecarSpecApplyConfiguration) WithComputeResources(value corev1.ResourceRequirements) *TaskRunSidecarSpecApplyConfiguration {
+	b.ComputeResources = &value
+	return b
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	duckv1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/duck/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apisduckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// TaskRunStatusApplyConfiguration represents a declarative configuration of the TaskRunStatus type for use
+// with apply.
+type TaskRunStatusApplyConfiguration struct {
+	duckv1.StatusApplyConfiguration       `json:",inline"`
+	TaskRunStatusFieldsApplyConfiguration `json:",inline"`
+}
+
+// TaskRunStatusApplyConfiguration constructs a declarative configuration of the TaskRunStatus type for use with
+// apply.
+func TaskRunStatus() *TaskRunStatusApplyConfiguration {
+	return &TaskRunStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithObservedGeneration(value int64) *TaskRunStatusApplyConfiguration {
+	b.StatusApplyConfiguration.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithConditions(value apisduckv1.Conditions) *TaskRunStatusApplyConfiguration {
+	b.StatusApplyConfiguration.Conditions = &value
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *TaskRunStatusApplyConfiguration) WithAnnotations(entries map[string]string) *TaskRunStatusApplyConfiguration {
+	if b.StatusApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.StatusApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.StatusApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithPodName sets the PodName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodName field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithPodName(value string) *TaskRunStatusApplyConfiguration {
+	b.TaskRunStatusFieldsApplyConfiguration.PodName = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithStartTime(value metav1.Time) *TaskRunStatusApplyConfiguration {
+	b.TaskRunStatusFieldsApplyConfiguration.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *TaskRunStatusApplyConfiguration {
+	b.TaskRunStatusFieldsApplyConfiguration.CompletionTime = &value
+	return b
+}
+
+// WithSteps adds the given value to the Steps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Steps field.
+func (b *TaskRunStatusApplyConfiguration) WithSteps(values ...*StepStateApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSteps")
+		}
+		b.TaskRunStatusFieldsApplyConfiguration.Steps = append(b.TaskRunStatusFieldsApplyConfiguration.Steps, *values[i])
+	}
+	return b
+}
+
+// WithRetriesStatus adds the given value to the RetriesStatus field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RetriesStatus field.
+func (b *TaskRunStatusApplyConfiguration) WithRetriesStatus(values ...*TaskRunStatusApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRetriesStatus")
+		}
+		b.TaskRunStatusFieldsApplyConfiguration.RetriesStatus = append(b.TaskRunStatusFieldsApplyConfiguration.RetriesStatus, *values[i])
+	}
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *TaskRunStatusApplyConfiguration) WithResults(values ...*TaskRunResultApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.TaskRunStatusFieldsApplyConfiguration.Results = append(b.TaskRunStatusFieldsApplyConfiguration.Results, *values[i])
+	}
+	return b
+}
+
+// WithArtifacts sets the Artifacts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Artifacts field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithArtifacts(value *ArtifactsApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	b.TaskRunStatusFieldsApplyConfiguration.Artifacts = value
+	return b
+}
+
+// WithSidecars adds the given value to the Sidecars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sidecars field.
+func (b *TaskRunStatusApplyConfiguration) WithSidecars(values ...*SidecarStateApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSidecars")
+		}
+		b.TaskRunStatusFieldsApplyConfiguration.Sidecars = append(b.TaskRunStatusFieldsApplyConfiguration.Sidecars, *values[i])
+	}
+	return b
+}
+
+// WithTaskSpec sets the TaskSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskSpec field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithTaskSpec(value *TaskSpecApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	b.TaskRunStatusFieldsApplyConfiguration.TaskSpec = value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *TaskRunStatusApplyConfiguration) WithProvenance(value *ProvenanceApplyConfiguration) *TaskRunStatusApplyConfiguration {
+	b.TaskRunStatusFieldsApplyConfiguration.Provenance = value
+	return b
+}
+
+// WithSpanContext puts the entries into the SpanContext field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SpanContext field,
+// overwriting an existing map entries in SpanContext field with the same key.
+func (b *TaskRunStatusApplyConfiguration) WithSpanContext(entries map[string]string) *TaskRunStatusApplyConfiguration {
+	if b.TaskRunStatusFieldsApplyConfiguration.SpanContext == nil && len(entries) > 0 {
+		b.TaskRunStatusFieldsApplyConfiguration.SpanContext = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.TaskRunStatusFieldsApplyConfiguration.SpanContext[k] = v
+	}
+	return b
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaskRunStatusFieldsApplyConfiguration represents a declarative configuration of the TaskRunStatusFields type for use
+// with apply.
+type TaskRunStatusFieldsApplyConfiguration struct {
+	PodName        *string                           `json:"podName,omitempty"`
+	StartTime      *metav1.Time                      `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time                      `json:"completionTime,omitempty"`
+	Steps          []StepStateApplyConfiguration     `json:"steps,omitempty"`
+	RetriesStatus  []TaskRunStatusApplyConfiguration `json:"retriesStatus,omitempty"`
+	Results        []TaskRunResultApplyConfiguration `json:"results,omitempty"`
+	Artifacts      *ArtifactsApplyConfiguration      `json:"artifacts,omitempty"`
+	Sidecars       []SidecarStateApplyConfiguration  `json:"sidecars,omitempty"`
+	TaskSpec       *TaskSpecApplyConfiguration       `json:"taskSpec,omitempty"`
+	Provenance     *ProvenanceApplyConfiguration     `json:"provenance,omitempty"`
+	SpanContext    map[string]string                 `json:"spanContext,omitempty"`
+}
+
+// TaskRunStatusFieldsApplyConfiguration constructs a declarative configuration of the TaskRunStatusFields type for use with
+// apply.
+func TaskRunStatusFields() *TaskRunStatusFieldsApplyConfiguration {
+	return &TaskRunStatusFieldsApplyConfiguration{}
+}
+
+// WithPodName sets the PodName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodName field is set to the value of the last call.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithPodName(value string) *TaskRunStatusFieldsApplyConfiguration {
+	b.PodName = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithStartTime(value metav1.Time) *TaskRunStatusFieldsApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithCompletionTime(value metav1.Time) *TaskRunStatusFieldsApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithSteps adds the given value to the Steps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Steps field.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithSteps(values ...*StepStateApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSteps")
+		}
+		b.Steps = append(b.Steps, *values[i])
+	}
+	return b
+}
+
+// WithRetriesStatus adds the given value to the RetriesStatus field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RetriesStatus field.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithRetriesStatus(values ...*TaskRunStatusApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRetriesStatus")
+		}
+		b.RetriesStatus = append(b.RetriesStatus, *values[i])
+	}
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithResults(values ...*TaskRunResultApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
+
+// WithArtifacts sets the Artifacts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Artifacts field is set to the value of the last call.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithArtifacts(value *ArtifactsApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	b.Artifacts = value
+	return b
+}
+
+// WithSidecars adds the given value to the Sidecars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sidecars field.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithSidecars(values ...*SidecarStateApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSidecars")
+		}
+		b.Sidecars = append(b.Sidecars, *values[i])
+	}
+	return b
+}
+
+// WithTaskSpec sets the TaskSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskSpec field is set to the value of the last call.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithTaskSpec(value *TaskSpecApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	b.TaskSpec = value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithProvenance(value *ProvenanceApplyConfiguration) *TaskRunStatusFieldsApplyConfiguration {
+	b.Provenance = value
+	return b
+}
+
+// WithSpanContext puts the entries into the SpanContext field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SpanContext field,
+// overwriting an existing map entries in SpanContext field with the same key.
+func (b *TaskRunStatusFieldsApplyConfiguration) WithSpanContext(entries map[string]string) *TaskRunStatusFieldsApplyConfiguration {
+	if b.SpanContext == nil && len(entries) > 0 {
+		b.SpanContext = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SpanContext[k] = v
+	}
+	return b
+}
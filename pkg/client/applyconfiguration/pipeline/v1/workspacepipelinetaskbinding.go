@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// WorkspacePipelineTaskBindingApplyConfiguration represents a declarative configuration of the WorkspacePipelineTaskBinding type for use
+// with apply.
+type WorkspacePipelineTaskBindingApplyConfiguration struct {
+	Name      *string `json:"name,omitempty"`
+	Workspace *string `json:"workspace,omitempty"`
+	SubPath   *string `json:"subPath,omitempty"`
+}
+
+// WorkspacePipelineTaskBindingApplyConfiguration constructs a declarative configuration of the WorkspacePipelineTaskBinding type for use with
+// apply.
+func WorkspacePipelineTaskBinding() *WorkspacePipelineTaskBindingApplyConfiguration {
+	return &WorkspacePipelineTaskBindingApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *WorkspacePipelineTaskBindingApplyConfiguration) WithName(value string) *WorkspacePipelineTaskBindingApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithWorkspace sets the Workspace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Workspace field is set to the value of the last call.
+func (b *WorkspacePipelineTaskBindingApplyConfiguration) WithWorkspace(value string) *WorkspacePipelineTaskBindingApplyConfiguration {
+	b.Workspace = &value
+	return b
+}
+
+// WithSubPath sets the SubPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubPath field is set to the value of the last call.
+func (b *WorkspacePipelineTaskBindingApplyConfiguration) WithSubPath(value string) *WorkspacePipelineTaskBindingApplyConfiguration {
+	b.SubPath = &value
+	return b
+}
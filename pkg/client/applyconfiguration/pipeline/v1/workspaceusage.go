@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// WorkspaceUsageApplyConfiguration represents a declarative configuration of the WorkspaceUsage type for use
+// with apply.
+type WorkspaceUsageApplyConfiguration struct {
+	Name      *string `json:"name,omitempty"`
+	MountPath *string `json:"mountPath,omitempty"`
+}
+
+// WorkspaceUsageApplyConfiguration constructs a declarative configuration of the WorkspaceUsage type for use with
+// apply.
+func WorkspaceUsage() *WorkspaceUsageApplyConfiguration {
+	return &WorkspaceUsageApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *WorkspaceUsageApplyConfiguration) WithName(value string) *WorkspaceUsageApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithMountPath sets the MountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountPath field is set to the value of the last call.
+func (b *WorkspaceUsageApplyConfiguration) WithMountPath(value string) *WorkspaceUsageApplyConfiguration {
+	b.MountPath = &value
+	return b
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// ArtifactValueApplyConfiguration represents a declarative configuration of the ArtifactValue type for use
+// with apply.
+type ArtifactValueApplyConfiguration struct {
+	Digest map[pipelinev1beta1.Algorithm]string `json:"digest,omitempty"`
+	Uri    *string                              `json:"uri,omitempty"`
+}
+
+// ArtifactValueApplyConfiguration constructs a declarative configuration of the ArtifactValue type for use with
+// apply.
+func ArtifactValue() *ArtifactValueApplyConfiguration {
+	return &ArtifactValueApplyConfiguration{}
+}
+
+// WithDigest puts the entries into the Digest field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Digest field,
+// overwriting an existing map entries in Digest field with the same key.
+func (b *ArtifactValueApplyConfiguration) WithDigest(entries map[pipelinev1beta1.Algorithm]string) *ArtifactValueApplyConfiguration {
+	if b.Digest == nil && len(entries) > 0 {
+		b.Digest = make(map[pipelinev1beta1.Algorithm]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Digest[k] = v
+	}
+	return b
+}
+
+// WithUri sets the Uri field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Uri field is set to the value of the last call.
+func (b *ArtifactValueApplyConfiguration) WithUri(value string) *ArtifactValueApplyConfiguration {
+	b.Uri = &value
+	return b
+}
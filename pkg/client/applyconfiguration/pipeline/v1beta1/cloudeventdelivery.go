@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// CloudEventDeliveryApplyConfiguration represents a declarative configuration of the CloudEventDelivery type for use
+// with apply.
+type CloudEventDeliveryApplyConfiguration struct {
+	Target *string                                    `json:"target,omitempty"`
+	Status *CloudEventDeliveryStateApplyConfiguration `json:"status,omitempty"`
+}
+
+// CloudEventDeliveryApplyConfiguration constructs a declarative configuration of the CloudEventDelivery type for use with
+// apply.
+func CloudEventDelivery() *CloudEventDeliveryApplyConfiguration {
+	return &CloudEventDeliveryApplyConfiguration{}
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *CloudEventDeliveryApplyConfiguration) WithTarget(value string) *CloudEventDeliveryApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *CloudEventDeliveryApplyConfiguration) WithStatus(value *CloudEventDeliveryStateApplyConfiguration) *CloudEventDeliveryApplyConfiguration {
+	b.Status = value
+	return b
+}
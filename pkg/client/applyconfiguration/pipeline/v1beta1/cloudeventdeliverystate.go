@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudEventDeliveryStateApplyConfiguration represents a declarative configuration of the CloudEventDeliveryState type for use
+// with apply.
+type CloudEventDeliveryStateApplyConfiguration struct {
+	Condition  *pipelinev1beta1.CloudEventCondition `json:"condition,omitempty"`
+	SentAt     *v1.Time                             `json:"sentAt,omitempty"`
+	Error      *string                              `json:"message,omitempty"`
+	RetryCount *int32                               `json:"retryCount,omitempty"`
+}
+
+// CloudEventDeliveryStateApplyConfiguration constructs a declarative configuration of the CloudEventDeliveryState type for use with
+// apply.
+func CloudEventDeliveryState() *CloudEventDeliveryStateApplyConfiguration {
+	return &CloudEventDeliveryStateApplyConfiguration{}
+}
+
+// WithCondition sets the Condition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Condition field is set to the value of the last call.
+func (b *CloudEventDeliveryStateApplyConfiguration) WithCondition(value pipelinev1beta1.CloudEventCondition) *CloudEventDeliveryStateApplyConfiguration {
+	b.Condition = &value
+	return b
+}
+
+// WithSentAt sets the SentAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SentAt field is set to the value of the last call.
+func (b *CloudEventDeliveryStateApplyConfiguration) WithSentAt(value v1.Time) *CloudEventDeliveryStateApplyConfiguration {
+	b.SentAt = &value
+	return b
+}
+
+// WithError sets the Error field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Error field is set to the value of the last call.
+func (b *CloudEventDeliveryStateApplyConfiguration) WithError(value string) *CloudEventDeliveryStateApplyConfiguration {
+	b.Error = &value
+	return b
+}
+
+// WithRetryCount sets the RetryCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryCount field is set to the value of the last call.
+func (b *CloudEventDeliveryStateApplyConfiguration) WithRetryCount(value int32) *CloudEventDeliveryStateApplyConfiguration {
+	b.RetryCount = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// EmbeddedCustomRunSpecApplyConfiguration represents a declarative configuration of the EmbeddedCustomRunSpec type for use
+// with apply.
+type EmbeddedCustomRunSpecApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration `json:",inline"`
+	Metadata                      *PipelineTaskMetadataApplyConfiguration `json:"metadata,omitempty"`
+	Spec                          *runtime.RawExtension                   `json:"spec,omitempty"`
+}
+
+// EmbeddedCustomRunSpecApplyConfiguration constructs a declarative configuration of the EmbeddedCustomRunSpec type for use with
+// apply.
+func EmbeddedCustomRunSpec() *EmbeddedCustomRunSpecApplyConfiguration {
+	return &EmbeddedCustomRunSpecApplyConfiguration{}
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *EmbeddedCustomRunSpecApplyConfiguration) WithAPIVersion(value string) *EmbeddedCustomRunSpecApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *EmbeddedCustomRunSpecApplyConfiguration) WithKind(value string) *EmbeddedCustomRunSpecApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithMetadata sets the Metadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metadata field is set to the value of the last call.
+func (b *EmbeddedCustomRunSpecApplyConfiguration) WithMetadata(value *PipelineTaskMetadataApplyConfiguration) *EmbeddedCustomRunSpecApplyConfiguration {
+	b.Metadata = value
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *EmbeddedCustomRunSpecApplyConfiguration) WithSpec(value runtime.RawExtension) *EmbeddedCustomRunSpecApplyConfiguration {
+	b.Spec = &value
+	return b
+}
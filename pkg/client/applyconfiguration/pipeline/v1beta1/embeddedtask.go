@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// EmbeddedTaskApplyConfiguration represents a declarative configuration of the EmbeddedTask type for use
+// with apply.
+type EmbeddedTaskApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration `json:",omitempty,inline"`
+	Spec                          *runtime.RawExtension                   `json:"spec,omitempty"`
+	Metadata                      *PipelineTaskMetadataApplyConfiguration `json:"metadata,omitempty"`
+	TaskSpecApplyConfiguration    `json:",omitempty,inline"`
+}
+
+// EmbeddedTaskApplyConfiguration constructs a declarative configuration of the EmbeddedTask type for use with
+// apply.
+func EmbeddedTask() *EmbeddedTaskApplyConfiguration {
+	return &EmbeddedTaskApplyConfiguration{}
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithAPIVersion(value string) *EmbeddedTaskApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithKind(value string) *EmbeddedTaskApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithSpec(value runtime.RawExtension) *EmbeddedTaskApplyConfiguration {
+	b.Spec = &value
+	return b
+}
+
+// WithMetadata sets the Metadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metadata field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithMetadata(value *PipelineTaskMetadataApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	b.Metadata = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithResources(value *TaskResourcesApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	b.TaskSpecApplyConfiguration.Resources = value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithParams(value pipelinev1beta1.ParamSpecs) *EmbeddedTaskApplyConfiguration {
+	b.TaskSpecApplyConfiguration.Params = &value
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithDisplayName(value string) *EmbeddedTaskApplyConfiguration {
+	b.TaskSpecApplyConfiguration.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithDescription(value string) *EmbeddedTaskApplyConfiguration {
+	b.TaskSpecApplyConfiguration.Description = &value
+	return b
+}
+
+// WithSteps adds the given value to the Steps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Steps field.
+func (b *EmbeddedTaskApplyConfiguration) WithSteps(values ...*StepApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSteps")
+		}
+		b.TaskSpecApplyConfiguration.Steps = append(b.TaskSpecApplyConfiguration.Steps, *values[i])
+	}
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *EmbeddedTaskApplyConfiguration) WithVolumes(values ...corev1.Volume) *EmbeddedTaskApplyConfiguration {
+	for i := range values {
+		b.TaskSpecApplyConfiguration.Volumes = append(b.TaskSpecApplyConfiguration.Volumes, values[i])
+	}
+	return b
+}
+
+// WithStepTemplate sets the StepTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StepTemplate field is set to the value of the last call.
+func (b *EmbeddedTaskApplyConfiguration) WithStepTemplate(value *StepTemplateApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	b.TaskSpecApplyConfiguration.StepTemplate = value
+	return b
+}
+
+// WithSidecars adds the given value to the Sidecars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sidecars field.
+func (b *EmbeddedTaskApplyConfiguration) WithSidecars(values ...*SidecarApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSidecars")
+		}
+		b.TaskSpecApplyConfiguration.Sidecars = append(b.TaskSpecApplyConfiguration.Sidecars, *values[i])
+	}
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *EmbeddedTaskApplyConfiguration) WithWorkspaces(values ...*WorkspaceDeclarationApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.TaskSpecApplyConfiguration.Workspaces = append(b.TaskSpecApplyConfiguration.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *EmbeddedTaskApplyConfiguration) WithResults(values ...*TaskResultApplyConfiguration) *EmbeddedTaskApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.TaskSpecApplyConfiguration.Results = append(b.TaskSpecApplyConfiguration.Results, *values[i])
+	}
+	return b
+}
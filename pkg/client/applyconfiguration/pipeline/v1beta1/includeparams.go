@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// IncludeParamsApplyConfiguration represents a declarative configuration of the IncludeParams type for use
+// with apply.
+type IncludeParamsApplyConfiguration struct {
+	Name   *string                 `json:"name,omitempty"`
+	Params *pipelinev1beta1.Params `json:"params,omitempty"`
+}
+
+// IncludeParamsApplyConfiguration constructs a declarative configuration of the IncludeParams type for use with
+// apply.
+func IncludeParams() *IncludeParamsApplyConfiguration {
+	return &IncludeParamsApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *IncludeParamsApplyConfiguration) WithName(value string) *IncludeParamsApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *IncludeParamsApplyConfiguration) WithParams(value pipelinev1beta1.Params) *IncludeParamsApplyConfiguration {
+	b.Params = &value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// ParamApplyConfiguration represents a declarative configuration of the Param type for use
+// with apply.
+type ParamApplyConfiguration struct {
+	Name  *string                     `json:"name,omitempty"`
+	Value *pipelinev1beta1.ParamValue `json:"value,omitempty"`
+}
+
+// ParamApplyConfiguration constructs a declarative configuration of the Param type for use with
+// apply.
+func Param() *ParamApplyConfiguration {
+	return &ParamApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ParamApplyConfiguration) WithName(value string) *ParamApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *ParamApplyConfiguration) WithValue(value pipelinev1beta1.ParamValue) *ParamApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// ParamSpecApplyConfiguration represents a declarative configuration of the ParamSpec type for use
+// with apply.
+type ParamSpecApplyConfiguration struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Type        *pipelinev1beta1.ParamType                `json:"type,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Properties  map[string]PropertySpecApplyConfiguration `json:"properties,omitempty"`
+	Default     *pipelinev1beta1.ParamValue               `json:"default,omitempty"`
+	Enum        []string                                  `json:"enum,omitempty"`
+	Deprecated  *ParamDeprecationApplyConfiguration       `json:"deprecated,omitempty"`
+}
+
+// ParamSpecApplyConfiguration constructs a declarative configuration of the ParamSpec type for use with
+// apply.
+func ParamSpec() *ParamSpecApplyConfiguration {
+	return &ParamSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ParamSpecApplyConfiguration) WithName(value string) *ParamSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ParamSpecApplyConfiguration) WithType(value pipelinev1beta1.ParamType) *ParamSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *ParamSpecApplyConfiguration) WithDescription(value string) *ParamSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithProperties puts the entries into the Properties field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Properties field,
+// overwriting an existing map entries in Properties field with the same key.
+func (b *ParamSpecApplyConfiguration) WithProperties(entries map[string]PropertySpecApplyConfiguration) *ParamSpecApplyConfiguration {
+	if b.Properties == nil && len(entries) > 0 {
+		b.Properties = make(map[string]PropertySpecApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Properties[k] = v
+	}
+	return b
+}
+
+// WithDefault sets the Default field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Default field is set to the value of the last call.
+func (b *ParamSpecApplyConfiguration) WithDefault(value pipelinev1beta1.ParamValue) *ParamSpecApplyConfiguration {
+	b.Default = &value
+	return b
+}
+
+// WithEnum adds the given value to the Enum field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Enum field.
+func (b *ParamSpecApplyConfiguration) WithEnum(values ...string) *ParamSpecApplyConfiguration {
+	for i := range values {
+		b.Enum = append(b.Enum, values[i])
+	}
+	return b
+}
+
+// WithDeprecated sets the Deprecated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecated field is set to the value of the last call.
+func (b *ParamSpecApplyConfiguration) WithDeprecated(value *ParamDeprecationApplyConfiguration) *ParamSpecApplyConfiguration {
+	b.Deprecated = value
+	return b
+}
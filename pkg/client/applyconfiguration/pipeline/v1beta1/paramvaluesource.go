@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ParamValueSourceApplyConfiguration represents a declarative configuration of the ParamValueSource type for use
+// with apply.
+type ParamValueSourceApplyConfiguration struct {
+	SecretKeyRef    *v1.SecretKeySelector    `json:"secretKeyRef,omitempty"`
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ParamValueSourceApplyConfiguration constructs a declarative configuration of the ParamValueSource type for use with
+// apply.
+func ParamValueSource() *ParamValueSourceApplyConfiguration {
+	return &ParamValueSourceApplyConfiguration{}
+}
+
+// WithSecretKeyRef sets the SecretKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKeyRef field is set to the value of the last call.
+func (b *ParamValueSourceApplyConfiguration) WithSecretKeyRef(value v1.SecretKeySelector) *ParamValueSourceApplyConfiguration {
+	b.SecretKeyRef = &value
+	return b
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *ParamValueSourceApplyConfiguration) WithConfigMapKeyRef(value v1.ConfigMapKeySelector) *ParamValueSourceApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
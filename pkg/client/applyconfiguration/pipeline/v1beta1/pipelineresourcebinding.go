@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1alpha1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/resource/v1alpha1"
+)
+
+// PipelineResourceBindingApplyConfiguration represents a declarative configuration of the PipelineResourceBinding type for use
+// with apply.
+type PipelineResourceBindingApplyConfiguration struct {
+	Name         *string                                          `json:"name,omitempty"`
+	ResourceRef  *PipelineResourceRefApplyConfiguration           `json:"resourceRef,omitempty"`
+	ResourceSpec *v1alpha1.PipelineResourceSpecApplyConfiguration `json:"resourceSpec,omitempty"`
+}
+
+// PipelineResourceBindingApplyConfiguration constructs a declarative configuration of the PipelineResourceBinding type for use with
+// apply.
+func PipelineResourceBinding() *PipelineResourceBindingApplyConfiguration {
+	return &PipelineResourceBindingApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PipelineResourceBindingApplyConfiguration) WithName(value string) *PipelineResourceBindingApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResourceRef sets the ResourceRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceRef field is set to the value of the last call.
+func (b *PipelineResourceBindingApplyConfiguration) WithResourceRef(value *PipelineResourceRefApplyConfiguration) *PipelineResourceBindingApplyConfiguration {
+	b.ResourceRef = value
+	return b
+}
+
+// WithResourceSpec sets the ResourceSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceSpec field is set to the value of the last call.
+func (b *PipelineResourceBindingApplyConfiguration) WithResourceSpec(value *v1alpha1.PipelineResourceSpecApplyConfiguration) *PipelineResourceBindingApplyConfiguration {
+	b.ResourceSpec = value
+	return b
+}
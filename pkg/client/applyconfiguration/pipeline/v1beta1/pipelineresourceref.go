@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PipelineResourceRefApplyConfiguration represents a declarative configuration of the PipelineResourceRef type for use
+// with apply.
+type PipelineResourceRefApplyConfiguration struct {
+	Name       *string `json:"name,omitempty"`
+	APIVersion *string `json:"apiVersion,omitempty"`
+}
+
+// PipelineResourceRefApplyConfiguration constructs a declarative configuration of the PipelineResourceRef type for use with
+// apply.
+func PipelineResourceRef() *PipelineResourceRefApplyConfiguration {
+	return &PipelineResourceRefApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PipelineResourceRefApplyConfiguration) WithName(value string) *PipelineResourceRefApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *PipelineResourceRefApplyConfiguration) WithAPIVersion(value string) *PipelineResourceRefApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pod "github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineRunSpecApplyConfiguration represents a declarative configuration of the PipelineRunSpec type for use
+// with apply.
+type PipelineRunSpecApplyConfiguration struct {
+	PipelineRef        *PipelineRefApplyConfiguration              `json:"pipelineRef,omitempty"`
+	PipelineSpec       *PipelineSpecApplyConfiguration             `json:"pipelineSpec,omitempty"`
+	Resources          []PipelineResourceBindingApplyConfiguration `json:"resources,omitempty"`
+	Params             *pipelinev1beta1.Params                     `json:"params,omitempty"`
+	ParamDefaults      *pipelinev1beta1.ParamSpecs                 `json:"paramDefaults,omitempty"`
+	ParamSources       []ParamSourceApplyConfiguration             `json:"paramSources,omitempty"`
+	ServiceAccountName *string                                     `json:"serviceAccountName,omitempty"`
+	Status             *pipelinev1beta1.PipelineRunSpecStatus      `json:"status,omitempty"`
+	Timeouts           *TimeoutFieldsApplyConfiguration            `json:"timeouts,omitempty"`
+	Timeout            *v1.Duration                                `json:"timeout,omitempty"`
+	PodTemplate        *pod.Template                               `json:"podTemplate,omitempty"`
+	Workspaces         []WorkspaceBindingApplyConfiguration        `json:"workspaces,omitempty"`
+	TaskRunSpecs       []PipelineTaskRunSpecApplyConfiguration     `json:"taskRunSpecs,omitempty"`
+}
+
+// PipelineRunSpecApplyConfiguration constructs a declarative configuration of the PipelineRunSpec type for use with
+// apply.
+func PipelineRunSpec() *PipelineRunSpecApplyConfiguration {
+	return &PipelineRunSpecApplyConfiguration{}
+}
+
+// WithPipelineRef sets the PipelineRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineRef field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithPipelineRef(value *PipelineRefApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	b.PipelineRef = value
+	return b
+}
+
+// WithPipelineSpec sets the PipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineSpec field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	b.PipelineSpec = value
+	return b
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *PipelineRunSpecApplyConfiguration) WithResources(values ...*PipelineResourceBindingApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResources")
+		}
+		b.Resources = append(b.Resources, *values[i])
+	}
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithParams(value pipelinev1beta1.Params) *PipelineRunSpecApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithParamDefaults sets the ParamDefaults field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParamDefaults field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithParamDefaults(value pipelinev1beta1.ParamSpecs) *PipelineRunSpecApplyConfiguration {
+	b.ParamDefaults = &value
+	return b
+}
+
+// WithParamSources adds the given value to the ParamSources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ParamSources field.
+func (b *PipelineRunSpecApplyConfiguration) WithParamSources(values ...*ParamSourceApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithParamSources")
+		}
+		b.ParamSources = append(b.ParamSources, *values[i])
+	}
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithServiceAccountName(value string) *PipelineRunSpecApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithStatus(value pipelinev1beta1.PipelineRunSpecStatus) *PipelineRunSpecApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithTimeouts sets the Timeouts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeouts field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithTimeouts(value *TimeoutFieldsApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	b.Timeouts = value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithTimeout(value v1.Duration) *PipelineRunSpecApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *PipelineRunSpecApplyConfiguration) WithPodTemplate(value pod.Template) *PipelineRunSpecApplyConfiguration {
+	b.PodTemplate = &value
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *PipelineRunSpecApplyConfiguration) WithWorkspaces(values ...*WorkspaceBindingApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithTaskRunSpecs adds the given value to the TaskRunSpecs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TaskRunSpecs field.
+func (b *PipelineRunSpecApplyConfiguration) WithTaskRunSpecs(values ...*PipelineTaskRunSpecApplyConfiguration) *PipelineRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTaskRunSpecs")
+		}
+		b.TaskRunSpecs = append(b.TaskRunSpecs, *values[i])
+	}
+	return b
+}
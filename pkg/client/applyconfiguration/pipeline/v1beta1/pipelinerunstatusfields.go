@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineRunStatusFieldsApplyConfiguration represents a declarative configuration of the PipelineRunStatusFields type for use
+// with apply.
+type PipelineRunStatusFieldsApplyConfiguration struct {
+	StartTime            *v1.Time                                             `json:"startTime,omitempty"`
+	CompletionTime       *v1.Time                                             `json:"completionTime,omitempty"`
+	TaskRuns             map[string]*pipelinev1beta1.PipelineRunTaskRunStatus `json:"taskRuns,omitempty"`
+	Runs                 map[string]*pipelinev1beta1.PipelineRunRunStatus     `json:"runs,omitempty"`
+	PipelineResults      []PipelineRunResultApplyConfiguration                `json:"pipelineResults,omitempty"`
+	PipelineSpec         *PipelineSpecApplyConfiguration                      `json:"pipelineSpec,omitempty"`
+	ResolvedPipelineSpec *PipelineSpecApplyConfiguration                      `json:"resolvedPipelineSpec,omitempty"`
+	SkippedTasks         []SkippedTaskApplyConfiguration                      `json:"skippedTasks,omitempty"`
+	ChildReferences      []ChildStatusReferenceApplyConfiguration             `json:"childReferences,omitempty"`
+	FinallyStartTime     *v1.Time                                             `json:"finallyStartTime,omitempty"`
+	Provenance           *ProvenanceApplyConfiguration                        `json:"provenance,omitempty"`
+	SpanContext          map[string]string                                    `json:"spanContext,omitempty"`
+}
+
+// PipelineRunStatusFieldsApplyConfiguration constructs a declarative configuration of the PipelineRunStatusFields type for use with
+// apply.
+func PipelineRunStatusFields() *PipelineRunStatusFieldsApplyConfiguration {
+	return &PipelineRunStatusFieldsApplyConfiguration{}
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithStartTime(value v1.Time) *PipelineRunStatusFieldsApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithCompletionTime(value v1.Time) *PipelineRunStatusFieldsApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithTaskRuns puts the entries into the TaskRuns field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the TaskRuns field,
+// overwriting an existing map entries in TaskRuns field with the same key.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithTaskRuns(entries map[string]*pipelinev1beta1.PipelineRunTaskRunStatus) *PipelineRunStatusFieldsApplyConfiguration {
+	if b.TaskRuns == nil && len(entries) > 0 {
+		b.TaskRuns = make(map[string]*pipelinev1beta1.PipelineRunTaskRunStatus, len(entries))
+	}
+	for k, v := range entries {
+		b.TaskRuns[k] = v
+	}
+	return b
+}
+
+// WithRuns puts the entries into the Runs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Runs field,
+// overwriting an existing map entries in Runs field with the same key.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithRuns(entries map[string]*pipelinev1beta1.PipelineRunRunStatus) *PipelineRunStatusFieldsApplyConfiguration {
+	if b.Runs == nil && len(entries) > 0 {
+		b.Runs = make(map[string]*pipelinev1beta1.PipelineRunRunStatus, len(entries))
+	}
+	for k, v := range entries {
+		b.Runs[k] = v
+	}
+	return b
+}
+
+// WithPipelineResults adds the given value to the PipelineResults field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PipelineResults field.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithPipelineResults(values ...*PipelineRunResultApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPipelineResults")
+		}
+		b.PipelineResults = append(b.PipelineResults, *values[i])
+	}
+	return b
+}
+
+// WithPipelineSpec sets the PipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineSpec field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	b.PipelineSpec = value
+	return b
+}
+
+// WithResolvedPipelineSpec sets the ResolvedPipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResolvedPipelineSpec field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithResolvedPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	b.ResolvedPipelineSpec = value
+	return b
+}
+
+// WithSkippedTasks adds the given value to the SkippedTasks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SkippedTasks field.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithSkippedTasks(values ...*SkippedTaskApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSkippedTasks")
+		}
+		b.SkippedTasks = append(b.SkippedTasks, *values[i])
+	}
+	return b
+}
+
+// WithChildReferences adds the given value to the ChildReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ChildReferences field.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithChildReferences(values ...*ChildStatusReferenceApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChildReferences")
+		}
+		b.ChildReferences = append(b.ChildReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinallyStartTime sets the FinallyStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FinallyStartTime field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithFinallyStartTime(value v1.Time) *PipelineRunStatusFieldsApplyConfiguration {
+	b.FinallyStartTime = &value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithProvenance(value *ProvenanceApplyConfiguration) *PipelineRunStatusFieldsApplyConfiguration {
+	b.Provenance = value
+	return b
+}
+
+// WithSpanContext puts the entries into the SpanContext field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SpanContext field,
+// overwriting an existing map entries in SpanContext field with the same key.
+func (b *PipelineRunStatusFieldsApplyConfiguration) WithSpanContext(entries map[string]string) *PipelineRunStatusFieldsApplyConfiguration {
+	if b.SpanContext == nil && len(entries) > 0 {
+		b.SpanContext = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.SpanContext[k] = v
+	}
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PipelineRunTaskRunStatusApplyConfiguration represents a declarative configuration of the PipelineRunTaskRunStatus type for use
+// with apply.
+type PipelineRunTaskRunStatusApplyConfiguration struct {
+	PipelineTaskName *string                            `json:"pipelineTaskName,omitempty"`
+	Status           *TaskRunStatusApplyConfiguration   `json:"status,omitempty"`
+	WhenExpressions  []WhenExpressionApplyConfiguration `json:"whenExpressions,omitempty"`
+}
+
+// PipelineRunTaskRunStatusApplyConfiguration constructs a declarative configuration of the PipelineRunTaskRunStatus type for use with
+// apply.
+func PipelineRunTaskRunStatus() *PipelineRunTaskRunStatusApplyConfiguration {
+	return &PipelineRunTaskRunStatusApplyConfiguration{}
+}
+
+// WithPipelineTaskName sets the PipelineTaskName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineTaskName field is set to the value of the last call.
+func (b *PipelineRunTaskRunStatusApplyConfiguration) WithPipelineTaskName(value string) *PipelineRunTaskRunStatusApplyConfiguration {
+	b.PipelineTaskName = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *PipelineRunTaskRunStatusApplyConfiguration) WithStatus(value *TaskRunStatusApplyConfiguration) *PipelineRunTaskRunStatusApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// WithWhenExpressions adds the given value to the WhenExpressions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WhenExpressions field.
+func (b *PipelineRunTaskRunStatusApplyConfiguration) WithWhenExpressions(values ...*WhenExpressionApplyConfiguration) *PipelineRunTaskRunStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWhenExpressions")
+		}
+		b.WhenExpressions = append(b.WhenExpressions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineTaskApplyConfiguration represents a declarative configuration of the PipelineTask type for use
+// with apply.
+type PipelineTaskApplyConfiguration struct {
+	Name             *string                                          `json:"name,omitempty"`
+	DisplayName      *string                                          `json:"displayName,omitempty"`
+	Description      *string                                          `json:"description,omitempty"`
+	TaskRef          *TaskRefApplyConfiguration                       `json:"taskRef,omitempty"`
+	TaskSpec         *EmbeddedTaskApplyConfiguration                  `json:"taskSpec,omitempty"`
+	WhenExpressions  *pipelinev1beta1.WhenExpressions                 `json:"when,omitempty"`
+	Retries          *int                                             `json:"retries,omitempty"`
+	RunAfter         []string                                         `json:"runAfter,omitempty"`
+	DependsOnResults []string                                         `json:"dependsOnResults,omitempty"`
+	Resources        *PipelineTaskResourcesApplyConfiguration         `json:"resources,omitempty"`
+	Params           *pipelinev1beta1.Params                          `json:"params,omitempty"`
+	Matrix           *MatrixApplyConfiguration                        `json:"matrix,omitempty"`
+	Workspaces       []WorkspacePipelineTaskBindingApplyConfiguration `json:"workspaces,omitempty"`
+	Timeout          *v1.Duration                                     `json:"timeout,omitempty"`
+	TimeoutString    *string                                          `json:"timeoutString,omitempty"`
+	PipelineRef      *PipelineRefApplyConfiguration                   `json:"pipelineRef,omitempty"`
+	PipelineSpec     *PipelineSpecApplyConfiguration                  `json:"pipelineSpec,omitempty"`
+	OnError          *pipelinev1beta1.PipelineTaskOnErrorType         `json:"onError,omitempty"`
+}
+
+// PipelineTaskApplyConfiguration constructs a declarative configuration of the PipelineTask type for use with
+// apply.
+func PipelineTask() *PipelineTaskApplyConfiguration {
+	return &PipelineTaskApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithName(value string) *PipelineTaskApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithDisplayName(value string) *PipelineTaskApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithDescription(value string) *PipelineTaskApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithTaskRef sets the TaskRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskRef field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithTaskRef(value *TaskRefApplyConfiguration) *PipelineTaskApplyConfiguration {
+	b.TaskRef = value
+	return b
+}
+
+// WithTaskSpec sets the TaskSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskSpec field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithTaskSpec(value *EmbeddedTaskApplyConfiguration) *PipelineTaskApplyConfiguration {
+	b.TaskSpec = value
+	return b
+}
+
+// WithWhenExpressions sets the WhenExpressions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WhenExpressions field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithWhenExpressions(value pipelinev1beta1.WhenExpressions) *PipelineTaskApplyConfiguration {
+	b.WhenExpressions = &value
+	return b
+}
+
+// WithRetries sets the Retries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retries field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithRetries(value int) *PipelineTaskApplyConfiguration {
+	b.Retries = &value
+	return b
+}
+
+// WithRunAfter adds the given value to the RunAfter field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RunAfter field.
+func (b *PipelineTaskApplyConfiguration) WithRunAfter(values ...string) *PipelineTaskApplyConfiguration {
+	for i := range values {
+		b.RunAfter = append(b.RunAfter, values[i])
+	}
+	return b
+}
+
+// WithDependsOnResults adds the given value to the DependsOnResults field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DependsOnResults field.
+func (b *PipelineTaskApplyConfiguration) WithDependsOnResults(values ...string) *PipelineTaskApplyConfiguration {
+	for i := range values {
+		b.DependsOnResults = append(b.DependsOnResults, values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithResources(value *PipelineTaskResourcesApplyConfiguration) *PipelineTaskApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithParams(value pipelinev1beta1.Params) *PipelineTaskApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithMatrix sets the Matrix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Matrix field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithMatrix(value *MatrixApplyConfiguration) *PipelineTaskApplyConfiguration {
+	b.Matrix = value
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *PipelineTaskApplyConfiguration) WithWorkspaces(values ...*WorkspacePipelineTaskBindingApplyConfiguration) *PipelineTaskApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithTimeout(value v1.Duration) *PipelineTaskApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithTimeoutString sets the TimeoutString field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutString field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithTimeoutString(value string) *PipelineTaskApplyConfiguration {
+	b.TimeoutString = &value
+	return b
+}
+
+// WithPipelineRef sets the PipelineRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineRef field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithPipelineRef(value *PipelineRefApplyConfiguration) *PipelineTaskApplyConfiguration {
+	b.PipelineRef = value
+	return b
+}
+
+// WithPipelineSpec sets the PipelineSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PipelineSpec field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithPipelineSpec(value *PipelineSpecApplyConfiguration) *PipelineTaskApplyConfiguration {
+	b.PipelineSpec = value
+	return b
+}
+
+// WithOnError sets the OnError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnError field is set to the value of the last call.
+func (b *PipelineTaskApplyConfiguration) WithOnError(value pipelinev1beta1.PipelineTaskOnErrorType) *PipelineTaskApplyConfiguration {
+	b.OnError = &value
+	return b
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PipelineTaskInputResourceApplyConfiguration represents a declarative configuration of the PipelineTaskInputResource type for use
+// with apply.
+type PipelineTaskInputResourceApplyConfiguration struct {
+	Name     *string  `json:"name,omitempty"`
+	Resource *string  `json:"resource,omitempty"`
+	From     []string `json:"from,omitempty"`
+}
+
+// PipelineTaskInputResourceApplyConfiguration constructs a declarative configuration of the PipelineTaskInputResource type for use with
+// apply.
+func PipelineTaskInputResource() *PipelineTaskInputResourceApplyConfiguration {
+	return &PipelineTaskInputResourceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PipelineTaskInputResourceApplyConfiguration) WithName(value string) *PipelineTaskInputResourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResource sets the Resource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resource field is set to the value of the last call.
+func (b *PipelineTaskInputResourceApplyConfiguration) WithResource(value string) *PipelineTaskInputResourceApplyConfiguration {
+	b.Resource = &value
+	return b
+}
+
+// WithFrom adds the given value to the From field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the From field.
+func (b *PipelineTaskInputResourceApplyConfiguration) WithFrom(values ...string) *PipelineTaskInputResourceApplyConfiguration {
+	for i := range values {
+		b.From = append(b.From, values[i])
+	}
+	return b
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PipelineTaskResourcesApplyConfiguration represents a declarative configuration of the PipelineTaskResources type for use
+// with apply.
+type PipelineTaskResourcesApplyConfiguration struct {
+	Inputs  []PipelineTaskInputResourceApplyConfiguration  `json:"inputs,omitempty"`
+	Outputs []PipelineTaskOutputResourceApplyConfiguration `json:"outputs,omitempty"`
+}
+
+// PipelineTaskResourcesApplyConfiguration constructs a declarative configuration of the PipelineTaskResources type for use with
+// apply.
+func PipelineTaskResources() *PipelineTaskResourcesApplyConfiguration {
+	return &PipelineTaskResourcesApplyConfiguration{}
+}
+
+// WithInputs adds the given value to the Inputs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Inputs field.
+func (b *PipelineTaskResourcesApplyConfiguration) WithInputs(values ...*PipelineTaskInputResourceApplyConfiguration) *PipelineTaskResourcesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithInputs")
+		}
+		b.Inputs = append(b.Inputs, *values[i])
+	}
+	return b
+}
+
+// WithOutputs adds the given value to the Outputs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Outputs field.
+func (b *PipelineTaskResourcesApplyConfiguration) WithOutputs(values ...*PipelineTaskOutputResourceApplyConfiguration) *PipelineTaskResourcesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOutputs")
+		}
+		b.Outputs = append(b.Outputs, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// PipelineWorkspaceDeclarationApplyConfiguration represents a declarative configuration of the PipelineWorkspaceDeclaration type for use
+// with apply.
+type PipelineWorkspaceDeclarationApplyConfiguration struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Optional    *bool   `json:"optional,omitempty"`
+}
+
+// PipelineWorkspaceDeclarationApplyConfiguration constructs a declarative configuration of the PipelineWorkspaceDeclaration type for use with
+// apply.
+func PipelineWorkspaceDeclaration() *PipelineWorkspaceDeclarationApplyConfiguration {
+	return &PipelineWorkspaceDeclarationApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PipelineWorkspaceDeclarationApplyConfiguration) WithName(value string) *PipelineWorkspaceDeclarationApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *PipelineWorkspaceDeclarationApplyConfiguration) WithDescription(value string) *PipelineWorkspaceDeclarationApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *PipelineWorkspaceDeclarationApplyConfiguration) WithOptional(value bool) *PipelineWorkspaceDeclarationApplyConfiguration {
+	b.Optional = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	config "github.com/tektoncd/pipeline/pkg/apis/config"
+)
+
+// ProvenanceApplyConfiguration represents a declarative configuration of the Provenance type for use
+// with apply.
+type ProvenanceApplyConfiguration struct {
+	ConfigSource *ConfigSourceApplyConfiguration `json:"configSource,omitempty"`
+	RefSource    *RefSourceApplyConfiguration    `json:"refSource,omitempty"`
+	FeatureFlags *config.FeatureFlags            `json:"featureFlags,omitempty"`
+}
+
+// ProvenanceApplyConfiguration constructs a declarative configuration of the Provenance type for use with
+// apply.
+func Provenance() *ProvenanceApplyConfiguration {
+	return &ProvenanceApplyConfiguration{}
+}
+
+// WithConfigSource sets the ConfigSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigSource field is set to the value of the last call.
+func (b *ProvenanceApplyConfiguration) WithConfigSource(value *ConfigSourceApplyConfiguration) *ProvenanceApplyConfiguration {
+	b.ConfigSource = value
+	return b
+}
+
+// WithRefSource sets the RefSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RefSource field is set to the value of the last call.
+func (b *ProvenanceApplyConfiguration) WithRefSource(value *RefSourceApplyConfiguration) *ProvenanceApplyConfiguration {
+	b.RefSource = value
+	return b
+}
+
+// WithFeatureFlags sets the FeatureFlags field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FeatureFlags field is set to the value of the last call.
+func (b *ProvenanceApplyConfiguration) WithFeatureFlags(value config.FeatureFlags) *ProvenanceApplyConfiguration {
+	b.FeatureFlags = &value
+	return b
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// RefApplyConfiguration represents a declarative configuration of the Ref type for use
+// with apply.
+type RefApplyConfiguration struct {
+	Name                           *string `json:"name,omitempty"`
+	*ResolverRefApplyConfiguration `json:"ResolverRef,omitempty"`
+}
+
+// RefApplyConfiguration constructs a declarative configuration of the Ref type for use with
+// apply.
+func Ref() *RefApplyConfiguration {
+	return &RefApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RefApplyConfiguration) WithName(value string) *RefApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResolver sets the Resolver field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resolver field is set to the value of the last call.
+func (b *RefApplyConfiguration) WithResolver(value pipelinev1beta1.ResolverName) *RefApplyConfiguration {
+	b.ensureResolverRefApplyConfigurationExists()
+	b.ResolverRefApplyConfiguration.Resolver = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *RefApplyConfiguration) WithParams(value pipelinev1beta1.Params) *RefApplyConfiguration {
+	b.ensureResolverRefApplyConfigurationExists()
+	b.ResolverRefApplyConfiguration.Params = &value
+	return b
+}
+
+func (b *RefApplyConfiguration) ensureResolverRefApplyConfigurationExists() {
+	if b.ResolverRefApplyConfiguration == nil {
+		b.ResolverRefApplyConfiguration = &ResolverRefApplyConfiguration{}
+	}
+}
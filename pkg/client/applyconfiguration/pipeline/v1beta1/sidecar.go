@@ -0,0 +1,278 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SidecarApplyConfiguration represents a declarative configuration of the Sidecar type for use
+// with apply.
+type SidecarApplyConfiguration struct {
+	Name                     *string                            `json:"name,omitempty"`
+	Image                    *string                            `json:"image,omitempty"`
+	Command                  []string                           `json:"command,omitempty"`
+	Args                     []string                           `json:"args,omitempty"`
+	WorkingDir               *string                            `json:"workingDir,omitempty"`
+	Ports                    []v1.ContainerPort                 `json:"ports,omitempty"`
+	EnvFrom                  []v1.EnvFromSource                 `json:"envFrom,omitempty"`
+	Env                      []v1.EnvVar                        `json:"env,omitempty"`
+	Resources                *v1.ResourceRequirements           `json:"resources,omitempty"`
+	VolumeMounts             []v1.VolumeMount                   `json:"volumeMounts,omitempty"`
+	VolumeDevices            []v1.VolumeDevice                  `json:"volumeDevices,omitempty"`
+	LivenessProbe            *v1.Probe                          `json:"livenessProbe,omitempty"`
+	ReadinessProbe           *v1.Probe                          `json:"readinessProbe,omitempty"`
+	StartupProbe             *v1.Probe                          `json:"startupProbe,omitempty"`
+	Lifecycle                *v1.Lifecycle                      `json:"lifecycle,omitempty"`
+	TerminationMessagePath   *string                            `json:"terminationMessagePath,omitempty"`
+	TerminationMessagePolicy *v1.TerminationMessagePolicy       `json:"terminationMessagePolicy,omitempty"`
+	ImagePullPolicy          *v1.PullPolicy                     `json:"imagePullPolicy,omitempty"`
+	SecurityContext          *v1.SecurityContext                `json:"securityContext,omitempty"`
+	Stdin                    *bool                              `json:"stdin,omitempty"`
+	StdinOnce                *bool                              `json:"stdinOnce,omitempty"`
+	TTY                      *bool                              `json:"tty,omitempty"`
+	Script                   *string                            `json:"script,omitempty"`
+	Workspaces               []WorkspaceUsageApplyConfiguration `json:"workspaces,omitempty"`
+	RestartPolicy            *v1.ContainerRestartPolicy         `json:"restartPolicy,omitempty"`
+}
+
+// SidecarApplyConfiguration constructs a declarative configuration of the Sidecar type for use with
+// apply.
+func Sidecar() *SidecarApplyConfiguration {
+	return &SidecarApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithName(value string) *SidecarApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithImage(value string) *SidecarApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *SidecarApplyConfiguration) WithCommand(values ...string) *SidecarApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *SidecarApplyConfiguration) WithArgs(values ...string) *SidecarApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithWorkingDir(value string) *SidecarApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithPorts adds the given value to the Ports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ports field.
+func (b *SidecarApplyConfiguration) WithPorts(values ...v1.ContainerPort) *SidecarApplyConfiguration {
+	for i := range values {
+		b.Ports = append(b.Ports, values[i])
+	}
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *SidecarApplyConfiguration) WithEnvFrom(values ...v1.EnvFromSource) *SidecarApplyConfiguration {
+	for i := range values {
+		b.EnvFrom = append(b.EnvFrom, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *SidecarApplyConfiguration) WithEnv(values ...v1.EnvVar) *SidecarApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithResources(value v1.ResourceRequirements) *SidecarApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *SidecarApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *SidecarApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithVolumeDevices adds the given value to the VolumeDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeDevices field.
+func (b *SidecarApplyConfiguration) WithVolumeDevices(values ...v1.VolumeDevice) *SidecarApplyConfiguration {
+	for i := range values {
+		b.VolumeDevices = append(b.VolumeDevices, values[i])
+	}
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithLivenessProbe(value v1.Probe) *SidecarApplyConfiguration {
+	b.LivenessProbe = &value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithReadinessProbe(value v1.Probe) *SidecarApplyConfiguration {
+	b.ReadinessProbe = &value
+	return b
+}
+
+// WithStartupProbe sets the StartupProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartupProbe field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithStartupProbe(value v1.Probe) *SidecarApplyConfiguration {
+	b.StartupProbe = &value
+	return b
+}
+
+// WithLifecycle sets the Lifecycle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lifecycle field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithLifecycle(value v1.Lifecycle) *SidecarApplyConfiguration {
+	b.Lifecycle = &value
+	return b
+}
+
+// WithTerminationMessagePath sets the TerminationMessagePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TerminationMessagePath field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithTerminationMessagePath(value string) *SidecarApplyConfiguration {
+	b.TerminationMessagePath = &value
+	return b
+}
+
+// WithTerminationMessagePolicy sets the TerminationMessagePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TerminationMessagePolicy field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithTerminationMessagePolicy(value v1.TerminationMessagePolicy) *SidecarApplyConfiguration {
+	b.TerminationMessagePolicy = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *SidecarApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithSecurityContext(value v1.SecurityContext) *SidecarApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
+// WithStdin sets the Stdin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stdin field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithStdin(value bool) *SidecarApplyConfiguration {
+	b.Stdin = &value
+	return b
+}
+
+// WithStdinOnce sets the StdinOnce field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StdinOnce field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithStdinOnce(value bool) *SidecarApplyConfiguration {
+	b.StdinOnce = &value
+	return b
+}
+
+// WithTTY sets the TTY field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTY field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithTTY(value bool) *SidecarApplyConfiguration {
+	b.TTY = &value
+	return b
+}
+
+// WithScript sets the Script field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Script field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithScript(value string) *SidecarApplyConfiguration {
+	b.Script = &value
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *SidecarApplyConfiguration) WithWorkspaces(values ...*WorkspaceUsageApplyConfiguration) *SidecarApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithRestartPolicy sets the RestartPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartPolicy field is set to the value of the last call.
+func (b *SidecarApplyConfiguration) WithRestartPolicy(value v1.ContainerRestartPolicy) *SidecarApplyConfiguration {
+	b.RestartPolicy = &value
+	return b
+}
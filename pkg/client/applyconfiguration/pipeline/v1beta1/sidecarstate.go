@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// SidecarStateApplyConfiguration represents a declarative configuration of the SidecarState type for use
+// with apply.
+type SidecarStateApplyConfiguration struct {
+	v1.ContainerStateApplyConfiguration `json:",inline"`
+	Name                                *string `json:"name,omitempty"`
+	ContainerName                       *string `json:"container,omitempty"`
+	ImageID                             *string `json:"imageID,omitempty"`
+}
+
+// SidecarStateApplyConfiguration constructs a declarative configuration of the SidecarState type for use with
+// apply.
+func SidecarState() *SidecarStateApplyConfiguration {
+	return &SidecarStateApplyConfiguration{}
+}
+
+// WithWaiting sets the Waiting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Waiting field is set to the value of the last call.
+func (b *SidecarStateApplyConfiguration) WithWaiting(value *v1.ContainerStateWaitingApplyConfiguration) *SidecarStateApplyConfiguration {
+	b.ContainerStateApplyConfiguration.Waiting = value
+	return b
+}
+
+// WithRunning sets the Running field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Running field is set to the value of the last call.
+func (b *SidecarStateApplyConfiguration) WithRunning(value *v1.ContainerStateRunningApplyConfiguration) *SidecarStateApplyConfiguration {
+	b.ContainerStateApplyConfiguration.Running = value
+	return b
+}
+
+// WithTerminated sets the Terminated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Terminated field is set to the value of the last call.
+func (b *SidecarStateApplyConfiguration) WithTerminated(value *v1.ContainerStateTerminatedApplyConfiguration) *SidecarStateApplyConfiguration {
+	b.ContainerStateApplyConfiguration.Terminated = value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SidecarStateApplyConfiguration) WithName(value string) *SidecarStateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithContainerName sets the ContainerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerName field is set to the value of the last call.
+func (b *SidecarStateApplyConfiguration) WithContainerName(value string) *SidecarStateApplyConfiguration {
+	b.ContainerName = &value
+	return b
+}
+
+// WithImageID sets the ImageID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImageID field is set to the value of the last call.
+func (b *SidecarStateApplyConfiguration) WithImageID(value string) *SidecarStateApplyConfiguration {
+	b.ImageID = &value
+	return b
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StepApplyConfiguration represents a declarative configuration of the Step type for use
+// with apply.
+type StepApplyConfiguration struct {
+	Name                               *string                                   `json:"name,omitempty"`
+	Image                              *string                                   `json:"image,omitempty"`
+	Command                            []string                                  `json:"command,omitempty"`
+	Args                               []string                                  `json:"args,omitempty"`
+	WorkingDir                         *string                                   `json:"workingDir,omitempty"`
+	DeprecatedPorts                    []v1.ContainerPort                        `json:"ports,omitempty"`
+	EnvFrom                            []v1.EnvFromSource                        `json:"envFrom,omitempty"`
+	Env                                []v1.EnvVar                               `json:"env,omitempty"`
+	Resources                          *v1.ResourceRequirements                  `json:"resources,omitempty"`
+	VolumeMounts                       []v1.VolumeMount                          `json:"volumeMounts,omitempty"`
+	VolumeDevices                      []v1.VolumeDevice                         `json:"volumeDevices,omitempty"`
+	DeprecatedLivenessProbe            *v1.Probe                                 `json:"livenessProbe,omitempty"`
+	DeprecatedReadinessProbe           *v1.Probe                                 `json:"readinessProbe,omitempty"`
+	DeprecatedStartupProbe             *v1.Probe                                 `json:"startupProbe,omitempty"`
+	DeprecatedLifecycle                *v1.Lifecycle                             `json:"lifecycle,omitempty"`
+	DeprecatedTerminationMessagePath   *string                                   `json:"terminationMessagePath,omitempty"`
+	DeprecatedTerminationMessagePolicy *v1.TerminationMessagePolicy              `json:"terminationMessagePolicy,omitempty"`
+	ImagePullPolicy                    *v1.PullPolicy                            `json:"imagePullPolicy,omitempty"`
+	SecurityContext                    *v1.SecurityContext                       `json:"securityContext,omitempty"`
+	DeprecatedStdin                    *bool                                     `json:"stdin,omitempty"`
+	DeprecatedStdinOnce                *bool                                     `json:"stdinOnce,omitempty"`
+	DeprecatedTTY                      *bool                                     `json:"tty,omitempty"`
+	Script                             *string                                   `json:"script,omitempty"`
+	Timeout                            *metav1.Duration                          `json:"timeout,omitempty"`
+	Workspaces                         []WorkspaceUsageApplyConfiguration        `json:"workspaces,omitempty"`
+	OnError                            *pipelinev1beta1.OnErrorType              `json:"onError,omitempty"`
+	StdoutConfig                       *StepOutputConfigApplyConfiguration       `json:"stdoutConfig,omitempty"`
+	StderrConfig                       *StepOutputConfigApplyConfiguration       `json:"stderrConfig,omitempty"`
+	Ref                                *RefApplyConfiguration                    `json:"ref,omitempty"`
+	Params                             *pipelinev1beta1.Params                   `json:"params,omitempty"`
+	Results                            []pipelinev1.StepResultApplyConfiguration `json:"results,omitempty"`
+	When                               *pipelinev1beta1.WhenExpressions          `json:"when,omitempty"`
+}
+
+// StepApplyConfiguration constructs a declarative configuration of the Step type for use with
+// apply.
+func Step() *StepApplyConfiguration {
+	return &StepApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithName(value string) *StepApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithImage(value string) *StepApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *StepApplyConfiguration) WithCommand(values ...string) *StepApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *StepApplyConfiguration) WithArgs(values ...string) *StepApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithWorkingDir(value string) *StepApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithDeprecatedPorts adds the given value to the DeprecatedPorts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DeprecatedPorts field.
+func (b *StepApplyConfiguration) WithDeprecatedPorts(values ...v1.ContainerPort) *StepApplyConfiguration {
+	for i := range values {
+		b.DeprecatedPorts = append(b.DeprecatedPorts, values[i])
+	}
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *StepApplyConfiguration) WithEnvFrom(values ...v1.EnvFromSource) *StepApplyConfiguration {
+	for i := range values {
+		b.EnvFrom = append(b.EnvFrom, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *StepApplyConfiguration) WithEnv(values ...v1.EnvVar) *StepApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithResources(value v1.ResourceRequirements) *StepApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *StepApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *StepApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithVolumeDevices adds the given value to the VolumeDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeDevices field.
+func (b *StepApplyConfiguration) WithVolumeDevices(values ...v1.VolumeDevice) *StepApplyConfiguration {
+	for i := range values {
+		b.VolumeDevices = append(b.VolumeDevices, values[i])
+	}
+	return b
+}
+
+// WithDeprecatedLivenessProbe sets the DeprecatedLivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedLivenessProbe field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedLivenessProbe(value v1.Probe) *StepApplyConfiguration {
+	b.DeprecatedLivenessProbe = &value
+	return b
+}
+
+// WithDeprecatedReadinessProbe sets the DeprecatedReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedReadinessProbe field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedReadinessProbe(value v1.Probe) *StepApplyConfiguration {
+	b.DeprecatedReadinessProbe = &value
+	return b
+}
+
+// WithDeprecatedStartupProbe sets the DeprecatedStartupProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedStartupProbe field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedStartupProbe(value v1.Probe) *StepApplyConfiguration {
+	b.DeprecatedStartupProbe = &value
+	return b
+}
+
+// WithDeprecatedLifecycle sets the DeprecatedLifecycle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedLifecycle field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedLifecycle(value v1.Lifecycle) *StepApplyConfiguration {
+	b.DeprecatedLifecycle = &value
+	return b
+}
+
+// WithDeprecatedTerminationMessagePath sets the DeprecatedTerminationMessagePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedTerminationMessagePath field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedTerminationMessagePath(value string) *StepApplyConfiguration {
+	b.DeprecatedTerminationMessagePath = &value
+	return b
+}
+
+// WithDeprecatedTerminationMessagePolicy sets the DeprecatedTerminationMessagePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedTerminationMessagePolicy field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedTerminationMessagePolicy(value v1.TerminationMessagePolicy) *StepApplyConfiguration {
+	b.DeprecatedTerminationMessagePolicy = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *StepApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithSecurityContext(value v1.SecurityContext) *StepApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
+// WithDeprecatedStdin sets the DeprecatedStdin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedStdin field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedStdin(value bool) *StepApplyConfiguration {
+	b.DeprecatedStdin = &value
+	return b
+}
+
+// WithDeprecatedStdinOnce sets the DeprecatedStdinOnce field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedStdinOnce field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedStdinOnce(value bool) *StepApplyConfiguration {
+	b.DeprecatedStdinOnce = &value
+	return b
+}
+
+// WithDeprecatedTTY sets the DeprecatedTTY field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedTTY field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithDeprecatedTTY(value bool) *StepApplyConfiguration {
+	b.DeprecatedTTY = &value
+	return b
+}
+
+// WithScript sets the Script field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Script field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithScript(value string) *StepApplyConfiguration {
+	b.Script = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithTimeout(value metav1.Duration) *StepApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *StepApplyConfiguration) WithWorkspaces(values ...*WorkspaceUsageApplyConfiguration) *StepApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithOnError sets the OnError field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnError field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithOnError(value pipelinev1beta1.OnErrorType) *StepApplyConfiguration {
+	b.OnError = &value
+	return b
+}
+
+// WithStdoutConfig sets the StdoutConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StdoutConfig field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithStdoutConfig(value *StepOutputConfigApplyConfiguration) *StepApplyConfiguration {
+	b.StdoutConfig = value
+	return b
+}
+
+// WithStderrConfig sets the StderrConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StderrConfig field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithStderrConfig(value *StepOutputConfigApplyConfiguration) *StepApplyConfiguration {
+	b.StderrConfig = value
+	return b
+}
+
+// WithRef sets the Ref field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ref field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithRef(value *RefApplyConfiguration) *StepApplyConfiguration {
+	b.Ref = value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithParams(value pipelinev1beta1.Params) *StepApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *StepApplyConfiguration) WithResults(values ...*pipelinev1.StepResultApplyConfiguration) *StepApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
+
+// WithWhen sets the When field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the When field is set to the value of the last call.
+func (b *StepApplyConfiguration) WithWhen(value pipelinev1beta1.WhenExpressions) *StepApplyConfiguration {
+	b.When = &value
+	return b
+}
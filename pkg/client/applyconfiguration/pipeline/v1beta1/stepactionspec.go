@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	applyconfigurationpipelinev1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// StepActionSpecApplyConfiguration represents a declarative configuration of the StepActionSpec type for use
+// with apply.
+type StepActionSpecApplyConfiguration struct {
+	Description     *string                                                     `json:"description,omitempty"`
+	Image           *string                                                     `json:"image,omitempty"`
+	Command         []string                                                    `json:"command,omitempty"`
+	Args            []string                                                    `json:"args,omitempty"`
+	Env             []v1.EnvVar                                                 `json:"env,omitempty"`
+	Script          *string                                                     `json:"script,omitempty"`
+	WorkingDir      *string                                                     `json:"workingDir,omitempty"`
+	Params          *pipelinev1.ParamSpecs                                      `json:"params,omitempty"`
+	Results         []applyconfigurationpipelinev1.StepResultApplyConfiguration `json:"results,omitempty"`
+	SecurityContext *v1.SecurityContext                                         `json:"securityContext,omitempty"`
+	VolumeMounts    []v1.VolumeMount                                            `json:"volumeMounts,omitempty"`
+}
+
+// StepActionSpecApplyConfiguration constructs a declarative configuration of the StepActionSpec type for use with
+// apply.
+func StepActionSpec() *StepActionSpecApplyConfiguration {
+	return &StepActionSpecApplyConfiguration{}
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *StepActionSpecApplyConfiguration) WithDescription(value string) *StepActionSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *StepActionSpecApplyConfiguration) WithImage(value string) *StepActionSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *StepActionSpecApplyConfiguration) WithCommand(values ...string) *StepActionSpecApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *StepActionSpecApplyConfiguration) WithArgs(values ...string) *StepActionSpecApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *StepActionSpecApplyConfiguration) WithEnv(values ...v1.EnvVar) *StepActionSpecApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithScript sets the Script field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Script field is set to the value of the last call.
+func (b *StepActionSpecApplyConfiguration) WithScript(value string) *StepActionSpecApplyConfiguration {
+	b.Script = &value
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *StepActionSpecApplyConfiguration) WithWorkingDir(value string) *StepActionSpecApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *StepActionSpecApplyConfiguration) WithParams(value pipelinev1.ParamSpecs) *StepActionSpecApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *StepActionSpecApplyConfiguration) WithResults(values ...*applyconfigurationpipelinev1.StepResultApplyConfiguration) *StepActionSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *StepActionSpecApplyConfiguration) WithSecurityContext(value v1.SecurityContext) *StepActionSpecApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *StepActionSpecApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *StepActionSpecApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// StepTemplateApplyConfiguration represents a declarative configuration of the StepTemplate type for use
+// with apply.
+type StepTemplateApplyConfiguration struct {
+	DeprecatedName                     *string                      `json:"name,omitempty"`
+	Image                              *string                      `json:"image,omitempty"`
+	Command                            []string                     `json:"command,omitempty"`
+	Args                               []string                     `json:"args,omitempty"`
+	WorkingDir                         *string                      `json:"workingDir,omitempty"`
+	DeprecatedPorts                    []v1.ContainerPort           `json:"ports,omitempty"`
+	EnvFrom                            []v1.EnvFromSource           `json:"envFrom,omitempty"`
+	Env                                []v1.EnvVar                  `json:"env,omitempty"`
+	Resources                          *v1.ResourceRequirements     `json:"resources,omitempty"`
+	VolumeMounts                       []v1.VolumeMount             `json:"volumeMounts,omitempty"`
+	VolumeDevices                      []v1.VolumeDevice            `json:"volumeDevices,omitempty"`
+	DeprecatedLivenessProbe            *v1.Probe                    `json:"livenessProbe,omitempty"`
+	DeprecatedReadinessProbe           *v1.Probe                    `json:"readinessProbe,omitempty"`
+	DeprecatedStartupProbe             *v1.Probe                    `json:"startupProbe,omitempty"`
+	DeprecatedLifecycle                *v1.Lifecycle                `json:"lifecycle,omitempty"`
+	DeprecatedTerminationMessagePath   *string                      `json:"terminationMessagePath,omitempty"`
+	DeprecatedTerminationMessagePolicy *v1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+	ImagePullPolicy                    *v1.PullPolicy               `json:"imagePullPolicy,omitempty"`
+	SecurityContext                    *v1.SecurityContext          `json:"securityContext,omitempty"`
+	DeprecatedStdin                    *bool                        `json:"stdin,omitempty"`
+	DeprecatedStdinOnce                *bool                        `json:"stdinOnce,omitempty"`
+	DeprecatedTTY                      *bool                        `json:"tty,omitempty"`
+}
+
+// StepTemplateApplyConfiguration constructs a declarative configuration of the StepTemplate type for use with
+// apply.
+func StepTemplate() *StepTemplateApplyConfiguration {
+	return &StepTemplateApplyConfiguration{}
+}
+
+// WithDeprecatedName sets the DeprecatedName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedName field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedName(value string) *StepTemplateApplyConfiguration {
+	b.DeprecatedName = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithImage(value string) *StepTemplateApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithCommand adds the given value to the Command field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Command field.
+func (b *StepTemplateApplyConfiguration) WithCommand(values ...string) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.Command = append(b.Command, values[i])
+	}
+	return b
+}
+
+// WithArgs adds the given value to the Args field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Args field.
+func (b *StepTemplateApplyConfiguration) WithArgs(values ...string) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.Args = append(b.Args, values[i])
+	}
+	return b
+}
+
+// WithWorkingDir sets the WorkingDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkingDir field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithWorkingDir(value string) *StepTemplateApplyConfiguration {
+	b.WorkingDir = &value
+	return b
+}
+
+// WithDeprecatedPorts adds the given value to the DeprecatedPorts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DeprecatedPorts field.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedPorts(values ...v1.ContainerPort) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.DeprecatedPorts = append(b.DeprecatedPorts, values[i])
+	}
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *StepTemplateApplyConfiguration) WithEnvFrom(values ...v1.EnvFromSource) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.EnvFrom = append(b.EnvFrom, values[i])
+	}
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *StepTemplateApplyConfiguration) WithEnv(values ...v1.EnvVar) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithResources(value v1.ResourceRequirements) *StepTemplateApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *StepTemplateApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
+// WithVolumeDevices adds the given value to the VolumeDevices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeDevices field.
+func (b *StepTemplateApplyConfiguration) WithVolumeDevices(values ...v1.VolumeDevice) *StepTemplateApplyConfiguration {
+	for i := range values {
+		b.VolumeDevices = append(b.VolumeDevices, values[i])
+	}
+	return b
+}
+
+// WithDeprecatedLivenessProbe sets the DeprecatedLivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedLivenessProbe field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedLivenessProbe(value v1.Probe) *StepTemplateApplyConfiguration {
+	b.DeprecatedLivenessProbe = &value
+	return b
+}
+
+// WithDeprecatedReadinessProbe sets the DeprecatedReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedReadinessProbe field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedReadinessProbe(value v1.Probe) *StepTemplateApplyConfiguration {
+	b.DeprecatedReadinessProbe = &value
+	return b
+}
+
+// WithDeprecatedStartupProbe sets the DeprecatedStartupProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedStartupProbe field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedStartupProbe(value v1.Probe) *StepTemplateApplyConfiguration {
+	b.DeprecatedStartupProbe = &value
+	return b
+}
+
+// WithDeprecatedLifecycle sets the DeprecatedLifecycle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedLifecycle field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedLifecycle(value v1.Lifecycle) *StepTemplateApplyConfiguration {
+	b.DeprecatedLifecycle = &value
+	return b
+}
+
+// WithDeprecatedTerminationMessagePath sets the DeprecatedTerminationMessagePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedTerminationMessagePath field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedTerminationMessagePath(value string) *StepTemplateApplyConfiguration {
+	b.DeprecatedTerminationMessagePath = &value
+	return b
+}
+
+// WithDeprecatedTerminationMessagePolicy sets the DeprecatedTerminationMessagePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedTerminationMessagePolicy field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedTerminationMessagePolicy(value v1.TerminationMessagePolicy) *StepTemplateApplyConfiguration {
+	b.DeprecatedTerminationMessagePolicy = &value
+	return b
+}
+
+// WithImagePullPolicy sets the ImagePullPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImagePullPolicy field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithImagePullPolicy(value v1.PullPolicy) *StepTemplateApplyConfiguration {
+	b.ImagePullPolicy = &value
+	return b
+}
+
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithSecurityContext(value v1.SecurityContext) *StepTemplateApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
+// WithDeprecatedStdin sets the DeprecatedStdin field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedStdin field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedStdin(value bool) *StepTemplateApplyConfiguration {
+	b.DeprecatedStdin = &value
+	return b
+}
+
+// WithDeprecatedStdinOnce sets the DeprecatedStdinOnce field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedStdinOnce field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedStdinOnce(value bool) *StepTemplateApplyConfiguration {
+	b.DeprecatedStdinOnce = &value
+	return b
+}
+
+// WithDeprecatedTTY sets the DeprecatedTTY field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeprecatedTTY field is set to the value of the last call.
+func (b *StepTemplateApplyConfiguration) WithDeprecatedTTY(value bool) *StepTemplateApplyConfiguration {
+	b.DeprecatedTTY = &value
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1alpha1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/resource/v1alpha1"
+)
+
+// TaskResourceApplyConfiguration represents a declarative configuration of the TaskResource type for use
+// with apply.
+type TaskResourceApplyConfiguration struct {
+	v1alpha1.ResourceDeclarationApplyConfiguration `json:",inline"`
+}
+
+// TaskResourceApplyConfiguration constructs a declarative configuration of the TaskResource type for use with
+// apply.
+func TaskResource() *TaskResourceApplyConfiguration {
+	return &TaskResourceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TaskResourceApplyConfiguration) WithName(value string) *TaskResourceApplyConfiguration {
+	b.ResourceDeclarationApplyConfiguration.Name = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *TaskResourceApplyConfiguration) WithType(value string) *TaskResourceApplyConfiguration {
+	b.ResourceDeclarationApplyConfiguration.Type = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *TaskResourceApplyConfiguration) WithDescription(value string) *TaskResourceApplyConfiguration {
+	b.ResourceDeclarationApplyConfiguration.Description = &value
+	return b
+}
+
+// WithTargetPath sets the TargetPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetPath field is set to the value of the last call.
+func (b *TaskResourceApplyConfiguration) WithTargetPath(value string) *TaskResourceApplyConfiguration {
+	b.ResourceDeclarationApplyConfiguration.TargetPath = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *TaskResourceApplyConfiguration) WithOptional(value bool) *TaskResourceApplyConfiguration {
+	b.ResourceDeclarationApplyConfiguration.Optional = &value
+	return b
+}
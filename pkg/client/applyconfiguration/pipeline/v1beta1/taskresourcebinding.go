@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1alpha1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/resource/v1alpha1"
+)
+
+// TaskResourceBindingApplyConfiguration represents a declarative configuration of the TaskResourceBinding type for use
+// with apply.
+type TaskResourceBindingApplyConfiguration struct {
+	PipelineResourceBindingApplyConfiguration `json:",inline"`
+	Paths                                     []string `json:"paths,omitempty"`
+}
+
+// TaskResourceBindingApplyConfiguration constructs a declarative configuration of the TaskResourceBinding type for use with
+// apply.
+func TaskResourceBinding() *TaskResourceBindingApplyConfiguration {
+	return &TaskResourceBindingApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TaskResourceBindingApplyConfiguration) WithName(value string) *TaskResourceBindingApplyConfiguration {
+	b.PipelineResourceBindingApplyConfiguration.Name = &value
+	return b
+}
+
+// WithResourceRef sets the ResourceRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceRef field is set to the value of the last call.
+func (b *TaskResourceBindingApplyConfiguration) WithResourceRef(value *PipelineResourceRefApplyConfiguration) *TaskResourceBindingApplyConfiguration {
+	b.PipelineResourceBindingApplyConfiguration.ResourceRef = value
+	return b
+}
+
+// WithResourceSpec sets the ResourceSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceSpec field is set to the value of the last call.
+func (b *TaskResourceBindingApplyConfiguration) WithResourceSpec(value *v1alpha1.PipelineResourceSpecApplyConfiguration) *TaskResourceBindingApplyConfiguration {
+	b.PipelineResourceBindingApplyConfiguration.ResourceSpec = value
+	return b
+}
+
+// WithPaths adds the given value to the Paths field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Paths field.
+func (b *TaskResourceBindingApplyConfiguration) WithPaths(values ...string) *TaskResourceBindingApplyConfiguration {
+	for i := range values {
+		b.Paths = append(b.Paths, values[i])
+	}
+	return b
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// TaskResultApplyConfiguration represents a declarative configuration of the TaskResult type for use
+// with apply.
+type TaskResultApplyConfiguration struct {
+	Name        *string                                   `json:"name,omitempty"`
+	Type        *pipelinev1beta1.ResultsType              `json:"type,omitempty"`
+	Properties  map[string]PropertySpecApplyConfiguration `json:"properties,omitempty"`
+	MaxItems    *int                                      `json:"maxItems,omitempty"`
+	Description *string                                   `json:"description,omitempty"`
+	Value       *pipelinev1beta1.ParamValue               `json:"value,omitempty"`
+}
+
+// TaskResultApplyConfiguration constructs a declarative configuration of the TaskResult type for use with
+// apply.
+func TaskResult() *TaskResultApplyConfiguration {
+	return &TaskResultApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TaskResultApplyConfiguration) WithName(value string) *TaskResultApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *TaskResultApplyConfiguration) WithType(value pipelinev1beta1.ResultsType) *TaskResultApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithProperties puts the entries into the Properties field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Properties field,
+// overwriting an existing map entries in Properties field with the same key.
+func (b *TaskResultApplyConfiguration) WithProperties(entries map[string]PropertySpecApplyConfiguration) *TaskResultApplyConfiguration {
+	if b.Properties == nil && len(entries) > 0 {
+		b.Properties = make(map[string]PropertySpecApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.Properties[k] = v
+	}
+	return b
+}
+
+// WithMaxItems sets the MaxItems field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxItems field is set to the value of the last call.
+func (b *TaskResultApplyConfiguration) WithMaxItems(value int) *TaskResultApplyConfiguration {
+	b.MaxItems = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *TaskResultApplyConfiguration) WithDescription(value string) *TaskResultApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *TaskResultApplyConfiguration) WithValue(value pipelinev1beta1.ParamValue) *TaskResultApplyConfiguration {
+	b.Value = &value
+	return b
+}
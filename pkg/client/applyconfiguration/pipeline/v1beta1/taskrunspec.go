@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pod "github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaskRunSpecApplyConfiguration represents a declarative configuration of the TaskRunSpec type for use
+// with apply.
+type TaskRunSpecApplyConfiguration struct {
+	Debug              *TaskRunDebugApplyConfiguration            `json:"debug,omitempty"`
+	Params             *pipelinev1beta1.Params                    `json:"params,omitempty"`
+	Resources          *TaskRunResourcesApplyConfiguration        `json:"resources,omitempty"`
+	ServiceAccountName *string                                    `json:"serviceAccountName,omitempty"`
+	TaskRef            *TaskRefApplyConfiguration                 `json:"taskRef,omitempty"`
+	TaskSpec           *TaskSpecApplyConfiguration                `json:"taskSpec,omitempty"`
+	Status             *pipelinev1beta1.TaskRunSpecStatus         `json:"status,omitempty"`
+	StatusMessage      *pipelinev1beta1.TaskRunSpecStatusMessage  `json:"statusMessage,omitempty"`
+	Retries            *int                                       `json:"retries,omitempty"`
+	Timeout            *v1.Duration                               `json:"timeout,omitempty"`
+	PodTemplate        *pod.Template                              `json:"podTemplate,omitempty"`
+	Workspaces         []WorkspaceBindingApplyConfiguration       `json:"workspaces,omitempty"`
+	StepOverrides      []TaskRunStepOverrideApplyConfiguration    `json:"stepOverrides,omitempty"`
+	SidecarOverrides   []TaskRunSidecarOverrideApplyConfiguration `json:"sidecarOverrides,omitempty"`
+	ComputeResources   *corev1.ResourceRequirements               `json:"computeResources,omitempty"`
+}
+
+// TaskRunSpecApplyConfiguration constructs a declarative configuration of the TaskRunSpec type for use with
+// apply.
+func TaskRunSpec() *TaskRunSpecApplyConfiguration {
+	return &TaskRunSpecApplyConfiguration{}
+}
+
+// WithDebug sets the Debug field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Debug field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithDebug(value *TaskRunDebugApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	b.Debug = value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithParams(value pipelinev1beta1.Params) *TaskRunSpecApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithResources(value *TaskRunResourcesApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithServiceAccountName sets the ServiceAccountName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceAccountName field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithServiceAccountName(value string) *TaskRunSpecApplyConfiguration {
+	b.ServiceAccountName = &value
+	return b
+}
+
+// WithTaskRef sets the TaskRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskRef field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithTaskRef(value *TaskRefApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	b.TaskRef = value
+	return b
+}
+
+// WithTaskSpec sets the TaskSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskSpec field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithTaskSpec(value *TaskSpecApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	b.TaskSpec = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithStatus(value pipelinev1beta1.TaskRunSpecStatus) *TaskRunSpecApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithStatusMessage sets the StatusMessage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatusMessage field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithStatusMessage(value pipelinev1beta1.TaskRunSpecStatusMessage) *TaskRunSpecApplyConfiguration {
+	b.StatusMessage = &value
+	return b
+}
+
+// WithRetries sets the Retries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retries field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithRetries(value int) *TaskRunSpecApplyConfiguration {
+	b.Retries = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithTimeout(value v1.Duration) *TaskRunSpecApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithPodTemplate sets the PodTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodTemplate field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithPodTemplate(value pod.Template) *TaskRunSpecApplyConfiguration {
+	b.PodTemplate = &value
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *TaskRunSpecApplyConfiguration) WithWorkspaces(values ...*WorkspaceBindingApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithStepOverrides adds the given value to the StepOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StepOverrides field.
+func (b *TaskRunSpecApplyConfiguration) WithStepOverrides(values ...*TaskRunStepOverrideApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithStepOverrides")
+		}
+		b.StepOverrides = append(b.StepOverrides, *values[i])
+	}
+	return b
+}
+
+// WithSidecarOverrides adds the given value to the SidecarOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SidecarOverrides field.
+func (b *TaskRunSpecApplyConfiguration) WithSidecarOverrides(values ...*TaskRunSidecarOverrideApplyConfiguration) *TaskRunSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSidecarOverrides")
+		}
+		b.SidecarOverrides = append(b.SidecarOverrides, *values[i])
+	}
+	return b
+}
+
+// WithComputeResources sets the ComputeResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ComputeResources field is set to the value of the last call.
+func (b *TaskRunSpecApplyConfiguration) WithComputeResources(value corev1.ResourceRequirements) *TaskRunSpecApplyConfiguration {
+	b.ComputeResources = &value
+	return b
+}
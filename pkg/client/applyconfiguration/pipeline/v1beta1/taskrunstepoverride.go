@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// TaskRunStepOverrideApplyConfiguration represents a declarative configuration of the TaskRunStepOverride type for use
+// with apply.
+type TaskRunStepOverrideApplyConfiguration struct {
+	Name      *string                  `json:"name,omitempty"`
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// TaskRunStepOverrideApplyConfiguration constructs a declarative configuration of the TaskRunStepOverride type for use with
+// apply.
+func TaskRunStepOverride() *TaskRunStepOverrideApplyConfiguration {
+	return &TaskRunStepOverrideApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TaskRunStepOverrideApplyConfiguration) WithName(value string) *TaskRunStepOverrideApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *TaskRunStepOverrideApplyConfiguration) WithResources(value v1.ResourceRequirements) *TaskRunStepOverrideApplyConfiguration {
+	b.Resources = &value
+	return b
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// TaskSpecApplyConfiguration represents a declarative configuration of the TaskSpec type for use
+// with apply.
+type TaskSpecApplyConfiguration struct {
+	Resources    *TaskResourcesApplyConfiguration         `json:"resources,omitempty"`
+	Params       *pipelinev1beta1.ParamSpecs              `json:"params,omitempty"`
+	DisplayName  *string                                  `json:"displayName,omitempty"`
+	Description  *string                                  `json:"description,omitempty"`
+	Steps        []StepApplyConfiguration                 `json:"steps,omitempty"`
+	Volumes      []v1.Volume                              `json:"volumes,omitempty"`
+	StepTemplate *StepTemplateApplyConfiguration          `json:"stepTemplate,omitempty"`
+	Sidecars     []SidecarApplyConfiguration              `json:"sidecars,omitempty"`
+	Workspaces   []WorkspaceDeclarationApplyConfiguration `json:"workspaces,omitempty"`
+	Results      []TaskResultApplyConfiguration           `json:"results,omitempty"`
+}
+
+// TaskSpecApplyConfiguration constructs a declarative configuration of the TaskSpec type for use with
+// apply.
+func TaskSpec() *TaskSpecApplyConfiguration {
+	return &TaskSpecApplyConfiguration{}
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *TaskSpecApplyConfiguration) WithResources(value *TaskResourcesApplyConfiguration) *TaskSpecApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithParams sets the Params field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Params field is set to the value of the last call.
+func (b *TaskSpecApplyConfiguration) WithParams(value pipelinev1beta1.ParamSpecs) *TaskSpecApplyConfiguration {
+	b.Params = &value
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *TaskSpecApplyConfiguration) WithDisplayName(value string) *TaskSpecApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *TaskSpecApplyConfiguration) WithDescription(value string) *TaskSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithSteps adds the given value to the Steps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Steps field.
+func (b *TaskSpecApplyConfiguration) WithSteps(values ...*StepApplyConfiguration) *TaskSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSteps")
+		}
+		b.Steps = append(b.Steps, *values[i])
+	}
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *TaskSpecApplyConfiguration) WithVolumes(values ...v1.Volume) *TaskSpecApplyConfiguration {
+	for i := range values {
+		b.Volumes = append(b.Volumes, values[i])
+	}
+	return b
+}
+
+// WithStepTemplate sets the StepTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StepTemplate field is set to the value of the last call.
+func (b *TaskSpecApplyConfiguration) WithStepTemplate(value *StepTemplateApplyConfiguration) *TaskSpecApplyConfiguration {
+	b.StepTemplate = value
+	return b
+}
+
+// WithSidecars adds the given value to the Sidecars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sidecars field.
+func (b *TaskSpecApplyConfiguration) WithSidecars(values ...*SidecarApplyConfiguration) *TaskSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSidecars")
+		}
+		b.Sidecars = append(b.Sidecars, *values[i])
+	}
+	return b
+}
+
+// WithWorkspaces adds the given value to the Workspaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Workspaces field.
+func (b *TaskSpecApplyConfiguration) WithWorkspaces(values ...*WorkspaceDeclarationApplyConfiguration) *TaskSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkspaces")
+		}
+		b.Workspaces = append(b.Workspaces, *values[i])
+	}
+	return b
+}
+
+// WithResults adds the given value to the Results field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Results field.
+func (b *TaskSpecApplyConfiguration) WithResults(values ...*TaskResultApplyConfiguration) *TaskSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResults")
+		}
+		b.Results = append(b.Results, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TimeoutFieldsApplyConfiguration represents a declarative configuration of the TimeoutFields type for use
+// with apply.
+type TimeoutFieldsApplyConfiguration struct {
+	Pipeline *v1.Duration `json:"pipeline,omitempty"`
+	Tasks    *v1.Duration `json:"tasks,omitempty"`
+	Finally  *v1.Duration `json:"finally,omitempty"`
+}
+
+// TimeoutFieldsApplyConfiguration constructs a declarative configuration of the TimeoutFields type for use with
+// apply.
+func TimeoutFields() *TimeoutFieldsApplyConfiguration {
+	return &TimeoutFieldsApplyConfiguration{}
+}
+
+// WithPipeline sets the Pipeline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pipeline field is set to the value of the last call.
+func (b *TimeoutFieldsApplyConfiguration) WithPipeline(value v1.Duration) *TimeoutFieldsApplyConfiguration {
+	b.Pipeline = &value
+	return b
+}
+
+// WithTasks sets the Tasks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tasks field is set to the value of the last call.
+func (b *TimeoutFieldsApplyConfiguration) WithTasks(value v1.Duration) *TimeoutFieldsApplyConfiguration {
+	b.Tasks = &value
+	return b
+}
+
+// WithFinally sets the Finally field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Finally field is set to the value of the last call.
+func (b *TimeoutFieldsApplyConfiguration) WithFinally(value v1.Duration) *TimeoutFieldsApplyConfiguration {
+	b.Finally = &value
+	return b
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	selection "k8s.io/apimachinery/pkg/selection"
+)
+
+// WhenExpressionApplyConfiguration represents a declarative configuration of the WhenExpression type for use
+// with apply.
+type WhenExpressionApplyConfiguration struct {
+	Input    *string             `json:"input,omitempty"`
+	Operator *selection.Operator `json:"operator,omitempty"`
+	Values   []string            `json:"values,omitempty"`
+	CEL      *string             `json:"cel,omitempty"`
+}
+
+// WhenExpressionApplyConfiguration constructs a declarative configuration of the WhenExpression type for use with
+// apply.
+func WhenExpression() *WhenExpressionApplyConfiguration {
+	return &WhenExpressionApplyConfiguration{}
+}
+
+// WithInput sets the Input field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Input field is set to the value of the last call.
+func (b *WhenExpressionApplyConfiguration) WithInput(value string) *WhenExpressionApplyConfiguration {
+	b.Input = &value
+	return b
+}
+
+// WithOperator sets the Operator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Operator field is set to the value of the last call.
+func (b *WhenExpressionApplyConfiguration) WithOperator(value selection.Operator) *WhenExpressionApplyConfiguration {
+	b.Operator = &value
+	return b
+}
+
+// WithValues adds the given value to the Values field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Values field.
+func (b *WhenExpressionApplyConfiguration) WithValues(values ...string) *WhenExpressionApplyConfiguration {
+	for i := range values {
+		b.Values = append(b.Values, values[i])
+	}
+	return b
+}
+
+// WithCEL sets the CEL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CEL field is set to the value of the last call.
+func (b *WhenExpressionApplyConfiguration) WithCEL(value string) *WhenExpressionApplyConfiguration {
+	b.CEL = &value
+	return b
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// WorkspaceBindingApplyConfiguration represents a declarative configuration of the WorkspaceBinding type for use
+// with apply.
+type WorkspaceBindingApplyConfiguration struct {
+	Name                  *string                               `json:"name,omitempty"`
+	SubPath               *string                               `json:"subPath,omitempty"`
+	VolumeClaimTemplate   *v1.PersistentVolumeClaim             `json:"volumeClaimTemplate,omitempty"`
+	PersistentVolumeClaim *v1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	EmptyDir              *v1.EmptyDirVolumeSource              `json:"emptyDir,omitempty"`
+	ConfigMap             *v1.ConfigMapVolumeSource             `json:"configMap,omitempty"`
+	Secret                *v1.SecretVolumeSource                `json:"secret,omitempty"`
+	Projected             *v1.ProjectedVolumeSource             `json:"projected,omitempty"`
+	CSI                   *v1.CSIVolumeSource                   `json:"csi,omitempty"`
+}
+
+// WorkspaceBindingApplyConfiguration constructs a declarative configuration of the WorkspaceBinding type for use with
+// apply.
+func WorkspaceBinding() *WorkspaceBindingApplyConfiguration {
+	return &WorkspaceBindingApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithName(value string) *WorkspaceBindingApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSubPath sets the SubPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubPath field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithSubPath(value string) *WorkspaceBindingApplyConfiguration {
+	b.SubPath = &value
+	return b
+}
+
+// WithVolumeClaimTemplate sets the VolumeClaimTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeClaimTemplate field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithVolumeClaimTemplate(value v1.PersistentVolumeClaim) *WorkspaceBindingApplyConfiguration {
+	b.VolumeClaimTemplate = &value
+	return b
+}
+
+// WithPersistentVolumeClaim sets the PersistentVolumeClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaim field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithPersistentVolumeClaim(value v1.PersistentVolumeClaimVolumeSource) *WorkspaceBindingApplyConfiguration {
+	b.PersistentVolumeClaim = &value
+	return b
+}
+
+// WithEmptyDir sets the EmptyDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EmptyDir field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithEmptyDir(value v1.EmptyDirVolumeSource) *WorkspaceBindingApplyConfiguration {
+	b.EmptyDir = &value
+	return b
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithConfigMap(value v1.ConfigMapVolumeSource) *WorkspaceBindingApplyConfiguration {
+	b.ConfigMap = &value
+	return b
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithSecret(value v1.SecretVolumeSource) *WorkspaceBindingApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithProjected sets the Projected field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Projected field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithProjected(value v1.ProjectedVolumeSource) *WorkspaceBindingApplyConfiguration {
+	b.Projected = &value
+	return b
+}
+
+// WithCSI sets the CSI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CSI field is set to the value of the last call.
+func (b *WorkspaceBindingApplyConfiguration) WithCSI(value v1.CSIVolumeSource) *WorkspaceBindingApplyConfiguration {
+	b.CSI = &value
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// WorkspaceDeclarationApplyConfiguration represents a declarative configuration of the WorkspaceDeclaration type for use
+// with apply.
+type WorkspaceDeclarationApplyConfiguration struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	MountPath   *string `json:"mountPath,omitempty"`
+	ReadOnly    *bool   `json:"readOnly,omitempty"`
+	Optional    *bool   `json:"optional,omitempty"`
+}
+
+// WorkspaceDeclarationApplyConfiguration constructs a declarative configuration of the WorkspaceDeclaration type for use with
+// apply.
+func WorkspaceDeclaration() *WorkspaceDeclarationApplyConfiguration {
+	return &WorkspaceDeclarationApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *WorkspaceDeclarationApplyConfiguration) WithName(value string) *WorkspaceDeclarationApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *WorkspaceDeclarationApplyConfiguration) WithDescription(value string) *WorkspaceDeclarationApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithMountPath sets the MountPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MountPath field is set to the value of the last call.
+func (b *WorkspaceDeclarationApplyConfiguration) WithMountPath(value string) *WorkspaceDeclarationApplyConfiguration {
+	b.MountPath = &value
+	return b
+}
+
+// WithReadOnly sets the ReadOnly field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadOnly field is set to the value of the last call.
+func (b *WorkspaceDeclarationApplyConfiguration) WithReadOnly(value bool) *WorkspaceDeclarationApplyConfiguration {
+	b.ReadOnly = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *WorkspaceDeclarationApplyConfiguration) WithOptional(value bool) *WorkspaceDeclarationApplyConfiguration {
+	b.Optional = &value
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PipelineResourceSpecApplyConfiguration represents a declarative configuration of the PipelineResourceSpec type for use
+// with apply.
+type PipelineResourceSpecApplyConfiguration struct {
+	Description  *string                           `json:"description,omitempty"`
+	Type         *string                           `json:"type,omitempty"`
+	Params       []ResourceParamApplyConfiguration `json:"params,omitempty"`
+	SecretParams []SecretParamApplyConfiguration   `json:"secrets,omitempty"`
+}
+
+// PipelineResourceSpecApplyConfiguration constructs a declarative configuration of the PipelineResourceSpec type for use with
+// apply.
+func PipelineResourceSpec() *PipelineResourceSpecApplyConfiguration {
+	return &PipelineResourceSpecApplyConfiguration{}
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *PipelineResourceSpecApplyConfiguration) WithDescription(value string) *PipelineResourceSpecApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *PipelineResourceSpecApplyConfiguration) WithType(value string) *PipelineResourceSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithParams adds the given value to the Params field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Params field.
+func (b *PipelineResourceSpecApplyConfiguration) WithParams(values ...*ResourceParamApplyConfiguration) *PipelineResourceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithParams")
+		}
+		b.Params = append(b.Params, *values[i])
+	}
+	return b
+}
+
+// WithSecretParams adds the given value to the SecretParams field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SecretParams field.
+func (b *PipelineResourceSpecApplyConfiguration) WithSecretParams(values ...*SecretParamApplyConfiguration) *PipelineResourceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSecretParams")
+		}
+		b.SecretParams = append(b.SecretParams, *values[i])
+	}
+	return b
+}
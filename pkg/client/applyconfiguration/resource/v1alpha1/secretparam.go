@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// SecretParamApplyConfiguration represents a declarative configuration of the SecretParam type for use
+// with apply.
+type SecretParamApplyConfiguration struct {
+	FieldName  *string `json:"fieldName,omitempty"`
+	SecretKey  *string `json:"secretKey,omitempty"`
+	SecretName *string `json:"secretName,omitempty"`
+}
+
+// SecretParamApplyConfiguration constructs a declarative configuration of the SecretParam type for use with
+// apply.
+func SecretParam() *SecretParamApplyConfiguration {
+	return &SecretParamApplyConfiguration{}
+}
+
+// WithFieldName sets the FieldName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FieldName field is set to the value of the last call.
+func (b *SecretParamApplyConfiguration) WithFieldName(value string) *SecretParamApplyConfiguration {
+	b.FieldName = &value
+	return b
+}
+
+// WithSecretKey sets the SecretKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKey field is set to the value of the last call.
+func (b *SecretParamApplyConfiguration) WithSecretKey(value string) *SecretParamApplyConfiguration {
+	b.SecretKey = &value
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *SecretParamApplyConfiguration) WithSecretName(value string) *SecretParamApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	v1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1alpha1 "github.com/tektoncd/pipeline/pkg/apis/resource/v1alpha1"
+	duckv1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/duck/v1"
+	internal "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/internal"
+	applyconfigurationpipelinev1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1beta1"
+	resourcev1alpha1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/resource/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+	v1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=duck.knative.dev, Version=v1
+	case v1.SchemeGroupVersion.WithKind("Status"):
+		return &duckv1.StatusApplyConfiguration{}
+
+		// Group=tekton.dev, Version=v1
+	case pipelinev1.SchemeGroupVersion.WithKind("Artifact"):
+		return &applyconfigurationpipelinev1.ArtifactApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Artifacts"):
+		return &applyconfigurationpipelinev1.ArtifactsApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ArtifactValue"):
+		return &applyconfigurationpipelinev1.ArtifactValueApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ChildStatusReference"):
+		return &applyconfigurationpipelinev1.ChildStatusReferenceApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("EmbeddedTask"):
+		return &applyconfigurationpipelinev1.EmbeddedTaskApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("IncludeParams"):
+		return &applyconfigurationpipelinev1.IncludeParamsApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Matrix"):
+		return &applyconfigurationpipelinev1.MatrixApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Param"):
+		return &applyconfigurationpipelinev1.ParamApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ParamDeprecation"):
+		return &applyconfigurationpipelinev1.ParamDeprecationApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ParamSource"):
+		return &applyconfigurationpipelinev1.ParamSourceApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ParamSpec"):
+		return &applyconfigurationpipelinev1.ParamSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ParamValueSource"):
+		return &applyconfigurationpipelinev1.ParamValueSourceApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Pipeline"):
+		return &applyconfigurationpipelinev1.PipelineApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineRef"):
+		return &applyconfigurationpipelinev1.PipelineRefApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineResult"):
+		return &applyconfigurationpipelinev1.PipelineResultApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineRun"):
+		return &applyconfigurationpipelinev1.PipelineRunApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineRunResult"):
+		return &applyconfigurationpipelinev1.PipelineRunResultApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineRunSpec"):
+		return &applyconfigurationpipelinev1.PipelineRunSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineRunStatus"):
+		return &applyconfigurationpipelinev1.PipelineRunStatusApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineRunStatusFields"):
+		return &applyconfigurationpipelinev1.PipelineRunStatusFieldsApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineSpec"):
+		return &applyconfigurationpipelinev1.PipelineSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineTask"):
+		return &applyconfigurationpipelinev1.PipelineTaskApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineTaskMetadata"):
+		return &applyconfigurationpipelinev1.PipelineTaskMetadataApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineTaskRunSpec"):
+		return &applyconfigurationpipelinev1.PipelineTaskRunSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineTaskRunTemplate"):
+		return &applyconfigurationpipelinev1.PipelineTaskRunTemplateApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PipelineWorkspaceDeclaration"):
+		return &applyconfigurationpipelinev1.PipelineWorkspaceDeclarationApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("PropertySpec"):
+		return &applyconfigurationpipelinev1.PropertySpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Provenance"):
+		return &applyconfigurationpipelinev1.ProvenanceApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Ref"):
+		return &applyconfigurationpipelinev1.RefApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("RefSource"):
+		return &applyconfigurationpipelinev1.RefSourceApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("ResolverRef"):
+		return &applyconfigurationpipelinev1.ResolverRefApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Sidecar"):
+		return &applyconfigurationpipelinev1.SidecarApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("SidecarState"):
+		return &applyconfigurationpipelinev1.SidecarStateApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("SkippedTask"):
+		return &applyconfigurationpipelinev1.SkippedTaskApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Step"):
+		return &applyconfigurationpipelinev1.StepApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("StepOutputConfig"):
+		return &applyconfigurationpipelinev1.StepOutputConfigApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("StepResult"):
+		return &applyconfigurationpipelinev1.StepResultApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("StepState"):
+		return &applyconfigurationpipelinev1.StepStateApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("StepTemplate"):
+		return &applyconfigurationpipelinev1.StepTemplateApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("Task"):
+		return &applyconfigurationpipelinev1.TaskApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskBreakpoints"):
+		return &applyconfigurationpipelinev1.TaskBreakpointsApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRef"):
+		return &applyconfigurationpipelinev1.TaskRefApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskResult"):
+		return &applyconfigurationpipelinev1.TaskResultApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRun"):
+		return &applyconfigurationpipelinev1.TaskRunApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunDebug"):
+		return &applyconfigurationpipelinev1.TaskRunDebugApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunResult"):
+		return &applyconfigurationpipelinev1.TaskRunResultApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunSidecarSpec"):
+		return &applyconfigurationpipelinev1.TaskRunSidecarSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunSpec"):
+		return &applyconfigurationpipelinev1.TaskRunSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunStatus"):
+		return &applyconfigurationpipelinev1.TaskRunStatusApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunStatusFields"):
+		return &applyconfigurationpipelinev1.TaskRunStatusFieldsApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskRunStepSpec"):
+		return &applyconfigurationpipelinev1.TaskRunStepSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TaskSpec"):
+		return &applyconfigurationpipelinev1.TaskSpecApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("TimeoutFields"):
+		return &applyconfigurationpipelinev1.TimeoutFieldsApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("WhenExpression"):
+		return &applyconfigurationpipelinev1.WhenExpressionApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("WorkspaceBinding"):
+		return &applyconfigurationpipelinev1.WorkspaceBindingApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("WorkspaceDeclaration"):
+		return &applyconfigurationpipelinev1.WorkspaceDeclarationApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("WorkspacePipelineTaskBinding"):
+		return &applyconfigurationpipelinev1.WorkspacePipelineTaskBindingApplyConfiguration{}
+	case pipelinev1.SchemeGroupVersion.WithKind("WorkspaceUsage"):
+		return &applyconfigurationpipelinev1.WorkspaceUsageApplyConfiguration{}
+
+		// Group=tekton.dev, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("PipelineResource"):
+		return &resourcev1alpha1.PipelineResourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PipelineResourceSpec"):
+		return &resourcev1alpha1.PipelineResourceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResourceDeclaration"):
+		return &resourcev1alpha1.ResourceDeclarationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResourceParam"):
+		return &resourcev1alpha1.ResourceParamApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SecretParam"):
+		return &resourcev1alpha1.SecretParamApplyConfiguration{}
+
+		// Group=tekton.dev, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("Artifact"):
+		return &pipelinev1beta1.ArtifactApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ArtifactValue"):
+		return &pipelinev1beta1.ArtifactValueApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChildStatusReference"):
+		return &pipelinev1beta1.ChildStatusReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CloudEventDelivery"):
+		return &pipelinev1beta1.CloudEventDeliveryApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CloudEventDeliveryState"):
+		return &pipelinev1beta1.CloudEventDeliveryStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConfigSource"):
+		return &pipelinev1beta1.ConfigSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CustomRun"):
+		return &pipelinev1beta1.CustomRunApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CustomRunSpec"):
+		return &pipelinev1beta1.CustomRunSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("EmbeddedCustomRunSpec"):
+		return &pipelinev1beta1.EmbeddedCustomRunSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("EmbeddedTask"):
+		return &pipelinev1beta1.EmbeddedTaskApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("IncludeParams"):
+		return &pipelinev1beta1.IncludeParamsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Matrix"):
+		return &pipelinev1beta1.MatrixApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Param"):
+		return &pipelinev1beta1.ParamApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParamDeprecation"):
+		return &pipelinev1beta1.ParamDeprecationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParamSource"):
+		return &pipelinev1beta1.ParamSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParamSpec"):
+		return &pipelinev1beta1.ParamSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParamValueSource"):
+		return &pipelinev1beta1.ParamValueSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Pipeline"):
+		return &pipelinev1beta1.PipelineApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineDeclaredResource"):
+		return &pipelinev1beta1.PipelineDeclaredResourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRef"):
+		return &pipelinev1beta1.PipelineRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineResourceBinding"):
+		return &pipelinev1beta1.PipelineResourceBindingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineResourceRef"):
+		return &pipelinev1beta1.PipelineResourceRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineResult"):
+		return &pipelinev1beta1.PipelineResultApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRun"):
+		return &pipelinev1beta1.PipelineRunApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRunResult"):
+		return &pipelinev1beta1.PipelineRunResultApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRunRunStatus"):
+		return &pipelinev1beta1.PipelineRunRunStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRunSpec"):
+		return &pipelinev1beta1.PipelineRunSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRunStatus"):
+		return &pipelinev1beta1.PipelineRunStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRunStatusFields"):
+		return &pipelinev1beta1.PipelineRunStatusFieldsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineRunTaskRunStatus"):
+		return &pipelinev1beta1.PipelineRunTaskRunStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineSpec"):
+		return &pipelinev1beta1.PipelineSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineTask"):
+		return &pipelinev1beta1.PipelineTaskApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineTaskInputResource"):
+		return &pipelinev1beta1.PipelineTaskInputResourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineTaskMetadata"):
+		return &pipelinev1beta1.PipelineTaskMetadataApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineTaskOutputResource"):
+		return &pipelinev1beta1.PipelineTaskOutputResourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineTaskResources"):
+		return &pipelinev1beta1.PipelineTaskResourcesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineTaskRunSpec"):
+		return &pipelinev1beta1.PipelineTaskRunSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PipelineWorkspaceDeclaration"):
+		return &pipelinev1beta1.PipelineWorkspaceDeclarationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PropertySpec"):
+		return &pipelinev1beta1.PropertySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Provenance"):
+		return &pipelinev1beta1.ProvenanceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Ref"):
+		return &pipelinev1beta1.RefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RefSource"):
+		return &pipelinev1beta1.RefSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResolverRef"):
+		return &pipelinev1beta1.ResolverRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Sidecar"):
+		return &pipelinev1beta1.SidecarApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SidecarState"):
+		return &pipelinev1beta1.SidecarStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SkippedTask"):
+		return &pipelinev1beta1.SkippedTaskApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Step"):
+		return &pipelinev1beta1.StepApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("StepAction"):
+		return &pipelinev1beta1.StepActionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("StepActionSpec"):
+		return &pipelinev1beta1.StepActionSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("StepOutputConfig"):
+		return &pipelinev1beta1.StepOutputConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("StepState"):
+		return &pipelinev1beta1.StepStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("StepTemplate"):
+		return &pipelinev1beta1.StepTemplateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Task"):
+		return &pipelinev1beta1.TaskApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskBreakpoints"):
+		return &pipelinev1beta1.TaskBreakpointsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRef"):
+		return &pipelinev1beta1.TaskRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskResource"):
+		return &pipelinev1beta1.TaskResourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskResourceBinding"):
+		return &pipelinev1beta1.TaskResourceBindingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskResources"):
+		return &pipelinev1beta1.TaskResourcesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskResult"):
+		return &pipelinev1beta1.TaskResultApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRun"):
+		return &pipelinev1beta1.TaskRunApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunDebug"):
+		return &pipelinev1beta1.TaskRunDebugApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunResources"):
+		return &pipelinev1beta1.TaskRunResourcesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunResult"):
+		return &pipelinev1beta1.TaskRunResultApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunSidecarOverride"):
+		return &pipelinev1beta1.TaskRunSidecarOverrideApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunSpec"):
+		return &pipelinev1beta1.TaskRunSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunStatus"):
+		return &pipelinev1beta1.TaskRunStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunStatusFields"):
+		return &pipelinev1beta1.TaskRunStatusFieldsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskRunStepOverride"):
+		return &pipelinev1beta1.TaskRunStepOverrideApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskSpec"):
+		return &pipelinev1beta1.TaskSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TimeoutFields"):
+		return &pipelinev1beta1.TimeoutFieldsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WhenExpression"):
+		return &pipelinev1beta1.WhenExpressionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkspaceBinding"):
+		return &pipelinev1beta1.WorkspaceBindingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkspaceDeclaration"):
+		return &pipelinev1beta1.WorkspaceDeclarationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkspacePipelineTaskBinding"):
+		return &pipelinev1beta1.WorkspacePipelineTaskBindingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkspaceUsage"):
+		return &pipelinev1beta1.WorkspaceUsageApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) *testing.TypeConverter {
+	return &testing.TypeConverter{Scheme: scheme, TypeResolver: internal.Parser()}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	applyconfigurationv1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1"
+	typedpipelinev1 "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied PipelineRun.
+func (c *fakePipelineRuns) Apply(ctx context.Context, pipelineRun *applyconfigurationv1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1.PipelineRun, error) {
+	data, name, err := typedpipelinev1.MarshalPipelineRunApplyConfiguration(pipelineRun, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.ApplyPatchType, data, opts.ToPatchOptions())
+}
+
+// SetFinalizer adds the finalizer to the given PipelineRun, or removes it when present is false, and returns
+// the patched PipelineRun.
+func (c *fakePipelineRuns) SetFinalizer(ctx context.Context, pipelineRun *pipelinev1.PipelineRun, finalizer string, present bool, opts metav1.PatchOptions) (*pipelinev1.PipelineRun, error) {
+	data, err := typedpipelinev1.MarshalPipelineRunFinalizerPatch(pipelineRun, finalizer, present)
+	if err != nil || data == nil {
+		return pipelineRun, err
+	}
+	return c.Patch(ctx, pipelineRun.Name, types.MergePatchType, data, opts)
+}
@@ -20,8 +20,6 @@ package v1
 
 type PipelineExpansion interface{}
 
-type PipelineRunExpansion interface{}
-
 type TaskExpansion interface{}
 
 type TaskRunExpansion interface{}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	applyconfigurationv1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PipelineRunExpansion has the methods of PipelineRunInterface which are not generated by client-gen.
+type PipelineRunExpansion interface {
+	// Apply takes the given apply declarative configuration, applies it with server-side apply
+	// and returns the applied PipelineRun.
+	Apply(ctx context.Context, pipelineRun *applyconfigurationv1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1.PipelineRun, error)
+	// SetFinalizer adds the finalizer to the given PipelineRun, or removes it when present is false, and returns
+	// the patched PipelineRun.
+	SetFinalizer(ctx context.Context, pipelineRun *pipelinev1.PipelineRun, finalizer string, present bool, opts metav1.PatchOptions) (*pipelinev1.PipelineRun, error)
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied PipelineRun.
+func (c *pipelineRuns) Apply(ctx context.Context, pipelineRun *applyconfigurationv1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1.PipelineRun, error) {
+	data, name, err := MarshalPipelineRunApplyConfiguration(pipelineRun, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.ApplyPatchType, data, opts.ToPatchOptions())
+}
+
+// MarshalPipelineRunApplyConfiguration returns the server-side apply patch and the name of the
+// PipelineRun described by the given apply declarative configuration. Server-side apply requires
+// a field manager, so an error is returned if opts.FieldManager is empty.
+func MarshalPipelineRunApplyConfiguration(pipelineRun *applyconfigurationv1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) ([]byte, string, error) {
+	if opts.FieldManager == "" {
+		return nil, "", errors.New("opts.FieldManager must be provided to Apply")
+	}
+	if pipelineRun == nil {
+		return nil, "", errors.New("pipelineRun provided to Apply must not be nil")
+	}
+	name := pipelineRun.GetName()
+	if name == nil {
+		return nil, "", errors.New("pipelineRun.Name must be provided to Apply")
+	}
+	data, err := json.Marshal(pipelineRun)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, *name, nil
+}
+
+// SetFinalizer adds the finalizer to the given PipelineRun, or removes it when present is false, with a merge patch
+// conditioned by the resourceVersion of the PipelineRun. The PipelineRun is returned as is if it already has or
+// doesn't have the finalizer.
+func (c *pipelineRuns) SetFinalizer(ctx context.Context, pipelineRun *pipelinev1.PipelineRun, finalizer string, present bool, opts metav1.PatchOptions) (*pipelinev1.PipelineRun, error) {
+	data, err := MarshalPipelineRunFinalizerPatch(pipelineRun, finalizer, present)
+	if err != nil || data == nil {
+		return pipelineRun, err
+	}
+	return c.Patch(ctx, pipelineRun.Name, types.MergePatchType, data, opts)
+}
+
+// MarshalPipelineRunFinalizerPatch returns the merge patch adding the finalizer to the given PipelineRun, or
+// removing it when present is false, or nil if the PipelineRun already has or doesn't have the finalizer.
+func MarshalPipelineRunFinalizerPatch(pipelineRun *pipelinev1.PipelineRun, finalizer string, present bool) ([]byte, error) {
+	if pipelineRun == nil {
+		return nil, errors.New("pipelineRun provided to SetFinalizer must not be nil")
+	}
+	if slices.Contains(pipelineRun.Finalizers, finalizer) == present {
+		return nil, nil
+	}
+	finalizers := slices.DeleteFunc(slices.Clone(pipelineRun.Finalizers), func(f string) bool {
+		return f == finalizer
+	})
+	if present {
+		finalizers = append(finalizers, finalizer)
+	}
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      finalizers,
+			"resourceVersion": pipelineRun.ResourceVersion,
+		},
+	})
+}
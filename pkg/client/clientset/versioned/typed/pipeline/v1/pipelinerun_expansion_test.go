@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	applyconfigurationv1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+	typedpipelinev1 "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestMarshalPipelineRunApplyConfiguration(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		pipelineRun *applyconfigurationv1.PipelineRunApplyConfiguration
+		want        string
+	}{{
+		name:        "metadata only",
+		pipelineRun: applyconfigurationv1.PipelineRun("pr", "ns").WithLabels(map[string]string{"app": "foo"}),
+		want:        `{"kind":"PipelineRun","apiVersion":"tekton.dev/v1","metadata":{"name":"pr","namespace":"ns","labels":{"app":"foo"}}}`,
+	}, {
+		name: "only the set fields of the spec are applied",
+		pipelineRun: applyconfigurationv1.PipelineRun("pr", "ns").
+			WithSpec(applyconfigurationv1.PipelineRunSpec().
+				WithPipelineRef(applyconfigurationv1.PipelineRef().WithName("pipeline")).
+				WithTimeouts(applyconfigurationv1.TimeoutFields().WithPipeline(metav1.Duration{Duration: time.Hour}))),
+		want: `{"kind":"PipelineRun","apiVersion":"tekton.dev/v1","metadata":{"name":"pr","namespace":"ns"},"spec":{"pipelineRef":{"name":"pipeline"},"timeouts":{"pipeline":"1h0m0s"}}}`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, name, err := typedpipelinev1.MarshalPipelineRunApplyConfiguration(tc.pipelineRun, metav1.ApplyOptions{FieldManager: "test"})
+			if err != nil {
+				t.Fatalf("MarshalPipelineRunApplyConfiguration() = %v", err)
+			}
+			if name != "pr" {
+				t.Errorf("expected the name pr, got %q", name)
+			}
+			if d := cmp.Diff(tc.want, string(data)); d != "" {
+				t.Errorf("unexpected apply patch %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestMarshalPipelineRunApplyConfiguration_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		pipelineRun *applyconfigurationv1.PipelineRunApplyConfiguration
+		opts        metav1.ApplyOptions
+		wantErr     string
+	}{{
+		name:        "no field manager",
+		pipelineRun: applyconfigurationv1.PipelineRun("pr", "ns"),
+		wantErr:     "opts.FieldManager must be provided to Apply",
+	}, {
+		name:    "nil apply configuration",
+		opts:    metav1.ApplyOptions{FieldManager: "test"},
+		wantErr: "pipelineRun provided to Apply must not be nil",
+	}, {
+		name:        "no name",
+		pipelineRun: &applyconfigurationv1.PipelineRunApplyConfiguration{},
+		opts:        metav1.ApplyOptions{FieldManager: "test"},
+		wantErr:     "pipelineRun.Name must be provided to Apply",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := typedpipelinev1.MarshalPipelineRunApplyConfiguration(tc.pipelineRun, tc.opts)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("expected the error %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPipelineRunApply(t *testing.T) {
+	var gotMethod, gotPath, gotContentType, gotFieldManager, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotMethod, gotPath, gotBody = r.Method, r.URL.Path, string(body)
+		gotContentType = r.Header.Get("Content-Type")
+		gotFieldManager = r.URL.Query().Get("fieldManager")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"PipelineRun","apiVersion":"tekton.dev/v1","metadata":{"name":"pr","namespace":"ns","labels":{"app":"foo"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := typedpipelinev1.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("NewForConfig() = %v", err)
+	}
+	pr, err := client.PipelineRuns("ns").Apply(context.Background(),
+		applyconfigurationv1.PipelineRun("pr", "ns").WithLabels(map[string]string{"app": "foo"}),
+		metav1.ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatalf("Apply() = %v", err)
+	}
+	if pr.Name != "pr" || pr.Labels["app"] != "foo" {
+		t.Errorf("unexpected applied PipelineRun %v", pr)
+	}
+	if gotMethod != http.MethodPatch || gotPath != "/apis/tekton.dev/v1/namespaces/ns/pipelineruns/pr" {
+		t.Errorf("expected a PATCH of the PipelineRun, got %s %s", gotMethod, gotPath)
+	}
+	if gotContentType != string(types.ApplyPatchType) {
+		t.Errorf("expected the content type %s, got %s", types.ApplyPatchType, gotContentType)
+	}
+	if gotFieldManager != "test" {
+		t.Errorf("expected the field manager test, got %q", gotFieldManager)
+	}
+	if want := `{"kind":"PipelineRun","apiVersion":"tekton.dev/v1","metadata":{"name":"pr","namespace":"ns","labels":{"app":"foo"}}}`; gotBody != want {
+		t.Errorf("unexpected apply patch %s", diff.PrintWantGot(cmp.Diff(want, gotBody)))
+	}
+}
+
+func TestFakePipelineRunApply(t *testing.T) {
+	existing := &pipelinev1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "ns"},
+		Spec: pipelinev1.PipelineRunSpec{
+			PipelineRef: &pipelinev1.PipelineRef{Name: "pipeline"},
+			Timeouts:    &pipelinev1.TimeoutFields{Pipeline: &metav1.Duration{Duration: time.Hour}},
+		},
+	}
+	client := fake.NewSimpleClientset(existing)
+
+	pr, err := client.TektonV1().PipelineRuns("ns").Apply(context.Background(),
+		applyconfigurationv1.PipelineRun("pr", "ns").WithLabels(map[string]string{"app": "foo"}),
+		metav1.ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatalf("Apply() = %v", err)
+	}
+	if d := cmp.Diff(map[string]string{"app": "foo"}, pr.Labels); d != "" {
+		t.Errorf("unexpected labels %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(existing.Spec, pr.Spec); d != "" {
+		t.Errorf("the fields which aren't in the apply configuration must be kept %s", diff.PrintWantGot(d))
+	}
+
+	var patches []k8stesting.PatchAction
+	for _, action := range client.Actions() {
+		if patch, ok := action.(k8stesting.PatchAction); ok {
+			patches = append(patches, patch)
+		}
+	}
+	if len(patches) != 1 || patches[0].GetPatchType() != types.ApplyPatchType {
+		t.Errorf("expected one apply patch, got %v", patches)
+	}
+
+	if _, err := client.TektonV1().PipelineRuns("ns").Apply(context.Background(), applyconfigurationv1.PipelineRun("pr", "ns"), metav1.ApplyOptions{}); err == nil {
+		t.Error("expected Apply to fail without a field manager")
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	applyconfigurationv1beta1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1beta1"
+	typedpipelinev1beta1 "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied PipelineRun.
+func (c *fakePipelineRuns) Apply(ctx context.Context, pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1beta1.PipelineRun, error) {
+	data, name, err := typedpipelinev1beta1.MarshalPipelineRunApplyConfiguration(pipelineRun, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.ApplyPatchType, data, opts.ToPatchOptions())
+}
+
+// ApplyStatus takes the given apply declarative configuration, applies its status and returns the applied PipelineRun.
+func (c *fakePipelineRuns) ApplyStatus(ctx context.Context, pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1beta1.PipelineRun, error) {
+	data, name, err := typedpipelinev1beta1.MarshalPipelineRunApplyConfiguration(pipelineRun, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status")
+}
+
+// BulkCreate creates the given PipelineRuns concurrently and returns the created PipelineRuns and the errors.
+func (c *fakePipelineRuns) BulkCreate(ctx context.Context, pipelineRuns []*pipelinev1beta1.PipelineRun, concurrency int, opts metav1.CreateOptions) ([]*pipelinev1beta1.PipelineRun, []error) {
+	return typedpipelinev1beta1.BulkCreatePipelineRuns(ctx, c.Create, pipelineRuns, concurrency, opts)
+}
+
+// PaginatedList lists the PipelineRuns by pages and calls visitor for each page.
+func (c *fakePipelineRuns) PaginatedList(ctx context.Context, opts metav1.ListOptions, pageSize int64, visitor func(*pipelinev1beta1.PipelineRunList) error) error {
+	return typedpipelinev1beta1.PaginatePipelineRuns(ctx, c.List, opts, pageSize, visitor)
+}
@@ -22,8 +22,6 @@ type CustomRunExpansion interface{}
 
 type PipelineExpansion interface{}
 
-type PipelineRunExpansion interface{}
-
 type StepActionExpansion interface{}
 
 type TaskExpansion interface{}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	applyconfigurationv1beta1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PipelineRunExpansion has the methods of PipelineRunInterface which are not generated by client-gen.
+type PipelineRunExpansion interface {
+	// Apply takes the given apply declarative configuration, applies it with server-side apply
+	// and returns the applied PipelineRun.
+	Apply(ctx context.Context, pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1beta1.PipelineRun, error)
+	// ApplyStatus is like Apply but applies the status of the given apply declarative configuration
+	// through the status subresource.
+	ApplyStatus(ctx context.Context, pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1beta1.PipelineRun, error)
+	// BulkCreate creates the given PipelineRuns with at most concurrency requests at the same time and returns
+	// the created PipelineRuns and the errors, both indexed as the given PipelineRuns. See BulkCreatePipelineRuns.
+	BulkCreate(ctx context.Context, pipelineRuns []*pipelinev1beta1.PipelineRun, concurrency int, opts metav1.CreateOptions) ([]*pipelinev1beta1.PipelineRun, []error)
+	// PaginatedList lists the PipelineRuns matching opts by pages of at most pageSize PipelineRuns, following
+	// the continue tokens, and calls visitor for each page. See PaginatePipelineRuns.
+	PaginatedList(ctx context.Context, opts metav1.ListOptions, pageSize int64, visitor func(*pipelinev1beta1.PipelineRunList) error) error
+}
+
+// DefaultBulkCreateConcurrency is the maximum number of PipelineRuns created at the same time by BulkCreate
+// when the given concurrency is lower than 1.
+const DefaultBulkCreateConcurrency = 10
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied PipelineRun.
+func (c *pipelineRuns) Apply(ctx context.Context, pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1beta1.PipelineRun, error) {
+	data, name, err := MarshalPipelineRunApplyConfiguration(pipelineRun, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.ApplyPatchType, data, opts.ToPatchOptions())
+}
+
+// ApplyStatus takes the given apply declarative configuration, applies its status and returns the applied PipelineRun.
+func (c *pipelineRuns) ApplyStatus(ctx context.Context, pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) (*pipelinev1beta1.PipelineRun, error) {
+	data, name, err := MarshalPipelineRunApplyConfiguration(pipelineRun, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status")
+}
+
+// MarshalPipelineRunApplyConfiguration returns the server-side apply patch and the name of the
+// PipelineRun described by the given apply declarative configuration. Server-side apply requires
+// a field manager, so an error is returned if opts.FieldManager is empty.
+func MarshalPipelineRunApplyConfiguration(pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration, opts metav1.ApplyOptions) ([]byte, string, error) {
+	if opts.FieldManager == "" {
+		return nil, "", errors.New("opts.FieldManager must be provided to Apply")
+	}
+	if pipelineRun == nil {
+		return nil, "", errors.New("pipelineRun provided to Apply must not be nil")
+	}
+	name := pipelineRun.GetName()
+	if name == nil {
+		return nil, "", errors.New("pipelineRun.Name must be provided to Apply")
+	}
+	data, err := json.Marshal(pipelineRun)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, *name, nil
+}
+
+// BulkCreate creates the given PipelineRuns concurrently and returns the created PipelineRuns and the errors.
+func (c *pipelineRuns) BulkCreate(ctx context.Context, pipelineRuns []*pipelinev1beta1.PipelineRun, concurrency int, opts metav1.CreateOptions) ([]*pipelinev1beta1.PipelineRun, []error) {
+	return BulkCreatePipelineRuns(ctx, c.Create, pipelineRuns, concurrency, opts)
+}
+
+// BulkCreatePipelineRuns creates the given PipelineRuns with create, with at most concurrency requests at the
+// same time, or DefaultBulkCreateConcurrency if concurrency is lower than 1. There is no batch API for
+// PipelineRuns, so the creation is not atomic: each PipelineRun is created with its own request, which is
+// retried as any other request of the clientset, and the failure to create one PipelineRun doesn't prevent
+// the others from being created.
+// The created PipelineRuns and the errors are indexed as the given PipelineRuns; the created PipelineRun is
+// nil when the error is not. The returned errors are nil if all the PipelineRuns were created. The PipelineRuns
+// which aren't created yet when the context is done fail with the error of the context.
+func BulkCreatePipelineRuns(ctx context.Context,
+	create func(context.Context, *pipelinev1beta1.PipelineRun, metav1.CreateOptions) (*pipelinev1beta1.PipelineRun, error),
+	pipelineRuns []*pipelinev1beta1.PipelineRun, concurrency int, opts metav1.CreateOptions) ([]*pipelinev1beta1.PipelineRun, []error) {
+	if concurrency < 1 {
+		concurrency = DefaultBulkCreateConcurrency
+	}
+	created := make([]*pipelinev1beta1.PipelineRun, len(pipelineRuns))
+	errs := make([]error, len(pipelineRuns))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, pr := range pipelineRuns {
+		// select picks randomly among the ready cases, so the context is checked first
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int, pr *pipelinev1beta1.PipelineRun) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// each goroutine only writes to its own index
+			created[i], errs[i] = create(ctx, pr, opts)
+			if errs[i] != nil {
+				created[i] = nil
+			}
+		}(i, pr)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return created, errs
+		}
+	}
+	return created, nil
+}
+
+// PaginatedList lists the PipelineRuns by pages and calls visitor for each page.
+func (c *pipelineRuns) PaginatedList(ctx context.Context, opts metav1.ListOptions, pageSize int64, visitor func(*pipelinev1beta1.PipelineRunList) error) error {
+	return PaginatePipelineRuns(ctx, c.List, opts, pageSize, visitor)
+}
+
+// PaginatePipelineRuns lists the PipelineRuns matching opts with list, by pages of at most pageSize PipelineRuns,
+// and calls visitor for each page until the last one, the one without a continue token. A pageSize lower than 1
+// keeps the limit of opts. The listing stops at the first error of list or visitor, which is returned; this
+// includes the expiry of the continue token, in which case the listing has to be started over.
+func PaginatePipelineRuns(ctx context.Context,
+	list func(context.Context, metav1.ListOptions) (*pipelinev1beta1.PipelineRunList, error),
+	opts metav1.ListOptions, pageSize int64, visitor func(*pipelinev1beta1.PipelineRunList) error) error {
+	if pageSize > 0 {
+		opts.Limit = pageSize
+	}
+	for {
+		page, err := list(ctx, opts)
+		if err != nil {
+			return err
+		}
+		if err := visitor(page); err != nil {
+			return err
+		}
+		if page.Continue == "" {
+			return nil
+		}
+		opts.Continue = page.Continue
+	}
+}
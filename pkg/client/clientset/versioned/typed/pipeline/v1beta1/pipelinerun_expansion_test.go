@@ -0,0 +1,522 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	applyconfigurationv1beta1 "github.com/tektoncd/pipeline/pkg/client/applyconfiguration/pipeline/v1beta1"
+	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+	typedpipelinev1beta1 "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1beta1"
+	"github.com/tektoncd/pipeline/test/diff"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func pipelineRun(name string) *pipelinev1beta1.PipelineRun {
+	return &pipelinev1beta1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+}
+
+func TestMarshalPipelineRunApplyConfiguration(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	pipelineRun := applyconfigurationv1beta1.PipelineRun("pr", "ns").
+		WithSpec(applyconfigurationv1beta1.PipelineRunSpec().
+			WithPipelineRef(applyconfigurationv1beta1.PipelineRef().WithName("pipeline")).
+			WithParams(pipelinev1beta1.Params{{Name: "foo", Value: *pipelinev1beta1.NewStructuredValues("bar")}}).
+			WithTaskRunSpecs(applyconfigurationv1beta1.PipelineTaskRunSpec().
+				WithPipelineTaskName("build").
+				WithTaskServiceAccountName("builder").
+				WithMetadata(applyconfigurationv1beta1.PipelineTaskMetadata().WithLabels(map[string]string{"app": "foo"})))).
+		WithStatus(applyconfigurationv1beta1.PipelineRunStatus().
+			WithConditions(duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: "Unknown", Reason: "Running"}}).
+			WithStartTime(startTime).
+			WithChildReferences(applyconfigurationv1beta1.ChildStatusReference().
+				WithName("pr-build").
+				WithPipelineTaskName("build")))
+
+	data, name, err := typedpipelinev1beta1.MarshalPipelineRunApplyConfiguration(pipelineRun, metav1.ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatalf("MarshalPipelineRunApplyConfiguration() = %v", err)
+	}
+	if name != "pr" {
+		t.Errorf("expected the name pr, got %q", name)
+	}
+
+	// only the fields set in the nested apply configurations are part of the patch
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Failed to unmarshal the apply patch: %v", err)
+	}
+	for field, want := range map[string][]string{
+		"spec":   {"params", "pipelineRef", "taskRunSpecs"},
+		"status": {"childReferences", "conditions", "startTime"},
+	} {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw[field], &object); err != nil {
+			t.Fatalf("Failed to unmarshal the %s of the apply patch: %v", field, err)
+		}
+		var got []string
+		for key := range object {
+			got = append(got, key)
+		}
+		sort.Strings(got)
+		if d := cmp.Diff(want, got); d != "" {
+			t.Errorf("unexpected fields in the %s of the apply patch %s", field, diff.PrintWantGot(d))
+		}
+	}
+
+	var got pipelinev1beta1.PipelineRun
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Failed to unmarshal the apply patch: %v", err)
+	}
+	want := pipelinev1beta1.PipelineRun{
+		TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1beta1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "ns"},
+		Spec: pipelinev1beta1.PipelineRunSpec{
+			PipelineRef: &pipelinev1beta1.PipelineRef{Name: "pipeline"},
+			Params:      pipelinev1beta1.Params{{Name: "foo", Value: *pipelinev1beta1.NewStructuredValues("bar")}},
+			TaskRunSpecs: []pipelinev1beta1.PipelineTaskRunSpec{{
+				PipelineTaskName:       "build",
+				TaskServiceAccountName: "builder",
+				Metadata:               &pipelinev1beta1.PipelineTaskMetadata{Labels: map[string]string{"app": "foo"}},
+			}},
+		},
+		Status: pipelinev1beta1.PipelineRunStatus{
+			Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: "Unknown", Reason: "Running"}}},
+			PipelineRunStatusFields: pipelinev1beta1.PipelineRunStatusFields{
+				StartTime:       &startTime,
+				ChildReferences: []pipelinev1beta1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+			},
+		},
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("unexpected apply patch %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestMarshalPipelineRunApplyConfiguration_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		pipelineRun *applyconfigurationv1beta1.PipelineRunApplyConfiguration
+		opts        metav1.ApplyOptions
+		wantErr     string
+	}{{
+		name:        "no field manager",
+		pipelineRun: applyconfigurationv1beta1.PipelineRun("pr", "ns"),
+		wantErr:     "opts.FieldManager must be provided to Apply",
+	}, {
+		name:    "nil apply configuration",
+		opts:    metav1.ApplyOptions{FieldManager: "test"},
+		wantErr: "pipelineRun provided to Apply must not be nil",
+	}, {
+		name:        "no name",
+		pipelineRun: &applyconfigurationv1beta1.PipelineRunApplyConfiguration{},
+		opts:        metav1.ApplyOptions{FieldManager: "test"},
+		wantErr:     "pipelineRun.Name must be provided to Apply",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := typedpipelinev1beta1.MarshalPipelineRunApplyConfiguration(tc.pipelineRun, tc.opts)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("expected the error %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPipelineRunApply(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		apply    func(typedpipelinev1beta1.PipelineRunInterface, *applyconfigurationv1beta1.PipelineRunApplyConfiguration) (*pipelinev1beta1.PipelineRun, error)
+		wantPath string
+	}{{
+		name: "apply",
+		apply: func(c typedpipelinev1beta1.PipelineRunInterface, pr *applyconfigurationv1beta1.PipelineRunApplyConfiguration) (*pipelinev1beta1.PipelineRun, error) {
+			return c.Apply(context.Background(), pr, metav1.ApplyOptions{FieldManager: "test", Force: true})
+		},
+		wantPath: "/apis/tekton.dev/v1beta1/namespaces/ns/pipelineruns/pr",
+	}, {
+		name: "apply status",
+		apply: func(c typedpipelinev1beta1.PipelineRunInterface, pr *applyconfigurationv1beta1.PipelineRunApplyConfiguration) (*pipelinev1beta1.PipelineRun, error) {
+			return c.ApplyStatus(context.Background(), pr, metav1.ApplyOptions{FieldManager: "test", Force: true})
+		},
+		wantPath: "/apis/tekton.dev/v1beta1/namespaces/ns/pipelineruns/pr/status",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotMethod, gotPath, gotContentType, gotFieldManager, gotForce string
+			var gotBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotBody, _ = io.ReadAll(r.Body)
+				gotMethod, gotPath = r.Method, r.URL.Path
+				gotContentType = r.Header.Get("Content-Type")
+				gotFieldManager, gotForce = r.URL.Query().Get("fieldManager"), r.URL.Query().Get("force")
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(gotBody)
+			}))
+			defer server.Close()
+			client, err := typedpipelinev1beta1.NewForConfig(&rest.Config{Host: server.URL})
+			if err != nil {
+				t.Fatalf("NewForConfig() = %v", err)
+			}
+
+			pr, err := tc.apply(client.PipelineRuns("ns"), applyconfigurationv1beta1.PipelineRun("pr", "ns").
+				WithStatus(applyconfigurationv1beta1.PipelineRunStatus().
+					WithConditions(duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: "True"}})))
+			if err != nil {
+				t.Fatalf("Apply() = %v", err)
+			}
+			if !pr.IsDone() {
+				t.Errorf("expected the applied PipelineRun to be done, got %v", pr.Status)
+			}
+			if gotMethod != http.MethodPatch || gotPath != tc.wantPath {
+				t.Errorf("expected a PATCH of %s, got %s %s", tc.wantPath, gotMethod, gotPath)
+			}
+			if gotContentType != string(types.ApplyPatchType) || gotFieldManager != "test" || gotForce != "true" {
+				t.Errorf("expected a forced apply patch of the field manager test, got the content type %s, the field manager %q and force %q",
+					gotContentType, gotFieldManager, gotForce)
+			}
+		})
+	}
+}
+
+func TestFakePipelineRunApplyStatus(t *testing.T) {
+	existing := pipelineRun("pr")
+	existing.Spec.PipelineRef = &pipelinev1beta1.PipelineRef{Name: "pipeline"}
+	c := fake.NewSimpleClientset(existing)
+
+	pr, err := c.TektonV1beta1().PipelineRuns("ns").ApplyStatus(context.Background(),
+		applyconfigurationv1beta1.PipelineRun("pr", "ns").
+			WithStatus(applyconfigurationv1beta1.PipelineRunStatus().
+				WithConditions(duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: "True"}})),
+		metav1.ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatalf("ApplyStatus() = %v", err)
+	}
+	if !pr.IsDone() {
+		t.Errorf("expected the applied status, got %v", pr.Status)
+	}
+	if d := cmp.Diff(existing.Spec, pr.Spec); d != "" {
+		t.Errorf("the spec must be kept %s", diff.PrintWantGot(d))
+	}
+	var patches []k8stesting.PatchAction
+	for _, action := range c.Actions() {
+		if patch, ok := action.(k8stesting.PatchAction); ok {
+			patches = append(patches, patch)
+		}
+	}
+	if len(patches) != 1 || patches[0].GetPatchType() != types.ApplyPatchType || patches[0].GetSubresource() != "status" {
+		t.Errorf("expected one apply patch of the status subresource, got %v", patches)
+	}
+}
+
+func TestBulkCreate(t *testing.T) {
+	errRejected := errors.New("admission webhook denied the request")
+	c := fake.NewSimpleClientset(pipelineRun("existing"))
+	c.PrependReactor("create", "pipelineruns", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.CreateAction).GetObject().(*pipelinev1beta1.PipelineRun).Name == "rejected" {
+			return true, nil, errRejected
+		}
+		return false, nil, nil
+	})
+
+	created, errs := c.TektonV1beta1().PipelineRuns("ns").BulkCreate(context.Background(), []*pipelinev1beta1.PipelineRun{
+		pipelineRun("a"), pipelineRun("existing"), pipelineRun("b"), pipelineRun("rejected"), pipelineRun("c"),
+	}, 2, metav1.CreateOptions{})
+
+	if len(created) != 5 || len(errs) != 5 {
+		t.Fatalf("expected the results to be indexed as the 5 PipelineRuns, got %d PipelineRuns and %d errors", len(created), len(errs))
+	}
+	for i, name := range map[int]string{0: "a", 2: "b", 4: "c"} {
+		if errs[i] != nil {
+			t.Errorf("expected PipelineRun %s to be created, got %v", name, errs[i])
+		}
+		if created[i] == nil || created[i].Name != name {
+			t.Errorf("expected the created PipelineRun %s at %d, got %v", name, i, created[i])
+		}
+	}
+	if !apierrors.IsAlreadyExists(errs[1]) {
+		t.Errorf("expected an AlreadyExists error for the existing PipelineRun, got %v", errs[1])
+	}
+	if !errors.Is(errs[3], errRejected) {
+		t.Errorf("expected the error of the rejected PipelineRun, got %v", errs[3])
+	}
+	for _, i := range []int{1, 3} {
+		if created[i] != nil {
+			t.Errorf("expected no created PipelineRun %d, got %v", i, created[i])
+		}
+	}
+
+	// the failures don't prevent the other PipelineRuns from being created
+	list, err := c.TektonV1beta1().PipelineRuns("ns").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() = %v", err)
+	}
+	var names []string
+	for _, pr := range list.Items {
+		names = append(names, pr.Name)
+	}
+	if d := cmp.Diff([]string{"a", "b", "c", "existing"}, names); d != "" {
+		t.Errorf("unexpected PipelineRuns %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestBulkCreate_NoErrors(t *testing.T) {
+	c := fake.NewSimpleClientset()
+	created, errs := c.TektonV1beta1().PipelineRuns("ns").BulkCreate(context.Background(), []*pipelinev1beta1.PipelineRun{
+		pipelineRun("a"), pipelineRun("b"),
+	}, 0, metav1.CreateOptions{})
+	if errs != nil {
+		t.Errorf("expected nil errors when all the PipelineRuns are created, got %v", errs)
+	}
+	if len(created) != 2 || created[0].Name != "a" || created[1].Name != "b" {
+		t.Errorf("unexpected created PipelineRuns %v", created)
+	}
+}
+
+func TestBulkCreatePipelineRuns_Concurrency(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		concurrency int
+		want        int32
+	}{{
+		name:        "sequential",
+		concurrency: 1,
+		want:        1,
+	}, {
+		name:        "limited",
+		concurrency: 3,
+		want:        3,
+	}, {
+		name:        "default",
+		concurrency: 0,
+		want:        typedpipelinev1beta1.DefaultBulkCreateConcurrency,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			var inFlight, maxInFlight atomic.Int32
+			create := func(ctx context.Context, pr *pipelinev1beta1.PipelineRun, opts metav1.CreateOptions) (*pipelinev1beta1.PipelineRun, error) {
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					m := maxInFlight.Load()
+					if n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return pr, nil
+			}
+			pipelineRuns := make([]*pipelinev1beta1.PipelineRun, 2*typedpipelinev1beta1.DefaultBulkCreateConcurrency)
+			for i := range pipelineRuns {
+				pipelineRuns[i] = pipelineRun("pr")
+			}
+			if _, errs := typedpipelinev1beta1.BulkCreatePipelineRuns(context.Background(), create, pipelineRuns, tc.concurrency, metav1.CreateOptions{}); errs != nil {
+				t.Fatalf("BulkCreatePipelineRuns() = %v", errs)
+			}
+			if got := maxInFlight.Load(); got > tc.want || got < 1 {
+				t.Errorf("expected at most %d concurrent creations, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBulkCreatePipelineRuns_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls atomic.Int32
+	create := func(ctx context.Context, pr *pipelinev1beta1.PipelineRun, opts metav1.CreateOptions) (*pipelinev1beta1.PipelineRun, error) {
+		calls.Add(1)
+		return pr, nil
+	}
+	created, errs := typedpipelinev1beta1.BulkCreatePipelineRuns(ctx, create, []*pipelinev1beta1.PipelineRun{pipelineRun("a"), pipelineRun("b")}, 1, metav1.CreateOptions{})
+	if calls.Load() != 0 {
+		t.Errorf("expected no creation once the context is done, got %d", calls.Load())
+	}
+	if len(errs) != 2 || !errors.Is(errs[0], context.Canceled) || !errors.Is(errs[1], context.Canceled) || created[0] != nil || created[1] != nil {
+		t.Errorf("expected the PipelineRuns to fail with the error of the context, got %v", errs)
+	}
+}
+
+// pagedPipelineRuns returns a fake clientset serving the PipelineRuns a, b, c, d and e by pages of at most the
+// limit of the list request, with the continue token of a page being the name of the next PipelineRun, and
+// the recorder of the options of the list requests.
+func pagedPipelineRuns(listErr error) (*fake.Clientset, *[]metav1.ListOptions) {
+	names := []string{"a", "b", "c", "d", "e"}
+	var requests []metav1.ListOptions
+	c := fake.NewSimpleClientset()
+	c.PrependReactor("list", "pipelineruns", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		opts := action.(k8stesting.ListActionImpl).GetListOptions()
+		requests = append(requests, opts)
+		if listErr != nil && opts.Continue != "" {
+			return true, nil, listErr
+		}
+		start := 0
+		for i, name := range names {
+			if name == opts.Continue {
+				start = i
+			}
+		}
+		end := len(names)
+		if opts.Limit > 0 && start+int(opts.Limit) < end {
+			end = start + int(opts.Limit)
+		}
+		list := &pipelinev1beta1.PipelineRunList{}
+		if end < len(names) {
+			list.Continue = names[end]
+		}
+		for _, name := range names[start:end] {
+			pr := pipelineRun(name)
+			pr.Labels = map[string]string{"app": "foo"}
+			list.Items = append(list.Items, *pr)
+		}
+		return true, list, nil
+	})
+	return c, &requests
+}
+
+func TestPaginatedList(t *testing.T) {
+	type request struct {
+		Limit    int64
+		Continue string
+	}
+	for _, tc := range []struct {
+		name         string
+		limit        int64
+		pageSize     int64
+		wantPages    [][]string
+		wantRequests []request
+	}{{
+		name:         "the continue tokens are followed until the last page",
+		pageSize:     2,
+		wantPages:    [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		wantRequests: []request{{Limit: 2}, {Limit: 2, Continue: "c"}, {Limit: 2, Continue: "e"}},
+	}, {
+		name:         "the page size overrides the limit of the options",
+		limit:        1,
+		pageSize:     3,
+		wantPages:    [][]string{{"a", "b", "c"}, {"d", "e"}},
+		wantRequests: []request{{Limit: 3}, {Limit: 3, Continue: "d"}},
+	}, {
+		name:         "a page size of exactly the number of PipelineRuns",
+		pageSize:     5,
+		wantPages:    [][]string{{"a", "b", "c", "d", "e"}},
+		wantRequests: []request{{Limit: 5}},
+	}, {
+		name:         "a page size of 0 keeps the limit of the options",
+		limit:        4,
+		wantPages:    [][]string{{"a", "b", "c", "d"}, {"e"}},
+		wantRequests: []request{{Limit: 4}, {Limit: 4, Continue: "e"}},
+	}, {
+		name:         "a negative page size keeps the limit of the options",
+		limit:        4,
+		pageSize:     -1,
+		wantPages:    [][]string{{"a", "b", "c", "d"}, {"e"}},
+		wantRequests: []request{{Limit: 4}, {Limit: 4, Continue: "e"}},
+	}, {
+		name:         "no page size nor limit lists everything at once",
+		wantPages:    [][]string{{"a", "b", "c", "d", "e"}},
+		wantRequests: []request{{}},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, requests := pagedPipelineRuns(nil)
+			var pages [][]string
+			err := c.TektonV1beta1().PipelineRuns("ns").PaginatedList(context.Background(),
+				metav1.ListOptions{LabelSelector: "app=foo", Limit: tc.limit}, tc.pageSize,
+				func(page *pipelinev1beta1.PipelineRunList) error {
+					var names []string
+					for _, pr := range page.Items {
+						names = append(names, pr.Name)
+					}
+					pages = append(pages, names)
+					return nil
+				})
+			if err != nil {
+				t.Fatalf("PaginatedList() = %v", err)
+			}
+			if d := cmp.Diff(tc.wantPages, pages); d != "" {
+				t.Errorf("unexpected pages %s", diff.PrintWantGot(d))
+			}
+			var gotRequests []request
+			for _, opts := range *requests {
+				if opts.LabelSelector != "app=foo" {
+					t.Errorf("expected the label selector to be kept, got %q", opts.LabelSelector)
+				}
+				gotRequests = append(gotRequests, request{Limit: opts.Limit, Continue: opts.Continue})
+			}
+			if d := cmp.Diff(tc.wantRequests, gotRequests); d != "" {
+				t.Errorf("unexpected list requests %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestPaginatedList_Errors(t *testing.T) {
+	errVisitor := errors.New("visitor failed")
+	for _, tc := range []struct {
+		name         string
+		listErr      error
+		visitorErr   error
+		wantErr      error
+		wantRequests int
+		wantPages    int
+	}{{
+		name:         "the error of the visitor stops the listing",
+		visitorErr:   errVisitor,
+		wantErr:      errVisitor,
+		wantRequests: 1,
+		wantPages:    1,
+	}, {
+		name:         "the expiry of the continue token is returned",
+		listErr:      apierrors.NewResourceExpired("the continue token is too old"),
+		wantRequests: 2,
+		wantPages:    1,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, requests := pagedPipelineRuns(tc.listErr)
+			pages := 0
+			err := c.TektonV1beta1().PipelineRuns("ns").PaginatedList(context.Background(), metav1.ListOptions{}, 2,
+				func(*pipelinev1beta1.PipelineRunList) error {
+					pages++
+					return tc.visitorErr
+				})
+			switch {
+			case tc.wantErr != nil && !errors.Is(err, tc.wantErr):
+				t.Errorf("expected the error %v, got %v", tc.wantErr, err)
+			case tc.listErr != nil && !apierrors.IsResourceExpired(err):
+				t.Errorf("expected the expiry of the continue token, got %v", err)
+			}
+			if len(*requests) != tc.wantRequests || pages != tc.wantPages {
+				t.Errorf("expected %d list requests and %d pages, got %d and %d", tc.wantRequests, tc.wantPages, len(*requests), pages)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package watcher provides helpers to react to changes of Tekton resources without polling.
+package watcher
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	typedv1beta1 "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// ChangeType describes how a PipelineRun changed.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded is the ChangeType of a PipelineRun seen for the first time.
+	ChangeTypeAdded ChangeType = "Added"
+	// ChangeTypeStatusChanged is the ChangeType of a PipelineRun whose status changed.
+	ChangeTypeStatusChanged ChangeType = "StatusChanged"
+	// ChangeTypeDeleted is the ChangeType of a deleted PipelineRun.
+	ChangeTypeDeleted ChangeType = "Deleted"
+)
+
+// PipelineRunStatusEvent is emitted by a PipelineRunWatcher when a PipelineRun changes.
+// Old is nil for ChangeTypeAdded and New is nil for ChangeTypeDeleted.
+type PipelineRunStatusEvent struct {
+	Old        *v1beta1.PipelineRun
+	New        *v1beta1.PipelineRun
+	ChangeType ChangeType
+}
+
+// defaultBackoff is the backoff between attempts to re-establish a watch which ended or failed.
+var defaultBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    math.MaxInt32,
+	Cap:      30 * time.Second,
+}
+
+// PipelineRunWatcher watches the PipelineRuns of a namespace and emits a PipelineRunStatusEvent whenever
+// one is added, deleted or its status changes. Changes of the metadata or spec only are not emitted.
+// The watch is re-established with an exponential backoff when it times out or fails. The PipelineRuns are
+// listed when the watcher starts and whenever the resource version to resume from expired, and the PipelineRuns
+// deleted in the meantime are emitted as deleted.
+type PipelineRunWatcher struct {
+	client        typedv1beta1.PipelineRunsGetter
+	namespace     string
+	labelSelector string
+	backoff       wait.Backoff
+}
+
+// NewPipelineRunWatcher returns a PipelineRunWatcher for the PipelineRuns in the namespace matching the
+// label selector. An empty namespace watches all namespaces and an empty label selector matches all
+// PipelineRuns.
+func NewPipelineRunWatcher(client typedv1beta1.PipelineRunsGetter, namespace, labelSelector string) *PipelineRunWatcher {
+	return &PipelineRunWatcher{
+		client:        client,
+		namespace:     namespace,
+		labelSelector: labelSelector,
+		backoff:       defaultBackoff,
+	}
+}
+
+// Run starts watching and returns the channel the events are emitted on. The channel is closed once the
+// context is done.
+func (w *PipelineRunWatcher) Run(ctx context.Context) <-chan PipelineRunStatusEvent {
+	events := make(chan PipelineRunStatusEvent)
+	go func() {
+		defer close(events)
+		w.run(ctx, events)
+	}()
+	return events
+}
+
+func (w *PipelineRunWatcher) run(ctx context.Context, events chan<- PipelineRunStatusEvent) {
+	// known holds the last seen version of each PipelineRun, keyed by namespace/name
+	known := map[string]*v1beta1.PipelineRun{}
+	backoff := w.backoff
+	resourceVersion := ""
+	for {
+		var err error
+		if resourceVersion == "" {
+			resourceVersion, err = w.relist(ctx, known, events)
+		}
+		if err == nil {
+			var wi watch.Interface
+			wi, err = w.client.PipelineRuns(w.namespace).Watch(ctx, metav1.ListOptions{
+				LabelSelector:       w.labelSelector,
+				ResourceVersion:     resourceVersion,
+				AllowWatchBookmarks: true,
+			})
+			if err == nil {
+				var received bool
+				resourceVersion, received = w.consume(ctx, wi, known, resourceVersion, events)
+				if received {
+					backoff = w.backoff
+				}
+			} else if isExpired(err) {
+				resourceVersion = ""
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff.Step()):
+		}
+	}
+}
+
+// relist lists the PipelineRuns to synchronize the known PipelineRuns with the current state, emits the
+// events of the PipelineRuns which were added, changed or deleted since they were last seen and returns
+// the resource version to watch from.
+func (w *PipelineRunWatcher) relist(ctx context.Context, known map[string]*v1beta1.PipelineRun, events chan<- PipelineRunStatusEvent) (string, error) {
+	list, err := w.client.PipelineRuns(w.namespace).List(ctx, metav1.ListOptions{LabelSelector: w.labelSelector})
+	if err != nil {
+		return "", err
+	}
+	var statusEvents []PipelineRunStatusEvent
+	listed := map[string]bool{}
+	for i := range list.Items {
+		pr := &list.Items[i]
+		listed[pr.Namespace+"/"+pr.Name] = true
+		if statusEvent, emit := toStatusEvent(watch.Modified, pr, known); emit {
+			statusEvents = append(statusEvents, statusEvent)
+		}
+	}
+	// the PipelineRuns deleted while not watching are only noticed by their absence from the list
+	var deleted []string
+	for key := range known {
+		if !listed[key] {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		statusEvent, _ := toStatusEvent(watch.Deleted, known[key], known)
+		statusEvents = append(statusEvents, statusEvent)
+	}
+	for _, statusEvent := range statusEvents {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case events <- statusEvent:
+		}
+	}
+	return list.ResourceVersion, nil
+}
+
+// consume emits the events of the watch until it ends or the context is done. It returns the resource
+// version to resume watching from and whether any event was received.
+func (w *PipelineRunWatcher) consume(ctx context.Context, wi watch.Interface, known map[string]*v1beta1.PipelineRun,
+	resourceVersion string, events chan<- PipelineRunStatusEvent) (string, bool) {
+	defer wi.Stop()
+	received := false
+	for {
+		var event watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return resourceVersion, received
+		case event, ok = <-wi.ResultChan():
+			if !ok {
+				return resourceVersion, received
+			}
+		}
+		received = true
+
+		if event.Type == watch.Error {
+			// the resource version is too old, relist to resume from the current state
+			if isExpired(apierrors.FromObject(event.Object)) {
+				return "", received
+			}
+			continue
+		}
+		pr, isPipelineRun := event.Object.(*v1beta1.PipelineRun)
+		if !isPipelineRun {
+			continue
+		}
+		resourceVersion = pr.ResourceVersion
+		if event.Type == watch.Bookmark {
+			continue
+		}
+
+		statusEvent, emit := toStatusEvent(event.Type, pr, known)
+		if !emit {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return resourceVersion, received
+		case events <- statusEvent:
+		}
+	}
+}
+
+// isExpired returns whether the error means that the resource version to watch from is too old.
+func isExpired(err error) bool {
+	return apierrors.IsResourceExpired(err) || apierrors.IsGone(err)
+}
+
+// toStatusEvent updates the known PipelineRuns with the PipelineRun of the watch event and returns the
+// PipelineRunStatusEvent to emit, if any.
+func toStatusEvent(eventType watch.EventType, pr *v1beta1.PipelineRun, known map[string]*v1beta1.PipelineRun) (PipelineRunStatusEvent, bool) {
+	key := pr.Namespace + "/" + pr.Name
+	old := known[key]
+	switch eventType {
+	case watch.Deleted:
+		delete(known, key)
+		if old == nil {
+			old = pr
+		}
+		return PipelineRunStatusEvent{Old: old, ChangeType: ChangeTypeDeleted}, true
+	case watch.Added, watch.Modified:
+		known[key] = pr
+		if old == nil {
+			return PipelineRunStatusEvent{New: pr, ChangeType: ChangeTypeAdded}, true
+		}
+		if equality.Semantic.DeepEqual(old.Status, pr.Status) {
+			return PipelineRunStatusEvent{}, false
+		}
+		return PipelineRunStatusEvent{Old: old, New: pr, ChangeType: ChangeTypeStatusChanged}, true
+	default:
+		return PipelineRunStatusEvent{}, false
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func pipelineRun(name, resourceVersion string, status corev1.ConditionStatus) *v1beta1.PipelineRun {
+	return &v1beta1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", ResourceVersion: resourceVersion},
+		Status: v1beta1.PipelineRunStatus{Status: duckv1.Status{Conditions: duckv1.Conditions{{
+			Type:   apis.ConditionSucceeded,
+			Status: status,
+		}}}},
+	}
+}
+
+func nextEvent(t *testing.T, events <-chan PipelineRunStatusEvent) PipelineRunStatusEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("the events channel was closed")
+		}
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+	return PipelineRunStatusEvent{}
+}
+
+func nextWatch(t *testing.T, watches <-chan string) string {
+	t.Helper()
+	select {
+	case resourceVersion := <-watches:
+		return resourceVersion
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a watch")
+	}
+	return ""
+}
+
+func TestPipelineRunWatcher(t *testing.T) {
+	prA := pipelineRun("a", "1", corev1.ConditionUnknown)
+	prB := pipelineRun("b", "2", corev1.ConditionUnknown)
+	prBRunning := pipelineRun("b", "3", corev1.ConditionTrue)
+	prALabeled := pipelineRun("a", "4", corev1.ConditionUnknown)
+	prALabeled.Labels = map[string]string{"foo": "bar"}
+	prADone := pipelineRun("a", "9", corev1.ConditionTrue)
+
+	lists := []*v1beta1.PipelineRunList{{
+		ListMeta: metav1.ListMeta{ResourceVersion: "2"},
+		Items:    []v1beta1.PipelineRun{*prA, *prB},
+	}, {
+		// b was deleted while the watcher couldn't watch
+		ListMeta: metav1.ListMeta{ResourceVersion: "10"},
+		Items:    []v1beta1.PipelineRun{*prADone},
+	}}
+	watchers := []*watch.FakeWatcher{nil, watch.NewFake(), watch.NewFake(), watch.NewFake()}
+	watches := make(chan string, len(watchers))
+
+	c := fake.NewSimpleClientset()
+	listCalls := 0
+	c.PrependReactor("list", "pipelineruns", func(k8stesting.Action) (bool, runtime.Object, error) {
+		list := lists[listCalls]
+		listCalls++
+		return true, list, nil
+	})
+	watchCalls := 0
+	c.PrependWatchReactor("pipelineruns", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		fw := watchers[watchCalls]
+		watchCalls++
+		watches <- action.(k8stesting.WatchAction).GetWatchRestrictions().ResourceVersion
+		if fw == nil {
+			return true, nil, errors.New("connection refused")
+		}
+		return true, fw, nil
+	})
+
+	w := NewPipelineRunWatcher(c.TektonV1beta1(), "ns", "")
+	w.backoff = wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: math.MaxInt32, Cap: 10 * time.Millisecond}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := w.Run(ctx)
+
+	// the initial list emits the existing PipelineRuns
+	for _, want := range []PipelineRunStatusEvent{
+		{New: prA, ChangeType: ChangeTypeAdded},
+		{New: prB, ChangeType: ChangeTypeAdded},
+	} {
+		if d := cmp.Diff(want, nextEvent(t, events)); d != "" {
+			t.Errorf("unexpected event %s", diff.PrintWantGot(d))
+		}
+	}
+
+	// the failed watch is retried after the backoff, from the resource version of the list
+	for range 2 {
+		if rv := nextWatch(t, watches); rv != "2" {
+			t.Errorf("expected to watch from the resource version 2, got %q", rv)
+		}
+	}
+
+	watchers[1].Modify(prBRunning)
+	if d := cmp.Diff(PipelineRunStatusEvent{Old: prB, New: prBRunning, ChangeType: ChangeTypeStatusChanged}, nextEvent(t, events)); d != "" {
+		t.Errorf("unexpected event %s", diff.PrintWantGot(d))
+	}
+	// a change of the metadata only isn't emitted, but its resource version is watched from
+	watchers[1].Modify(prALabeled)
+	watchers[1].Stop()
+	if rv := nextWatch(t, watches); rv != "4" {
+		t.Errorf("expected to resume watching from the resource version 4, got %q", rv)
+	}
+
+	// the resource version expired, so the PipelineRuns are listed again and the missing ones are deleted
+	watchers[2].Error(&metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   http.StatusGone,
+		Reason: metav1.StatusReasonExpired,
+	})
+	for _, want := range []PipelineRunStatusEvent{
+		{Old: prALabeled, New: prADone, ChangeType: ChangeTypeStatusChanged},
+		{Old: prBRunning, ChangeType: ChangeTypeDeleted},
+	} {
+		if d := cmp.Diff(want, nextEvent(t, events)); d != "" {
+			t.Errorf("unexpected event %s", diff.PrintWantGot(d))
+		}
+	}
+	if rv := nextWatch(t, watches); rv != "10" {
+		t.Errorf("expected to watch from the resource version 10 of the relist, got %q", rv)
+	}
+
+	cancel()
+	for event := range events {
+		t.Errorf("unexpected event after the context is done: %v", event)
+	}
+	if listCalls != 2 {
+		t.Errorf("expected 2 lists, got %d", listCalls)
+	}
+}
+
+func TestToStatusEvent_DeletedUnknown(t *testing.T) {
+	pr := pipelineRun("a", "1", corev1.ConditionTrue)
+	known := map[string]*v1beta1.PipelineRun{}
+	got, emit := toStatusEvent(watch.Deleted, pr, known)
+	if !emit {
+		t.Fatal("expected the deletion to be emitted")
+	}
+	if d := cmp.Diff(PipelineRunStatusEvent{Old: pr, ChangeType: ChangeTypeDeleted}, got); d != "" {
+		t.Errorf("unexpected event %s", diff.PrintWantGot(d))
+	}
+	if len(known) != 0 {
+		t.Errorf("expected no known PipelineRun, got %v", known)
+	}
+}
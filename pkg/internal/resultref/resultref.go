@@ -171,3 +171,24 @@ func ParseResultName(resultName string) (string, string) {
 	resultName = arrayIndexingRegex.ReplaceAllString(resultName, "")
 	return resultName, stringIdx
 }
+
+// StepResultRef is a reference to the result of a step, used as
+// $(steps.<stepName>.results.<resultName>) within the same Task.
+type StepResultRef struct {
+	Step   string
+	Result string
+}
+
+// FindStepResultRefs returns the step result references used in the given string.
+// Usages which cannot be parsed as a step result reference are ignored.
+func FindStepResultRefs(value string) []StepResultRef {
+	var refs []StepResultRef
+	for _, match := range StepResultRegex.FindAllString(value, -1) {
+		pr, err := ParseStepExpression(strings.TrimSuffix(strings.TrimPrefix(match, "$("), ")"))
+		if err != nil {
+			continue
+		}
+		refs = append(refs, StepResultRef{Step: pr.ResourceName, Result: pr.ResultName})
+	}
+	return refs
+}
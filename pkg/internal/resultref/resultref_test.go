@@ -239,3 +239,34 @@ func TestParseResultName(t *testing.T) {
 		})
 	}
 }
+
+func TestFindStepResultRefs(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []resultref.StepResultRef
+	}{{
+		name:  "no reference",
+		value: "echo $(params.foo)",
+	}, {
+		name:  "string, array and object references",
+		value: "$(steps.one.results.str) $(steps.two.results.arr[1]) $(steps.three.results.obj.key)",
+		want: []resultref.StepResultRef{
+			{Step: "one", Result: "str"},
+			{Step: "two", Result: "arr"},
+			{Step: "three", Result: "obj"},
+		},
+	}, {
+		name:  "invalid reference is ignored",
+		value: "$(steps.one.results.obj.key.extra) $(steps.two.results.str)",
+		want:  []resultref.StepResultRef{{Step: "two", Result: "str"}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resultref.FindStepResultRefs(tt.value)
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -38,7 +38,7 @@ import (
 	"knative.dev/pkg/apis"
 )
 
-var cancelTaskRunPatchBytes, cancelCustomRunPatchBytes []byte
+var cancelTaskRunPatchBytes, cancelCustomRunPatchBytes, cancelPipelineRunPatchBytes []byte
 
 func init() {
 	var err error
@@ -70,6 +70,15 @@ func init() {
 	if err != nil {
 		log.Fatalf("failed to marshal CustomRun cancel patch bytes: %v", err)
 	}
+	cancelPipelineRunPatchBytes, err = json.Marshal([]jsonpatch.JsonPatchOperation{
+		{
+			Operation: "add",
+			Path:      "/spec/status",
+			Value:     v1.PipelineRunSpecStatusCancelled,
+		}})
+	if err != nil {
+		log.Fatalf("failed to marshal PipelineRun cancel patch bytes: %v", err)
+	}
 }
 
 func cancelCustomRun(ctx context.Context, runName string, namespace string, clientSet clientset.Interface) error {
@@ -96,6 +105,16 @@ func cancelTaskRun(ctx context.Context, taskRunName string, namespace string, cl
 	return err
 }
 
+func cancelChildPipelineRun(ctx context.Context, pipelineRunName string, namespace string, clientSet clientset.Interface) error {
+	_, err := clientSet.TektonV1().PipelineRuns(namespace).Patch(ctx, pipelineRunName, types.JSONPatchType, cancelPipelineRunPatchBytes, metav1.PatchOptions{}, "")
+	if errors.IsNotFound(err) {
+		// The resource may have been deleted in the meanwhile, but we should
+		// still be able to cancel the PipelineRun
+		return nil
+	}
+	return err
+}
+
 // cancelPipelineRun marks the PipelineRun as cancelled and any resolved TaskRun(s) too.
 func cancelPipelineRun(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, clientSet clientset.Interface) error {
 	errs := cancelPipelineTaskRuns(ctx, logger, pr, clientSet)
@@ -131,11 +150,11 @@ func cancelPipelineTaskRuns(ctx context.Context, logger *zap.SugaredLogger, pr *
 	return cancelPipelineTaskRunsForTaskNames(ctx, logger, pr, clientSet, sets.NewString())
 }
 
-// cancelPipelineTaskRunsForTaskNames patches `TaskRun`s and `Run`s for the given task names, or all if no task names are given, with canceled status
+// cancelPipelineTaskRunsForTaskNames patches `TaskRun`s, `Run`s and child `PipelineRun`s for the given task names, or all if no task names are given, with canceled status
 func cancelPipelineTaskRunsForTaskNames(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, clientSet clientset.Interface, taskNames sets.String) []string {
 	errs := []string{}
 
-	trNames, customRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
+	trNames, customRunNames, pipelineRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
@@ -157,14 +176,24 @@ func cancelPipelineTaskRunsForTaskNames(ctx context.Context, logger *zap.Sugared
 			continue
 		}
 	}
+
+	for _, pipelineRunName := range pipelineRunNames {
+		logger.Infof("cancelling child PipelineRun %s", pipelineRunName)
+
+		if err := cancelChildPipelineRun(ctx, pipelineRunName, pr.Namespace, clientSet); err != nil {
+			errs = append(errs, fmt.Errorf("failed to patch PipelineRun `%s` with cancellation: %w", pipelineRunName, err).Error())
+			continue
+		}
+	}
 	return errs
 }
 
-// getChildObjectsFromPRStatusForTaskNames returns taskruns and customruns in the PipelineRunStatus's ChildReferences,
-// based on the given set of PipelineTask names. If that set is empty, all are returned.
-func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.PipelineRunStatus, taskNames sets.String) ([]string, []string, error) {
+// getChildObjectsFromPRStatusForTaskNames returns taskruns, customruns and child pipelineruns in the PipelineRunStatus's
+// ChildReferences, based on the given set of PipelineTask names. If that set is empty, all are returned.
+func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.PipelineRunStatus, taskNames sets.String) ([]string, []string, []string, error) {
 	var trNames []string
 	var customRunNames []string
+	var pipelineRunNames []string
 	unknownChildKinds := make(map[string]string)
 
 	for _, cr := range prs.ChildReferences {
@@ -174,6 +203,8 @@ func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.Pipelin
 				trNames = append(trNames, cr.Name)
 			case customRun:
 				customRunNames = append(customRunNames, cr.Name)
+			case pipelineRun:
+				pipelineRunNames = append(pipelineRunNames, cr.Name)
 			default:
 				unknownChildKinds[cr.Name] = cr.Kind
 			}
@@ -185,7 +216,7 @@ func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.Pipelin
 		err = fmt.Errorf("found child objects of unknown kinds: %v", unknownChildKinds)
 	}
 
-	return trNames, customRunNames, err
+	return trNames, customRunNames, pipelineRunNames, err
 }
 
 // gracefullyCancelPipelineRun marks any non-final resolved TaskRun(s) as cancelled and runs finally.
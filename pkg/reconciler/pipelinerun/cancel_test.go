@@ -359,13 +359,14 @@ func TestCancelPipelineRun(t *testing.T) {
 
 func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 	testCases := []struct {
-		name                   string
-		prStatus               v1.PipelineRunStatus
-		taskNames              sets.String
-		expectedTRNames        []string
-		expectedRunNames       []string
-		expectedCustomRunNames []string
-		hasError               bool
+		name                     string
+		prStatus                 v1.PipelineRunStatus
+		taskNames                sets.String
+		expectedTRNames          []string
+		expectedRunNames         []string
+		expectedCustomRunNames   []string
+		expectedPipelineRunNames []string
+		hasError                 bool
 	}{
 		{
 			name: "beta custom tasks",
@@ -381,6 +382,20 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 			}},
 			expectedCustomRunNames: []string{"r1"},
 			hasError:               false,
+		}, {
+			name: "child pipelineruns",
+			prStatus: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				ChildReferences: []v1.ChildStatusReference{{
+					TypeMeta: runtime.TypeMeta{
+						APIVersion: v1.SchemeGroupVersion.String(),
+						Kind:       pipelineRun,
+					},
+					Name:             "pr1",
+					PipelineTaskName: "pipeline-1",
+				}},
+			}},
+			expectedPipelineRunNames: []string{"pr1"},
+			hasError:                 false,
 		}, {
 			name: "unknown kind",
 			prStatus: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
@@ -403,7 +418,7 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx, _ := ttesting.SetupFakeContext(t)
-			trNames, customRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, tc.prStatus, tc.taskNames)
+			trNames, customRunNames, pipelineRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, tc.prStatus, tc.taskNames)
 
 			if tc.hasError {
 				if err == nil {
@@ -419,6 +434,9 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 			if d := cmp.Diff(tc.expectedCustomRunNames, customRunNames); d != "" {
 				t.Errorf("expected to see CustomRun names %v. Diff %s", tc.expectedCustomRunNames, diff.PrintWantGot(d))
 			}
+			if d := cmp.Diff(tc.expectedPipelineRunNames, pipelineRunNames); d != "" {
+				t.Errorf("expected to see PipelineRun names %v. Diff %s", tc.expectedPipelineRunNames, diff.PrintWantGot(d))
+			}
 		})
 	}
 }
@@ -0,0 +1,225 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	listers "github.com/tektoncd/pipeline/pkg/client/listers/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+// cleanupFinallyTasks returns the names of the finally tasks of the PipelineSpec labeled tekton.dev/cleanup: "true",
+// either in the metadata of their embedded Task or in the metadata of their taskRunSpec in the PipelineRun.
+func cleanupFinallyTasks(pr *v1.PipelineRun, ps *v1.PipelineSpec) sets.Set[string] {
+	names := sets.New[string]()
+	if ps == nil {
+		return names
+	}
+	labeledTaskRunSpecs := sets.New[string]()
+	for _, trs := range pr.Spec.TaskRunSpecs {
+		if trs.Metadata != nil && trs.Metadata.Labels[pipeline.CleanupLabelKey] == "true" {
+			labeledTaskRunSpecs.Insert(trs.PipelineTaskName)
+		}
+	}
+	for _, pt := range ps.Finally {
+		if labeledTaskRunSpecs.Has(pt.Name) || (pt.TaskSpec != nil && pt.TaskSpec.Metadata.Labels[pipeline.CleanupLabelKey] == "true") {
+			names.Insert(pt.Name)
+		}
+	}
+	return names
+}
+
+// updateCleanupFinalizer adds the tekton.dev/pipelinerun-cleanup finalizer to the PipelineRuns which are not done and
+// have cleanup finally tasks, and removes it from the other ones.
+func (c *Reconciler) updateCleanupFinalizer(ctx context.Context, pr *v1.PipelineRun) error {
+	present := !pr.IsDone() && cleanupFinallyTasks(pr, pr.Status.PipelineSpec).Len() > 0
+	if slices.Contains(pr.Finalizers, pipeline.PipelineRunCleanupFinalizer) == present {
+		return nil
+	}
+	// the PipelineRun may have been updated since the informer's copy, e.g. its labels and annotations
+	latest, err := c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).Get(ctx, pr.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get PipelineRun %s to update its %s finalizer: %w", pr.Name, pipeline.PipelineRunCleanupFinalizer, err)
+	}
+	_, err = c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).SetFinalizer(ctx, latest, pipeline.PipelineRunCleanupFinalizer, present, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update the %s finalizer of PipelineRun %s: %w", pipeline.PipelineRunCleanupFinalizer, pr.Name, err)
+	}
+	return nil
+}
+
+// finalizeCleanup runs the cleanup finally tasks of a PipelineRun being deleted before it completed, and removes the
+// tekton.dev/pipelinerun-cleanup finalizer once they are done, or once the default-pipelinerun-cleanup-timeout
+// elapsed since the deletion so that the deletion is never blocked indefinitely. The other tasks are left to the
+// garbage collector.
+func (c *Reconciler) finalizeCleanup(ctx context.Context, pr *v1.PipelineRun) error {
+	logger := logging.FromContext(ctx)
+	cleanupTasks := cleanupFinallyTasks(pr, pr.Status.PipelineSpec)
+	remaining := config.FromContextOrDefaults(ctx).Defaults.DefaultPipelineRunCleanupTimeout - c.Clock.Since(pr.DeletionTimestamp.Time)
+
+	if !pr.IsDone() && cleanupTasks.Len() > 0 && remaining > 0 {
+		done, err := c.runCleanupFinallyTasks(ctx, pr, cleanupTasks)
+		switch {
+		case err != nil && !controller.IsPermanentError(err):
+			return err
+		case err != nil:
+			logger.Errorf("Failed to run the cleanup finally tasks of PipelineRun %s: %v", pr.Name, err)
+		case !done:
+			return controller.NewRequeueAfter(remaining)
+		}
+	} else if remaining <= 0 {
+		logger.Warnf("The cleanup finally tasks of PipelineRun %s did not complete before the cleanup timeout", pr.Name)
+	}
+
+	_, err := c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).SetFinalizer(ctx, pr, pipeline.PipelineRunCleanupFinalizer, false, metav1.PatchOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove the %s finalizer of PipelineRun %s: %w", pipeline.PipelineRunCleanupFinalizer, pr.Name, err)
+	}
+	return nil
+}
+
+// runCleanupFinallyTasks creates the TaskRuns of the given cleanup finally tasks which were not started yet, and
+// returns true once all of them are done or skipped.
+func (c *Reconciler) runCleanupFinallyTasks(ctx context.Context, pr *v1.PipelineRun, cleanupTasks sets.Set[string]) (bool, error) {
+	logger := logging.FromContext(ctx)
+	pipelineSpec := pr.Status.PipelineSpec
+
+	d, err := dag.Build(v1.PipelineTaskList(pipelineSpec.Tasks), v1.PipelineTaskList(pipelineSpec.Tasks).Deps())
+	if err != nil {
+		return false, controller.NewPermanentError(err)
+	}
+	dfinally, err := dag.Build(v1.PipelineTaskList(pipelineSpec.Finally), map[string][]string{})
+	if err != nil {
+		return false, controller.NewPermanentError(err)
+	}
+
+	// only the tasks which ran, for their results, and the cleanup tasks are resolved
+	ranOrRunningTaskNames := sets.New[string]()
+	for _, child := range pr.Status.ChildReferences {
+		ranOrRunningTaskNames.Insert(child.PipelineTaskName)
+	}
+	var tasks []v1.PipelineTask
+	for _, task := range slices.Concat(pipelineSpec.Tasks, pipelineSpec.Finally) {
+		if ranOrRunningTaskNames.Has(task.Name) || cleanupTasks.Has(task.Name) {
+			tasks = append(tasks, task)
+		}
+	}
+	pipelineRunState, err := c.resolvePipelineState(ctx, tasks, &pr.ObjectMeta, pr, resources.PipelineRunState{})
+	if err != nil {
+		return false, err
+	}
+	facts := &resources.PipelineRunFacts{
+		State:           pipelineRunState,
+		SpecStatus:      pr.Spec.Status,
+		TasksGraph:      d,
+		FinalTasksGraph: dfinally,
+		TimeoutsState: resources.PipelineRunTimeoutsState{
+			Clock: c.Clock,
+		},
+	}
+
+	done := true
+	var notStarted resources.PipelineRunState
+	for _, rpt := range pipelineRunState {
+		// only the cleanup tasks running Tasks are run during the deletion
+		if !cleanupTasks.Has(rpt.PipelineTask.Name) || rpt.IsCustomTask() || rpt.IsChildPipeline() {
+			continue
+		}
+		switch {
+		case len(rpt.TaskRuns) == 0:
+			notStarted = append(notStarted, rpt)
+		case rpt.IsRunning():
+			done = false
+		}
+	}
+
+	resources.ApplyPipelineTaskStateContext(notStarted, facts.GetPipelineTaskStatus(ctx), pr)
+	for _, rpt := range notStarted {
+		if _, _, err := resources.ResolveResultRef(facts.State, rpt); err != nil {
+			logger.Infof("Cleanup task %q of %q is not executed as it could not resolve its task results: %v", rpt.PipelineTask.Name, pr.Name, err)
+			continue
+		}
+		if err := resources.ApplyResultsToFinallyTasks(resources.PipelineRunState{rpt}, facts); err != nil {
+			logger.Infof("Cleanup task %q of %q is not executed as it could not apply its task results: %v", rpt.PipelineTask.Name, pr.Name, err)
+			continue
+		}
+		if err := rpt.EvaluateCEL(); err != nil {
+			logger.Infof("Cleanup task %q of %q is not executed as its CEL could not be evaluated: %v", rpt.PipelineTask.Name, pr.Name, err)
+			continue
+		}
+		if !rpt.PipelineTask.When.AllowsExecution(rpt.EvaluatedCEL) {
+			continue
+		}
+		if err := resources.ApplyPipelineTaskTimeout(rpt.PipelineTask); err != nil {
+			logger.Infof("Cleanup task %q of %q is not executed as its timeout is invalid: %v", rpt.PipelineTask.Name, pr.Name, err)
+			continue
+		}
+		logger.Infof("Running cleanup task %q of PipelineRun %s being deleted", rpt.PipelineTask.Name, pr.Name)
+		if rpt.TaskRuns, err = c.createTaskRuns(ctx, rpt, pr, facts); err != nil {
+			return false, fmt.Errorf("error creating TaskRuns called %s for cleanup task %s from PipelineRun %s: %w", rpt.TaskRunNames, rpt.PipelineTask.Name, pr.Name, err)
+		}
+		done = false
+	}
+	return done, nil
+}
+
+// leaderAwareReconciler is the controller.Reconciler generated for the PipelineRuns.
+type leaderAwareReconciler interface {
+	controller.Reconciler
+	pkgreconciler.LeaderAware
+	IsLeaderFor(key types.NamespacedName) bool
+}
+
+// cleanupFinalizerReconciler finalizes the PipelineRuns being deleted with the tekton.dev/pipelinerun-cleanup
+// finalizer and delegates the other ones to the generated reconciler. The Reconciler doesn't implement the
+// generated Finalizer interface, which would add a finalizer to all the PipelineRuns.
+type cleanupFinalizerReconciler struct {
+	leaderAwareReconciler
+	reconciler  *Reconciler
+	lister      listers.PipelineRunLister
+	configStore pkgreconciler.ConfigStore
+}
+
+// Reconcile implements controller.Reconciler
+func (r *cleanupFinalizerReconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return r.leaderAwareReconciler.Reconcile(ctx, key)
+	}
+	pr, err := r.lister.PipelineRuns(namespace).Get(name)
+	if err != nil || pr.DeletionTimestamp.IsZero() || !slices.Contains(pr.Finalizers, pipeline.PipelineRunCleanupFinalizer) ||
+		!r.IsLeaderFor(types.NamespacedName{Namespace: namespace, Name: name}) {
+		return r.leaderAwareReconciler.Reconcile(ctx, key)
+	}
+	ctx = r.configStore.ToContext(ctx)
+	return r.reconciler.finalizeCleanup(ctx, pr.DeepCopy())
+}
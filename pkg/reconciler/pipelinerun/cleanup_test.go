@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+)
+
+func TestCleanupFinallyTasks(t *testing.T) {
+	pr := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: pr
+  namespace: foo
+spec:
+  taskRunSpecs:
+  - pipelineTaskName: release-lock
+    metadata:
+      labels:
+        tekton.dev/cleanup: "true"
+  - pipelineTaskName: build
+    metadata:
+      labels:
+        tekton.dev/cleanup: "true"
+  pipelineSpec:
+    tasks:
+    - name: build
+      taskRef:
+        name: build
+    finally:
+    - name: delete-namespace
+      taskSpec:
+        metadata:
+          labels:
+            tekton.dev/cleanup: "true"
+        steps:
+        - image: busybox
+    - name: release-lock
+      taskRef:
+        name: release-lock
+    - name: notify
+      taskSpec:
+        metadata:
+          labels:
+            tekton.dev/cleanup: "false"
+        steps:
+        - image: busybox
+`)
+	got := cleanupFinallyTasks(pr, pr.Spec.PipelineSpec)
+	if d := cmp.Diff(sets.New("delete-namespace", "release-lock"), got); d != "" {
+		t.Errorf("cleanupFinallyTasks() %s", diff.PrintWantGot(d))
+	}
+	if got := cleanupFinallyTasks(pr, nil); got.Len() != 0 {
+		t.Errorf("cleanupFinallyTasks() without PipelineSpec = %v, want none", sets.List(got))
+	}
+}
+
+func TestReconcile_CleanupFinalizer(t *testing.T) {
+	pipelineRun := func(t *testing.T, deletedAgo *time.Duration, finalizers ...string) *v1.PipelineRun {
+		t.Helper()
+		pr := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: pr
+  namespace: foo
+spec:
+  pipelineSpec:
+    tasks:
+    - name: build
+      taskSpec:
+        steps:
+        - image: busybox
+    finally:
+    - name: cleanup
+      taskSpec:
+        metadata:
+          labels:
+            tekton.dev/cleanup: "true"
+        steps:
+        - image: busybox
+    - name: notify
+      taskSpec:
+        steps:
+        - image: busybox
+`)
+		pr.Finalizers = finalizers
+		if deletedAgo != nil {
+			pr.DeletionTimestamp = &metav1.Time{Time: now.Add(-*deletedAgo)}
+			pr.Status.PipelineSpec = pr.Spec.PipelineSpec.DeepCopy()
+			pr.Status.StartTime = &metav1.Time{Time: now.Add(-*deletedAgo - time.Minute)}
+			pr.Status.Status = duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown, Reason: v1.PipelineRunReasonRunning.String()}}}
+			pr.Status.ChildReferences = []v1.ChildStatusReference{{
+				TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+				Name:             "pr-build",
+				PipelineTaskName: "build",
+			}}
+		}
+		return pr
+	}
+	taskRun := func(t *testing.T, name string, status corev1.ConditionStatus) *v1.TaskRun {
+		t.Helper()
+		tr := parse.MustParseV1TaskRun(t, `
+metadata:
+  name: `+name+`
+  namespace: foo
+  ownerReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: pr
+    controller: true
+spec:
+  taskSpec:
+    steps:
+    - image: busybox
+`)
+		tr.Status.Status = duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status}}}
+		return tr
+	}
+	deleted := func(d time.Duration) *time.Duration { return &d }
+
+	for _, tc := range []struct {
+		name           string
+		pipelineRun    *v1.PipelineRun
+		taskRuns       []*v1.TaskRun
+		wantFinalizers []string
+		wantTaskRuns   []string
+		wantRequeue    bool
+	}{{
+		name:           "finalizer added to a running PipelineRun with cleanup finally tasks",
+		pipelineRun:    pipelineRun(t, nil),
+		wantFinalizers: []string{pipeline.PipelineRunCleanupFinalizer},
+		wantTaskRuns:   []string{"pr-build"},
+		wantRequeue:    true,
+	}, {
+		name: "finalizer removed from a done PipelineRun",
+		pipelineRun: func() *v1.PipelineRun {
+			pr := pipelineRun(t, nil, pipeline.PipelineRunCleanupFinalizer)
+			pr.Status.Status = duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}}
+			return pr
+		}(),
+	}, {
+		name:           "cleanup finally task run when the PipelineRun is deleted",
+		pipelineRun:    pipelineRun(t, deleted(10*time.Second), pipeline.PipelineRunCleanupFinalizer),
+		taskRuns:       []*v1.TaskRun{taskRun(t, "pr-build", corev1.ConditionUnknown)},
+		wantFinalizers: []string{pipeline.PipelineRunCleanupFinalizer},
+		wantTaskRuns:   []string{"pr-build", "pr-cleanup"},
+		wantRequeue:    true,
+	}, {
+		name:           "finalizer kept while the cleanup finally task is running",
+		pipelineRun:    pipelineRun(t, deleted(10*time.Second), "other", pipeline.PipelineRunCleanupFinalizer),
+		taskRuns:       []*v1.TaskRun{taskRun(t, "pr-build", corev1.ConditionUnknown), taskRun(t, "pr-cleanup", corev1.ConditionUnknown)},
+		wantFinalizers: []string{"other", pipeline.PipelineRunCleanupFinalizer},
+		wantTaskRuns:   []string{"pr-build", "pr-cleanup"},
+		wantRequeue:    true,
+	}, {
+		name:           "finalizer removed once the cleanup finally task is done",
+		pipelineRun:    pipelineRun(t, deleted(10*time.Second), "other", pipeline.PipelineRunCleanupFinalizer),
+		taskRuns:       []*v1.TaskRun{taskRun(t, "pr-build", corev1.ConditionUnknown), taskRun(t, "pr-cleanup", corev1.ConditionFalse)},
+		wantFinalizers: []string{"other"},
+		wantTaskRuns:   []string{"pr-build", "pr-cleanup"},
+	}, {
+		name:         "finalizer removed after the cleanup timeout",
+		pipelineRun:  pipelineRun(t, deleted(2*time.Minute), pipeline.PipelineRunCleanupFinalizer),
+		taskRuns:     []*v1.TaskRun{taskRun(t, "pr-build", corev1.ConditionUnknown)},
+		wantTaskRuns: []string{"pr-build"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := test.Data{
+				PipelineRuns: []*v1.PipelineRun{tc.pipelineRun},
+				TaskRuns:     tc.taskRuns,
+			}
+			prt := newPipelineRunTest(t, d)
+			defer prt.Cancel()
+
+			err := prt.TestAssets.Controller.Reconciler.Reconcile(prt.TestAssets.Ctx, "foo/pr")
+			if ok, _ := controller.IsRequeueKey(err); ok != tc.wantRequeue || (err != nil && !ok) {
+				t.Fatalf("Reconcile() = %v, want requeue: %t", err, tc.wantRequeue)
+			}
+
+			// the fake clients update the whole PipelineRuns on status updates, the finalizers are read from the patches
+			clients := prt.TestAssets.Clients
+			finalizers := tc.pipelineRun.Finalizers
+			for _, action := range clients.Pipeline.Actions() {
+				if patch, ok := action.(ktesting.PatchAction); ok && patch.GetResource().Resource == "pipelineruns" {
+					var patched v1.PipelineRun
+					if err := json.Unmarshal(patch.GetPatch(), &patched); err != nil {
+						t.Fatal(err)
+					}
+					finalizers = patched.Finalizers
+				}
+			}
+			if d := cmp.Diff(tc.wantFinalizers, finalizers, cmpopts.EquateEmpty()); d != "" {
+				t.Errorf("PipelineRun finalizers %s", diff.PrintWantGot(d))
+			}
+			for _, name := range []string{"pr-build", "pr-cleanup", "pr-notify"} {
+				_, err := clients.Pipeline.TektonV1().TaskRuns("foo").Get(prt.TestAssets.Ctx, name, metav1.GetOptions{})
+				want := sets.New(tc.wantTaskRuns...).Has(name)
+				if got := !apierrors.IsNotFound(err); got != want {
+					t.Errorf("TaskRun %s exists: %t, want %t", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -79,6 +79,7 @@ func NewController(opts *pipeline.Options, clock clock.PassiveClock) func(contex
 			taskRunLister:            taskRunInformer.Lister(),
 			customRunLister:          customRunInformer.Lister(),
 			verificationPolicyLister: verificationpolicyInformer.Lister(),
+			secretLister:             secretinformer.Lister(),
 			cloudEventClient:         cloudeventclient.Get(ctx),
 			metrics:                  pipelinerunmetricsRecorder,
 			pvcHandler:               volumeclaim.NewPVCHandler(kubeclientset, logger),
@@ -92,14 +93,43 @@ func NewController(opts *pipeline.Options, clock clock.PassiveClock) func(contex
 			}
 		})
 
+		// the PipelineRuns being deleted with the cleanup finalizer run their cleanup finally tasks
+		impl.Reconciler = &cleanupFinalizerReconciler{
+			leaderAwareReconciler: impl.Reconciler.(leaderAwareReconciler),
+			reconciler:            c,
+			lister:                pipelineRunInformer.Lister(),
+			configStore:           configStore,
+		}
+
 		if _, err := secretinformer.Informer().AddEventHandler(controller.HandleAll(tracerProvider.Handler)); err != nil {
 			logging.FromContext(ctx).Panicf("Couldn't register Secret informer event handler: %w", err)
 		}
 
+		// the PipelineRuns can be listed by Pipeline with ListPipelineRunsByPipeline and by status with
+		// ListPipelineRunsByStatus
+		for name, indexFunc := range map[string]cache.IndexFunc{
+			PipelineNameIndex: PipelineNameIndexFunc,
+			StatusIndex:       StatusIndexFunc,
+		} {
+			if _, ok := pipelineRunInformer.Informer().GetIndexer().GetIndexers()[name]; !ok {
+				if err := pipelineRunInformer.Informer().AddIndexers(cache.Indexers{name: indexFunc}); err != nil {
+					logging.FromContext(ctx).Panicf("Couldn't register PipelineRun informer indexer %s: %w", name, err)
+				}
+			}
+		}
+
 		if _, err := pipelineRunInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
 			logging.FromContext(ctx).Panicf("Couldn't register PipelineRun informer event handler: %w", err)
 		}
 
+		// the child PipelineRuns of the PipelineTasks referencing a Pipeline enqueue their parent PipelineRun
+		if _, err := pipelineRunInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1.PipelineRun{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register child PipelineRun informer event handler: %w", err)
+		}
+
 		if _, err := taskRunInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 			FilterFunc: controller.FilterController(&v1.PipelineRun{}),
 			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug helps diagnosing the variable substitutions applied while a PipelineRun is reconciled.
+package debug
+
+import (
+	"context"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+)
+
+const (
+	// SourceDefault is the source of values coming from the defaults of the params declared in the PipelineSpec.
+	SourceDefault = "Default"
+	// SourcePipelineRunParam is the source of values coming from the params provided by the PipelineRun.
+	SourcePipelineRunParam = "PipelineRunParam"
+	// SourceContext is the source of values coming from the context variables of the PipelineRun.
+	SourceContext = "Context"
+	// SourcePipelineTaskContext is the source of values coming from the context variables of a PipelineTask.
+	SourcePipelineTaskContext = "PipelineTaskContext"
+)
+
+// Substitution is the value a variable expression is replaced with and where the value comes from.
+type Substitution struct {
+	// Value is a string, a []string or a map[string]string depending on the type of the variable.
+	Value interface{} `json:"value"`
+	// Source is one of SourceDefault, SourcePipelineRunParam, SourceContext or SourcePipelineTaskContext.
+	Source string `json:"source"`
+}
+
+// CollectSubstitutions returns the substitutions applied to the PipelineSpec of the PipelineRun, keyed by
+// variable expression, e.g. "params.foo". Params provided by the PipelineRun override the defaults declared
+// in the PipelineSpec. Substitutions scoped to a single PipelineTask, such as context.pipelineTask.retries,
+// are keyed by "<pipelineTaskName>/<variable expression>".
+//
+// The substitutions are computed in dry-run mode: no events are emitted and neither the PipelineSpec nor
+// the PipelineRun are modified. Task results are only known once the referenced TaskRuns complete and so
+// are not collected. An ErrDuplicateParams is returned if the PipelineRun provides the same param more than once.
+func CollectSubstitutions(ctx context.Context, spec *v1.PipelineSpec, pr *v1.PipelineRun) (map[string]interface{}, error) {
+	// dry-run: don't emit the warnings for undeclared params again
+	ctx = controller.WithEventRecorder(ctx, nil)
+
+	substitutions := map[string]interface{}{}
+	defaults, provided, err := resources.GetParamReplacements(ctx, spec, pr)
+	if err != nil {
+		return nil, err
+	}
+	addParamReplacements(substitutions, defaults, SourceDefault)
+	addParamReplacements(substitutions, provided, SourcePipelineRunParam)
+
+	for k, v := range resources.GetContextReplacements(ctx, &v1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: resources.PipelineNameFromPipelineRun(pr)}}, pr) {
+		substitutions[k] = Substitution{Value: v, Source: SourceContext}
+	}
+	for k, v := range resources.GetStatusContextReplacements(pr) {
+		substitutions[k] = Substitution{Value: v, Source: SourceContext}
+	}
+
+	facts := &resources.PipelineRunFacts{}
+	for _, tasks := range [][]v1.PipelineTask{spec.Tasks, spec.Finally} {
+		for i := range tasks {
+			pt := tasks[i].DeepCopy()
+			for k, v := range resources.GetPipelineTaskContextReplacements(pt, pr, facts) {
+				substitutions[pt.Name+"/"+k] = Substitution{Value: v, Source: SourcePipelineTaskContext}
+			}
+		}
+	}
+	return substitutions, nil
+}
+
+func addParamReplacements(substitutions map[string]interface{}, replacements resources.ParamReplacements, source string) {
+	for k, v := range replacements.Strings {
+		substitutions[k] = Substitution{Value: v, Source: source}
+	}
+	for k, v := range replacements.Arrays {
+		substitutions[k] = Substitution{Value: v, Source: source}
+	}
+	for k, v := range replacements.Objects {
+		substitutions[k] = Substitution{Value: v, Source: source}
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/debug"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+)
+
+func TestCollectSubstitutions(t *testing.T) {
+	spec := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{{
+			Name:    "first",
+			Type:    v1.ParamTypeString,
+			Default: v1.NewStructuredValues("default-first"),
+		}, {
+			Name:    "second",
+			Type:    v1.ParamTypeString,
+			Default: v1.NewStructuredValues("default-second"),
+		}, {
+			Name:    "list",
+			Type:    v1.ParamTypeArray,
+			Default: v1.NewStructuredValues("a", "b"),
+		}},
+		Tasks: []v1.PipelineTask{{
+			Name:    "build",
+			Retries: 2,
+		}},
+		Finally: []v1.PipelineTask{{
+			Name: "notify",
+		}},
+	}
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pr",
+			Namespace: "ns",
+			Labels:    map[string]string{"team": "ci"},
+		},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+			Params: v1.Params{{
+				Name:  "second",
+				Value: *v1.NewStructuredValues("provided-second"),
+			}, {
+				Name:  "undeclared",
+				Value: *v1.NewStructuredValues("value"),
+			}},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+	got, err := debug.CollectSubstitutions(ctx, spec, pr)
+	if err != nil {
+		t.Fatalf("CollectSubstitutions() unexpected error: %v", err)
+	}
+
+	for k, want := range map[string]debug.Substitution{
+		"params.first":                        {Value: "default-first", Source: debug.SourceDefault},
+		"params.second":                       {Value: "provided-second", Source: debug.SourcePipelineRunParam},
+		`params["second"]`:                    {Value: "provided-second", Source: debug.SourcePipelineRunParam},
+		"params.undeclared":                   {Value: "value", Source: debug.SourcePipelineRunParam},
+		"params.list":                         {Value: []string{"a", "b"}, Source: debug.SourceDefault},
+		"params.list[1]":                      {Value: "b", Source: debug.SourceDefault},
+		"context.pipeline.name":               {Value: "pipeline", Source: debug.SourceContext},
+		"context.pipelineRun.namespace":       {Value: "ns", Source: debug.SourceContext},
+		"context.pipelineRun.labels.team":     {Value: "ci", Source: debug.SourceContext},
+		"context.pipelineRun.startTime":       {Value: "", Source: debug.SourceContext},
+		"build/context.pipelineTask.retries":  {Value: "2", Source: debug.SourcePipelineTaskContext},
+		"notify/context.pipelineTask.retries": {Value: "0", Source: debug.SourcePipelineTaskContext},
+	} {
+		if d := cmp.Diff(want, got[k]); d != "" {
+			t.Errorf("CollectSubstitutions()[%q] %s", k, diff.PrintWantGot(d))
+		}
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("CollectSubstitutions() emitted %d events, expected none in dry-run mode", len(recorder.Events))
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"fmt"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// PipelineNameIndex is the name of the index of the PipelineRun informer keyed by the referenced Pipeline.
+	PipelineNameIndex = "byPipelineName"
+	// EmbeddedPipelineName is the Pipeline name under which the PipelineRuns with an embedded PipelineSpec are indexed.
+	EmbeddedPipelineName = "embedded"
+	// StatusIndex is the name of the index of the PipelineRun informer keyed by the status of the Succeeded condition.
+	StatusIndex = "byStatus"
+)
+
+// PipelineNameIndexFunc indexes the PipelineRuns by namespace and spec.pipelineRef.name, e.g. "foo/build", or
+// by namespace and EmbeddedPipelineName for the PipelineRuns with an embedded PipelineSpec. The PipelineRuns
+// referencing a Pipeline through a resolver, whose name is only known once resolved, are not indexed.
+func PipelineNameIndexFunc(obj interface{}) ([]string, error) {
+	pr, ok := obj.(*v1.PipelineRun)
+	if !ok {
+		return nil, fmt.Errorf("expected a PipelineRun but got %T", obj)
+	}
+	switch {
+	case pr.Spec.PipelineRef == nil:
+		return []string{pipelineNameIndexKey(pr.Namespace, EmbeddedPipelineName)}, nil
+	case pr.Spec.PipelineRef.Name != "":
+		return []string{pipelineNameIndexKey(pr.Namespace, pr.Spec.PipelineRef.Name)}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// pipelineNameIndexKey returns the key of the Pipeline in PipelineNameIndex.
+func pipelineNameIndexKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// ListPipelineRunsByPipeline returns the PipelineRuns of the namespace referencing the Pipeline with the given
+// name, or the ones with an embedded PipelineSpec for EmbeddedPipelineName, from an indexer with PipelineNameIndex.
+func ListPipelineRunsByPipeline(indexer cache.Indexer, namespace, name string) ([]*v1.PipelineRun, error) {
+	objs, err := indexer.ByIndex(PipelineNameIndex, pipelineNameIndexKey(namespace, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the PipelineRuns of Pipeline %s/%s: %w", namespace, name, err)
+	}
+	prs := make([]*v1.PipelineRun, 0, len(objs))
+	for _, obj := range objs {
+		if pr, ok := obj.(*v1.PipelineRun); ok {
+			prs = append(prs, pr)
+		}
+	}
+	return prs, nil
+}
+
+// StatusIndexFunc indexes the PipelineRuns by the status of their Succeeded condition: "True", "False" or
+// "Unknown". The PipelineRuns without a Succeeded condition, e.g. not reconciled yet, are not indexed.
+func StatusIndexFunc(obj interface{}) ([]string, error) {
+	pr, ok := obj.(*v1.PipelineRun)
+	if !ok {
+		return nil, fmt.Errorf("expected a PipelineRun but got %T", obj)
+	}
+	condition := pr.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil {
+		return nil, nil
+	}
+	return []string{string(condition.Status)}, nil
+}
+
+// ListPipelineRunsByStatus returns the PipelineRuns of all the namespaces whose Succeeded condition has the given
+// status, from an indexer with StatusIndex.
+func ListPipelineRunsByStatus(indexer cache.Indexer, status corev1.ConditionStatus) ([]*v1.PipelineRun, error) {
+	objs, err := indexer.ByIndex(StatusIndex, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the PipelineRuns with status %s: %w", status, err)
+	}
+	prs := make([]*v1.PipelineRun, 0, len(objs))
+	for _, obj := range objs {
+		if pr, ok := obj.(*v1.PipelineRun); ok {
+			prs = append(prs, pr)
+		}
+	}
+	return prs, nil
+}
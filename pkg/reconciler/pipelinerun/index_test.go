@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestListPipelineRunsByPipeline(t *testing.T) {
+	pipelineRun := func(namespace, name string, ref *v1.PipelineRef) *v1.PipelineRun {
+		pr := &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1.PipelineRunSpec{PipelineRef: ref},
+		}
+		if ref == nil {
+			pr.Spec.PipelineSpec = &v1.PipelineSpec{}
+		}
+		return pr
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{PipelineNameIndex: PipelineNameIndexFunc})
+	for _, pr := range []*v1.PipelineRun{
+		pipelineRun("foo", "build-1", &v1.PipelineRef{Name: "build"}),
+		pipelineRun("foo", "build-2", &v1.PipelineRef{Name: "build"}),
+		pipelineRun("foo", "deploy-1", &v1.PipelineRef{Name: "deploy"}),
+		pipelineRun("bar", "build-1", &v1.PipelineRef{Name: "build"}),
+		pipelineRun("foo", "embedded-1", nil),
+		pipelineRun("foo", "resolved-1", &v1.PipelineRef{ResolverRef: v1.ResolverRef{Resolver: "git"}}),
+	} {
+		if err := indexer.Add(pr); err != nil {
+			t.Fatalf("Failed to add PipelineRun %s/%s to the indexer: %v", pr.Namespace, pr.Name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		namespace string
+		pipeline  string
+		want      []string
+	}{{
+		name:      "referenced pipeline",
+		namespace: "foo",
+		pipeline:  "build",
+		want:      []string{"build-1", "build-2"},
+	}, {
+		name:      "other namespace",
+		namespace: "bar",
+		pipeline:  "build",
+		want:      []string{"build-1"},
+	}, {
+		name:      "embedded pipelines",
+		namespace: "foo",
+		pipeline:  EmbeddedPipelineName,
+		want:      []string{"embedded-1"},
+	}, {
+		name:      "no pipelineruns",
+		namespace: "foo",
+		pipeline:  "test",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prs, err := ListPipelineRunsByPipeline(indexer, tc.namespace, tc.pipeline)
+			if err != nil {
+				t.Fatalf("ListPipelineRunsByPipeline() unexpected error: %v", err)
+			}
+			var got []string
+			for _, pr := range prs {
+				got = append(got, pr.Name)
+			}
+			sort.Strings(got)
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("ListPipelineRunsByPipeline() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestPipelineNameIndexFunc_NotAPipelineRun(t *testing.T) {
+	if _, err := PipelineNameIndexFunc(&v1.TaskRun{}); err == nil {
+		t.Error("PipelineNameIndexFunc() expected an error for a TaskRun but got none")
+	}
+}
+
+func statusPipelineRun(namespace, name string, status corev1.ConditionStatus) *v1.PipelineRun {
+	pr := &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if status != "" {
+		pr.Status.Status = duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status}}}
+	}
+	return pr
+}
+
+func pipelineRunNames(prs []*v1.PipelineRun) []string {
+	var names []string
+	for _, pr := range prs {
+		names = append(names, pr.Namespace+"/"+pr.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestListPipelineRunsByStatus(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{StatusIndex: StatusIndexFunc})
+	for _, pr := range []*v1.PipelineRun{
+		statusPipelineRun("foo", "succeeded", corev1.ConditionTrue),
+		statusPipelineRun("bar", "succeeded", corev1.ConditionTrue),
+		statusPipelineRun("foo", "failed", corev1.ConditionFalse),
+		statusPipelineRun("foo", "running", corev1.ConditionUnknown),
+		statusPipelineRun("foo", "new", ""),
+	} {
+		if err := indexer.Add(pr); err != nil {
+			t.Fatalf("Failed to add PipelineRun %s/%s to the indexer: %v", pr.Namespace, pr.Name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		status corev1.ConditionStatus
+		want   []string
+	}{{
+		status: corev1.ConditionTrue,
+		want:   []string{"bar/succeeded", "foo/succeeded"},
+	}, {
+		status: corev1.ConditionFalse,
+		want:   []string{"foo/failed"},
+	}, {
+		status: corev1.ConditionUnknown,
+		want:   []string{"foo/running"},
+	}} {
+		t.Run(string(tc.status), func(t *testing.T) {
+			prs, err := ListPipelineRunsByStatus(indexer, tc.status)
+			if err != nil {
+				t.Fatalf("ListPipelineRunsByStatus() unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, pipelineRunNames(prs)); d != "" {
+				t.Errorf("ListPipelineRunsByStatus() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestStatusIndexFunc_NotAPipelineRun(t *testing.T) {
+	if _, err := StatusIndexFunc(&v1.TaskRun{}); err == nil {
+		t.Error("StatusIndexFunc() expected an error for a TaskRun but got none")
+	}
+}
+
+func TestNewController_RegistersIndexers(t *testing.T) {
+	prs := []*v1.PipelineRun{
+		statusPipelineRun("foo", "succeeded", corev1.ConditionTrue),
+		statusPipelineRun("foo", "running", corev1.ConditionUnknown),
+	}
+	prs[0].Spec.PipelineRef = &v1.PipelineRef{Name: "build"}
+	prs[1].Spec.PipelineRef = &v1.PipelineRef{Name: "build"}
+	testAssets, cancel := getPipelineRunController(t, test.Data{PipelineRuns: prs})
+	defer cancel()
+	indexer := testAssets.Informers.PipelineRun.Informer().GetIndexer()
+
+	byPipeline, err := ListPipelineRunsByPipeline(indexer, "foo", "build")
+	if err != nil {
+		t.Fatalf("ListPipelineRunsByPipeline() unexpected error: %v", err)
+	}
+	if d := cmp.Diff([]string{"foo/running", "foo/succeeded"}, pipelineRunNames(byPipeline)); d != "" {
+		t.Errorf("ListPipelineRunsByPipeline() %s", diff.PrintWantGot(d))
+	}
+	byStatus, err := ListPipelineRunsByStatus(indexer, corev1.ConditionTrue)
+	if err != nil {
+		t.Fatalf("ListPipelineRunsByStatus() unexpected error: %v", err)
+	}
+	if d := cmp.Diff([]string{"foo/succeeded"}, pipelineRunNames(byStatus)); d != "" {
+		t.Errorf("ListPipelineRunsByStatus() %s", diff.PrintWantGot(d))
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	rprp "github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/pipelinespec"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/pkg/remote"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// errPipelineChanged indicates that the Pipeline of a PipelineRun doesn't match the PipelineSpec stored in its
+// status anymore, besides the PipelineTasks which have not started yet.
+var errPipelineChanged = errors.New("the Pipeline changed since the PipelineRun started")
+
+// reapplyUpdatedParams detects the params of the PipelineRun which were added, removed or changed since the
+// previous reconcile, by comparing their hashes with the ones recorded in its tekton.dev/param-hashes annotation.
+// If there are any, a Warning event lists them and the new values are substituted in the PipelineTasks of the
+// PipelineSpec stored in the status which have not started yet. The rest of the stored PipelineSpec is kept as
+// is, so the matrix and when expressions of the started PipelineTasks are not evaluated again; the webhook
+// rejects the updates of the params they reference. It returns the names of the updated params.
+func (c *Reconciler) reapplyUpdatedParams(ctx context.Context, pr *v1.PipelineRun, vp []*v1alpha1.VerificationPolicy) []string {
+	hashes := paramHashes(pr.Spec.Params)
+	recorded, ok := pr.Annotations[pipeline.ParamHashesAnnotationKey]
+	if !ok && len(hashes) == 0 {
+		// the hashes are only recorded for the PipelineRuns with params
+		return nil
+	}
+	encoded, err := json.Marshal(hashes)
+	if err != nil {
+		logging.FromContext(ctx).Warnf("Failed to record the param hashes of PipelineRun %s: %v", pr.Name, err)
+		return nil
+	}
+
+	var previous map[string]string
+	var updated []string
+	if ok && json.Unmarshal([]byte(recorded), &previous) == nil {
+		updated = updatedParams(previous, hashes)
+	}
+	reason, message := "ParamsUpdated", "the new values are used by the PipelineTasks which have not started yet"
+	if len(updated) > 0 && pr.Status.PipelineSpec != nil {
+		pipelineSpec, err := c.substituteUpdatedParams(ctx, pr, vp)
+		switch {
+		case errors.Is(err, remote.ErrRequestInProgress):
+			// the hashes are recorded once the Pipeline is resolved, the new values are applied then
+			return nil
+		case err != nil:
+			reason, message = "ParamsNotUpdated", "the new values can't be used by the PipelineTasks which have not started yet: "+err.Error()
+		default:
+			pr.Status.PipelineSpec = pipelineSpec
+		}
+	}
+	if pr.Annotations == nil {
+		pr.Annotations = map[string]string{}
+	}
+	pr.Annotations[pipeline.ParamHashesAnnotationKey] = string(encoded)
+	if len(updated) > 0 {
+		controller.GetEventRecorder(ctx).Eventf(pr, corev1.EventTypeWarning, reason, "Params %v were updated, %s", updated, message)
+	}
+	return updated
+}
+
+// substituteUpdatedParams resolves the Pipeline of the PipelineRun again and substitutes the current params in it.
+// It returns the resulting PipelineSpec, or errPipelineChanged if it differs from the stored one anywhere but in
+// the PipelineTasks which have not started yet.
+func (c *Reconciler) substituteUpdatedParams(ctx context.Context, pr *v1.PipelineRun, vp []*v1alpha1.VerificationPolicy) (*v1.PipelineSpec, error) {
+	unsubstituted := pr.DeepCopy()
+	unsubstituted.Status.PipelineSpec = nil
+	getPipelineFunc := resources.GetPipelineFunc(ctx, c.KubeClientSet, c.PipelineClientSet, c.resolutionRequester, unsubstituted, vp)
+	pipelineMeta, pipelineSpec, err := rprp.GetPipelineData(ctx, unsubstituted, getPipelineFunc)
+	if err != nil {
+		return nil, err
+	}
+	// the ParamSources are substituted with their values like in the stored spec
+	sourcedParams, err := resources.ResolveParamSources(ctx, c.KubeClientSet, c.secretLister, pr)
+	if err != nil {
+		return nil, err
+	}
+	unsubstituted.Spec.Params = append(unsubstituted.Spec.Params, sourcedParams...)
+	pipelineSpec, substitutions, err := resources.DeepApplyParameters(ctx, pipelineSpec, unsubstituted)
+	if err != nil {
+		return nil, err
+	}
+	pipelineSpec, substitutions = resources.ApplyContexts(ctx, pipelineSpec, &v1.Pipeline{ObjectMeta: *pipelineMeta.ObjectMeta}, pr, substitutions)
+	pipelineSpec, _ = resources.ApplyWorkspaces(pipelineSpec, pr, substitutions)
+
+	notStarted := sets.New[string]()
+	for _, pt := range append(append([]v1.PipelineTask{}, pipelineSpec.Tasks...), pipelineSpec.Finally...) {
+		notStarted.Insert(pt.Name)
+	}
+	for _, child := range pr.Status.ChildReferences {
+		notStarted.Delete(child.PipelineTaskName)
+	}
+	if !equality.Semantic.DeepEqual(withoutPipelineTasks(pr.Status.PipelineSpec, notStarted), withoutPipelineTasks(pipelineSpec, notStarted)) {
+		return nil, errPipelineChanged
+	}
+	return pipelineSpec, nil
+}
+
+// withoutPipelineTasks returns a copy of the PipelineSpec in which the named PipelineTasks only keep their name.
+func withoutPipelineTasks(spec *v1.PipelineSpec, names sets.Set[string]) *v1.PipelineSpec {
+	spec = spec.DeepCopy()
+	for _, tasks := range [][]v1.PipelineTask{spec.Tasks, spec.Finally} {
+		for i := range tasks {
+			if names.Has(tasks[i].Name) {
+				tasks[i] = v1.PipelineTask{Name: tasks[i].Name}
+			}
+		}
+	}
+	return spec
+}
+
+// updatedParams returns the sorted names of the params whose hash differs between previous and current.
+func updatedParams(previous, current map[string]string) []string {
+	var updated []string
+	for name, hash := range current {
+		if previous[name] != hash {
+			updated = append(updated, name)
+		}
+	}
+	for name := range previous {
+		if _, ok := current[name]; !ok {
+			updated = append(updated, name)
+		}
+	}
+	sort.Strings(updated)
+	return updated
+}
+
+// recordParamConsumers records the names of the PipelineTasks which reference each param of the PipelineRun in
+// its tekton.dev/param-consumers annotation, so that the webhook can reject the updates of the params used by the
+// PipelineTasks which have started. pipelineSpec must not have its params substituted yet.
+func recordParamConsumers(ctx context.Context, pr *v1.PipelineRun, pipelineSpec *v1.PipelineSpec) {
+	names := sets.New[string]()
+	for _, p := range pipelineSpec.Params {
+		names.Insert(p.Name)
+	}
+	for _, p := range pr.Spec.Params {
+		names.Insert(p.Name)
+	}
+	if names.Len() == 0 {
+		// the consumers are only recorded for the PipelineRuns with params
+		return
+	}
+	encoded, err := json.Marshal(resources.ParamConsumers(pipelineSpec, sets.List(names)))
+	if err != nil {
+		logging.FromContext(ctx).Warnf("Failed to record the param consumers of PipelineRun %s: %v", pr.Name, err)
+		return
+	}
+	if pr.Annotations == nil {
+		pr.Annotations = map[string]string{}
+	}
+	pr.Annotations[pipeline.ParamConsumersAnnotationKey] = string(encoded)
+}
+
+// paramHashes returns the hashes of the values of the given params, by param name.
+func paramHashes(params v1.Params) map[string]string {
+	hashes := make(map[string]string, len(params))
+	for _, p := range params {
+		value, err := json.Marshal(p.Value)
+		if err != nil {
+			continue
+		}
+		sum := sha256.Sum256(value)
+		hashes[p.Name] = hex.EncodeToString(sum[:])[:10]
+	}
+	return hashes
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+)
+
+func TestReapplyUpdatedParams(t *testing.T) {
+	params := v1.Params{{
+		Name:  "tag",
+		Value: *v1.NewStructuredValues("v1"),
+	}, {
+		Name:  "flags",
+		Value: *v1.NewStructuredValues("-v", "-x"),
+	}}
+	recorded := func(params v1.Params) string {
+		encoded, err := json.Marshal(paramHashes(params))
+		if err != nil {
+			t.Fatalf("Unexpected error marshalling the param hashes: %v", err)
+		}
+		return string(encoded)
+	}
+	pipelineSpec := func(flags v1.ParamValue, tag string) *v1.PipelineSpec {
+		return &v1.PipelineSpec{
+			Params: v1.ParamSpecs{{Name: "tag", Type: v1.ParamTypeString}, {Name: "flags", Type: v1.ParamTypeArray}},
+			Tasks: []v1.PipelineTask{{
+				Name:    "build",
+				TaskRef: &v1.TaskRef{Name: "build", Kind: v1.NamespacedTaskKind},
+				Params:  v1.Params{{Name: "flags", Value: flags}},
+			}, {
+				Name:    "deploy",
+				TaskRef: &v1.TaskRef{Name: "deploy", Kind: v1.NamespacedTaskKind},
+				Params:  v1.Params{{Name: "tag", Value: *v1.NewStructuredValues(tag)}},
+			}},
+		}
+	}
+	updatedParams := v1.Params{{Name: "tag", Value: *v1.NewStructuredValues("v2")}, params[1]}
+
+	for _, tc := range []struct {
+		name             string
+		params           v1.Params
+		annotations      map[string]string
+		storedSpec       *v1.PipelineSpec
+		wantUpdated      []string
+		wantAnnotation   bool
+		wantPipelineSpec *v1.PipelineSpec
+		wantEvents       []string
+	}{{
+		name:             "no params",
+		storedSpec:       pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+		wantPipelineSpec: pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+	}, {
+		name:             "hashes recorded for the first time",
+		params:           params,
+		storedSpec:       pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+		wantAnnotation:   true,
+		wantPipelineSpec: pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+	}, {
+		name:             "params not updated",
+		params:           params,
+		annotations:      map[string]string{pipeline.ParamHashesAnnotationKey: recorded(params)},
+		storedSpec:       pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+		wantAnnotation:   true,
+		wantPipelineSpec: pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+	}, {
+		name:             "params updated",
+		params:           updatedParams,
+		annotations:      map[string]string{pipeline.ParamHashesAnnotationKey: recorded(params)},
+		storedSpec:       pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+		wantUpdated:      []string{"tag"},
+		wantAnnotation:   true,
+		wantPipelineSpec: pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v2"),
+		wantEvents:       []string{"Warning ParamsUpdated Params [tag] were updated, the new values are used by the PipelineTasks which have not started yet"},
+	}, {
+		name:             "params updated before the PipelineSpec is stored",
+		params:           updatedParams,
+		annotations:      map[string]string{pipeline.ParamHashesAnnotationKey: recorded(params)},
+		wantUpdated:      []string{"tag"},
+		wantAnnotation:   true,
+		wantPipelineSpec: nil,
+		wantEvents:       []string{"Warning ParamsUpdated Params [tag] were updated, the new values are used by the PipelineTasks which have not started yet"},
+	}, {
+		name:             "params updated but the started PipelineTasks changed",
+		params:           updatedParams,
+		annotations:      map[string]string{pipeline.ParamHashesAnnotationKey: recorded(params)},
+		storedSpec:       pipelineSpec(*v1.NewStructuredValues("-v"), "v1"),
+		wantUpdated:      []string{"tag"},
+		wantAnnotation:   true,
+		wantPipelineSpec: pipelineSpec(*v1.NewStructuredValues("-v"), "v1"),
+		wantEvents:       []string{"Warning ParamsNotUpdated Params [tag] were updated, the new values can't be used by the PipelineTasks which have not started yet: the Pipeline changed since the PipelineRun started"},
+	}, {
+		name:             "invalid recorded hashes",
+		params:           params,
+		annotations:      map[string]string{pipeline.ParamHashesAnnotationKey: "invalid"},
+		storedSpec:       pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+		wantAnnotation:   true,
+		wantPipelineSpec: pipelineSpec(*v1.NewStructuredValues("-v", "-x"), "v1"),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo", Annotations: tc.annotations},
+				Spec: v1.PipelineRunSpec{
+					PipelineSpec: pipelineSpec(*v1.NewStructuredValues("$(params.flags[*])"), "$(params.tag)"),
+					Params:       tc.params,
+				},
+				Status: v1.PipelineRunStatus{
+					PipelineRunStatusFields: v1.PipelineRunStatusFields{
+						PipelineSpec:    tc.storedSpec,
+						ChildReferences: []v1.ChildStatusReference{{Name: "pr-build", PipelineTaskName: "build"}},
+					},
+				},
+			}
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+			c := &Reconciler{}
+			updated := c.reapplyUpdatedParams(ctx, pr, nil)
+			if d := cmp.Diff(tc.wantUpdated, updated); d != "" {
+				t.Errorf("Unexpected updated params %s", diff.PrintWantGot(d))
+			}
+			annotation, ok := pr.Annotations[pipeline.ParamHashesAnnotationKey]
+			if ok != tc.wantAnnotation {
+				t.Errorf("Expected the param hashes annotation to be recorded: %t, got %t", tc.wantAnnotation, ok)
+			}
+			if ok && annotation != recorded(tc.params) {
+				t.Errorf("Expected the hashes of the current params to be recorded, got %s", annotation)
+			}
+			if d := cmp.Diff(tc.wantPipelineSpec, pr.Status.PipelineSpec); d != "" {
+				t.Errorf("Unexpected PipelineSpec in the status %s", diff.PrintWantGot(d))
+			}
+			var events []string
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			if d := cmp.Diff(tc.wantEvents, events); d != "" {
+				t.Errorf("Unexpected events %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestRecordParamConsumers(t *testing.T) {
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"},
+		Spec: v1.PipelineRunSpec{
+			Params: v1.Params{{Name: "propagated", Value: *v1.NewStructuredValues("value")}},
+		},
+	}
+	pipelineSpec := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{{Name: "tag", Type: v1.ParamTypeString}, {Name: "unused", Type: v1.ParamTypeString}},
+		Tasks: []v1.PipelineTask{{
+			Name:    "build",
+			TaskRef: &v1.TaskRef{Name: "build"},
+			Params:  v1.Params{{Name: "tag", Value: *v1.NewStructuredValues("$(params.tag)")}},
+		}, {
+			Name: "echo",
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{Name: "echo", Image: "busybox", Script: "echo $(params.propagated)"}},
+			}},
+		}},
+		Finally: []v1.PipelineTask{{
+			Name:    "notify",
+			TaskRef: &v1.TaskRef{Name: "notify"},
+			When:    v1.WhenExpressions{{Input: "$(params.tag)", Operator: selection.In, Values: []string{"latest"}}},
+		}},
+	}
+
+	recordParamConsumers(context.Background(), pr, pipelineSpec)
+	want := `{"propagated":["echo"],"tag":["build","notify"]}`
+	if d := cmp.Diff(want, pr.Annotations[pipeline.ParamConsumersAnnotationKey]); d != "" {
+		t.Errorf("Unexpected param consumers %s", diff.PrintWantGot(d))
+	}
+}
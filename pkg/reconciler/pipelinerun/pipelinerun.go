@@ -69,6 +69,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/clock"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/controller"
@@ -146,8 +147,9 @@ var (
 // "ControllerName" const in describing the type of run, we import these
 // constants (for consistency) but rename them (for ergonomic semantics).
 const (
-	taskRun   = pipeline.TaskRunControllerName
-	customRun = pipeline.CustomRunControllerName
+	taskRun     = pipeline.TaskRunControllerName
+	customRun   = pipeline.CustomRunControllerName
+	pipelineRun = pipeline.PipelineRunControllerName
 )
 
 // Reconciler implements controller.Reconciler for Configuration resources.
@@ -162,6 +164,7 @@ type Reconciler struct {
 	taskRunLister            listers.TaskRunLister
 	customRunLister          beta1listers.CustomRunLister
 	verificationPolicyLister alpha1listers.VerificationPolicyLister
+	secretLister             corev1listers.SecretLister
 	cloudEventClient         cloudevent.CEClient
 	metrics                  *pipelinerunmetrics.Recorder
 	pvcHandler               volumeclaim.PvcHandler
@@ -231,6 +234,11 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) pkgr
 	if err != nil {
 		return fmt.Errorf("failed to list VerificationPolicies from namespace %s with error %w", pr.Namespace, err)
 	}
+	// The params can be updated while the PipelineRun is running, the new values are then applied to the
+	// PipelineTasks of the stored PipelineSpec which have not started yet.
+	if !pr.IsDone() {
+		c.reapplyUpdatedParams(ctx, pr, vp)
+	}
 	getPipelineFunc := resources.GetPipelineFunc(ctx, c.KubeClientSet, c.PipelineClientSet, c.resolutionRequester, pr, vp)
 
 	if pr.IsDone() {
@@ -239,7 +247,11 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) pkgr
 		if err != nil {
 			logger.Errorf("Failed to delete StatefulSet or PVC for PipelineRun %s: %v", pr.Name, err)
 		}
-		return c.finishReconcileUpdateEmitEvents(ctx, pr, before, err)
+		if err := c.finishReconcileUpdateEmitEvents(ctx, pr, before, err); err != nil {
+			return err
+		}
+		// the cleanup finally tasks ran with the other finally tasks
+		return c.updateCleanupFinalizer(ctx, pr)
 	}
 
 	if err := propagatePipelineNameLabelToPipelineRun(pr); err != nil {
@@ -271,6 +283,11 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) pkgr
 		return err
 	}
 
+	// the cleanup finalizer is added once the PipelineSpec is known
+	if err := c.updateCleanupFinalizer(ctx, pr); err != nil {
+		return err
+	}
+
 	if pr.Status.StartTime != nil {
 		// Compute the time since the task started.
 		elapsed := c.Clock.Since(pr.Status.StartTime.Time)
@@ -372,6 +389,9 @@ func (c *Reconciler) resolvePipelineState(
 				return c.taskRunLister.TaskRuns(pr.Namespace).Get(name)
 			},
 			getCustomRunFunc,
+			func(name string) (*v1.PipelineRun, error) {
+				return c.pipelineRunLister.PipelineRuns(pr.Namespace).Get(name)
+			},
 			task,
 			pst,
 		)
@@ -420,6 +440,9 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		return nil
 	}
 
+	// The stored PipelineSpec has its params substituted, the embedded one is used as is
+	firstResolution := pr.Status.PipelineSpec == nil
+	unsubstituted := firstResolution || pr.Spec.PipelineSpec != nil
 	pipelineMeta, pipelineSpec, err := rprp.GetPipelineData(ctx, pr, getPipelineFunc)
 	switch {
 	case errors.Is(err, remote.ErrRequestInProgress):
@@ -445,6 +468,9 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		if err := storePipelineSpecAndMergeMeta(ctx, pr, pipelineSpec, pipelineMeta); err != nil {
 			logger.Errorf("Failed to store PipelineSpec on PipelineRun.Status for pipelinerun %s: %v", pr.Name, err)
 		}
+		if unsubstituted {
+			recordParamConsumers(ctx, pr, pipelineSpec)
+		}
 	}
 
 	if pipelineMeta.VerificationResult != nil {
@@ -478,7 +504,8 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		return controller.NewPermanentError(err)
 	}
 
-	if err := pipelineSpec.Validate(ctx); err != nil {
+	// Warnings, e.g. about results which can't be validated before the Tasks are resolved, don't fail the Run
+	if err := pipelineSpec.Validate(ctx).Filter(apis.ErrorLevel); err != nil {
 		// This Run has failed, so we need to mark it as failed and stop reconciling it
 		pr.Status.MarkFailed(v1.PipelineRunReasonFailedValidation.String(),
 			"Pipeline %s/%s can't be Run; it has an invalid spec: %s",
@@ -486,8 +513,28 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		return controller.NewPermanentError(err)
 	}
 
+	// The values of the ParamSources are fetched from Secrets and ConfigMaps and handled like the Params from
+	// now on, so they are substituted in the resolved PipelineSpec and the runs. They are never logged.
+	if len(pr.Spec.ParamSources) > 0 {
+		sourcedParams, err := resources.ResolveParamSources(ctx, c.KubeClientSet, c.secretLister, pr)
+		if err != nil {
+			if !errors.Is(err, resources.ErrInvalidParamSource) {
+				return err
+			}
+			// This Run has failed, so we need to mark it as failed and stop reconciling it
+			pr.Status.MarkFailed(v1.PipelineRunReasonInvalidParamSource.String(),
+				"PipelineRun %s/%s can't resolve its paramSources: %s",
+				pr.Namespace, pr.Name, pipelineErrors.WrapUserError(err))
+			return controller.NewPermanentError(err)
+		}
+		pr.Spec.Params = append(pr.Spec.Params, sourcedParams...)
+	}
+
+	// The ParamDefaults of the PipelineRun override the defaults declared in the Pipeline
+	paramSpecs := resources.ParamSpecsWithRunDefaults(pipelineSpec, pr)
+
 	// Ensure that the PipelineRun provides all the parameters required by the Pipeline
-	if err := resources.ValidateRequiredParametersProvided(&pipelineSpec.Params, &pr.Spec.Params); err != nil {
+	if err := resources.ValidateRequiredParametersProvided(&paramSpecs, &pr.Spec.Params); err != nil {
 		// This Run has failed, so we need to mark it as failed and stop reconciling it
 		pr.Status.MarkFailed(v1.PipelineRunReasonParameterMissing.String(),
 			"PipelineRun %s/%s is missing some parameters required by Pipeline %s/%s: %s",
@@ -495,6 +542,33 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		return controller.NewPermanentError(err)
 	}
 
+	// The params which are not declared in the Pipeline are still used for substitutions, they are reported once
+	if firstResolution {
+		for _, name := range resources.UndeclaredParams(pipelineSpec, pr) {
+			controller.GetEventRecorder(ctx).Eventf(pr, corev1.EventTypeWarning, "UndeclaredParameter",
+				"Parameter %q provided by PipelineRun %s is not declared in the Pipeline", name, pr.Name)
+		}
+	}
+
+	// Ensure that the PipelineRun doesn't provide the deprecated parameters removed in the version of the Pipeline
+	if pr.Spec.PipelineRef != nil && pipelineMeta.ObjectMeta != nil {
+		// The deprecated params of an embedded PipelineSpec are already reported by the admission webhook
+		if firstResolution {
+			for _, warning := range resources.DeprecatedParams(pipelineSpec, pr.Spec.Params) {
+				controller.GetEventRecorder(ctx).Eventf(pr, corev1.EventTypeWarning, "DeprecatedParameter",
+					"PipelineRun %s provides a deprecated parameter: %s", pr.Name, warning)
+			}
+		}
+		pipelineVersion := pipelineMeta.Labels[resources.PipelineVersionLabelKey]
+		if err := resources.ValidateRemovedParams(pipelineSpec, pipelineVersion, pr.Spec.Params); err != nil {
+			// This Run has failed, so we need to mark it as failed and stop reconciling it
+			pr.Status.MarkFailed(v1.PipelineRunReasonParameterRemoved.String(),
+				"PipelineRun %s/%s provides some parameters removed from Pipeline %s/%s: %s",
+				pr.Namespace, pr.Name, pr.Namespace, pipelineMeta.Name, err)
+			return controller.NewPermanentError(err)
+		}
+	}
+
 	// Ensure that the parameters from the PipelineRun are overriding Pipeline parameters with the same type.
 	// Weird substitution issues can occur if this is not validated (ApplyParameters() does not verify type).
 	if err = resources.ValidateParamTypesMatching(pipelineSpec, pr); err != nil {
@@ -516,7 +590,7 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 	}
 
 	// Ensure that the keys of an object param declared in PipelineSpec are not missed in the PipelineRunSpec
-	if err = resources.ValidateObjectParamRequiredKeys(pipelineSpec.Params, pr.Spec.Params); err != nil {
+	if err = resources.ValidateObjectParamRequiredKeys(paramSpecs, pr.Spec.Params); err != nil {
 		// This Run has failed, so we need to mark it as failed and stop reconciling it
 		pr.Status.MarkFailed(v1.PipelineRunReasonObjectParameterMissKeys.String(),
 			"PipelineRun %s/%s parameters is missing object keys required by Pipeline %s/%s's parameters: %s",
@@ -533,6 +607,15 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		return controller.NewPermanentError(err)
 	}
 
+	// Ensure that the embedded task specs do not reference variables denied by the substitution policy
+	if err := resources.ValidateSubstitutionPolicy(config.FromContextOrDefaults(ctx).SubstitutionPolicy, pipelineSpec); err != nil {
+		// This Run has failed, so we need to mark it as failed and stop reconciling it
+		pr.Status.MarkFailed(v1.PipelineRunReasonFailedValidation.String(),
+			"PipelineRun %s/%s failed validation: Pipeline %s/%s references variables denied by the substitution policy: %s",
+			pr.Namespace, pr.Name, pr.Namespace, pipelineMeta.Name, err)
+		return controller.NewPermanentError(err)
+	}
+
 	// Ensure that the workspaces expected by the Pipeline are provided by the PipelineRun.
 	if err := resources.ValidateWorkspaceBindings(pipelineSpec, pr); err != nil {
 		pr.Status.MarkFailed(v1.PipelineRunReasonInvalidWorkspaceBinding.String(),
@@ -549,17 +632,37 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		return controller.NewPermanentError(err)
 	}
 
-	resources.ApplyParametersToWorkspaceBindings(ctx, pr)
+	// Ensure that the PipelineRun doesn't provide the same param more than once, the value to substitute being ambiguous.
+	if err := resources.ApplyParametersToWorkspaceBindings(ctx, pipelineSpec, pr); err != nil {
+		pr.Status.MarkFailed(v1.PipelineRunReasonInvalidParamValue.String(),
+			"PipelineRun %s/%s parameters are invalid: %s",
+			pr.Namespace, pr.Name, pipelineErrors.WrapUserError(err))
+		return controller.NewPermanentError(err)
+	}
+	if err := resources.ApplyParametersToTaskRunSpecs(ctx, pipelineSpec, pr); err != nil {
+		pr.Status.MarkFailed(v1.PipelineRunReasonInvalidParamValue.String(),
+			"PipelineRun %s/%s parameters are invalid: %s",
+			pr.Namespace, pr.Name, pipelineErrors.WrapUserError(err))
+		return controller.NewPermanentError(err)
+	}
 	// Make a deep copy of the Pipeline and its Tasks before value substution.
 	// This is used to find referenced pipeline-level params at each PipelineTask when validate param enum subset requirement
 	originalPipeline := pipelineSpec.DeepCopy()
 	originalTasks := originalPipeline.Tasks
 	originalTasks = append(originalTasks, originalPipeline.Finally...)
 
-	// Apply parameter substitution from the PipelineRun
-	pipelineSpec = resources.ApplyParameters(ctx, pipelineSpec, pr)
-	pipelineSpec = resources.ApplyContexts(pipelineSpec, pipelineMeta.Name, pr)
-	pipelineSpec = resources.ApplyWorkspaces(pipelineSpec, pr)
+	// Apply parameter substitution from the PipelineRun, including to the embedded child pipelines
+	var substitutions *resources.SubstitutionContext
+	pipelineSpec, substitutions, err = resources.DeepApplyParameters(ctx, pipelineSpec, pr)
+	if err != nil {
+		pr.Status.MarkFailed(v1.PipelineRunReasonInvalidParamValue.String(),
+			"PipelineRun %s/%s parameters are invalid: %s",
+			pr.Namespace, pr.Name, pipelineErrors.WrapUserError(err))
+		return controller.NewPermanentError(err)
+	}
+	pipelineSpec, substitutions = resources.ApplyContexts(ctx, pipelineSpec, &v1.Pipeline{ObjectMeta: *pipelineMeta.ObjectMeta}, pr, substitutions)
+	pipelineSpec, substitutions = resources.ApplyWorkspaces(pipelineSpec, pr, substitutions)
+	logger.Debugf("Applied %d substitution steps to the PipelineSpec of PipelineRun %s", len(substitutions.History()), pr.Name)
 	// Update pipelinespec of pipelinerun's status field
 	pr.Status.PipelineSpec = pipelineSpec
 
@@ -660,7 +763,7 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 	}
 
 	for i, rpt := range pipelineRunFacts.State {
-		if !rpt.IsCustomTask() {
+		if !rpt.IsCustomTask() && !rpt.IsChildPipeline() {
 			err := taskrun.ValidateResolvedTask(ctx, rpt.PipelineTask.Params, rpt.PipelineTask.Matrix, rpt.ResolvedTask)
 			if err != nil {
 				logger.Errorf("Failed to validate pipelinerun %s with error %w", pr.Name, err)
@@ -809,17 +912,25 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 	after = pr.Status.GetCondition(apis.ConditionSucceeded)
 	pr.Status.StartTime = pipelineRunFacts.State.AdjustStartTime(pr.Status.StartTime)
 
-	pr.Status.ChildReferences = pipelineRunFacts.GetChildReferences()
+	pr.Status.ChildReferences = pipelineRunFacts.GetChildReferences(ctx)
+
+	// The resolved PipelineSpec is only kept in the status of the PipelineRuns which ask for it, to not grow the
+	// PipelineRuns stored in etcd.
+	if pr.Annotations[pipeline.StoreResolvedSpecAnnotationKey] == "true" {
+		pr.Status.ResolvedPipelineSpec = pipelineRunFacts.GetResolvedPipelineSpec(ctx, pipelineSpec, pr)
+	} else {
+		pr.Status.ResolvedPipelineSpec = nil
+	}
 
-	pr.Status.SkippedTasks = pipelineRunFacts.GetSkippedTasks()
+	pr.Status.SkippedTasks = pipelineRunFacts.GetSkippedTasks(ctx)
 
-	taskStatus := pipelineRunFacts.GetPipelineTaskStatus()
-	finalTaskStatus := pipelineRunFacts.GetPipelineFinalTaskStatus()
+	taskStatus := pipelineRunFacts.GetPipelineTaskStatus(ctx)
+	finalTaskStatus := pipelineRunFacts.GetPipelineFinalTaskStatus(ctx)
 	taskStatus = kmap.Union(taskStatus, finalTaskStatus)
 
 	if after.Status == corev1.ConditionTrue || after.Status == corev1.ConditionFalse {
-		pr.Status.Results, err = resources.ApplyTaskResultsToPipelineResults(ctx, pipelineSpec.Results,
-			pipelineRunFacts.State.GetTaskRunsResults(), pipelineRunFacts.State.GetRunsResults(), taskStatus)
+		pr.Status.Results, _, err = resources.ApplyTaskResultsToPipelineResults(ctx, pipelineSpec.Results,
+			pipelineRunFacts.State.GetTaskRunsResults().ToMap(), pipelineRunFacts.State.GetRunsResults(), taskStatus, nil)
 		if err != nil {
 			pr.Status.MarkFailed(v1.PipelineRunReasonCouldntGetPipelineResult.String(),
 				"Failed to get PipelineResult from TaskRun Results for PipelineRun %s: %s",
@@ -828,6 +939,13 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		}
 	}
 
+	// The history of the Pipeline is pruned as the PipelineRun finishes, while the metadata of the Pipeline is known
+	if pr.IsDone() && pr.Spec.PipelineRef != nil {
+		if err := c.prunePipelineRunHistory(ctx, pr, pipelineMeta.ObjectMeta); err != nil {
+			logger.Errorf("Failed to prune the history of PipelineRun %s: %v", pr.Name, err)
+		}
+	}
+
 	logger.Infof("PipelineRun %s status is being set to %s", pr.Name, after)
 	return nil
 }
@@ -843,7 +961,7 @@ func (c *Reconciler) runNextSchedulableTask(ctx context.Context, pr *v1.Pipeline
 	recorder := controller.GetEventRecorder(ctx)
 
 	// nextRpts holds a list of pipeline tasks which should be executed next
-	nextRpts, err := pipelineRunFacts.DAGExecutionQueue()
+	nextRpts, err := pipelineRunFacts.DAGExecutionQueue(ctx)
 	if err != nil {
 		logger.Errorf("Error getting potential next tasks for valid pipelinerun %s: %v", pr.Name, err)
 		return controller.NewPermanentError(err)
@@ -866,20 +984,22 @@ func (c *Reconciler) runNextSchedulableTask(ctx context.Context, pr *v1.Pipeline
 		}
 	}
 	// GetFinalTasks only returns final tasks when a DAG is complete
-	fNextRpts := pipelineRunFacts.GetFinalTasks()
+	fNextRpts := pipelineRunFacts.GetFinalTasks(ctx)
 	if len(fNextRpts) != 0 {
 		// apply the runtime context just before creating taskRuns for final tasks in queue
-		resources.ApplyPipelineTaskStateContext(fNextRpts, pipelineRunFacts.GetPipelineTaskStatus())
+		resources.ApplyPipelineTaskStateContext(fNextRpts, pipelineRunFacts.GetPipelineTaskStatus(ctx), pr)
 
 		// Before creating TaskRun for scheduled final task, check if it's consuming a task result
 		// Resolve and apply task result wherever applicable, report warning in case resolution fails
 		for _, rpt := range fNextRpts {
-			resolvedResultRefs, _, err := resources.ResolveResultRef(pipelineRunFacts.State, rpt)
-			if err != nil {
+			if _, _, err := resources.ResolveResultRef(pipelineRunFacts.State, rpt); err != nil {
 				logger.Infof("Final task %q is not executed as it could not resolve task params for %q: %v", rpt.PipelineTask.Name, pr.Name, err)
 				continue
 			}
-			resources.ApplyTaskResults(resources.PipelineRunState{rpt}, resolvedResultRefs)
+			if err := resources.ApplyResultsToFinallyTasks(resources.PipelineRunState{rpt}, pipelineRunFacts); err != nil {
+				logger.Infof("Final task %q is not executed as it could not apply task results for %q: %v", rpt.PipelineTask.Name, pr.Name, err)
+				continue
+			}
 
 			if err := rpt.EvaluateCEL(); err != nil {
 				logger.Errorf("Final task %q is not executed, due to error evaluating CEL %s: %v", rpt.PipelineTask.Name, pr.Name, err)
@@ -890,34 +1010,70 @@ func (c *Reconciler) runNextSchedulableTask(ctx context.Context, pr *v1.Pipeline
 
 			nextRpts = append(nextRpts, rpt)
 		}
+
+		// propagate the artifacts of the completed tasks to the final tasks
+		if err := resources.ApplyArtifactsToFinallyTasks(fNextRpts, pipelineRunFacts.State); err != nil {
+			logger.Errorf("Failed to propagate artifacts to final tasks due to error: %v", err)
+			return controller.NewPermanentError(err)
+		}
 	}
 
 	// If FinallyStartTime is not set, and one or more final tasks has been created
 	// Try to set the FinallyStartTime of this PipelineRun
-	if pr.Status.FinallyStartTime == nil && pipelineRunFacts.IsFinalTaskStarted() {
+	if pr.Status.FinallyStartTime == nil && pipelineRunFacts.IsFinalTaskStarted(ctx) {
 		c.setFinallyStartedTimeIfNeeded(pr, pipelineRunFacts)
 	}
 
-	resources.ApplyResultsToWorkspaceBindings(pipelineRunFacts.State.GetTaskRunsResults(), pr)
+	// the workspace bindings referencing task results are resolved just before the runs binding them are created
+	pendingWorkspaces, err := resources.PendingWorkspaceBindings(pr.Spec.Workspaces)
+	if err != nil {
+		logger.Errorf("Failed to apply task results to workspace bindings for %q with error %v", pr.Name, err)
+		pr.Status.MarkFailed(v1.PipelineRunReasonInvalidWorkspaceBinding.String(),
+			"Failed to apply task results to workspace bindings for PipelineRun %s: %s", pr.Name, err)
+		return controller.NewPermanentError(err)
+	}
 
 	for _, rpt := range nextRpts {
 		if rpt.IsFinalTask(pipelineRunFacts) {
 			c.setFinallyStartedTimeIfNeeded(pr, pipelineRunFacts)
 		}
 
-		if rpt == nil || rpt.Skip(pipelineRunFacts).IsSkipped || rpt.IsFinallySkipped(pipelineRunFacts).IsSkipped {
+		if rpt == nil || rpt.Skip(ctx, pipelineRunFacts).IsSkipped || rpt.IsFinallySkipped(ctx, pipelineRunFacts).IsSkipped {
 			continue
 		}
 
 		// propagate previous task results
-		resources.PropagateResults(rpt, pipelineRunFacts.State)
+		resultsReport, err := resources.PropagateResults(ctx, rpt, pipelineRunFacts.State)
+		if err != nil {
+			// the pipeline task is scheduled again once the upstream pipeline task completes,
+			// the other pipeline tasks are scheduled now
+			logger.Infof("Not creating the runs of pipeline task %q of %q yet: %v", rpt.PipelineTask.Name, pr.Name, err)
+			continue
+		}
+
+		// apply the results available now to the workspace bindings of the pipeline task
+		if err := resources.ResolvePendingWorkspaceBindings(ctx, rpt.PipelineTask, pendingWorkspaces, pipelineRunFacts, pr); err != nil {
+			if errors.Is(err, resources.ErrUpstreamResultNotAvailable) {
+				// the pipeline task is scheduled again once the upstream pipeline task completes,
+				// the other pipeline tasks are scheduled now
+				logger.Infof("Not creating the runs of pipeline task %q of %q yet: %v", rpt.PipelineTask.Name, pr.Name, err)
+				continue
+			}
+			logger.Errorf("Failed to apply task results to workspace bindings for %q with error %v", pr.Name, err)
+			pr.Status.MarkFailed(v1.PipelineRunReasonInvalidWorkspaceBinding.String(),
+				"Failed to apply task results to workspace bindings for PipelineRun %s: %s", pr.Name, err)
+			return controller.NewPermanentError(err)
+		}
 
 		// propagate previous task artifacts
-		err = resources.PropagateArtifacts(rpt, pipelineRunFacts.State)
+		artifactsReport, err := resources.PropagateArtifacts(rpt, pipelineRunFacts.State)
 		if err != nil {
 			logger.Errorf("Failed to propagate artifacts due to error: %v", err)
 			return controller.NewPermanentError(err)
 		}
+		if unresolved := append(resultsReport.Unresolved, artifactsReport.Unresolved...); len(unresolved) > 0 {
+			logger.Infof("References %v in pipeline task %q of %q were not resolved by the propagated results and artifacts", unresolved, rpt.PipelineTask.Name, pr.Name)
+		}
 
 		// Validate parameter types in matrix after apply substitutions from Task Results
 		if rpt.PipelineTask.IsMatrixed() {
@@ -929,14 +1085,40 @@ func (c *Reconciler) runNextSchedulableTask(ctx context.Context, pr *v1.Pipeline
 			}
 		}
 
-		if rpt.IsCustomTask() {
+		// Parse the pipeline task timeout after apply substitutions from Params and Task Results
+		// if error found, no TaskRun is created and present rpt will be
+		// added to the validationFailedTask list
+		if err := resources.ApplyPipelineTaskTimeout(rpt.PipelineTask); err != nil {
+			logger.Infof("Failed to apply timeout of pipeline task %q for %q with error %v", rpt.PipelineTask.Name, pr.Name, err)
+			logger.Infof("Adding the task %q to the validation failed list", rpt.PipelineTask.Name)
+			pipelineRunFacts.ValidationFailedTask = append(pipelineRunFacts.ValidationFailedTask, rpt)
+			continue
+		}
+
+		// the pipeline task contexts are the last substitutions applied, when the runs are created,
+		// any variable left afterwards is passed as a literal to the runs
+		pt := resources.ApplyPipelineTaskContexts(ctx, rpt.PipelineTask, pr, pipelineRunFacts)
+		for _, uv := range resources.FindUnresolvedVariables(&v1.PipelineSpec{Tasks: []v1.PipelineTask{*pt}}) {
+			recorder.Eventf(pr, corev1.EventTypeWarning, "UnresolvedSubstitution",
+				"Variable %q in %s of pipeline task %q was not resolved", uv.Variable, uv.Field, uv.PipelineTask)
+		}
+
+		switch {
+		case rpt.IsChildPipeline():
+			rpt.ChildPipelineRuns, err = c.createChildPipelineRuns(ctx, rpt, pr, pipelineRunFacts)
+			if err != nil {
+				recorder.Eventf(pr, corev1.EventTypeWarning, "PipelineRunsCreationFailed", "Failed to create child PipelineRuns %q: %v", rpt.ChildPipelineRunNames, err)
+				err = fmt.Errorf("error creating child PipelineRuns called %s for PipelineTask %s from PipelineRun %s: %w", rpt.ChildPipelineRunNames, rpt.PipelineTask.Name, pr.Name, err)
+				return err
+			}
+		case rpt.IsCustomTask():
 			rpt.CustomRuns, err = c.createCustomRuns(ctx, rpt, pr, pipelineRunFacts)
 			if err != nil {
 				recorder.Eventf(pr, corev1.EventTypeWarning, "RunsCreationFailed", "Failed to create CustomRuns %q: %v", rpt.CustomRunNames, err)
 				err = fmt.Errorf("error creating CustomRuns called %s for PipelineTask %s from PipelineRun %s: %w", rpt.CustomRunNames, rpt.PipelineTask.Name, pr.Name, err)
 				return err
 			}
-		} else {
+		default:
 			rpt.TaskRuns, err = c.createTaskRuns(ctx, rpt, pr, pipelineRunFacts)
 			if err != nil {
 				recorder.Eventf(pr, corev1.EventTypeWarning, "TaskRunsCreationFailed", "Failed to create TaskRuns %q: %v", rpt.TaskRunNames, err)
@@ -948,6 +1130,15 @@ func (c *Reconciler) runNextSchedulableTask(ctx context.Context, pr *v1.Pipeline
 	return nil
 }
 
+// withPipelineTaskContexts returns a copy of the ResolvedPipelineTask whose PipelineTask has the pipeline task
+// contexts applied, to create its runs from. The PipelineTask of the state is left as is, so that the contexts are
+// applied once to the runs of all the combinations of its matrix, and once to the resolved PipelineSpec.
+func withPipelineTaskContexts(ctx context.Context, rpt *resources.ResolvedPipelineTask, pr *v1.PipelineRun, facts *resources.PipelineRunFacts) *resources.ResolvedPipelineTask {
+	final := *rpt
+	final.PipelineTask = resources.ApplyPipelineTaskContexts(ctx, rpt.PipelineTask, pr, facts)
+	return &final
+}
+
 // setFinallyStartedTimeIfNeeded sets the PipelineRun.Status.FinallyStartedTime to the current time if it's nil.
 func (c *Reconciler) setFinallyStartedTimeIfNeeded(pr *v1.PipelineRun, facts *resources.PipelineRunFacts) {
 	if pr.Status.FinallyStartTime == nil {
@@ -961,6 +1152,7 @@ func (c *Reconciler) setFinallyStartedTimeIfNeeded(pr *v1.PipelineRun, facts *re
 func (c *Reconciler) createTaskRuns(ctx context.Context, rpt *resources.ResolvedPipelineTask, pr *v1.PipelineRun, facts *resources.PipelineRunFacts) ([]*v1.TaskRun, error) {
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createTaskRuns")
 	defer span.End()
+	rpt = withPipelineTaskContexts(ctx, rpt, pr, facts)
 	var taskRuns []*v1.TaskRun
 	var matrixCombinations []v1.Params
 	if rpt.PipelineTask.IsMatrixed() {
@@ -1001,7 +1193,6 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createTaskRun")
 	defer span.End()
 	logger := logging.FromContext(ctx)
-	rpt.PipelineTask = resources.ApplyPipelineTaskContexts(rpt.PipelineTask, pr.Status, facts)
 	taskRunSpec := pr.GetTaskRunSpec(rpt.PipelineTask.Name)
 	params = append(params, rpt.PipelineTask.Params...)
 	tr := &v1.TaskRun{
@@ -1080,6 +1271,7 @@ func (c *Reconciler) createCustomRuns(ctx context.Context, rpt *resources.Resolv
 	var customRuns []*v1beta1.CustomRun
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createCustomRuns")
 	defer span.End()
+	rpt = withPipelineTaskContexts(ctx, rpt, pr, facts)
 	var matrixCombinations []v1.Params
 
 	if rpt.PipelineTask.IsMatrixed() {
@@ -1104,7 +1296,6 @@ func (c *Reconciler) createCustomRun(ctx context.Context, runName string, params
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createCustomRun")
 	defer span.End()
 	logger := logging.FromContext(ctx)
-	rpt.PipelineTask = resources.ApplyPipelineTaskContexts(rpt.PipelineTask, pr.Status, facts)
 	taskRunSpec := pr.GetTaskRunSpec(rpt.PipelineTask.Name)
 	params = append(params, rpt.PipelineTask.Params...)
 
@@ -1190,6 +1381,71 @@ func (c *Reconciler) createCustomRun(ctx context.Context, runName string, params
 	return c.PipelineClientSet.TektonV1beta1().CustomRuns(pr.Namespace).Create(ctx, r, metav1.CreateOptions{})
 }
 
+// createChildPipelineRuns creates a child PipelineRun of the Pipeline referenced by the PipelineTask, or one for
+// each combination of the Matrix of the PipelineTask.
+func (c *Reconciler) createChildPipelineRuns(ctx context.Context, rpt *resources.ResolvedPipelineTask, pr *v1.PipelineRun, facts *resources.PipelineRunFacts) ([]*v1.PipelineRun, error) {
+	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createChildPipelineRuns")
+	defer span.End()
+	rpt = withPipelineTaskContexts(ctx, rpt, pr, facts)
+	var pipelineRuns []*v1.PipelineRun
+	var matrixCombinations []v1.Params
+	if rpt.PipelineTask.IsMatrixed() {
+		matrixCombinations = rpt.PipelineTask.Matrix.FanOut()
+	}
+	for i, pipelineRunName := range rpt.ChildPipelineRunNames {
+		var params v1.Params
+		if len(matrixCombinations) > i {
+			params = matrixCombinations[i]
+		}
+		pipelineRun, err := c.createChildPipelineRun(ctx, pipelineRunName, params, rpt, pr, facts)
+		if err != nil {
+			err := c.handleRunCreationError(ctx, pr, err)
+			return nil, err
+		}
+		pipelineRuns = append(pipelineRuns, pipelineRun)
+	}
+	return pipelineRuns, nil
+}
+
+func (c *Reconciler) createChildPipelineRun(ctx context.Context, pipelineRunName string, params v1.Params, rpt *resources.ResolvedPipelineTask, pr *v1.PipelineRun, facts *resources.PipelineRunFacts) (*v1.PipelineRun, error) {
+	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createChildPipelineRun")
+	defer span.End()
+	logger := logging.FromContext(ctx)
+	taskRunSpec := pr.GetTaskRunSpec(rpt.PipelineTask.Name)
+	params = append(params, rpt.PipelineTask.Params...)
+
+	workspaces, _, err := c.getTaskrunWorkspaces(ctx, pr, rpt)
+	if err != nil {
+		return nil, err
+	}
+
+	child := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pipelineRunName,
+			Namespace:       pr.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(pr)},
+			Labels:          getTaskrunLabels(pr, rpt.PipelineTask.Name, false),
+			Annotations:     getTaskrunAnnotations(pr),
+		},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef:  rpt.PipelineTask.PipelineRef,
+			PipelineSpec: rpt.PipelineTask.PipelineSpec,
+			Params:       params,
+			Workspaces:   workspaces,
+			TaskRunTemplate: v1.PipelineTaskRunTemplate{
+				ServiceAccountName: taskRunSpec.ServiceAccountName,
+				PodTemplate:        taskRunSpec.PodTemplate,
+			},
+		},
+	}
+	if rpt.PipelineTask.Timeout != nil {
+		child.Spec.Timeouts = &v1.TimeoutFields{Pipeline: rpt.PipelineTask.Timeout}
+	}
+
+	logger.Infof("Creating a new child PipelineRun object %s for pipeline task %s", pipelineRunName, rpt.PipelineTask.Name)
+	return c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).Create(ctx, child, metav1.CreateOptions{})
+}
+
 // propagateWorkspaces identifies the workspaces that the pipeline task usess
 // It adds the additional workspaces to the pipeline task's workspaces after
 // creating workspace bindings. Finally, it returns the updated resolved pipeline task.
@@ -1271,12 +1527,12 @@ func (c *Reconciler) getTaskrunWorkspaces(ctx context.Context, pr *v1.PipelineRu
 	}
 
 	// replace pipelineRun context variables in workspace subPath in the workspace binding
-	var p string
+	p := &v1.Pipeline{}
 	if pr.Spec.PipelineRef != nil {
-		p = pr.Spec.PipelineRef.Name
+		p.Name = pr.Spec.PipelineRef.Name
 	}
 	for j := range workspaces {
-		workspaces[j].SubPath = substitution.ApplyReplacements(workspaces[j].SubPath, resources.GetContextReplacements(p, pr))
+		workspaces[j].SubPath = substitution.ApplyReplacements(workspaces[j].SubPath, resources.GetContextReplacements(ctx, p, pr))
 	}
 
 	return workspaces, pipelinePVCWorkspaceName, nil
@@ -1326,8 +1582,11 @@ func getTaskrunAnnotations(pr *v1.PipelineRun) map[string]string {
 	for key, val := range pr.ObjectMeta.Annotations {
 		annotations[key] = val
 	}
+	// the param hashes and consumers are only used to handle the updates of the params of the PipelineRun, and
+	// the marker of the webhook to propagate the Pipeline labels once
 	return kmap.Filter(annotations, func(s string) bool {
-		return filterReservedAnnotationRegexp.MatchString(s)
+		return filterReservedAnnotationRegexp.MatchString(s) || s == pipeline.ParamHashesAnnotationKey ||
+			s == pipeline.ParamConsumersAnnotationKey || s == pipeline.PipelineLabelsPropagatedAnnotationKey
 	})
 }
 
@@ -1472,7 +1731,14 @@ func storePipelineSpecAndMergeMeta(ctx context.Context, pr *v1.PipelineRun, ps *
 		}
 
 		// Propagate labels from Pipeline to PipelineRun. PipelineRun labels take precedences over Pipeline.
-		pr.ObjectMeta.Labels = kmap.Union(meta.Labels, pr.ObjectMeta.Labels)
+		// The webhook already propagated the labels of the Pipelines referenced by name when the PipelineRun
+		// was created.
+		if pr.ObjectMeta.Annotations[pipeline.PipelineLabelsPropagatedAnnotationKey] != "true" {
+			pr.ObjectMeta.Labels = kmap.Union(meta.Labels, pr.ObjectMeta.Labels)
+		}
+		if pr.ObjectMeta.Labels == nil {
+			pr.ObjectMeta.Labels = map[string]string{}
+		}
 		if len(meta.Name) > 0 {
 			pr.ObjectMeta.Labels[pipeline.PipelineLabelKey] = meta.Name
 		}
@@ -1533,7 +1799,7 @@ func validateChildObjectsInPipelineRunStatus(ctx context.Context, prs v1.Pipelin
 
 	for _, cr := range prs.ChildReferences {
 		switch cr.Kind {
-		case taskRun, customRun:
+		case taskRun, customRun, pipelineRun:
 			continue
 		default:
 			err = multierror.Append(err, fmt.Errorf("child with name %s has unknown kind %s", cr.Name, cr.Kind))
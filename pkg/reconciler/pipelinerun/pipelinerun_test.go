@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -65,6 +66,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	fakek8s "k8s.io/client-go/kubernetes/fake"
@@ -360,6 +362,7 @@ spec:
 
 	wantEvents := []string{
 		"Normal Started",
+		`Warning UnresolvedSubstitution Variable "params.notfound" in params.param-not-found of pipeline task "unit-test-1" was not resolved`,
 		"Normal Running Tasks Completed: 0",
 	}
 	reconciledRun, clients := prt.reconcileRun(namespace, prName, wantEvents, false)
@@ -911,6 +914,29 @@ spec:
 			"Normal Started",
 			"Warning Failed [User error] PipelineRun foo/pipeline-param-array-out-of-bound failed validation: failed to validate Pipeline foo/a-pipeline-with-array-indexing-params's parameter which has an invalid index while referring to an array: non-existent param references:[$(params.some-param[2])]",
 		},
+	}, {
+		name: "invalid-pipeline-run-duplicate-params",
+		pipelineRun: parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: pipeline-duplicate-params
+  namespace: foo
+spec:
+  pipelineRef:
+    name: a-pipeline-with-array-params
+  params:
+    - name: some-param
+      value:
+        - "a"
+    - name: some-param
+      value:
+        - "b"
+`),
+		reason:         v1.PipelineRunReasonInvalidParamValue.String(),
+		permanentError: true,
+		wantEvents: []string{
+			"Normal Started",
+			"Warning Failed [User error] PipelineRun foo/pipeline-duplicate-params parameters are invalid: params provided more than once by the PipelineRun: some-param",
+		},
 	}, {
 		name: "invalid-embedded-pipeline-bad-name-shd-stop-reconciling",
 		pipelineRun: parse.MustParseV1PipelineRun(t, `
@@ -973,6 +999,28 @@ spec:
 			"Normal Started",
 			"Warning Failed [User error] PipelineRun foo/pipelinerun-missing-params-1 is missing some parameters required by Pipeline foo/a-pipeline-with-array-params: pipelineRun missing parameters: [some-param]",
 		},
+	}, {
+		name: "invalid-pipeline-run-param-source-secret-not-found-shd-stop-reconciling",
+		pipelineRun: parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: pipelinerun-param-source-secret-not-found
+  namespace: foo
+spec:
+  pipelineRef:
+    name: a-pipeline-with-array-params
+  paramSources:
+    - name: some-param
+      valueFrom:
+        secretKeyRef:
+          name: a-secret-that-doesnt-exist
+          key: token
+`),
+		reason:         v1.PipelineRunReasonInvalidParamSource.String(),
+		permanentError: true,
+		wantEvents: []string{
+			"Normal Started",
+			`Warning Failed [User error] PipelineRun foo/pipelinerun-param-source-secret-not-found can't resolve its paramSources: failed to resolve param "some-param": invalid param source: secret "a-secret-that-doesnt-exist" not found in namespace "foo"`,
+		},
 	}, {
 		name: "invalid-pipeline-run-missing-params-with-spec-shd-stop-reconciling",
 		pipelineRun: parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
@@ -5913,6 +5961,115 @@ spec:
 	}
 }
 
+func TestReconcileWithTaskResultsInTimeoutString(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		resultValue string
+		wantTimeout string
+		wantFailed  bool
+	}{{
+		name:        "result is a valid duration",
+		resultValue: "2m",
+		wantTimeout: "2m0s",
+	}, {
+		name:        "result is not a valid duration",
+		resultValue: "forever",
+		wantFailed:  true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			names.TestingSeed()
+			ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: a-task
+    taskRef:
+      name: a-task
+  - name: b-task
+    timeoutString: $(tasks.a-task.results.aResult)
+    taskRef:
+      name: b-task
+`)}
+			prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-timeout-string
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+`)}
+			ts := []*v1.Task{
+				parse.MustParseV1Task(t, `
+metadata:
+  name: a-task
+  namespace: foo
+spec: {}
+`),
+				parse.MustParseV1Task(t, `
+metadata:
+  name: b-task
+  namespace: foo
+spec: {}
+`),
+			}
+			trs := []*v1.TaskRun{mustParseTaskRunWithObjectMeta(t,
+				taskRunObjectMeta("test-pipeline-run-timeout-string-a-task", "foo",
+					"test-pipeline-run-timeout-string", "test-pipeline", "a-task", true),
+				`
+spec:
+  taskRef:
+    name: a-task
+status:
+  conditions:
+  - lastTransitionTime: null
+    status: "True"
+    type: Succeeded
+  results:
+  - name: aResult
+    value: `+tc.resultValue+`
+`)}
+
+			d := test.Data{
+				PipelineRuns: prs,
+				Pipelines:    ps,
+				Tasks:        ts,
+				TaskRuns:     trs,
+				ConfigMaps:   []*corev1.ConfigMap{withEnabledAlphaAPIFields(newFeatureFlagsConfigMap())},
+			}
+			prt := newPipelineRunTest(t, d)
+			defer prt.Cancel()
+
+			pipelineRun, clients := prt.reconcileRun("foo", "test-pipeline-run-timeout-string", []string{}, false)
+			actual, err := clients.Pipeline.TektonV1().TaskRuns("foo").List(prt.TestAssets.Ctx, metav1.ListOptions{
+				LabelSelector: "tekton.dev/pipelineTask=b-task,tekton.dev/pipelineRun=test-pipeline-run-timeout-string",
+				Limit:         1,
+			})
+			if err != nil {
+				t.Fatalf("Failure to list TaskRun's %s", err)
+			}
+			if tc.wantFailed {
+				// only the pipeline task with the invalid timeout fails, no TaskRun is created for it
+				if len(actual.Items) != 0 {
+					t.Fatalf("Expected no TaskRun for b-task got %d", len(actual.Items))
+				}
+				checkPipelineRunConditionStatusAndReason(t, pipelineRun, corev1.ConditionFalse, v1.PipelineRunReasonFailedValidation.String())
+				return
+			}
+			if len(actual.Items) != 1 {
+				t.Fatalf("Expected 1 TaskRuns got %d", len(actual.Items))
+			}
+			if actual.Items[0].Spec.Timeout == nil {
+				t.Fatal("Expected TaskRun timeout to be set")
+			}
+			if d := cmp.Diff(tc.wantTimeout, actual.Items[0].Spec.Timeout.Duration.String()); d != "" {
+				t.Errorf("TaskRun timeout %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestReconcileAndPopulateTaskResultsToWorkspaceBindings(t *testing.T) {
 	names.TestingSeed()
 	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
@@ -6814,6 +6971,152 @@ status:
 	}
 }
 
+func TestReconcile_ChildPipelineRunsFromMatrix(t *testing.T) {
+	names.TestingSeed()
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-children
+  namespace: foo
+  uid: bar
+spec:
+  pipelineSpec:
+    tasks:
+    - name: run-suite
+      params:
+      - name: platform
+        value: linux
+      matrix:
+        params:
+        - name: suite
+          value: [unit, e2e]
+      pipelineRef:
+        name: run-test-suite
+`)}
+	d := test.Data{
+		PipelineRuns: prs,
+		ConfigMaps:   []*corev1.ConfigMap{withEnabledAlphaAPIFields(newFeatureFlagsConfigMap())},
+	}
+	prt := newPipelineRunTest(t, d)
+	defer prt.Cancel()
+
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run-children", []string{}, false)
+
+	var childRefNames []string
+	for _, cr := range reconciledRun.Status.ChildReferences {
+		if cr.Kind != pipelineRun || cr.PipelineTaskName != "run-suite" {
+			t.Errorf("expected a child reference to a PipelineRun of the pipeline task run-suite but got %v", cr)
+		}
+		childRefNames = append(childRefNames, cr.Name)
+	}
+	wantNames := []string{"test-pipeline-run-children-run-suite-0", "test-pipeline-run-children-run-suite-1"}
+	if d := cmp.Diff(wantNames, childRefNames); d != "" {
+		t.Errorf("unexpected child references %s", diff.PrintWantGot(d))
+	}
+
+	for i, suite := range []string{"unit", "e2e"} {
+		child, err := clients.Pipeline.TektonV1().PipelineRuns("foo").Get(prt.TestAssets.Ctx, wantNames[i], metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected the child PipelineRun %s to be created: %v", wantNames[i], err)
+		}
+		if len(child.OwnerReferences) != 1 || child.OwnerReferences[0].Name != "test-pipeline-run-children" || !*child.OwnerReferences[0].Controller {
+			t.Errorf("expected the child PipelineRun %s to be controlled by its parent but got %v", child.Name, child.OwnerReferences)
+		}
+		wantSpec := v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "run-test-suite"},
+			Params: v1.Params{
+				{Name: "suite", Value: *v1.NewStructuredValues(suite)},
+				{Name: "platform", Value: *v1.NewStructuredValues("linux")},
+			},
+			TaskRunTemplate: v1.PipelineTaskRunTemplate{ServiceAccountName: "default"},
+		}
+		if d := cmp.Diff(wantSpec, child.Spec, cmpopts.EquateEmpty()); d != "" {
+			t.Errorf("unexpected spec of the child PipelineRun %s %s", child.Name, diff.PrintWantGot(d))
+		}
+	}
+}
+
+func TestReconcileWithPipelineResults_FromChildPipelineRuns(t *testing.T) {
+	names.TestingSeed()
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-children
+  namespace: foo
+  uid: bar
+spec:
+  pipelineSpec:
+    results:
+    - name: reports
+      type: array
+      value: $(tasks.run-suite.results.report[*])
+    tasks:
+    - name: run-suite
+      matrix:
+        params:
+        - name: suite
+          value: [unit, e2e]
+      pipelineRef:
+        name: run-test-suite
+status:
+  conditions:
+  - status: "Unknown"
+    type: Succeeded
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: test-pipeline-run-children-run-suite-0
+    pipelineTaskName: run-suite
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: test-pipeline-run-children-run-suite-1
+    pipelineTaskName: run-suite
+`)}
+	for i, suite := range []string{"unit", "e2e"} {
+		prs = append(prs, parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: test-pipeline-run-children-run-suite-%d
+  namespace: foo
+  ownerReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: test-pipeline-run-children
+    uid: bar
+    controller: true
+spec:
+  pipelineRef:
+    name: run-test-suite
+  params:
+  - name: suite
+    value: %s
+status:
+  conditions:
+  - status: "True"
+    type: Succeeded
+  results:
+  - name: report
+    value: %s-report
+`, i, suite, suite)))
+	}
+	d := test.Data{
+		PipelineRuns: prs,
+		ConfigMaps:   []*corev1.ConfigMap{withEnabledAlphaAPIFields(newFeatureFlagsConfigMap())},
+	}
+	prt := newPipelineRunTest(t, d)
+	defer prt.Cancel()
+
+	reconciledRun, _ := prt.reconcileRun("foo", "test-pipeline-run-children", []string{}, false)
+
+	if !reconciledRun.Status.GetCondition(apis.ConditionSucceeded).IsTrue() {
+		t.Errorf("expected the PipelineRun to succeed but got %v", reconciledRun.Status.GetCondition(apis.ConditionSucceeded))
+	}
+	wantResults := []v1.PipelineRunResult{{
+		Name:  "reports",
+		Value: *v1.NewStructuredValues("unit-report", "e2e-report"),
+	}}
+	if d := cmp.Diff(wantResults, reconciledRun.Status.Results); d != "" {
+		t.Errorf("unexpected results aggregated from the child PipelineRuns %s", diff.PrintWantGot(d))
+	}
+}
+
 func Test_storePipelineSpecAndRefSource(t *testing.T) {
 	pr := parse.MustParseV1PipelineRun(t, `
 metadata:
@@ -6935,42 +7238,642 @@ func Test_storePipelineSpec_metadata(t *testing.T) {
 	}
 }
 
-func TestReconcileOutOfSyncPipelineRun(t *testing.T) {
-	// It may happen that a PipelineRun creates one or more TaskRuns during reconcile
-	// but it fails to sync the update on the status back. This test verifies that
-	// the reconciler is able to coverge back to a consistent state with the orphaned
-	// TaskRuns back in the PipelineRun status.
-	// For more details, see https://github.com/tektoncd/pipeline/issues/2558
-	ctx := context.Background()
-
-	namespace := "foo"
-	prOutOfSyncName := "test-pipeline-run-out-of-sync"
-	helloWorldTask := simpleHelloWorldTask
+func Test_storePipelineSpec_propagateLabels(t *testing.T) {
+	pipelinelabels := map[string]string{"cost-center": "1234", "env": "prod"}
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		wantLabels  map[string]string
+	}{{
+		name:       "labels are propagated when the webhook did not propagate them",
+		wantLabels: map[string]string{"cost-center": "1234", "env": "staging", pipeline.PipelineLabelKey: "bar"},
+	}, {
+		name:        "labels are not propagated again when the webhook propagated them",
+		annotations: map[string]string{pipeline.PipelineLabelsPropagatedAnnotationKey: "true"},
+		wantLabels:  map[string]string{"env": "staging", pipeline.PipelineLabelKey: "bar"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"env": "staging"}, Annotations: tc.annotations},
+			}
+			meta := metav1.ObjectMeta{Name: "bar", Labels: pipelinelabels}
+			if err := storePipelineSpecAndMergeMeta(context.Background(), pr, &v1.PipelineSpec{}, &resolutionutil.ResolvedObjectMeta{
+				ObjectMeta: &meta,
+			}); err != nil {
+				t.Errorf("storePipelineSpecAndMergeMeta error = %v", err)
+			}
+			if d := cmp.Diff(tc.wantLabels, pr.ObjectMeta.Labels); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
 
-	testPipeline := parse.MustParseV1Pipeline(t, `
+func TestReconcile_StoreResolvedPipelineSpec(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		annotations string
+		want        *v1.PipelineSpec
+	}{{
+		name: "not stored without the annotation",
+	}, {
+		name:        "not stored when the annotation is not true",
+		annotations: `tekton.dev/store-resolved-spec: "false"`,
+	}, {
+		name:        "stored with the annotation",
+		annotations: `tekton.dev/store-resolved-spec: "true"`,
+		want: &v1.PipelineSpec{
+			Params: v1.ParamSpecs{{Name: "image", Type: v1.ParamTypeString}},
+			Tasks: []v1.PipelineTask{{
+				Name:    "build",
+				Retries: 2,
+				Params: v1.Params{
+					{Name: "image", Value: *v1.NewStructuredValues("app")},
+					{Name: "retries", Value: *v1.NewStructuredValues("2")},
+				},
+				TaskRef: &v1.TaskRef{Name: "build", Kind: v1.NamespacedTaskKind},
+			}, {
+				Name: "push",
+				Params: v1.Params{
+					{Name: "image", Value: *v1.NewStructuredValues("app")},
+					{Name: "retries", Value: *v1.NewStructuredValues("$(context.pipelineTask.retries)")},
+					{Name: "digest", Value: *v1.NewStructuredValues("$(tasks.build.results.digest)")},
+				},
+				TaskRef: &v1.TaskRef{Name: "build", Kind: v1.NamespacedTaskKind},
+			}},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
 metadata:
-  name: test-pipeline
+  name: test-pipeline-run
   namespace: foo
+  annotations:
+    %s
 spec:
-  tasks:
-  - name: hello-world-1
-    taskRef:
-      name: hello-world
-  - name: hello-world-2
-    taskRef:
-      name: hello-world
-  - name: hello-world-5
-    taskRef:
-      apiVersion: example.dev/v0
-      kind: Example
-`)
-
-	// This taskrun is in the pipelinerun status. It completed successfully.
-	taskRunDone := mustParseTaskRunWithObjectMeta(t,
-		taskRunObjectMeta("test-pipeline-run-out-of-sync-hello-world-1", "foo", prOutOfSyncName, testPipeline.Name, "hello-world-1", false),
-		`
-spec:
-  taskRef:
+  params:
+  - name: image
+    value: app
+  pipelineSpec:
+    params:
+    - name: image
+      type: string
+    tasks:
+    - name: build
+      retries: 2
+      params:
+      - name: image
+        value: $(params.image)
+      - name: retries
+        value: $(context.pipelineTask.retries)
+      taskRef:
+        name: build
+    - name: push
+      params:
+      - name: image
+        value: $(params.image)
+      - name: retries
+        value: $(context.pipelineTask.retries)
+      - name: digest
+        value: $(tasks.build.results.digest)
+      taskRef:
+        name: build
+`, tc.annotations))}
+			ts := []*v1.Task{parse.MustParseV1Task(t, `
+metadata:
+  name: build
+  namespace: foo
+spec:
+  params:
+  - name: image
+  - name: retries
+  - name: digest
+    default: ""
+  results:
+  - name: digest
+  steps:
+  - name: build
+    image: busybox
+`)}
+			prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Tasks: ts})
+			defer prt.Cancel()
+
+			reconciledRun, _ := prt.reconcileRun("foo", "test-pipeline-run", []string{}, false)
+			if d := cmp.Diff(tc.want, reconciledRun.Status.ResolvedPipelineSpec); d != "" {
+				t.Errorf("Unexpected resolved PipelineSpec %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestReconcile_TaskResultsNotSubstitutedAgain(t *testing.T) {
+	names.TestingSeed()
+
+	// the result of build contains variables, which are passed as is to the TaskRuns of both combinations of the
+	// matrix of push and to the resolved PipelineSpec, whether the pipeline task has started or not
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+  annotations:
+    tekton.dev/store-resolved-spec: "true"
+spec:
+  pipelineSpec:
+    tasks:
+    - name: build
+      taskRef:
+        name: build
+    - name: push
+      retries: 1
+      params:
+      - name: digest
+        value: $(tasks.build.results.digest)
+      matrix:
+        params:
+        - name: platform
+          value: [linux, mac]
+      taskRef:
+        name: push
+    - name: notify
+      runAfter: [push]
+      params:
+      - name: digest
+        value: $(tasks.build.results.digest)
+      - name: platform
+        value: linux
+      taskRef:
+        name: push
+status:
+  startTime: "2026-01-01T00:00:00Z"
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: test-pipeline-run-build
+    pipelineTaskName: build
+`)}
+	ts := []*v1.Task{parse.MustParseV1Task(t, `
+metadata:
+  name: build
+  namespace: foo
+spec:
+  results:
+  - name: digest
+  steps:
+  - name: build
+    image: busybox
+`), parse.MustParseV1Task(t, `
+metadata:
+  name: push
+  namespace: foo
+spec:
+  params:
+  - name: digest
+  - name: platform
+  steps:
+  - name: push
+    image: busybox
+`)}
+	trs := []*v1.TaskRun{mustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-build", "foo", "test-pipeline-run", "test-pipeline-run", "build", false), `
+spec:
+  taskRef:
+    name: build
+    kind: Task
+status:
+  conditions:
+  - type: Succeeded
+    status: "True"
+    reason: Succeeded
+  results:
+  - name: digest
+    type: string
+    value: $(context.pipelineTask.retries) $(SOME_VAR)
+`)}
+
+	prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Tasks: ts, TaskRuns: trs})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", []string{}, false)
+
+	want := *v1.NewStructuredValues("$(context.pipelineTask.retries) $(SOME_VAR)")
+	var pushed int
+	for _, tr := range getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run") {
+		if tr.Labels[pipeline.PipelineTaskLabelKey] != "push" {
+			continue
+		}
+		pushed++
+		for _, p := range tr.Spec.Params {
+			if p.Name != "digest" {
+				continue
+			}
+			if d := cmp.Diff(want, p.Value); d != "" {
+				t.Errorf("unexpected digest param of the TaskRun %s %s", tr.Name, diff.PrintWantGot(d))
+			}
+		}
+	}
+	if pushed != 2 {
+		t.Errorf("expected a TaskRun for each combination of the matrix of push, got %d", pushed)
+	}
+	if reconciledRun.Status.ResolvedPipelineSpec == nil {
+		t.Fatal("expected the resolved PipelineSpec to be stored")
+	}
+	for _, pt := range reconciledRun.Status.ResolvedPipelineSpec.Tasks[1:] {
+		if d := cmp.Diff(want, pt.Params[0].Value); d != "" {
+			t.Errorf("unexpected digest param of the pipeline task %s in the resolved PipelineSpec %s", pt.Name, diff.PrintWantGot(d))
+		}
+	}
+}
+
+func TestReconcile_UpdatedParamsAppliedToNotStartedTasks(t *testing.T) {
+	names.TestingSeed()
+
+	hashes, err := json.Marshal(paramHashes(v1.Params{
+		{Name: "tag", Value: *v1.NewStructuredValues("v1")},
+		{Name: "platform", Value: *v1.NewStructuredValues("linux")},
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error marshalling the param hashes: %v", err)
+	}
+	// the tag param was updated after build started, deploy which hasn't started yet uses its new value while
+	// the stored PipelineSpec of build is kept as is
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+  annotations:
+    tekton.dev/param-hashes: '%s'
+spec:
+  pipelineRef:
+    name: test-pipeline
+  params:
+  - name: tag
+    value: v2
+  - name: platform
+    value: linux
+status:
+  startTime: "2026-01-01T00:00:00Z"
+  pipelineSpec:
+    params:
+    - name: tag
+      type: string
+    - name: platform
+      type: string
+    tasks:
+    - name: build
+      when:
+      - input: linux
+        operator: in
+        values: [linux]
+      taskRef:
+        name: build
+        kind: Task
+    - name: deploy
+      runAfter: [build]
+      params:
+      - name: tag
+        value: v1
+      taskRef:
+        name: deploy
+        kind: Task
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: test-pipeline-run-build
+    pipelineTaskName: build
+`, hashes))}
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: tag
+  - name: platform
+  tasks:
+  - name: build
+    when:
+    - input: $(params.platform)
+      operator: in
+      values: [linux]
+    taskRef:
+      name: build
+  - name: deploy
+    runAfter: [build]
+    params:
+    - name: tag
+      value: $(params.tag)
+    taskRef:
+      name: deploy
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask, parse.MustParseV1Task(t, `
+metadata:
+  name: build
+  namespace: foo
+spec:
+  steps:
+  - name: build
+    image: busybox
+`), parse.MustParseV1Task(t, `
+metadata:
+  name: deploy
+  namespace: foo
+spec:
+  params:
+  - name: tag
+  steps:
+  - name: deploy
+    image: busybox
+`)}
+	trs := []*v1.TaskRun{mustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-build", "foo", "test-pipeline-run", "test-pipeline", "build", false), `
+spec:
+  taskRef:
+    name: build
+    kind: Task
+status:
+  conditions:
+  - type: Succeeded
+    status: "True"
+    reason: Succeeded
+`)}
+
+	prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Pipelines: ps, Tasks: ts, TaskRuns: trs})
+	defer prt.Cancel()
+	wantEvents := []string{
+		"Warning ParamsUpdated Params [tag] were updated, the new values are used by the PipelineTasks which have not started yet",
+		"Normal Started",
+	}
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", wantEvents, false)
+
+	var deployed int
+	for _, tr := range getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run") {
+		if tr.Labels[pipeline.PipelineTaskLabelKey] != "deploy" {
+			continue
+		}
+		deployed++
+		if d := cmp.Diff(v1.Params{{Name: "tag", Value: *v1.NewStructuredValues("v2")}}, tr.Spec.Params); d != "" {
+			t.Errorf("unexpected params of the TaskRun %s %s", tr.Name, diff.PrintWantGot(d))
+		}
+	}
+	if deployed != 1 {
+		t.Errorf("expected a TaskRun for deploy, got %d", deployed)
+	}
+	wantWhen := v1.WhenExpressions{{Input: "linux", Operator: selection.In, Values: []string{"linux"}}}
+	if d := cmp.Diff(wantWhen, reconciledRun.Status.PipelineSpec.Tasks[0].When); d != "" {
+		t.Errorf("expected the stored PipelineSpec of build to be kept %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(v1.Params{{Name: "tag", Value: *v1.NewStructuredValues("v2")}}, reconciledRun.Status.PipelineSpec.Tasks[1].Params); d != "" {
+		t.Errorf("expected the new value in the stored PipelineSpec of deploy %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestReconcile_UndeclaredParamsReportedOnce(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: hello-world
+    taskRef:
+      name: hello-world
+`)}
+	for _, tc := range []struct {
+		name       string
+		status     string
+		wantEvents []string
+	}{{
+		name: "first reconcile",
+		wantEvents: []string{
+			"Normal Started",
+			`Warning UndeclaredParameter Parameter "extra" provided by PipelineRun test-pipeline-run is not declared in the Pipeline`,
+			"Normal Running Tasks Completed: 0 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0",
+		},
+	}, {
+		name: "PipelineSpec already resolved",
+		status: `
+status:
+  startTime: "2026-01-01T00:00:00Z"
+  pipelineSpec:
+    tasks:
+    - name: hello-world
+      taskRef:
+        name: hello-world
+        kind: Task
+`,
+		wantEvents: []string{"Normal Started"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+  params:
+  - name: extra
+    value: value
+`+tc.status)}
+			prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Pipelines: ps, Tasks: []*v1.Task{simpleHelloWorldTask}})
+			defer prt.Cancel()
+			prt.reconcileRun("foo", "test-pipeline-run", tc.wantEvents, false)
+		})
+	}
+}
+
+func TestReconcile_DeprecatedParamsReportedOnce(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: old-image
+    default: ""
+    deprecated:
+      message: use image instead
+  tasks:
+  - name: hello-world
+    taskRef:
+      name: hello-world
+`)}
+	for _, tc := range []struct {
+		name       string
+		status     string
+		wantEvents []string
+	}{{
+		name: "first reconcile",
+		wantEvents: []string{
+			"Normal Started",
+			`Warning DeprecatedParameter PipelineRun test-pipeline-run provides a deprecated parameter: parameter "old-image" is deprecated: use image instead`,
+			"Normal Running Tasks Completed: 0 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0",
+		},
+	}, {
+		name: "PipelineSpec already resolved",
+		status: `
+status:
+  startTime: "2026-01-01T00:00:00Z"
+  pipelineSpec:
+    params:
+    - name: old-image
+      default: ""
+      deprecated:
+        message: use image instead
+    tasks:
+    - name: hello-world
+      taskRef:
+        name: hello-world
+        kind: Task
+`,
+		wantEvents: []string{"Normal Started"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+  params:
+  - name: old-image
+    value: app
+`+tc.status)}
+			prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Pipelines: ps, Tasks: []*v1.Task{simpleHelloWorldTask}})
+			defer prt.Cancel()
+			prt.reconcileRun("foo", "test-pipeline-run", tc.wantEvents, false)
+		})
+	}
+}
+
+func TestReconcile_ParamSourcesSubstituted(t *testing.T) {
+	names.TestingSeed()
+
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+  paramSources:
+  - name: token
+    valueFrom:
+      secretKeyRef:
+        name: my-secret
+        key: token
+  - name: region
+    valueFrom:
+      configMapKeyRef:
+        name: my-config
+        key: region
+`)}
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: token
+  - name: region
+  tasks:
+  - name: deploy
+    params:
+    - name: token
+      value: $(params.token)
+    when:
+    - input: $(params.region)
+      operator: in
+      values: ["eu-west-1"]
+    taskRef:
+      name: deploy
+  - name: hello-world
+    when:
+    - input: $(params.region)
+      operator: in
+      values: ["us-east-1"]
+    taskRef:
+      name: hello-world
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask, parse.MustParseV1Task(t, `
+metadata:
+  name: deploy
+  namespace: foo
+spec:
+  params:
+  - name: token
+  steps:
+  - name: deploy
+    image: busybox
+    args: ["--token", "$(params.token)"]
+`)}
+	secrets := []*corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "foo"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}}
+	cms := []*corev1.ConfigMap{{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "foo"},
+		Data:       map[string]string{"region": "eu-west-1"},
+	}}
+
+	prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Pipelines: ps, Tasks: ts, Secrets: secrets, ConfigMaps: cms})
+	defer prt.Cancel()
+	wantEvents := []string{
+		"Normal Started",
+		"Normal Running Tasks Completed: 0 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 1",
+	}
+	_, clients := prt.reconcileRun("foo", "test-pipeline-run", wantEvents, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run")
+	if len(taskRuns) != 1 {
+		t.Fatalf("expected 1 TaskRun, got %d", len(taskRuns))
+	}
+	for _, tr := range taskRuns {
+		if d := cmp.Diff("deploy", tr.Labels[pipeline.PipelineTaskLabelKey]); d != "" {
+			t.Errorf("unexpected TaskRun %s %s", tr.Name, diff.PrintWantGot(d))
+		}
+		if d := cmp.Diff(v1.Params{{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")}}, tr.Spec.Params); d != "" {
+			t.Errorf("unexpected params of the TaskRun %s %s", tr.Name, diff.PrintWantGot(d))
+		}
+	}
+}
+
+func TestReconcileOutOfSyncPipelineRun(t *testing.T) {
+	// It may happen that a PipelineRun creates one or more TaskRuns during reconcile
+	// but it fails to sync the update on the status back. This test verifies that
+	// the reconciler is able to coverge back to a consistent state with the orphaned
+	// TaskRuns back in the PipelineRun status.
+	// For more details, see https://github.com/tektoncd/pipeline/issues/2558
+	ctx := context.Background()
+
+	namespace := "foo"
+	prOutOfSyncName := "test-pipeline-run-out-of-sync"
+	helloWorldTask := simpleHelloWorldTask
+
+	testPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: hello-world-1
+    taskRef:
+      name: hello-world
+  - name: hello-world-2
+    taskRef:
+      name: hello-world
+  - name: hello-world-5
+    taskRef:
+      apiVersion: example.dev/v0
+      kind: Example
+`)
+
+	// This taskrun is in the pipelinerun status. It completed successfully.
+	taskRunDone := mustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-out-of-sync-hello-world-1", "foo", prOutOfSyncName, testPipeline.Name, "hello-world-1", false),
+		`
+spec:
+  taskRef:
     name: hello-world
 status:
   conditions:
@@ -8299,7 +9202,7 @@ spec:
 	}
 	expectedSkippedTasks := []v1.SkippedTask{{
 		Name:   "final-task-2",
-		Reason: v1.MissingResultsSkip,
+		Reason: v1.ParentTasksFailedSkip,
 	}, {
 		Name:   "final-task-3",
 		Reason: v1.WhenExpressionsSkip,
@@ -8310,10 +9213,10 @@ spec:
 		}},
 	}, {
 		Name:   "final-task-5",
-		Reason: v1.MissingResultsSkip,
+		Reason: v1.ParentTasksFailedSkip,
 	}, {
 		Name:   "final-task-6",
-		Reason: v1.MissingResultsSkip,
+		Reason: v1.ParentTasksFailedSkip,
 	}}
 
 	if d := cmp.Diff(expectedSkippedTasks, reconciledRun.Status.SkippedTasks); d != "" {
@@ -8438,6 +9341,177 @@ func (prt PipelineRunTest) reconcileRun(namespace, pipelineRunName string, wantE
 	return reconciledRun, clients
 }
 
+func TestReconcile_UpstreamResultNotAvailable(t *testing.T) {
+	names.TestingSeed()
+
+	// b references the result of a, which is still running, without depending on it, so only c is created
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  pipelineSpec:
+    tasks:
+    - name: a
+      taskRef:
+        name: a-task
+    - name: b
+      taskRef:
+        name: b-task
+    - name: c
+      taskRef:
+        name: hello-world
+status:
+  startTime: "2026-01-01T00:00:00Z"
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: test-pipeline-run-a
+    pipelineTaskName: a
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask, parse.MustParseV1Task(t, `
+metadata:
+  name: a-task
+  namespace: foo
+spec:
+  results:
+  - name: r
+  steps:
+  - name: produce
+    image: busybox
+    script: echo -n foo > $(results.r.path)
+`), parse.MustParseV1Task(t, `
+metadata:
+  name: b-task
+  namespace: foo
+spec:
+  steps:
+  - name: consume
+    image: busybox
+    script: echo $(tasks.a.results.r)
+`)}
+	trs := []*v1.TaskRun{mustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-a", "foo", "test-pipeline-run", "test-pipeline-run", "a", false), `
+spec:
+  taskRef:
+    name: a-task
+    kind: Task
+status:
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+`)}
+
+	prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Tasks: ts, TaskRuns: trs})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", []string{}, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run")
+	var got []string
+	for _, tr := range taskRuns {
+		got = append(got, tr.Labels[pipeline.PipelineTaskLabelKey])
+	}
+	sort.Strings(got)
+	if d := cmp.Diff([]string{"a", "c"}, got); d != "" {
+		t.Errorf("unexpected pipeline tasks with a TaskRun %s", diff.PrintWantGot(d))
+	}
+	if c := reconciledRun.Status.GetCondition(apis.ConditionSucceeded); c == nil || c.Reason != v1.PipelineRunReasonRunning.String() {
+		t.Errorf("expected the PipelineRun to be running, got %v", c)
+	}
+}
+
+func TestReconcile_PendingWorkspaceBindingNotAvailable(t *testing.T) {
+	names.TestingSeed()
+
+	// b binds a workspace referencing the result of a, which is still running, so only c is created
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  workspaces:
+  - name: ws
+    emptyDir: {}
+    subPath: $(tasks.a.results.r)
+  pipelineSpec:
+    workspaces:
+    - name: ws
+    tasks:
+    - name: a
+      taskRef:
+        name: a-task
+    - name: b
+      taskRef:
+        name: b-task
+      workspaces:
+      - name: src
+        workspace: ws
+    - name: c
+      taskRef:
+        name: hello-world
+status:
+  startTime: "2026-01-01T00:00:00Z"
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: test-pipeline-run-a
+    pipelineTaskName: a
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask, parse.MustParseV1Task(t, `
+metadata:
+  name: a-task
+  namespace: foo
+spec:
+  results:
+  - name: r
+  steps:
+  - name: produce
+    image: busybox
+    script: echo -n foo > $(results.r.path)
+`), parse.MustParseV1Task(t, `
+metadata:
+  name: b-task
+  namespace: foo
+spec:
+  workspaces:
+  - name: src
+  steps:
+  - name: consume
+    image: busybox
+    script: ls $(workspaces.src.path)
+`)}
+	trs := []*v1.TaskRun{mustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-a", "foo", "test-pipeline-run", "test-pipeline-run", "a", false), `
+spec:
+  taskRef:
+    name: a-task
+    kind: Task
+status:
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+`)}
+
+	prt := newPipelineRunTest(t, test.Data{PipelineRuns: prs, Tasks: ts, TaskRuns: trs})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", []string{}, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run")
+	var got []string
+	for _, tr := range taskRuns {
+		got = append(got, tr.Labels[pipeline.PipelineTaskLabelKey])
+	}
+	sort.Strings(got)
+	if d := cmp.Diff([]string{"a", "c"}, got); d != "" {
+		t.Errorf("unexpected pipeline tasks with a TaskRun %s", diff.PrintWantGot(d))
+	}
+	if c := reconciledRun.Status.GetCondition(apis.ConditionSucceeded); c == nil || c.Reason != v1.PipelineRunReasonRunning.String() {
+		t.Errorf("expected the PipelineRun to be running, got %v", c)
+	}
+}
+
 func TestReconcile_RemotePipelineRef(t *testing.T) {
 	names.TestingSeed()
 
@@ -8976,8 +10050,9 @@ spec:
 			name:   "pipelinerun-param-invalid-result-variable",
 			reason: v1.PipelineRunReasonInvalidTaskResultReference.String(),
 		}, {
+			// pt0 embeds its taskSpec, so the undeclared result is caught when validating the pipelineSpec
 			name:   "pipelinerun-pipeline-result-invalid-result-variable",
-			reason: v1.PipelineRunReasonInvalidPipelineResultReference.String(),
+			reason: v1.PipelineRunReasonFailedValidation.String(),
 		}, {
 			name:   "pipelinerun-with-optional-workspace-validation",
 			reason: v1.PipelineRunReasonRequiredWorkspaceMarkedOptional.String(),
@@ -10550,7 +11625,9 @@ spec:
 metadata:
   name: pr
   namespace: foo
-  annotations: {}
+  annotations:
+    tekton.dev/param-consumers: '{"browsers":["platforms-and-browsers"],"platforms":["platforms-and-browsers"]}'
+    tekton.dev/param-hashes: '{"browsers":"09838ebbad","platforms":"5a2e638bb7"}'
   labels:
     tekton.dev/pipeline: p-dag
 spec:
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/logging"
+)
+
+// prunePipelineRunHistory deletes the oldest finished PipelineRuns of the Pipeline of the given PipelineRun, so that
+// only default-pipelinerun-history-limit of them are kept. Only the Pipelines annotated with
+// tekton.dev/prune-history: "true" are pruned, the annotation is read from the metadata of the resolved Pipeline.
+// It is called when the PipelineRun is done, which the lister may not reflect yet.
+func (c *Reconciler) prunePipelineRunHistory(ctx context.Context, pr *v1.PipelineRun, pipelineMeta *metav1.ObjectMeta) error {
+	limit := config.FromContextOrDefaults(ctx).Defaults.DefaultPipelineRunHistoryLimit
+	pipelineName := pr.Labels[pipeline.PipelineLabelKey]
+	if limit <= 0 || pipelineName == "" || pipelineMeta == nil || pipelineMeta.Annotations[pipeline.PruneHistoryAnnotationKey] != "true" {
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{pipeline.PipelineLabelKey: pipelineName})
+	runs, err := c.pipelineRunLister.PipelineRuns(pr.Namespace).List(selector)
+	if err != nil {
+		return fmt.Errorf("failed to list the PipelineRuns of Pipeline %s: %w", pipelineName, err)
+	}
+	var done []*v1.PipelineRun
+	for _, run := range runs {
+		if run.UID == pr.UID {
+			run = pr
+		}
+		if run.IsDone() {
+			done = append(done, run)
+		}
+	}
+	if len(done) <= limit {
+		return nil
+	}
+	// most recent first, the name making the order deterministic for PipelineRuns created in the same second
+	sort.Slice(done, func(i, j int) bool {
+		if !done[i].CreationTimestamp.Equal(&done[j].CreationTimestamp) {
+			return done[j].CreationTimestamp.Before(&done[i].CreationTimestamp)
+		}
+		return done[i].Name < done[j].Name
+	})
+
+	logger := logging.FromContext(ctx)
+	var errs []error
+	for _, run := range done[limit:] {
+		logger.Infof("Deleting PipelineRun %s beyond the history limit of %d of Pipeline %s", run.Name, limit, pipelineName)
+		err := c.PipelineClientSet.TektonV1().PipelineRuns(run.Namespace).Delete(ctx, run.Name, metav1.DeleteOptions{
+			Preconditions: metav1.NewUIDPreconditions(string(run.UID)),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete PipelineRun %s: %w", run.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
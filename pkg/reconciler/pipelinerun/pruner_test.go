@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	ttesting "github.com/tektoncd/pipeline/pkg/reconciler/testing"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestPrunePipelineRunHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pipelineRun := func(name, pipelineName string, age time.Duration, done bool, prune bool) *v1.PipelineRun {
+		pr := &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "foo",
+				UID:               types.UID("uid-" + name),
+				CreationTimestamp: metav1.NewTime(start.Add(-age)),
+				Labels:            map[string]string{pipeline.PipelineLabelKey: pipelineName},
+			},
+		}
+		if prune {
+			pr.Annotations = map[string]string{pipeline.PruneHistoryAnnotationKey: "true"}
+		}
+		if done {
+			pr.Status.Status = duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}}
+		}
+		return pr
+	}
+	runs := func(prune bool) []*v1.PipelineRun {
+		return []*v1.PipelineRun{
+			pipelineRun("pr-1", "pipeline", 1*time.Hour, true, prune),
+			pipelineRun("pr-2", "pipeline", 2*time.Hour, true, prune),
+			pipelineRun("pr-3", "pipeline", 3*time.Hour, true, prune),
+			pipelineRun("pr-4", "pipeline", 4*time.Hour, false, prune),
+			pipelineRun("pr-5", "pipeline", 5*time.Hour, true, prune),
+			pipelineRun("other", "other-pipeline", 6*time.Hour, true, prune),
+		}
+	}
+
+	prunedPipeline := &metav1.ObjectMeta{
+		Name:        "pipeline",
+		Annotations: map[string]string{pipeline.PruneHistoryAnnotationKey: "true"},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		limit        string
+		pipelineMeta *metav1.ObjectMeta
+		runs         []*v1.PipelineRun
+		// finishing reconciles pr-4 as done while the lister still has it running
+		finishing bool
+		wantRuns  []string
+	}{{
+		name:         "oldest finished runs beyond the limit deleted",
+		limit:        "2",
+		pipelineMeta: prunedPipeline,
+		runs:         runs(true),
+		wantRuns:     []string{"other", "pr-1", "pr-2", "pr-4"},
+	}, {
+		name:         "runs within the limit",
+		limit:        "4",
+		pipelineMeta: prunedPipeline,
+		runs:         runs(true),
+		wantRuns:     []string{"other", "pr-1", "pr-2", "pr-3", "pr-4", "pr-5"},
+	}, {
+		name:         "finishing run counted",
+		limit:        "4",
+		pipelineMeta: prunedPipeline,
+		runs:         runs(false),
+		finishing:    true,
+		wantRuns:     []string{"other", "pr-1", "pr-2", "pr-3", "pr-4"},
+	}, {
+		name:         "no limit",
+		limit:        "0",
+		pipelineMeta: prunedPipeline,
+		runs:         runs(true),
+		wantRuns:     []string{"other", "pr-1", "pr-2", "pr-3", "pr-4", "pr-5"},
+	}, {
+		name:         "pipeline not opted in",
+		limit:        "2",
+		pipelineMeta: &metav1.ObjectMeta{Name: "pipeline"},
+		runs:         runs(false),
+		wantRuns:     []string{"other", "pr-1", "pr-2", "pr-3", "pr-4", "pr-5"},
+	}, {
+		name:         "runs annotated but not the pipeline",
+		limit:        "2",
+		pipelineMeta: &metav1.ObjectMeta{Name: "pipeline"},
+		runs:         runs(true),
+		wantRuns:     []string{"other", "pr-1", "pr-2", "pr-3", "pr-4", "pr-5"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ttesting.SetupFakeContext(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			ctx = cfgtesting.SetDefaults(ctx, t, map[string]string{"default-pipelinerun-history-limit": tc.limit})
+			c, informers := test.SeedTestData(t, ctx, test.Data{PipelineRuns: tc.runs})
+			r := &Reconciler{
+				PipelineClientSet: c.Pipeline,
+				pipelineRunLister: informers.PipelineRun.Lister(),
+			}
+
+			pr := tc.runs[0]
+			if tc.finishing {
+				pr = tc.runs[3].DeepCopy()
+				pr.Status.Status = duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}}
+			}
+			if err := r.prunePipelineRunHistory(ctx, pr, tc.pipelineMeta); err != nil {
+				t.Fatalf("prunePipelineRunHistory() unexpected error: %v", err)
+			}
+
+			list, err := c.Pipeline.TektonV1().PipelineRuns("foo").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, pr := range list.Items {
+				got = append(got, pr.Name)
+			}
+			sort.Strings(got)
+			if d := cmp.Diff(tc.wantRuns, got); d != "" {
+				t.Errorf("prunePipelineRunHistory() remaining PipelineRuns %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -19,15 +19,31 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/tektoncd/pipeline/internal/artifactref"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"github.com/tektoncd/pipeline/pkg/internal/resultref"
 	"github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	"github.com/tektoncd/pipeline/pkg/workspace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/logging"
 )
 
 const (
@@ -38,6 +54,8 @@ const (
 	objectElementResultsParseNumber = 5
 	// objectIndividualVariablePattern is the reference pattern for object individual keys params.<object_param_name>.<key_name>
 	objectIndividualVariablePattern = "params.%s.%s"
+	// applyTracerName is the name of the tracer of the spans of the substitutions
+	applyTracerName = "tekton/apply"
 )
 
 var paramPatterns = []string{
@@ -46,170 +64,474 @@ var paramPatterns = []string{
 	"params['%s']",
 }
 
-// ApplyParameters applies the params from a PipelineRun.Params to a PipelineSpec.
-func ApplyParameters(ctx context.Context, p *v1.PipelineSpec, pr *v1.PipelineRun) *v1.PipelineSpec {
+// RegisterParamPattern adds a pattern to the ones params can be referenced with, e.g. "env.%s" makes the
+// param "foo" available as $(env.foo) in addition to $(params.foo). The pattern must contain exactly one
+// %s or %q verb, which is replaced by the name of the param; registering a pattern twice is a no-op.
+// It is not safe for concurrent use and is meant to be called before any PipelineRun is reconciled,
+// e.g. from an init function of a custom controller.
+func RegisterParamPattern(pattern string) error {
+	if strings.Count(pattern, "%") != 1 || (!strings.Contains(pattern, "%s") && !strings.Contains(pattern, "%q")) {
+		return fmt.Errorf("invalid param pattern %q: must contain exactly one %%s or %%q verb", pattern)
+	}
+	for _, p := range paramPatterns {
+		if p == pattern {
+			return nil
+		}
+	}
+	paramPatterns = append(paramPatterns, pattern)
+	return nil
+}
+
+// SubstitutionProvider provides platform-specific variables, e.g. $(platform.costCenter), which can be referenced
+// in the params, when expressions and display name of the PipelineTasks alongside the built-in ones.
+type SubstitutionProvider interface {
+	// GetReplacements returns the values of the variables for the PipelineTask of the PipelineRun, keyed by the
+	// variable without the enclosing $(), e.g. "platform.costCenter".
+	GetReplacements(ctx context.Context, pr *v1.PipelineRun, pt *v1.PipelineTask) map[string]string
+}
+
+var substitutionProviders []SubstitutionProvider
+
+// RegisterSubstitutionProvider adds a provider of variables to the ones substituted by ApplyPipelineTaskContexts.
+// The built-in variables take precedence over the ones of the providers, and the providers registered later over
+// the ones registered earlier. It is not safe for concurrent use and is meant to be called before any PipelineRun
+// is reconciled, e.g. from an init function of a custom controller.
+func RegisterSubstitutionProvider(provider SubstitutionProvider) {
+	substitutionProviders = append(substitutionProviders, provider)
+}
+
+// ApplyParameters applies the params from a PipelineRun.Params to a PipelineSpec. It is the first substitution
+// step, the returned SubstitutionContext holds the replacements it applied.
+func ApplyParameters(ctx context.Context, p *v1.PipelineSpec, pr *v1.PipelineRun) (*v1.PipelineSpec, *SubstitutionContext, error) {
 	// This assumes that the PipelineRun inputs have been validated against what the Pipeline requests.
 
-	// stringReplacements is used for standard single-string stringReplacements,
-	// while arrayReplacements/objectReplacements contains arrays/objects that need to be further processed.
-	stringReplacements := map[string]string{}
-	arrayReplacements := map[string][]string{}
-	objectReplacements := map[string]map[string]string{}
+	ctx, span := startApplySpan(ctx, "ApplyParameters",
+		attribute.String("pipeline", pr.Labels[pipeline.PipelineLabelKey]),
+		attribute.Int("tasks", len(p.Tasks)+len(p.Finally)))
+	defer span.End()
 
-	// Set all the default stringReplacements
-	for _, p := range p.Params {
-		if p.Default != nil {
-			switch p.Default.Type {
-			case v1.ParamTypeArray:
-				for _, pattern := range paramPatterns {
-					for i := range len(p.Default.ArrayVal) {
-						stringReplacements[fmt.Sprintf(pattern+"[%d]", p.Name, i)] = p.Default.ArrayVal[i]
-					}
-					arrayReplacements[fmt.Sprintf(pattern, p.Name)] = p.Default.ArrayVal
-				}
-			case v1.ParamTypeObject:
-				for _, pattern := range paramPatterns {
-					objectReplacements[fmt.Sprintf(pattern, p.Name)] = p.Default.ObjectVal
-				}
-				for k, v := range p.Default.ObjectVal {
-					stringReplacements[fmt.Sprintf(objectIndividualVariablePattern, p.Name, k)] = v
-				}
-			case v1.ParamTypeString:
-				fallthrough
-			default:
-				for _, pattern := range paramPatterns {
-					stringReplacements[fmt.Sprintf(pattern, p.Name)] = p.Default.StringVal
-				}
+	start := time.Now()
+	// The params from the PipelineRun override its ParamDefaults, which override the defaults declared in the PipelineSpec
+	defaults, provided, err := GetParamReplacements(ctx, p, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+	replacements := defaults.Merge(provided)
+	sc := &SubstitutionContext{
+		StringReplacements: replacements.Strings,
+		ArrayReplacements:  replacements.Arrays,
+		ObjectReplacements: replacements.Objects,
+		Source:             SubstitutionSourceParams,
+	}
+	spec, applied := applyReplacementsCounted(p, sc)
+	duration := time.Since(start)
+	span.SetAttributes(attribute.Int("replacements", applied))
+	substitution.RecordSubstitutionMetrics(ctx, applied, duration)
+	return spec, sc, nil
+}
+
+// DeepApplyParameters applies the params from a PipelineRun.Params to a PipelineSpec as ApplyParameters does, and to
+// the PipelineSpecs embedded in its PipelineTasks, recursively. The params of the PipelineRun act as defaults for the
+// inner pipelines: a param declared by an inner PipelineSpec, or passed to it by its PipelineTask, is a scoping
+// boundary, its references in that PipelineSpec and below are left to the child PipelineRun.
+func DeepApplyParameters(ctx context.Context, p *v1.PipelineSpec, pr *v1.PipelineRun) (*v1.PipelineSpec, *SubstitutionContext, error) {
+	spec, sc, err := ApplyParameters(ctx, p, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+	applyParametersToEmbeddedPipelines(spec, sc)
+	return spec, sc, nil
+}
+
+// applyParametersToEmbeddedPipelines substitutes the replacements in the PipelineSpecs embedded in the PipelineTasks
+// of the PipelineSpec, recursively, except for the params they declare or which their PipelineTask passes them.
+func applyParametersToEmbeddedPipelines(p *v1.PipelineSpec, sc *SubstitutionContext) {
+	for _, tasks := range [][]v1.PipelineTask{p.Tasks, p.Finally} {
+		for i := range tasks {
+			inner := tasks[i].PipelineSpec
+			if inner == nil {
+				continue
 			}
+			scoped := sets.New[string]()
+			for _, ps := range inner.Params {
+				scoped.Insert(ps.Name)
+			}
+			for _, param := range tasks[i].Params {
+				scoped.Insert(param.Name)
+			}
+			innerContext := sc.withoutParams(scoped)
+			tasks[i].PipelineSpec = ApplyReplacements(inner, innerContext)
+			applyParametersToEmbeddedPipelines(tasks[i].PipelineSpec, innerContext)
 		}
 	}
-	// Set and overwrite params with the ones from the PipelineRun
-	prStrings, prArrays, prObjects := paramsFromPipelineRun(ctx, pr)
+}
 
-	for k, v := range prStrings {
-		stringReplacements[k] = v
+// startApplySpan starts a span for a substitution, as a child of the span carried by ctx, with the tracer provider
+// of that span. Nothing is traced if ctx carries no span.
+func startApplySpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return trace.SpanFromContext(ctx).TracerProvider().Tracer(applyTracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// ParamSpecsWithRunDefaults returns the params declared in the PipelineSpec with their default values overridden
+// by the ParamDefaults of the PipelineRun. The ParamDefaults of params which are not declared in the PipelineSpec
+// are appended, as the params provided by the PipelineRun are used for substitutions even if not declared.
+func ParamSpecsWithRunDefaults(ps *v1.PipelineSpec, pr *v1.PipelineRun) v1.ParamSpecs {
+	var params v1.ParamSpecs
+	if ps != nil {
+		params = append(params, ps.Params...)
 	}
-	for k, v := range prArrays {
-		arrayReplacements[k] = v
+	if pr == nil {
+		return params
 	}
-	for k, v := range prObjects {
-		objectReplacements[k] = v
+	for _, d := range pr.Spec.ParamDefaults {
+		found := false
+		for i := range params {
+			if params[i].Name == d.Name {
+				params[i].Default = d.Default
+				found = true
+				break
+			}
+		}
+		if !found {
+			params = append(params, d)
+		}
 	}
+	return params
+}
 
-	return ApplyReplacements(p, stringReplacements, arrayReplacements, objectReplacements)
+// paramsFromPipelineSpecDefaults returns the replacements for the default values of the given params.
+func paramsFromPipelineSpecDefaults(params v1.ParamSpecs) (map[string]string, map[string][]string, map[string]map[string]string) {
+	var defaults v1.Params
+	for _, p := range params {
+		if p.Default != nil {
+			defaults = append(defaults, v1.Param{Name: p.Name, Value: *p.Default})
+		}
+	}
+	return defaults.ConvertToReplacementMaps(paramPatterns)
 }
 
-func paramsFromPipelineRun(ctx context.Context, pr *v1.PipelineRun) (map[string]string, map[string][]string, map[string]map[string]string) {
-	// stringReplacements is used for standard single-string stringReplacements,
-	// while arrayReplacements/objectReplacements contains arrays/objects that need to be further processed.
-	stringReplacements := map[string]string{}
-	arrayReplacements := map[string][]string{}
-	objectReplacements := map[string]map[string]string{}
+// ErrDuplicateParams is returned when the PipelineRun provides several values for the same params, whatever
+// their types, as the value which would be used for substitutions is ambiguous.
+type ErrDuplicateParams struct {
+	// Names are the names of the duplicated params, sorted.
+	Names []string
+}
+
+// Error implements error.
+func (e *ErrDuplicateParams) Error() string {
+	return fmt.Sprintf("params provided more than once by the PipelineRun: %s", strings.Join(e.Names, ", "))
+}
+
+// checkDuplicateParams returns an ErrDuplicateParams if several of the params have the same name.
+func checkDuplicateParams(params v1.Params) error {
+	seen, duplicates := sets.NewString(), sets.NewString()
+	for _, p := range params {
+		if seen.Has(p.Name) {
+			duplicates.Insert(p.Name)
+		}
+		seen.Insert(p.Name)
+	}
+	if duplicates.Len() > 0 {
+		return &ErrDuplicateParams{Names: duplicates.List()}
+	}
+	return nil
+}
+
+// paramsFromPipelineRun returns the replacements for the params provided by the PipelineRun, or an
+// ErrDuplicateParams if it provides the same param more than once.
+func paramsFromPipelineRun(ps *v1.PipelineSpec, pr *v1.PipelineRun) (map[string]string, map[string][]string, map[string]map[string]string, error) {
+	if err := checkDuplicateParams(pr.Spec.Params); err != nil {
+		return nil, nil, nil, err
+	}
+	// the keys of the object params which are not provided by the PipelineRun are taken from the defaults, as
+	// they are for the references to individual keys
+	objectDefaults := map[string]map[string]string{}
+	for _, p := range ParamSpecsWithRunDefaults(ps, pr) {
+		if p.Type == v1.ParamTypeObject && p.Default != nil && p.Default.ObjectVal != nil {
+			objectDefaults[p.Name] = p.Default.ObjectVal
+		}
+	}
 
+	stringReplacements, arrayReplacements, objectReplacements := pr.Spec.Params.ConvertToReplacementMaps(paramPatterns)
 	for _, p := range pr.Spec.Params {
-		switch p.Value.Type {
-		case v1.ParamTypeArray:
+		if defaults, ok := objectDefaults[p.Name]; ok && p.Value.Type == v1.ParamTypeObject {
+			object := mergeReplacements(defaults, p.Value.ObjectVal)
 			for _, pattern := range paramPatterns {
-				for i := range len(p.Value.ArrayVal) {
-					stringReplacements[fmt.Sprintf(pattern+"[%d]", p.Name, i)] = p.Value.ArrayVal[i]
-				}
-				arrayReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.ArrayVal
-			}
-		case v1.ParamTypeObject:
-			for _, pattern := range paramPatterns {
-				objectReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.ObjectVal
-			}
-			for k, v := range p.Value.ObjectVal {
-				stringReplacements[fmt.Sprintf(objectIndividualVariablePattern, p.Name, k)] = v
-			}
-		case v1.ParamTypeString:
-			fallthrough
-		default:
-			for _, pattern := range paramPatterns {
-				stringReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.StringVal
+				objectReplacements[fmt.Sprintf(pattern, p.Name)] = object
 			}
 		}
 	}
 
-	return stringReplacements, arrayReplacements, objectReplacements
+	return stringReplacements, arrayReplacements, objectReplacements, nil
 }
 
-// GetContextReplacements returns the pipelineRun context which can be used to replace context variables in the specifications
-func GetContextReplacements(pipelineName string, pr *v1.PipelineRun) map[string]string {
-	return map[string]string{
-		"context.pipelineRun.name":      pr.Name,
-		"context.pipeline.name":         pipelineName,
-		"context.pipelineRun.namespace": pr.Namespace,
-		"context.pipelineRun.uid":       string(pr.ObjectMeta.UID),
+// GetParamReplacements returns the string, array and object replacements for the params of the PipelineRun,
+// separately for the default values and for the values provided by the PipelineRun. The default values declared
+// in the PipelineSpec are overridden by the ParamDefaults of the PipelineRun. An ErrDuplicateParams is returned
+// if the PipelineRun provides the same param more than once.
+func GetParamReplacements(ctx context.Context, ps *v1.PipelineSpec, pr *v1.PipelineRun) (defaults, provided ParamReplacements, err error) {
+	defaults.Strings, defaults.Arrays, defaults.Objects = paramsFromPipelineSpecDefaults(ParamSpecsWithRunDefaults(ps, pr))
+	provided.Strings, provided.Arrays, provided.Objects, err = paramsFromPipelineRun(ps, pr)
+	return defaults, provided, err
+}
+
+// ParamReplacements holds the replacements for string, array and object params.
+type ParamReplacements struct {
+	Strings map[string]string
+	Arrays  map[string][]string
+	Objects map[string]map[string]string
+}
+
+// Merge returns the replacements in which the replacements of other override the ones with the same
+// variable, e.g. the params provided by the PipelineRun overriding the defaults. Neither r nor other
+// are modified.
+func (r ParamReplacements) Merge(other ParamReplacements) ParamReplacements {
+	return ParamReplacements{
+		Strings: mergeReplacements(r.Strings, other.Strings),
+		Arrays:  mergeReplacements(r.Arrays, other.Arrays),
+		Objects: mergeReplacements(r.Objects, other.Objects),
+	}
+}
+
+// mergeReplacements returns a new map holding the replacements of base and override, override winning
+// for the variables present in both.
+func mergeReplacements[V any](base, override map[string]V) map[string]V {
+	merged := make(map[string]V, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
+	}
+	return merged
+}
+
+// GetContextReplacements returns the pipelineRun context which can be used to replace context variables in the specifications.
+// The labels and annotations of the PipelineRun are exposed as context.pipelineRun.labels.<key> and
+// context.pipelineRun.annotations.<key>, and the ones of the Pipeline as context.pipeline.labels.<key> and
+// context.pipeline.annotations.<key>, with the keys sanitized by v1.ContextMetadataKeys. If the
+// "enable-audit-context-variables" feature flag is set, the creation timestamp (RFC 3339, UTC) and generation
+// are exposed as context.pipelineRun.creationTimestamp and context.pipelineRun.generation. The service account of the PipelineRun, or "default" if none is set, is
+// exposed as context.pipelineRun.serviceAccountName. The Pipeline may be nil if it is not known, in which case
+// context.pipeline.name is empty.
+func GetContextReplacements(ctx context.Context, pipeline *v1.Pipeline, pr *v1.PipelineRun) map[string]string {
+	var pipelineMeta metav1.ObjectMeta
+	if pipeline != nil {
+		pipelineMeta = pipeline.ObjectMeta
+	}
+	replacements := map[string]string{
+		"context.pipelineRun.name":               pr.Name,
+		"context.pipeline.name":                  pipelineMeta.Name,
+		"context.pipelineRun.namespace":          pr.Namespace,
+		"context.pipelineRun.uid":                string(pr.ObjectMeta.UID),
+		"context.pipelineRun.serviceAccountName": serviceAccountNameOrDefault(pr.Spec.TaskRunTemplate.ServiceAccountName),
+	}
+	if config.FromContextOrDefaults(ctx).FeatureFlags.EnableAuditContextVariables {
+		replacements["context.pipelineRun.creationTimestamp"] = pr.CreationTimestamp.UTC().Format(time.RFC3339)
+		replacements["context.pipelineRun.generation"] = strconv.FormatInt(pr.Generation, 10)
+	}
+	for prefix, metadata := range map[string]map[string]string{
+		"context.pipelineRun.labels.":      pr.ObjectMeta.Labels,
+		"context.pipelineRun.annotations.": pr.ObjectMeta.Annotations,
+		"context.pipeline.labels.":         pipelineMeta.Labels,
+		"context.pipeline.annotations.":    pipelineMeta.Annotations,
+	} {
+		// the keys shared by several labels or annotations are left out, their variables aren't substituted
+		values, _ := v1.ContextMetadataKeys(metadata)
+		for k, v := range values {
+			replacements[prefix+k] = v
+		}
+	}
+	return replacements
+}
+
+// serviceAccountNameOrDefault returns the service account name, or the default service account if it is empty.
+func serviceAccountNameOrDefault(serviceAccountName string) string {
+	if serviceAccountName == "" {
+		return config.DefaultServiceAccountValue
+	}
+	return serviceAccountName
+}
+
+// GetStatusContextReplacements returns the replacements for the context variables exposing the status of the
+// PipelineRun, context.pipelineRun.startTime and context.pipelineRun.completionTime, formatted as RFC 3339 in UTC.
+// The values are empty strings if the times are not set yet.
+func GetStatusContextReplacements(pr *v1.PipelineRun) map[string]string {
+	replacements := map[string]string{
+		"context.pipelineRun.startTime":      formatTime(pr.Status.StartTime),
+		"context.pipelineRun.completionTime": formatTime(pr.Status.CompletionTime),
 	}
+	return replacements
+}
+
+// formatTime returns the given time as an RFC 3339 string in UTC, or an empty string if it is nil.
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
 }
 
 // ApplyContexts applies the substitution from $(context.(pipelineRun|pipeline).*) with the specified values.
-// Currently supports only name substitution. Uses "" as a default if name is not specified.
-func ApplyContexts(spec *v1.PipelineSpec, pipelineName string, pr *v1.PipelineRun) *v1.PipelineSpec {
+// Uses "" as a default if name is not specified. The returned SubstitutionContext holds the replacements it
+// applied and follows previous.
+func ApplyContexts(ctx context.Context, spec *v1.PipelineSpec, pipeline *v1.Pipeline, pr *v1.PipelineRun, previous *SubstitutionContext) (*v1.PipelineSpec, *SubstitutionContext) {
+	sc := &SubstitutionContext{StringReplacements: GetContextReplacements(ctx, pipeline, pr), Source: SubstitutionSourceContext, Previous: previous}
 	for i := range spec.Tasks {
-		spec.Tasks[i].DisplayName = substitution.ApplyReplacements(spec.Tasks[i].DisplayName, GetContextReplacements(pipelineName, pr))
+		spec.Tasks[i].DisplayName = substitution.ApplyReplacements(spec.Tasks[i].DisplayName, sc.StringReplacements)
 	}
 	for i := range spec.Finally {
-		spec.Finally[i].DisplayName = substitution.ApplyReplacements(spec.Finally[i].DisplayName, GetContextReplacements(pipelineName, pr))
+		spec.Finally[i].DisplayName = substitution.ApplyReplacements(spec.Finally[i].DisplayName, sc.StringReplacements)
 	}
-	return ApplyReplacements(spec, GetContextReplacements(pipelineName, pr), map[string][]string{}, map[string]map[string]string{})
+	return ApplyReplacements(spec, sc), sc
 }
 
-// filterMatrixContextVar returns a list of params which contain any matrix context variables such as
-// $(tasks.<pipelineTaskName>.matrix.length) and $(tasks.<pipelineTaskName>.matrix.<resultName>.length)
-func filterMatrixContextVar(params v1.Params) v1.Params {
-	var filteredParams v1.Params
-	for _, param := range params {
-		if expressions, ok := param.GetVarSubstitutionExpressions(); ok {
-			for _, expression := range expressions {
-				// tasks.<pipelineTaskName>.matrix.length
-				// tasks.<pipelineTaskName>.matrix.<resultName>.length
-				subExpressions := strings.Split(expression, ".")
-				if len(subExpressions) >= 4 && subExpressions[2] == "matrix" && subExpressions[len(subExpressions)-1] == "length" {
-					filteredParams = append(filteredParams, param)
-				}
-			}
+// filterMatrixContextVar returns the matrix context variables such as tasks.<pipelineTaskName>.matrix.length
+// and tasks.<pipelineTaskName>.matrix.<resultName>.length referenced in the params, when expressions and
+// display name of the PipelineTask
+func filterMatrixContextVar(pt *v1.PipelineTask) []string {
+	var expressions []string
+	for _, param := range pt.Params {
+		if paramExpressions, ok := param.GetVarSubstitutionExpressions(); ok {
+			expressions = append(expressions, paramExpressions...)
+		}
+	}
+	for _, we := range pt.When {
+		if whenExpressions, ok := we.GetVarSubstitutionExpressions(); ok {
+			expressions = append(expressions, whenExpressions...)
+		}
+	}
+	for _, expression := range v1.VariableSubstitutionRegex.FindAllString(pt.DisplayName, -1) {
+		expressions = append(expressions, strings.TrimSuffix(strings.TrimPrefix(expression, "$("), ")"))
+	}
+
+	var filteredExpressions []string
+	for _, expression := range expressions {
+		// tasks.<pipelineTaskName>.matrix.length
+		// tasks.<pipelineTaskName>.matrix.<resultName>.length
+		subExpressions := strings.Split(expression, ".")
+		if (len(subExpressions) == 4 || len(subExpressions) == 5) && subExpressions[0] == "tasks" && subExpressions[2] == "matrix" && subExpressions[len(subExpressions)-1] == "length" {
+			filteredExpressions = append(filteredExpressions, expression)
 		}
 	}
-	return filteredParams
+	return filteredExpressions
 }
 
 // ApplyPipelineTaskContexts applies the substitution from $(context.pipelineTask.*) with the specified values.
 // Uses "0" as a default if a value is not available as well as matrix context variables
 // $(tasks.<pipelineTaskName>.matrix.length) and $(tasks.<pipelineTaskName>.matrix.<resultName>.length)
-func ApplyPipelineTaskContexts(pt *v1.PipelineTask, pipelineRunStatus v1.PipelineRunStatus, facts *PipelineRunFacts) *v1.PipelineTask {
+// referenced in the params, when expressions and display name of the PipelineTask, along with the variables of the
+// registered SubstitutionProviders.
+func ApplyPipelineTaskContexts(ctx context.Context, pt *v1.PipelineTask, pr *v1.PipelineRun, facts *PipelineRunFacts) *v1.PipelineTask {
 	pt = pt.DeepCopy()
-	var pipelineTaskName string
-	var resultName string
-	var matrixLength int
+	replacements := map[string]string{}
+	for _, provider := range substitutionProviders {
+		maps.Copy(replacements, provider.GetReplacements(ctx, pr, pt))
+	}
+	maps.Copy(replacements, GetPipelineTaskContextReplacements(pt, pr, facts))
 
-	replacements := map[string]string{
-		"context.pipelineTask.retries": strconv.Itoa(pt.Retries),
+	pt.Params = pt.Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
+	if pt.IsMatrixed() {
+		pt.Matrix.Params = pt.Matrix.Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
+		for i := range pt.Matrix.Include {
+			pt.Matrix.Include[i].Params = pt.Matrix.Include[i].Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
+		}
+	}
+	pt.When = pt.When.ReplaceVariables(replacements, map[string][]string{})
+	pt.DisplayName = substitution.ApplyReplacements(pt.DisplayName, replacements)
+	// the pipeline task contexts are the last substitutions applied to the PipelineTask
+	return unescapePipelineTask(pt)
+}
+
+// unescapePipelineTask unescapes in place the task results escaped by applyResultReplacements in the params, the
+// matrix, the when expressions and the display name of the PipelineTask, and returns it.
+func unescapePipelineTask(pt *v1.PipelineTask) *v1.PipelineTask {
+	pt.Params = unescapeParams(pt.Params)
+	if pt.Matrix != nil {
+		pt.Matrix.Params = unescapeParams(pt.Matrix.Params)
+		for i := range pt.Matrix.Include {
+			pt.Matrix.Include[i].Params = unescapeParams(pt.Matrix.Include[i].Params)
+		}
+	}
+	pt.When = unescapeWhenExpressions(pt.When)
+	pt.DisplayName = substitution.UnescapeSubstitutionResult(pt.DisplayName)
+	return pt
+}
+
+// unescapeWhenExpressions returns a copy of the when expressions in which the values escaped by
+// substitution.EscapeSubstitutionResult are unescaped.
+func unescapeWhenExpressions(wes v1.WhenExpressions) v1.WhenExpressions {
+	if wes == nil {
+		return nil
+	}
+	unescaped := make(v1.WhenExpressions, len(wes))
+	for i := range wes {
+		we := wes[i].DeepCopy()
+		we.Input = substitution.UnescapeSubstitutionResult(we.Input)
+		for j := range we.Values {
+			we.Values[j] = substitution.UnescapeSubstitutionResult(we.Values[j])
+		}
+		we.CEL = substitution.UnescapeSubstitutionResult(we.CEL)
+		unescaped[i] = *we
+	}
+	return unescaped
+}
+
+// unescapeParams unescapes in place the values of the params escaped by substitution.EscapeSubstitutionResult,
+// and returns them.
+func unescapeParams(params v1.Params) v1.Params {
+	for i := range params {
+		value := &params[i].Value
+		value.StringVal = substitution.UnescapeSubstitutionResult(value.StringVal)
+		for j := range value.ArrayVal {
+			value.ArrayVal[j] = substitution.UnescapeSubstitutionResult(value.ArrayVal[j])
+		}
+		for k, v := range value.ObjectVal {
+			value.ObjectVal[k] = substitution.UnescapeSubstitutionResult(v)
+		}
 	}
+	return params
+}
 
-	filteredParams := filterMatrixContextVar(pt.Params)
+// GetPipelineTaskContextReplacements returns the replacements for $(context.pipelineTask.*) and the matrix context
+// variables $(tasks.<pipelineTaskName>.matrix.length) and $(tasks.<pipelineTaskName>.matrix.<resultName>.length)
+// referenced in the params, when expressions and display name of the PipelineTask.
+// $(context.pipelineTask.serviceAccountName) is the service account the TaskRuns of the PipelineTask run as: the
+// one set for the PipelineTask in the taskRunSpecs of the PipelineRun, if any, or else the one of the PipelineRun.
+func GetPipelineTaskContextReplacements(pt *v1.PipelineTask, pr *v1.PipelineRun, facts *PipelineRunFacts) map[string]string {
+	pipelineRunStatus := pr.Status
+	replacements := map[string]string{
+		"context.pipelineTask.retries":            strconv.Itoa(pt.Retries),
+		"context.pipelineTask.serviceAccountName": serviceAccountNameOrDefault(pr.GetTaskRunSpec(pt.Name).ServiceAccountName),
+	}
 
-	for _, p := range filteredParams {
-		pipelineTaskName, resultName = p.ParseTaskandResultName()
+	for _, expression := range filterMatrixContextVar(pt) {
+		subExpressions := strings.Split(expression, ".")
+		pipelineTaskName := subExpressions[1]
+		var resultName string
+		if len(subExpressions) == 5 {
+			resultName = subExpressions[3]
+		}
 		// find the referenced pipelineTask to count the matrix combinations
-		if pipelineTaskName != "" && pipelineRunStatus.PipelineSpec != nil {
-			for _, task := range pipelineRunStatus.PipelineSpec.Tasks {
+		if pipelineRunStatus.PipelineSpec != nil {
+			tasks := pipelineRunStatus.PipelineSpec.Tasks
+			// a finally task can also reference the matrix combinations of the other finally tasks
+			if facts != nil && facts.FinalTasksGraph != nil && facts.isFinalTask(pt.Name) {
+				tasks = append(slices.Clone(tasks), pipelineRunStatus.PipelineSpec.Finally...)
+			}
+			for _, task := range tasks {
 				if task.Name == pipelineTaskName {
-					matrixLength = task.Matrix.CountCombinations()
-					replacements["tasks."+pipelineTaskName+".matrix.length"] = strconv.Itoa(matrixLength)
+					replacements["tasks."+pipelineTaskName+".matrix.length"] = strconv.Itoa(task.Matrix.CountCombinations())
 					continue
 				}
 			}
 		}
 		// find the resultName from the ResultsCache
-		if pipelineTaskName != "" && resultName != "" {
+		if resultName != "" {
 			for _, pt := range facts.State {
 				if pt.PipelineTask.Name == pipelineTaskName {
-					if len(pt.ResultsCache) == 0 {
-						pt.ResultsCache = createResultsCacheMatrixedTaskRuns(pt)
-					}
-					resultLength := len(pt.ResultsCache[resultName])
+					values, _ := pt.GetResultsCache().Get(resultName)
+					resultLength := len(values)
 					replacements["tasks."+pipelineTaskName+".matrix."+resultName+".length"] = strconv.Itoa(resultLength)
 					continue
 				}
@@ -217,54 +539,320 @@ func ApplyPipelineTaskContexts(pt *v1.PipelineTask, pipelineRunStatus v1.Pipelin
 		}
 	}
 
-	pt.Params = pt.Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
-	if pt.IsMatrixed() {
-		pt.Matrix.Params = pt.Matrix.Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
-		for i := range pt.Matrix.Include {
-			pt.Matrix.Include[i].Params = pt.Matrix.Include[i].Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
+	return replacements
+}
+
+// SubstitutionError describes a variable reference which could not be substituted.
+type SubstitutionError struct {
+	// Variable is the referenced variable, e.g. tasks.build.results.digest.
+	Variable string
+	// Reason describes why the variable could not be substituted.
+	Reason string
+}
+
+// Error implements error.
+func (e SubstitutionError) Error() string {
+	return fmt.Sprintf("cannot substitute %q: %s", e.Variable, e.Reason)
+}
+
+// SubstitutionReport describes the variable substitutions performed by ApplyTaskResults, PropagateResults
+// and PropagateArtifacts, so that the caller can tell whether references were left as literals.
+type SubstitutionReport struct {
+	// Applied is the number of distinct variable references which were substituted.
+	Applied int
+	// Unresolved lists, sorted, the distinct variable references which were left as literals.
+	Unresolved []string
+	// Errors lists the variable references which could not be substituted because of an error.
+	Errors []SubstitutionError
+}
+
+// newSubstitutionReport returns the SubstitutionReport of the references found before and after substitution.
+func newSubstitutionReport(before, after []string) SubstitutionReport {
+	unresolved := sets.New(after...)
+	return SubstitutionReport{
+		Applied:    sets.New(before...).Difference(unresolved).Len(),
+		Unresolved: sets.List(unresolved),
+	}
+}
+
+// add merges other into the SubstitutionReport.
+func (r *SubstitutionReport) add(other SubstitutionReport) {
+	r.Applied += other.Applied
+	r.Unresolved = sets.List(sets.New(r.Unresolved...).Insert(other.Unresolved...))
+	r.Errors = append(r.Errors, other.Errors...)
+}
+
+// pipelineTaskResultReferences returns the task result references of the PipelineTask, e.g. tasks.build.results.digest.
+func pipelineTaskResultReferences(pt *v1.PipelineTask) []string {
+	if pt == nil {
+		return nil
+	}
+	var references []string
+	for _, ref := range v1.PipelineTaskResultRefs(pt) {
+		references = append(references, fmt.Sprintf("%s.%s.%s.%s", v1.ResultTaskPart, ref.PipelineTask, v1.ResultResultPart, ref.Result))
+	}
+	return references
+}
+
+// taskSpecReferences returns the variable references in the steps and sidecars of the TaskSpec which match.
+func taskSpecReferences(ts *v1.TaskSpec, matches func(string) bool) []string {
+	expressions := (&v1.PipelineTask{TaskSpec: &v1.EmbeddedTask{TaskSpec: *ts}}).GetVarSubstitutionExpressions()
+	var references []string
+	for _, expression := range expressions {
+		if matches(expression) {
+			references = append(references, expression)
 		}
 	}
-	pt.DisplayName = substitution.ApplyReplacements(pt.DisplayName, replacements)
-	return pt
+	return references
+}
+
+// ApplyTaskResults applies the ResolvedResultRef to each PipelineTask.Params and Pipeline.When in targets,
+// as well as to the embedded TaskSpec of PipelineTasks which don't use a TaskRef. The ResolvedResultRefs
+// are deduplicated first, and an error is returned without applying any of them if the same result is
+// resolved to different values. The returned SubstitutionReport describes the task result references
+// of the targets which were substituted and those which were left unresolved.
+func ApplyTaskResults(ctx context.Context, targets PipelineRunState, resolvedResultRefs ResolvedResultRefs) (SubstitutionReport, error) {
+	ctx, span := startApplySpan(ctx, "ApplyTaskResults", attribute.Int("tasks", len(targets)))
+	defer span.End()
+
+	start := time.Now()
+	report, err := applyTaskResults(targets, resolvedResultRefs)
+	span.SetAttributes(attribute.Int("replacements", report.Applied))
+	substitution.RecordSubstitutionMetrics(ctx, report.Applied, time.Since(start))
+	return report, err
 }
 
-// ApplyTaskResults applies the ResolvedResultRef to each PipelineTask.Params and Pipeline.When in targets
-func ApplyTaskResults(targets PipelineRunState, resolvedResultRefs ResolvedResultRefs) {
-	stringReplacements := resolvedResultRefs.getStringReplacements()
-	arrayReplacements := resolvedResultRefs.getArrayReplacements()
-	objectReplacements := resolvedResultRefs.getObjectReplacements()
+func applyTaskResults(targets PipelineRunState, resolvedResultRefs ResolvedResultRefs) (SubstitutionReport, error) {
+	resolvedResultRefs, err := resolvedResultRefs.Deduplicate()
+	if err != nil {
+		report := SubstitutionReport{}
+		var substitutionErr SubstitutionError
+		if errors.As(err, &substitutionErr) {
+			report.Errors = append(report.Errors, substitutionErr)
+		}
+		return report, err
+	}
+	sc := &SubstitutionContext{
+		StringReplacements: resolvedResultRefs.getStringReplacements(),
+		ArrayReplacements:  resolvedResultRefs.getArrayReplacements(),
+		ObjectReplacements: resolvedResultRefs.getObjectReplacements(),
+		Source:             SubstitutionSourceTaskResults,
+	}
+	report := SubstitutionReport{}
 	for _, resolvedPipelineRunTask := range targets {
+		before := pipelineTaskResultReferences(resolvedPipelineRunTask.PipelineTask)
+		var whenBefore v1.WhenExpressions
 		if resolvedPipelineRunTask.PipelineTask != nil {
-			pipelineTask := resolvedPipelineRunTask.PipelineTask.DeepCopy()
-			pipelineTask.Params = pipelineTask.Params.ReplaceVariables(stringReplacements, arrayReplacements, objectReplacements)
-			if pipelineTask.IsMatrixed() {
-				// Matrixed pipeline results replacements support:
-				// 1. String replacements from string, array or object results
-				// 2. array replacements from array results are supported
-				pipelineTask.Matrix.Params = pipelineTask.Matrix.Params.ReplaceVariables(stringReplacements, arrayReplacements, nil)
-				for i := range pipelineTask.Matrix.Include {
-					// matrix include parameters can only be type string
-					pipelineTask.Matrix.Include[i].Params = pipelineTask.Matrix.Include[i].Params.ReplaceVariables(stringReplacements, nil, nil)
-				}
+			whenBefore = resolvedPipelineRunTask.PipelineTask.When
+		}
+		applyResultReplacements(resolvedPipelineRunTask, sc)
+		report.add(newSubstitutionReport(before, pipelineTaskResultReferences(resolvedPipelineRunTask.PipelineTask)))
+		if len(whenBefore) > 0 {
+			report.Errors = append(report.Errors, emptyWhenExpressionErrors(whenBefore, resolvedPipelineRunTask.PipelineTask.When)...)
+		}
+	}
+	return report, nil
+}
+
+// emptyWhenExpressionErrors returns a SubstitutionError for each WhenExpression which had Values before the
+// substitution and has none left once the arrays they reference are expanded, e.g. an empty array result.
+func emptyWhenExpressionErrors(before, after v1.WhenExpressions) []SubstitutionError {
+	var errs []SubstitutionError
+	for i := range before {
+		if i >= len(after) || len(before[i].Values) == 0 || len(after[i].Values) != 0 {
+			continue
+		}
+		values := v1.WhenExpression{Values: before[i].Values}
+		expressions, _ := values.GetVarSubstitutionExpressions()
+		for _, expression := range expressions {
+			errs = append(errs, SubstitutionError{
+				Variable: strings.TrimSuffix(expression, "[*]"),
+				Reason:   fmt.Sprintf("when expression with input %q has no values left once the arrays are expanded", after[i].Input),
+			})
+		}
+	}
+	return errs
+}
+
+// UnresolvedVar is a variable reference left in a PipelineTask once all the substitutions have been applied.
+type UnresolvedVar struct {
+	// PipelineTask is the name of the PipelineTask referencing the variable.
+	PipelineTask string
+	// Field is the path of the field referencing the variable within the PipelineTask, e.g. params.image.
+	Field string
+	// Variable is the referenced variable, e.g. tasks.build.results.digest.
+	Variable string
+}
+
+// FindUnresolvedVariables returns the variable references remaining in the params, matrix and when expressions
+// of the tasks and finally tasks of the PipelineSpec, as well as the task result references remaining in the
+// steps, step template and sidecars of their embedded TaskSpecs. It is meant to be called once all the apply
+// functions have been called, so that any remaining reference is one which could not be resolved. References
+// to the context of the TaskRun are resolved when the TaskRun is reconciled and so are not reported.
+func FindUnresolvedVariables(spec *v1.PipelineSpec) []UnresolvedVar {
+	var unresolved []UnresolvedVar
+	for _, tasks := range [][]v1.PipelineTask{spec.Tasks, spec.Finally} {
+		for i := range tasks {
+			unresolved = append(unresolved, findUnresolvedVariablesInPipelineTask(&tasks[i])...)
+		}
+	}
+	return unresolved
+}
+
+func findUnresolvedVariablesInPipelineTask(pt *v1.PipelineTask) []UnresolvedVar {
+	var unresolved []UnresolvedVar
+	add := func(field string, expressions []string, matches func(string) bool) {
+		for _, expression := range expressions {
+			if matches(expression) {
+				unresolved = append(unresolved, UnresolvedVar{PipelineTask: pt.Name, Field: field, Variable: expression})
 			}
-			pipelineTask.When = pipelineTask.When.ReplaceVariables(stringReplacements, arrayReplacements)
-			if pipelineTask.TaskRef != nil {
-				if pipelineTask.TaskRef.Params != nil {
-					pipelineTask.TaskRef.Params = pipelineTask.TaskRef.Params.ReplaceVariables(stringReplacements, arrayReplacements, objectReplacements)
+		}
+	}
+	addParams := func(prefix string, params v1.Params) {
+		for _, p := range params {
+			expressions, _ := p.GetVarSubstitutionExpressions()
+			add(prefix+"params."+p.Name, expressions, isPipelineScopedVariable)
+		}
+	}
+
+	addParams("", pt.Params)
+	if pt.Matrix != nil {
+		addParams("matrix.", pt.Matrix.Params)
+		for i, include := range pt.Matrix.Include {
+			addParams(fmt.Sprintf("matrix.include[%d].", i), include.Params)
+		}
+	}
+	for i, we := range pt.When {
+		expressions, _ := we.GetVarSubstitutionExpressions()
+		add(fmt.Sprintf("when[%d]", i), expressions, isPipelineScopedVariable)
+	}
+	if pt.TaskSpec != nil {
+		if pt.TaskSpec.StepTemplate != nil {
+			add("taskSpec.stepTemplate", pt.TaskSpec.StepTemplate.GetVarSubstitutionExpressions(), resultref.LooksLikeResultRef)
+		}
+		for i, step := range pt.TaskSpec.Steps {
+			add(fmt.Sprintf("taskSpec.steps[%d]", i), step.GetVarSubstitutionExpressions(), resultref.LooksLikeResultRef)
+		}
+		for i, sidecar := range pt.TaskSpec.Sidecars {
+			add(fmt.Sprintf("taskSpec.sidecars[%d]", i), sidecar.GetVarSubstitutionExpressions(), resultref.LooksLikeResultRef)
+		}
+	}
+	return unresolved
+}
+
+// isPipelineScopedVariable returns whether the variable is resolved by the PipelineRun reconciler,
+// as opposed to the context of the TaskRun which is resolved by the TaskRun reconciler.
+func isPipelineScopedVariable(expression string) bool {
+	return !strings.HasPrefix(expression, "context.taskRun.") && !strings.HasPrefix(expression, "context.task.")
+}
+
+// ApplyResultsToFinallyTasks applies the results of completed tasks to each finally PipelineTask in targets.
+// References to DAG tasks use the $(tasks.<name>.results.<result>) form and references to sibling finally
+// tasks use the $(finally.<name>.results.<result>) form; each form is only resolved against tasks from its
+// own section. An error is returned when a finally task references a result of a sibling finally task
+// which has not completed yet.
+func ApplyResultsToFinallyTasks(targets PipelineRunState, facts *PipelineRunFacts) error {
+	stateMap := facts.State.ToMap()
+	for _, target := range targets {
+		if target.PipelineTask == nil {
+			continue
+		}
+		var dagResultRefs, finallyResultRefs ResolvedResultRefs
+		for _, resultRef := range v1.PipelineTaskResultRefs(target.PipelineTask) {
+			referencedPipelineTask := stateMap[resultRef.PipelineTask]
+			if referencedPipelineTask == nil {
+				continue
+			}
+			isFinallyRef := facts.isFinalTask(resultRef.PipelineTask)
+			if !referencedPipelineTask.isSuccessful() && !referencedPipelineTask.isFailure() {
+				if isFinallyRef {
+					return fmt.Errorf("finally task %q references result %q of finally task %q which is not available yet",
+						target.PipelineTask.Name, resultRef.Result, resultRef.PipelineTask)
 				}
-				pipelineTask.TaskRef.Name = substitution.ApplyReplacements(pipelineTask.TaskRef.Name, stringReplacements)
+				continue
 			}
-			pipelineTask.DisplayName = substitution.ApplyReplacements(pipelineTask.DisplayName, stringReplacements)
-			for i, workspace := range pipelineTask.Workspaces {
-				pipelineTask.Workspaces[i].SubPath = substitution.ApplyReplacements(workspace.SubPath, stringReplacements)
+			resolved, err := resolveResultRefFromTask(referencedPipelineTask, resultRef)
+			if err != nil {
+				return err
+			}
+			if isFinallyRef {
+				finallyResultRefs = append(finallyResultRefs, resolved...)
+			} else {
+				dagResultRefs = append(dagResultRefs, resolved...)
+			}
+		}
+		dagResultRefs, finallyResultRefs = removeDup(dagResultRefs), removeDup(finallyResultRefs)
+
+		applyResultReplacements(target, &SubstitutionContext{
+			StringReplacements: mergeReplacements(dagResultRefs.getStringReplacements(), toFinallyReplacements(finallyResultRefs.getStringReplacements())),
+			ArrayReplacements:  mergeReplacements(dagResultRefs.getArrayReplacements(), toFinallyReplacements(finallyResultRefs.getArrayReplacements())),
+			ObjectReplacements: mergeReplacements(dagResultRefs.getObjectReplacements(), toFinallyReplacements(finallyResultRefs.getObjectReplacements())),
+			Source:             SubstitutionSourceTaskResults,
+		})
+	}
+	return nil
+}
+
+// toFinallyReplacements rewrites the keys of the replacements built for $(tasks.<name>.results.<result>)
+// references into the $(finally.<name>.results.<result>) form.
+func toFinallyReplacements[V any](replacements map[string]V) map[string]V {
+	finallyReplacements := make(map[string]V, len(replacements))
+	for k, v := range replacements {
+		finallyReplacements[v1.ResultFinallyPart+strings.TrimPrefix(k, v1.ResultTaskPart)] = v
+	}
+	return finallyReplacements
+}
+
+// applyResultReplacements applies the result replacements to the PipelineTask.Params and Pipeline.When
+// of the given ResolvedPipelineTask, as well as to its embedded TaskSpec if it doesn't use a TaskRef.
+// The values substituted in the fields to which ApplyPipelineTaskContexts applies the pipeline task contexts, the
+// params, the matrix, the when expressions and the display name, are escaped so that it doesn't substitute the
+// "$(...)" they may contain. They are unescaped by ApplyPipelineTaskContexts, or by GetResolvedPipelineSpec for the
+// PipelineTasks which haven't started. The PipelineTask of the ResolvedPipelineTask keeps them escaped.
+func applyResultReplacements(resolvedPipelineRunTask *ResolvedPipelineTask, sc *SubstitutionContext) {
+	stringReplacements, arrayReplacements, objectReplacements := sc.StringReplacements, sc.ArrayReplacements, sc.ObjectReplacements
+	if resolvedPipelineRunTask.PipelineTask != nil {
+		pipelineTask := resolvedPipelineRunTask.PipelineTask.DeepCopy()
+		escaped := sc.escaped()
+		pipelineTask.Params = pipelineTask.Params.ReplaceVariables(escaped.StringReplacements, escaped.ArrayReplacements, escaped.ObjectReplacements)
+		if pipelineTask.IsMatrixed() {
+			// Matrixed pipeline results replacements support:
+			// 1. String replacements from string, array or object results
+			// 2. array replacements from array results are supported
+			pipelineTask.Matrix.Params = pipelineTask.Matrix.Params.ReplaceVariables(escaped.StringReplacements, escaped.ArrayReplacements, nil)
+			for i := range pipelineTask.Matrix.Include {
+				// matrix include parameters can only be type string
+				pipelineTask.Matrix.Include[i].Params = pipelineTask.Matrix.Include[i].Params.ReplaceVariables(escaped.StringReplacements, nil, nil)
 			}
-			resolvedPipelineRunTask.PipelineTask = pipelineTask
 		}
+		pipelineTask.When = pipelineTask.When.ReplaceVariables(escaped.StringReplacements, escaped.ArrayReplacements)
+		if pipelineTask.TaskRef != nil {
+			if pipelineTask.TaskRef.Params != nil {
+				pipelineTask.TaskRef.Params = pipelineTask.TaskRef.Params.ReplaceVariables(stringReplacements, arrayReplacements, objectReplacements)
+			}
+			pipelineTask.TaskRef.Name = substitution.ApplyReplacements(pipelineTask.TaskRef.Name, stringReplacements)
+		}
+		pipelineTask.DisplayName = substitution.ApplyReplacements(pipelineTask.DisplayName, escaped.StringReplacements)
+		pipelineTask.TimeoutString = substitution.ApplyReplacements(pipelineTask.TimeoutString, stringReplacements)
+		for i, workspace := range pipelineTask.Workspaces {
+			pipelineTask.Workspaces[i].SubPath = substitution.ApplyReplacements(workspace.SubPath, stringReplacements)
+		}
+		if pipelineTask.TaskRef == nil && pipelineTask.TaskSpec != nil {
+			// Embedded Task steps, sidecars, volumes etc. can refer to task results as well
+			pipelineTask.TaskSpec.TaskSpec = *resources.ApplyReplacements(&pipelineTask.TaskSpec.TaskSpec, stringReplacements, arrayReplacements, objectReplacements)
+			if resolvedPipelineRunTask.ResolvedTask != nil && resolvedPipelineRunTask.ResolvedTask.TaskSpec != nil {
+				resolvedPipelineRunTask.ResolvedTask.TaskSpec = resources.ApplyReplacements(resolvedPipelineRunTask.ResolvedTask.TaskSpec, stringReplacements, arrayReplacements, objectReplacements)
+			}
+		}
+		resolvedPipelineRunTask.PipelineTask = pipelineTask
 	}
 }
 
-// ApplyPipelineTaskStateContext replaces context variables referring to execution status with the specified status
-func ApplyPipelineTaskStateContext(state PipelineRunState, replacements map[string]string) {
+// ApplyPipelineTaskStateContext replaces context variables referring to execution status with the specified status,
+// and the context variables referring to the status of the PipelineRun with the values from GetStatusContextReplacements.
+func ApplyPipelineTaskStateContext(state PipelineRunState, replacements map[string]string, pr *v1.PipelineRun) {
+	replacements = maps.Clone(replacements)
+	maps.Copy(replacements, GetStatusContextReplacements(pr))
 	for _, resolvedPipelineRunTask := range state {
 		if resolvedPipelineRunTask.PipelineTask != nil {
 			pipelineTask := resolvedPipelineRunTask.PipelineTask.DeepCopy()
@@ -277,123 +865,400 @@ func ApplyPipelineTaskStateContext(state PipelineRunState, replacements map[stri
 				pipelineTask.TaskRef.Name = substitution.ApplyReplacements(pipelineTask.TaskRef.Name, replacements)
 			}
 			pipelineTask.DisplayName = substitution.ApplyReplacements(pipelineTask.DisplayName, replacements)
+			pipelineTask.TimeoutString = substitution.ApplyReplacements(pipelineTask.TimeoutString, replacements)
 			resolvedPipelineRunTask.PipelineTask = pipelineTask
 		}
 	}
 }
 
+// ApplyPipelineTaskTimeout parses the TimeoutString of the PipelineTask, once all of its variables
+// have been substituted, into the Timeout of the PipelineTask. It returns an error if the
+// TimeoutString still contains variable references or is not a valid, non-negative duration.
+func ApplyPipelineTaskTimeout(pt *v1.PipelineTask) error {
+	if pt.TimeoutString == "" {
+		return nil
+	}
+	if v1.VariableSubstitutionRegex.MatchString(pt.TimeoutString) {
+		return fmt.Errorf("timeoutString %q of pipeline task %q contains unresolved variable references", pt.TimeoutString, pt.Name)
+	}
+	d, err := time.ParseDuration(pt.TimeoutString)
+	if err != nil {
+		return fmt.Errorf("timeoutString %q of pipeline task %q is not a valid duration: %w", pt.TimeoutString, pt.Name, err)
+	}
+	if d < 0 {
+		return fmt.Errorf("timeoutString %q of pipeline task %q should be >= 0", pt.TimeoutString, pt.Name)
+	}
+	pt.Timeout = &metav1.Duration{Duration: d}
+	return nil
+}
+
 // ApplyWorkspaces replaces workspace variables in the given pipeline spec with their
-// concrete values.
-func ApplyWorkspaces(p *v1.PipelineSpec, pr *v1.PipelineRun) *v1.PipelineSpec {
+// concrete values. $(workspaces.<name>.claim) is replaced with the name of the PersistentVolumeClaim
+// bound to the workspace, or an empty string for other volume types, $(workspaces.<name>.volume)
+// with the name of the VolumeClaimTemplate bound to the workspace, and $(workspaces.<name>.medium)
+// with the medium of the emptyDir bound to the workspace, e.g. Memory, or None for other volume types
+// and unbound workspaces. As the claim, the volume and the medium of the workspaces of an embedded
+// TaskSpec are only known once its TaskRun is created, they are only replaced in the fields of the
+// PipelineTasks and not in their embedded TaskSpecs. The returned SubstitutionContext holds the replacements it
+// applied and follows previous.
+func ApplyWorkspaces(p *v1.PipelineSpec, pr *v1.PipelineRun, previous *SubstitutionContext) (*v1.PipelineSpec, *SubstitutionContext) {
 	p = p.DeepCopy()
 	replacements := map[string]string{}
+	bindingReplacements := map[string]string{}
 	for _, declaredWorkspace := range p.Workspaces {
 		key := fmt.Sprintf("workspaces.%s.bound", declaredWorkspace.Name)
 		replacements[key] = "false"
+		bindingReplacements[fmt.Sprintf("workspaces.%s.medium", declaredWorkspace.Name)] = "None"
 	}
 	for _, boundWorkspace := range pr.Spec.Workspaces {
 		key := fmt.Sprintf("workspaces.%s.bound", boundWorkspace.Name)
 		replacements[key] = "true"
+		claimKey := fmt.Sprintf("workspaces.%s.claim", boundWorkspace.Name)
+		if boundWorkspace.PersistentVolumeClaim != nil {
+			bindingReplacements[claimKey] = boundWorkspace.PersistentVolumeClaim.ClaimName
+		} else {
+			bindingReplacements[claimKey] = ""
+		}
+		mediumKey := fmt.Sprintf("workspaces.%s.medium", boundWorkspace.Name)
+		if boundWorkspace.EmptyDir != nil {
+			bindingReplacements[mediumKey] = string(boundWorkspace.EmptyDir.Medium)
+		} else {
+			bindingReplacements[mediumKey] = "None"
+		}
+		if boundWorkspace.VolumeClaimTemplate != nil {
+			bindingReplacements[fmt.Sprintf("workspaces.%s.volume", boundWorkspace.Name)] = boundWorkspace.VolumeClaimTemplate.Name
+		}
 	}
-	return ApplyReplacements(p, replacements, map[string][]string{}, map[string]map[string]string{})
+	p = ApplyReplacements(p, &SubstitutionContext{StringReplacements: replacements})
+	bindingContext := &SubstitutionContext{StringReplacements: bindingReplacements}
+	for _, tasks := range [][]v1.PipelineTask{p.Tasks, p.Finally} {
+		for i := range tasks {
+			taskSpec := tasks[i].TaskSpec
+			tasks[i].TaskSpec = nil
+			replaceVariablesInPipelineTasks(tasks[i:i+1], bindingContext)
+			tasks[i].TaskSpec = taskSpec
+		}
+	}
+	return p, &SubstitutionContext{StringReplacements: mergeReplacements(bindingReplacements, replacements), Source: SubstitutionSourceWorkspaces, Previous: previous}
 }
 
 // replaceVariablesInPipelineTasks handles variable replacement for a slice of PipelineTasks in-place
-func replaceVariablesInPipelineTasks(tasks []v1.PipelineTask, replacements map[string]string,
-	arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) {
+func replaceVariablesInPipelineTasks(tasks []v1.PipelineTask, sc *SubstitutionContext) {
+	// OnError is a plain string field, so references to individual keys of object params such as
+	// $(params.config.onError) are substituted from a flat string map with the object keys expanded,
+	// rather than teaching substitution.ApplyReplacements about object replacements.
+	onErrorReplacements := expandObjectKeyReplacements(sc.StringReplacements, sc.ObjectReplacements)
 	for i := range tasks {
-		tasks[i].Params = tasks[i].Params.ReplaceVariables(replacements, arrayReplacements, objectReplacements)
-		if tasks[i].IsMatrixed() {
-			tasks[i].Matrix.Params = tasks[i].Matrix.Params.ReplaceVariables(replacements, arrayReplacements, nil)
-			for j := range tasks[i].Matrix.Include {
-				tasks[i].Matrix.Include[j].Params = tasks[i].Matrix.Include[j].Params.ReplaceVariables(replacements, nil, nil)
-			}
-		} else {
-			tasks[i].DisplayName = substitution.ApplyReplacements(tasks[i].DisplayName, replacements)
+		replaceVariablesInPipelineTask(&tasks[i], sc, onErrorReplacements)
+	}
+}
+
+// ParallelReplaceVariablesInPipelineTasks handles variable replacement for a slice of PipelineTasks in-place as
+// replaceVariablesInPipelineTasks does, substituting up to concurrency PipelineTasks at the same time. It falls back
+// to the sequential substitution when concurrency <= 1. The SubstitutionContext is only read.
+func ParallelReplaceVariablesInPipelineTasks(tasks []v1.PipelineTask, sc *SubstitutionContext, concurrency int) {
+	if concurrency <= 1 {
+		replaceVariablesInPipelineTasks(tasks, sc)
+		return
+	}
+	onErrorReplacements := expandObjectKeyReplacements(sc.StringReplacements, sc.ObjectReplacements)
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+	for i := range tasks {
+		g.Go(func() error {
+			replaceVariablesInPipelineTask(&tasks[i], sc, onErrorReplacements)
+			return nil
+		})
+	}
+	// the substitution of a PipelineTask never fails
+	_ = g.Wait()
+}
+
+// replaceVariablesInPipelineTask handles variable replacement for a PipelineTask in-place. onErrorReplacements are
+// the replacements with the object keys expanded, see expandObjectKeyReplacements.
+func replaceVariablesInPipelineTask(pt *v1.PipelineTask, sc *SubstitutionContext, onErrorReplacements map[string]string) {
+	replacements, arrayReplacements, objectReplacements := sc.StringReplacements, sc.ArrayReplacements, sc.ObjectReplacements
+	pt.Params = pt.Params.ReplaceVariables(replacements, arrayReplacements, objectReplacements)
+	if pt.IsMatrixed() {
+		// a matrix param referencing a whole object param is typed as an object, to be reported as invalid
+		// by ValidateParameterTypesInMatrix, whereas the individual keys of object params are strings
+		pt.Matrix.Params = pt.Matrix.Params.ReplaceVariables(replacements, arrayReplacements, objectReplacements)
+		for j := range pt.Matrix.Include {
+			pt.Matrix.Include[j].Params = pt.Matrix.Include[j].Params.ReplaceVariables(replacements, nil, nil)
 		}
-		for j := range tasks[i].Workspaces {
-			tasks[i].Workspaces[j].SubPath = substitution.ApplyReplacements(tasks[i].Workspaces[j].SubPath, replacements)
+		// the references to the matrix params are left to be substituted with the values of each combination
+		// in the childReferences of the PipelineRun
+		pt.DisplayName = substitution.ApplyReplacements(pt.DisplayName, withoutMatrixParams(replacements, pt.Matrix))
+	} else {
+		pt.DisplayName = substitution.ApplyReplacements(pt.DisplayName, replacements)
+	}
+	for j := range pt.Workspaces {
+		pt.Workspaces[j].SubPath = substitution.ApplyReplacements(pt.Workspaces[j].SubPath, replacements)
+	}
+	pt.When = pt.When.ReplaceVariables(replacements, arrayReplacements)
+	if pt.TaskRef != nil {
+		if pt.TaskRef.Params != nil {
+			pt.TaskRef.Params = pt.TaskRef.Params.ReplaceVariables(replacements, arrayReplacements, objectReplacements)
 		}
-		tasks[i].When = tasks[i].When.ReplaceVariables(replacements, arrayReplacements)
-		if tasks[i].TaskRef != nil {
-			if tasks[i].TaskRef.Params != nil {
-				tasks[i].TaskRef.Params = tasks[i].TaskRef.Params.ReplaceVariables(replacements, arrayReplacements, objectReplacements)
+		pt.TaskRef.Name = substitution.ApplyReplacements(pt.TaskRef.Name, replacements)
+		pt.TaskRef.Resolver = v1.ResolverName(substitution.ApplyReplacements(string(pt.TaskRef.Resolver), replacements))
+	}
+	pt.OnError = v1.PipelineTaskOnErrorType(substitution.ApplyReplacements(string(pt.OnError), onErrorReplacements))
+	pt.TimeoutString = substitution.ApplyReplacements(pt.TimeoutString, replacements)
+	*pt = propagateParams(*pt, sc)
+}
+
+// withoutMatrixParams returns a copy of replacements without the entries of the params of the matrix, whose
+// value is only known for each combination of the matrix.
+func withoutMatrixParams(replacements map[string]string, matrix *v1.Matrix) map[string]string {
+	names := sets.NewString()
+	for _, p := range matrix.Params {
+		names.Insert(p.Name)
+	}
+	for _, include := range matrix.Include {
+		for _, p := range include.Params {
+			names.Insert(p.Name)
+		}
+	}
+	filtered := make(map[string]string, len(replacements))
+	for variable, value := range replacements {
+		filtered[variable] = value
+	}
+	for _, name := range names.List() {
+		for _, pattern := range paramPatterns {
+			variable := fmt.Sprintf(pattern, name)
+			for v := range filtered {
+				if v == variable || strings.HasPrefix(v, variable+"[") {
+					delete(filtered, v)
+				}
 			}
-			tasks[i].TaskRef.Name = substitution.ApplyReplacements(tasks[i].TaskRef.Name, replacements)
 		}
-		tasks[i].OnError = v1.PipelineTaskOnErrorType(substitution.ApplyReplacements(string(tasks[i].OnError), replacements))
-		tasks[i] = propagateParams(tasks[i], replacements, arrayReplacements, objectReplacements)
 	}
+	return filtered
+}
+
+// expandObjectKeyReplacements returns a copy of replacements which also contains an entry for each individual
+// key of the objects in objectReplacements, e.g. params.config.onError for the key onError of params.config.
+// Entries already present in replacements take precedence.
+func expandObjectKeyReplacements(replacements map[string]string, objectReplacements map[string]map[string]string) map[string]string {
+	objectKeys := make(map[string]string)
+	for variable, object := range objectReplacements {
+		for k, v := range object {
+			objectKeys[fmt.Sprintf("%s.%s", variable, k)] = v
+		}
+	}
+	return mergeReplacements(objectKeys, replacements)
 }
 
-// ApplyReplacements replaces placeholders for declared parameters with the specified replacements.
-func ApplyReplacements(p *v1.PipelineSpec, replacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) *v1.PipelineSpec {
+// ApplyReplacements replaces placeholders for declared parameters with the replacements of the SubstitutionContext.
+func ApplyReplacements(p *v1.PipelineSpec, sc *SubstitutionContext) *v1.PipelineSpec {
 	p = p.DeepCopy()
 
 	// Replace variables in Tasks and Finally tasks
-	replaceVariablesInPipelineTasks(p.Tasks, replacements, arrayReplacements, objectReplacements)
-	replaceVariablesInPipelineTasks(p.Finally, replacements, arrayReplacements, objectReplacements)
+	replaceVariablesInPipelineTasks(p.Tasks, sc)
+	replaceVariablesInPipelineTasks(p.Finally, sc)
 
 	return p
 }
 
+// applyReplacementsCounted substitutes the replacements of the SubstitutionContext as ApplyReplacements does, and
+// returns the number of references to its variables which were substituted, whatever the pattern they are
+// referenced with. The references of each PipelineTask are counted as it is substituted.
+func applyReplacementsCounted(p *v1.PipelineSpec, sc *SubstitutionContext) (*v1.PipelineSpec, int) {
+	p = p.DeepCopy()
+	applied := 0
+	onErrorReplacements := expandObjectKeyReplacements(sc.StringReplacements, sc.ObjectReplacements)
+	for _, tasks := range [][]v1.PipelineTask{p.Tasks, p.Finally} {
+		for i := range tasks {
+			applied += countReferences(&tasks[i], sc)
+			replaceVariablesInPipelineTask(&tasks[i], sc, onErrorReplacements)
+		}
+	}
+	return p, applied
+}
+
+// countReferences returns the number of references to the variables of the SubstitutionContext in the fields of
+// the PipelineTask which are substituted, i.e. all of them but its embedded PipelineSpec.
+func countReferences(pt *v1.PipelineTask, sc *SubstitutionContext) int {
+	withoutPipelineSpec := *pt
+	withoutPipelineSpec.PipelineSpec = nil
+	count := 0
+	forEachVariable(reflect.ValueOf(withoutPipelineSpec), func(variable string) {
+		if sc.has(variable) {
+			count++
+		}
+	})
+	return count
+}
+
+// ParamConsumers returns the names of the PipelineTasks of the PipelineSpec which reference each of the given
+// params, by param name. The references are looked for in all the fields of the PipelineTasks, including their
+// embedded specs to which the params are propagated, so a PipelineTask is also reported for a param it shadows
+// with one of its own. The PipelineSpec must not have its params substituted yet.
+func ParamConsumers(spec *v1.PipelineSpec, names []string) map[string][]string {
+	consumers := map[string][]string{}
+	for _, pt := range append(append([]v1.PipelineTask{}, spec.Tasks...), spec.Finally...) {
+		referenced := sets.New[string]()
+		forEachVariable(reflect.ValueOf(pt), func(variable string) {
+			for _, name := range names {
+				if referencesParam(variable, name) {
+					referenced.Insert(name)
+				}
+			}
+		})
+		for _, name := range sets.List(referenced) {
+			consumers[name] = append(consumers[name], pt.Name)
+		}
+	}
+	return consumers
+}
+
+// referencesParam returns whether the variable, without the enclosing $(), references the param, whole or one of
+// its elements or keys, with any of the param patterns.
+func referencesParam(variable, name string) bool {
+	for _, pattern := range paramPatterns {
+		reference := fmt.Sprintf(pattern, name)
+		if variable == reference || strings.HasPrefix(variable, reference+"[") || strings.HasPrefix(variable, reference+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// forEachVariable calls fn with each variable referenced in the strings held by v, without the enclosing $().
+func forEachVariable(v reflect.Value, fn func(string)) {
+	forEachString(v, func(s string) {
+		for rest := s; ; {
+			start := strings.Index(rest, "$(")
+			if start < 0 {
+				return
+			}
+			rest = rest[start+len("$("):]
+			end := strings.Index(rest, ")")
+			if end < 0 {
+				return
+			}
+			fn(rest[:end])
+			rest = rest[end+len(")"):]
+		}
+	})
+}
+
+// forEachString calls fn with each string held by v, e.g. the exported string fields of a struct and the elements
+// and values of its slices and maps.
+func forEachString(v reflect.Value, fn func(string)) {
+	switch kind := v.Kind(); {
+	case kind == reflect.String:
+		fn(v.String())
+	case (kind == reflect.Pointer || kind == reflect.Interface) && !v.IsNil():
+		forEachString(v.Elem(), fn)
+	case kind == reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				forEachString(v.Field(i), fn)
+			}
+		}
+	case kind == reflect.Slice || kind == reflect.Array:
+		for i := range v.Len() {
+			forEachString(v.Index(i), fn)
+		}
+	case kind == reflect.Map:
+		for iter := v.MapRange(); iter.Next(); {
+			forEachString(iter.Value(), fn)
+		}
+	}
+}
+
 // propagateParams returns a Pipeline Task spec that is the same as the input Pipeline Task spec, but with
-// all parameter replacements from `stringReplacements`, `arrayReplacements`, and `objectReplacements` substituted.
-// It does not modify `stringReplacements`, `arrayReplacements`, or `objectReplacements`.
-func propagateParams(t v1.PipelineTask, stringReplacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) v1.PipelineTask {
+// all parameter replacements of the SubstitutionContext substituted. It does not modify the SubstitutionContext.
+func propagateParams(t v1.PipelineTask, sc *SubstitutionContext) v1.PipelineTask {
 	if t.TaskSpec == nil {
 		return t
 	}
-	// check if there are task parameters defined that match the params at pipeline level
-	if len(t.Params) > 0 {
-		stringReplacementsDup := make(map[string]string)
-		arrayReplacementsDup := make(map[string][]string)
-		objectReplacementsDup := make(map[string]map[string]string)
-		for k, v := range stringReplacements {
-			stringReplacementsDup[k] = v
-		}
-		for k, v := range arrayReplacements {
-			arrayReplacementsDup[k] = v
-		}
-		for k, v := range objectReplacements {
-			objectReplacementsDup[k] = v
+	stringReplacements, arrayReplacements, objectReplacements := scopeReplacements(&t, sc.StringReplacements, sc.ArrayReplacements, sc.ObjectReplacements)
+	t.TaskSpec.TaskSpec = *resources.ApplyReplacements(&t.TaskSpec.TaskSpec, stringReplacements, arrayReplacements, objectReplacements)
+	return t
+}
+
+// ApplyReplacementsToStepActions substitutes the replacements in the params of the steps of the embedded
+// TaskSpec of the PipelineTask which reference a StepAction. As for the rest of the embedded TaskSpec,
+// the params of the PipelineTask take precedence over the pipeline params with the same name.
+// It does not modify `stringReplacements`, `arrayReplacements`, or `objectReplacements`.
+func ApplyReplacementsToStepActions(pt *v1.PipelineTask, stringReplacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) {
+	if pt.TaskSpec == nil {
+		return
+	}
+	stringReplacements, arrayReplacements, objectReplacements = scopeReplacements(pt, stringReplacements, arrayReplacements, objectReplacements)
+	for i := range pt.TaskSpec.Steps {
+		if pt.TaskSpec.Steps[i].Ref != nil && pt.TaskSpec.Steps[i].Params != nil {
+			pt.TaskSpec.Steps[i].Params = pt.TaskSpec.Steps[i].Params.ReplaceVariables(stringReplacements, arrayReplacements, objectReplacements)
 		}
-		for _, par := range t.Params {
-			for _, pattern := range paramPatterns {
-				checkName := fmt.Sprintf(pattern, par.Name)
-				// Scoping. Task Params will replace Pipeline Params
-				if _, ok := stringReplacementsDup[checkName]; ok {
-					stringReplacementsDup[checkName] = par.Value.StringVal
-				}
-				if _, ok := arrayReplacementsDup[checkName]; ok {
-					arrayReplacementsDup[checkName] = par.Value.ArrayVal
-				}
-				if _, ok := objectReplacementsDup[checkName]; ok {
-					objectReplacementsDup[checkName] = par.Value.ObjectVal
-					for k, v := range par.Value.ObjectVal {
-						stringReplacementsDup[fmt.Sprintf(objectIndividualVariablePattern, par.Name, k)] = v
-					}
+	}
+}
+
+// scopeReplacements returns the replacements to apply to the embedded TaskSpec of the PipelineTask: the params
+// of the PipelineTask replace the pipeline params with the same name. The given maps are returned as is if the
+// PipelineTask has no params, otherwise copies are returned.
+func scopeReplacements(t *v1.PipelineTask, stringReplacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) (map[string]string, map[string][]string, map[string]map[string]string) {
+	// check if there are task parameters defined that match the params at pipeline level
+	if len(t.Params) == 0 {
+		return stringReplacements, arrayReplacements, objectReplacements
+	}
+	stringReplacementsDup := mergeReplacements(stringReplacements, nil)
+	arrayReplacementsDup := mergeReplacements(arrayReplacements, nil)
+	objectReplacementsDup := make(map[string]map[string]string)
+	// deep-copy the object values, they may be shared with the replacements of other pipeline tasks
+	for k, v := range objectReplacements {
+		objectReplacementsDup[k] = maps.Clone(v)
+	}
+	for _, par := range t.Params {
+		for _, pattern := range paramPatterns {
+			checkName := fmt.Sprintf(pattern, par.Name)
+			// Scoping. Task Params will replace Pipeline Params
+			if _, ok := stringReplacementsDup[checkName]; ok {
+				stringReplacementsDup[checkName] = par.Value.StringVal
+			}
+			if _, ok := arrayReplacementsDup[checkName]; ok {
+				arrayReplacementsDup[checkName] = par.Value.ArrayVal
+			}
+			if _, ok := objectReplacementsDup[checkName]; ok {
+				objectReplacementsDup[checkName] = maps.Clone(par.Value.ObjectVal)
+				for k, v := range par.Value.ObjectVal {
+					stringReplacementsDup[fmt.Sprintf(objectIndividualVariablePattern, par.Name, k)] = v
 				}
 			}
 		}
-		t.TaskSpec.TaskSpec = *resources.ApplyReplacements(&t.TaskSpec.TaskSpec, stringReplacementsDup, arrayReplacementsDup, objectReplacementsDup)
-	} else {
-		t.TaskSpec.TaskSpec = *resources.ApplyReplacements(&t.TaskSpec.TaskSpec, stringReplacements, arrayReplacements, objectReplacements)
 	}
-	return t
+	return stringReplacementsDup, arrayReplacementsDup, objectReplacementsDup
 }
 
 // ApplyResultsToWorkspaceBindings applies results from TaskRuns to  WorkspaceBindings in a PipelineRun. It replaces placeholders in
-// various binding types with values from TaskRun results.
-func ApplyResultsToWorkspaceBindings(trResults map[string][]v1.TaskRunResult, pr *v1.PipelineRun) {
+// various binding types with values from TaskRun results. Array results can be referenced by index, e.g.
+// $(tasks.<taskName>.results.<arrayResultName>[i]); an error is returned if the index is out of bound.
+func ApplyResultsToWorkspaceBindings(trResults map[string][]v1.TaskRunResult, pr *v1.PipelineRun) error {
+	stringReplacements, arrayResultLengths := workspaceBindingResultReplacements(trResults)
+	if err := validateArrayResultIndexingInWorkspaceBindings(pr.Spec.Workspaces, arrayResultLengths); err != nil {
+		return err
+	}
+	pr.Spec.Workspaces = workspace.ReplaceWorkspaceBindingsVars(pr.Spec.Workspaces, stringReplacements)
+	return nil
+}
+
+// workspaceBindingResultReplacements returns the replacements for the references to the given results in
+// WorkspaceBindings, and the lengths of the array results keyed by their references without index.
+func workspaceBindingResultReplacements(trResults map[string][]v1.TaskRunResult) (map[string]string, map[string]int) {
 	stringReplacements := map[string]string{}
+	arrayResultLengths := map[string]int{}
 	for taskName, taskResults := range trResults {
 		for _, res := range taskResults {
 			switch res.Type {
 			case v1.ResultsTypeString:
 				stringReplacements[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = res.Value.StringVal
 			case v1.ResultsTypeArray:
-				continue
+				for i, v := range res.Value.ArrayVal {
+					stringReplacements[fmt.Sprintf("tasks.%s.results.%s[%d]", taskName, res.Name, i)] = v
+				}
+				arrayResultLengths[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = len(res.Value.ArrayVal)
 			case v1.ResultsTypeObject:
 				for k, v := range res.Value.ObjectVal {
 					stringReplacements[fmt.Sprintf("tasks.%s.results.%s.%s", taskName, res.Name, k)] = v
@@ -401,46 +1266,219 @@ func ApplyResultsToWorkspaceBindings(trResults map[string][]v1.TaskRunResult, pr
 			}
 		}
 	}
+	return stringReplacements, arrayResultLengths
+}
 
-	pr.Spec.Workspaces = workspace.ReplaceWorkspaceBindingsVars(pr.Spec.Workspaces, stringReplacements)
+// PendingWorkspaceBindings returns the names of the WorkspaceBindings of the PipelineRun which reference task
+// results, e.g. a subPath of $(tasks.<taskName>.results.<resultName>). The results may not be available when
+// the PipelineRun is reconciled, so these bindings are resolved by ResolvePendingWorkspaceBindings just before
+// the runs of a PipelineTask binding them are created.
+func PendingWorkspaceBindings(wbs []v1.WorkspaceBinding) (sets.String, error) {
+	pending := sets.NewString()
+	for i := range wbs {
+		expressions, err := workspace.ExtractWorkspaceBindingsVarExpressions(wbs[i:i+1], v1.ResultTaskPart)
+		if err != nil {
+			return nil, err
+		}
+		if len(expressions) > 0 {
+			pending.Insert(wbs[i].Name)
+		}
+	}
+	return pending, nil
+}
+
+// ResolvePendingWorkspaceBindings substitutes the results of the completed pipeline tasks in the pending
+// WorkspaceBindings of the PipelineRun bound by the PipelineTask. An error wrapping ErrUpstreamResultNotAvailable
+// is returned if they reference the results of a pipeline task which has not completed yet, in which case the
+// runs of the PipelineTask must not be created yet. References to results which will never be produced, e.g.
+// by a skipped pipeline task, are left as they are.
+func ResolvePendingWorkspaceBindings(ctx context.Context, pt *v1.PipelineTask, pending sets.String, facts *PipelineRunFacts, pr *v1.PipelineRun) error {
+	bound := sets.NewString()
+	for _, ws := range pt.Workspaces {
+		if ws.Workspace != "" {
+			bound.Insert(ws.Workspace)
+		} else {
+			bound.Insert(ws.Name)
+		}
+	}
+	stringReplacements, arrayResultLengths := workspaceBindingResultReplacements(facts.State.GetTaskRunsResults().ToMap())
+	tasks := facts.State.ToMap()
+	for i := range pr.Spec.Workspaces {
+		if !pending.Has(pr.Spec.Workspaces[i].Name) || !bound.Has(pr.Spec.Workspaces[i].Name) {
+			continue
+		}
+		wbs := pr.Spec.Workspaces[i : i+1]
+		if err := validateArrayResultIndexingInWorkspaceBindings(wbs, arrayResultLengths); err != nil {
+			return err
+		}
+		workspace.ReplaceWorkspaceBindingsVars(wbs, stringReplacements)
+		unresolved, err := workspace.ExtractWorkspaceBindingsVarExpressions(wbs, v1.ResultTaskPart)
+		if err != nil {
+			return err
+		}
+		for _, expression := range unresolved {
+			parts := strings.SplitN(substitution.StripStarVarSubExpression(expression), ".", 3)
+			if len(parts) < 3 {
+				continue
+			}
+			if upstream, ok := tasks[parts[1]]; ok && !upstream.isDone(ctx, facts) {
+				return fmt.Errorf("%w: workspace binding %q references %s but pipeline task %q has not completed yet",
+					ErrUpstreamResultNotAvailable, pr.Spec.Workspaces[i].Name, expression, parts[1])
+			}
+		}
+	}
+	return nil
+}
+
+// validateArrayResultIndexingInWorkspaceBindings returns an error if any WorkspaceBinding refers to
+// an array result with an index that is out of bound of the length of that result.
+func validateArrayResultIndexingInWorkspaceBindings(wbs []v1.WorkspaceBinding, arrayResultLengths map[string]int) error {
+	if len(arrayResultLengths) == 0 {
+		return nil
+	}
+	expressions, err := workspace.ExtractWorkspaceBindingsVarExpressions(wbs, v1.ResultTaskPart)
+	if err != nil {
+		return err
+	}
+	for _, expression := range expressions {
+		variable := substitution.StripStarVarSubExpression(expression)
+		indexString := substitution.ExtractIndexString(variable)
+		if indexString == "" {
+			continue
+		}
+		length, ok := arrayResultLengths[substitution.TrimArrayIndex(variable)]
+		if !ok {
+			continue
+		}
+		idx, err := substitution.ExtractIndex(indexString)
+		if err != nil {
+			return err
+		}
+		if idx >= length {
+			return fmt.Errorf("array index %d out of bound in workspace binding reference %s, the result has length %d", idx, expression, length)
+		}
+	}
+	return nil
+}
+
+// ErrUpstreamResultNotAvailable indicates that the results of a pipeline task could not be propagated because
+// the pipeline task producing them is still running. The propagation should be retried once it completes.
+var ErrUpstreamResultNotAvailable = errors.New("upstream task result not available")
+
+// PropagateResults propagate the result of the completed task to the unfinished task that is not explicitly specify in the params.
+// The returned SubstitutionReport describes the task result references of the resolved TaskSpec which were substituted.
+// An error wrapping ErrUpstreamResultNotAvailable is returned if the TaskSpec references the results of a pipeline
+// task which is still running, in which case the TaskSpec must not be used as it is.
+func PropagateResults(ctx context.Context, rpt *ResolvedPipelineTask, runStates PipelineRunState) (SubstitutionReport, error) {
+	ctx, span := startApplySpan(ctx, "PropagateResults", attribute.Int("tasks", len(runStates)))
+	defer span.End()
+	if rpt.PipelineTask != nil {
+		span.SetAttributes(attribute.String("pipelineTask", rpt.PipelineTask.Name))
+	}
+
+	start := time.Now()
+	report, err := propagateResults(rpt, runStates)
+	span.SetAttributes(attribute.Int("replacements", report.Applied))
+	substitution.RecordSubstitutionMetrics(ctx, report.Applied, time.Since(start))
+	return report, err
 }
 
-// PropagateResults propagate the result of the completed task to the unfinished task that is not explicitly specify in the params
-func PropagateResults(rpt *ResolvedPipelineTask, runStates PipelineRunState) {
+func propagateResults(rpt *ResolvedPipelineTask, runStates PipelineRunState) (SubstitutionReport, error) {
 	if rpt.ResolvedTask == nil || rpt.ResolvedTask.TaskSpec == nil {
-		return
+		return SubstitutionReport{}, nil
 	}
-	stringReplacements := map[string]string{}
-	arrayReplacements := map[string][]string{}
-	for taskName, taskResults := range runStates.GetTaskRunsResults() {
-		for _, res := range taskResults {
+	before := taskSpecReferences(rpt.ResolvedTask.TaskSpec, resultref.LooksLikeResultRef)
+	sc := NewSubstitutionContext(SubstitutionSourceTaskResults, nil)
+	for _, taskResults := range runStates.GetTaskRunsResults() {
+		taskName := taskResults.PipelineTaskName
+		for _, res := range taskResults.Results {
 			switch res.Type {
 			case v1.ResultsTypeString:
-				stringReplacements[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = res.Value.StringVal
+				sc.StringReplacements[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = res.Value.StringVal
 			case v1.ResultsTypeArray:
-				arrayReplacements[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = res.Value.ArrayVal
+				sc.ArrayReplacements[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = res.Value.ArrayVal
 			case v1.ResultsTypeObject:
 				for k, v := range res.Value.ObjectVal {
-					stringReplacements[fmt.Sprintf("tasks.%s.results.%s.%s", taskName, res.Name, k)] = v
+					sc.StringReplacements[fmt.Sprintf("tasks.%s.results.%s.%s", taskName, res.Name, k)] = v
 				}
+				sc.ObjectReplacements[fmt.Sprintf("tasks.%s.results.%s", taskName, res.Name)] = res.Value.ObjectVal
 			}
 		}
 	}
-	rpt.ResolvedTask.TaskSpec = resources.ApplyReplacements(rpt.ResolvedTask.TaskSpec, stringReplacements, arrayReplacements, map[string]map[string]string{})
+	rpt.ResolvedTask.TaskSpec = resources.ApplyReplacements(rpt.ResolvedTask.TaskSpec, sc.StringReplacements, sc.ArrayReplacements, sc.ObjectReplacements)
+	report := newSubstitutionReport(before, taskSpecReferences(rpt.ResolvedTask.TaskSpec, resultref.LooksLikeResultRef))
+
+	// the results of the pipeline tasks which are still running are not available yet, while the results of the
+	// other pipeline tasks are left unresolved as they will never be produced
+	tasks := runStates.ToMap()
+	for _, reference := range report.Unresolved {
+		parts := strings.SplitN(reference, ".", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		if upstream, ok := tasks[parts[1]]; ok && upstream.IsRunning() {
+			report.Errors = append(report.Errors, SubstitutionError{
+				Variable: reference,
+				Reason:   fmt.Sprintf("pipeline task %q has not completed yet", parts[1]),
+			})
+		}
+	}
+	if len(report.Errors) > 0 {
+		return report, fmt.Errorf("%w: %w", ErrUpstreamResultNotAvailable, report.Errors[0])
+	}
+	return report, nil
 }
 
 // PropagateArtifacts propagates artifact values from previous task runs into the TaskSpec of the current task.
-func PropagateArtifacts(rpt *ResolvedPipelineTask, runStates PipelineRunState) error {
+// The returned SubstitutionReport describes the task artifact references of the resolved TaskSpec which were substituted.
+func PropagateArtifacts(rpt *ResolvedPipelineTask, runStates PipelineRunState) (SubstitutionReport, error) {
 	if rpt.ResolvedTask == nil || rpt.ResolvedTask.TaskSpec == nil {
-		return nil
+		return SubstitutionReport{}, nil
+	}
+	stringReplacements, err := artifactReplacements(runStates)
+	if err != nil {
+		report := SubstitutionReport{}
+		var substitutionErr SubstitutionError
+		if errors.As(err, &substitutionErr) {
+			report.Errors = append(report.Errors, substitutionErr)
+		}
+		return report, err
 	}
+	before := taskSpecReferences(rpt.ResolvedTask.TaskSpec, looksLikeTaskArtifactRef)
+	rpt.ResolvedTask.TaskSpec = resources.ApplyReplacements(rpt.ResolvedTask.TaskSpec, stringReplacements, map[string][]string{}, map[string]map[string]string{})
+	return newSubstitutionReport(before, taskSpecReferences(rpt.ResolvedTask.TaskSpec, looksLikeTaskArtifactRef)), nil
+}
+
+// looksLikeTaskArtifactRef returns whether the expression references the artifacts of a task, e.g. tasks.build.outputs.image.
+func looksLikeTaskArtifactRef(expression string) bool {
+	return artifactref.TaskArtifactRegex.MatchString("$(" + expression + ")")
+}
+
+// ApplyArtifactsToFinallyTasks propagates artifact values from completed task runs into the TaskSpec
+// of each finally task in targets, so that finally tasks can reference the artifacts of the DAG tasks.
+func ApplyArtifactsToFinallyTasks(targets PipelineRunState, runStates PipelineRunState) error {
+	stringReplacements, err := artifactReplacements(runStates)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if target.PipelineTask == nil || target.ResolvedTask == nil || target.ResolvedTask.TaskSpec == nil {
+			continue
+		}
+		target.ResolvedTask.TaskSpec = resources.ApplyReplacements(target.ResolvedTask.TaskSpec, stringReplacements, map[string][]string{}, map[string]map[string]string{})
+	}
+	return nil
+}
+
+// artifactReplacements returns the replacements for the artifact references of the task runs in runStates.
+func artifactReplacements(runStates PipelineRunState) (map[string]string, error) {
 	stringReplacements := map[string]string{}
 	for taskName, artifacts := range runStates.GetTaskRunsArtifacts() {
 		if artifacts != nil {
 			for i, input := range artifacts.Inputs {
 				ib, err := json.Marshal(input.Values)
 				if err != nil {
-					return err
+					return nil, SubstitutionError{Variable: fmt.Sprintf("tasks.%s.inputs.%s", taskName, input.Name), Reason: err.Error()}
 				}
 				stringReplacements[fmt.Sprintf("tasks.%s.inputs.%s", taskName, input.Name)] = string(ib)
 				if i == 0 {
@@ -450,7 +1488,7 @@ func PropagateArtifacts(rpt *ResolvedPipelineTask, runStates PipelineRunState) e
 			for i, output := range artifacts.Outputs {
 				ob, err := json.Marshal(output.Values)
 				if err != nil {
-					return err
+					return nil, SubstitutionError{Variable: fmt.Sprintf("tasks.%s.outputs.%s", taskName, output.Name), Reason: err.Error()}
 				}
 				stringReplacements[fmt.Sprintf("tasks.%s.outputs.%s", taskName, output.Name)] = string(ob)
 				if i == 0 {
@@ -459,49 +1497,208 @@ func PropagateArtifacts(rpt *ResolvedPipelineTask, runStates PipelineRunState) e
 			}
 		}
 	}
-	rpt.ResolvedTask.TaskSpec = resources.ApplyReplacements(rpt.ResolvedTask.TaskSpec, stringReplacements, map[string][]string{}, map[string]map[string]string{})
-	return nil
+	return stringReplacements, nil
+}
+
+const (
+	// PipelineResultReasonTaskSkipped indicates the referenced PipelineTask did not run, e.g. it was skipped.
+	PipelineResultReasonTaskSkipped = "TaskSkipped"
+	// PipelineResultReasonTaskFailed indicates the referenced PipelineTask failed before producing the result.
+	PipelineResultReasonTaskFailed = "TaskFailed"
+	// PipelineResultReasonTaskMissing indicates the referenced PipelineTask does not exist.
+	PipelineResultReasonTaskMissing = "TaskMissing"
+	// PipelineResultReasonResultMissing indicates the referenced result does not exist.
+	PipelineResultReasonResultMissing = "ResultMissing"
+	// PipelineResultReasonIndexOutOfBounds indicates the referenced array index is out of bounds.
+	PipelineResultReasonIndexOutOfBounds = "IndexOutOfBounds"
+	// PipelineResultReasonKeyMissing indicates the referenced object key does not exist.
+	PipelineResultReasonKeyMissing = "KeyMissing"
+	// PipelineResultReasonTypeMismatch indicates the referenced result cannot be coerced to the declared type.
+	PipelineResultReasonTypeMismatch = "TypeMismatch"
+)
+
+// InvalidPipelineResult describes why a PipelineResult could not be computed from the results
+// of the PipelineTask it references.
+type InvalidPipelineResult struct {
+	// ResultName is the name of the PipelineResult.
+	ResultName string `json:"pipelineResult"`
+	// Variable is the unresolved reference, e.g. tasks.foo.results.bar[2].
+	Variable string `json:"variable"`
+	// TaskName is the name of the referenced PipelineTask, if known.
+	TaskName string `json:"taskName,omitempty"`
+	// Reason is one of the PipelineResultReason constants.
+	Reason string `json:"reason"`
+}
+
+// Error implements error.
+func (e InvalidPipelineResult) Error() string {
+	return fmt.Sprintf("pipeline result %q: reference $(%s) to task %q is invalid: %s", e.ResultName, e.Variable, e.TaskName, e.Reason)
+}
+
+// InvalidPipelineResultsError is returned by ApplyTaskResultsToPipelineResults when PipelineResults
+// reference task results which don't exist. It can be serialized to JSON for log aggregation tools.
+type InvalidPipelineResultsError struct {
+	// Invalid describes each invalid reference, in the order of the PipelineResults.
+	Invalid []InvalidPipelineResult `json:"invalid"`
+}
+
+// Error implements error.
+func (e *InvalidPipelineResultsError) Error() string {
+	msgs := make([]string, 0, len(e.Invalid))
+	for _, invalid := range e.Invalid {
+		msgs = append(msgs, invalid.Error())
+	}
+	return "invalid pipelineresults, the referenced results don't exist: " + strings.Join(msgs, "; ")
+}
+
+// SubstitutionCache holds the values of the task result references resolved by ApplyTaskResultsToPipelineResults,
+// so that they are not looked up again when it is called again with the same cache for the same PipelineRun. Only
+// the references which could be resolved are cached: the results of a task do not change once written, whereas a
+// reference which cannot be resolved yet may be later. A SubstitutionCache is not safe for concurrent use.
+type SubstitutionCache struct {
+	stringReplacements map[string]string
+	arrayReplacements  map[string][]string
+	objectReplacements map[string]map[string]string
+	// coercedValues holds the values of the references coerced to the declared type of a PipelineResult. The type
+	// a reference is coerced to only depends on the reference, see resultCoercionType.
+	coercedValues map[string]*v1.ResultValue
+}
+
+// NewSubstitutionCache returns an empty SubstitutionCache.
+func NewSubstitutionCache() *SubstitutionCache {
+	return &SubstitutionCache{
+		stringReplacements: map[string]string{},
+		arrayReplacements:  map[string][]string{},
+		objectReplacements: map[string]map[string]string{},
+		coercedValues:      map[string]*v1.ResultValue{},
+	}
+}
+
+// isCached returns whether the value of the given variable is in the cache. Whole arrays and objects, referenced
+// as e.g. tasks.foo.results.bar[*], are cached without the [*] suffix.
+func (c *SubstitutionCache) isCached(variable string) bool {
+	if _, ok := c.stringReplacements[variable]; ok {
+		return true
+	}
+	stripped := substitution.StripStarVarSubExpression(variable)
+	if _, ok := c.arrayReplacements[stripped]; ok {
+		return true
+	}
+	_, ok := c.objectReplacements[stripped]
+	return ok
 }
 
 // ApplyTaskResultsToPipelineResults applies the results of completed TasksRuns and Runs to a Pipeline's
 // list of PipelineResults, returning the computed set of PipelineRunResults. References to
 // non-existent TaskResults or failed TaskRuns or Runs result in a PipelineResult being considered invalid
 // and omitted from the returned slice. A nil slice is returned if no results are passed in or all
-// results are invalid.
+// results are invalid. A PipelineResult referencing a skipped task takes its Default value instead,
+// if one is set. Every omitted PipelineResult is described by a InvalidPipelineResult; only
+// references to results that don't exist produce the returned error, results missing because the
+// referenced task was skipped or failed do not: the returned error is an *InvalidPipelineResultsError
+// and each of these references is also logged as a warning with structured fields.
+// The resolved references are memoized in cache, which may be nil, so that a later call with the same cache
+// does not resolve them again.
 func ApplyTaskResultsToPipelineResults(
-	_ context.Context,
+	ctx context.Context,
 	results []v1.PipelineResult,
 	taskRunResults map[string][]v1.TaskRunResult,
 	customTaskResults map[string][]v1beta1.CustomRunResult,
 	taskstatus map[string]string,
-) ([]v1.PipelineRunResult, error) {
+	cache *SubstitutionCache,
+) ([]v1.PipelineRunResult, []InvalidPipelineResult, error) {
+	ctx, span := startApplySpan(ctx, "ApplyTaskResultsToPipelineResults", attribute.Int("tasks", len(taskstatus)))
+	defer span.End()
+
+	runResults, resultErrors, err := applyTaskResultsToPipelineResults(ctx, results, taskRunResults, customTaskResults, taskstatus, cache)
+	span.SetAttributes(attribute.Int("replacements", len(runResults)))
+	return runResults, resultErrors, err
+}
+
+func applyTaskResultsToPipelineResults(
+	ctx context.Context,
+	results []v1.PipelineResult,
+	taskRunResults map[string][]v1.TaskRunResult,
+	customTaskResults map[string][]v1beta1.CustomRunResult,
+	taskstatus map[string]string,
+	cache *SubstitutionCache,
+) ([]v1.PipelineRunResult, []InvalidPipelineResult, error) {
 	var runResults []v1.PipelineRunResult
-	var invalidPipelineResults []string
+	var resultErrors []InvalidPipelineResult
+	var invalidPipelineResults []InvalidPipelineResult
+	logger := logging.FromContext(ctx)
 
-	stringReplacements := map[string]string{}
-	arrayReplacements := map[string][]string{}
-	objectReplacements := map[string]map[string]string{}
+	if cache == nil {
+		cache = NewSubstitutionCache()
+	}
+	stringReplacements := cache.stringReplacements
+	arrayReplacements := cache.arrayReplacements
+	objectReplacements := cache.objectReplacements
 	for _, pipelineResult := range results {
 		variablesInPipelineResult, _ := pipelineResult.GetVarSubstitutionExpressions()
 		if len(variablesInPipelineResult) == 0 {
 			continue
 		}
 		validPipelineResult := true
-		for _, variable := range variablesInPipelineResult {
-			if _, isMemoized := stringReplacements[variable]; isMemoized {
-				continue
+		useDefault := false
+		// coerced holds the values of the references coerced to the declared type of the PipelineResult. They are
+		// kept apart from the replacements, as the same references are not coerced in the untyped PipelineResults.
+		coerced := map[string]*v1.ResultValue{}
+		// invalidate marks the PipelineResult as invalid, recording why. Results missing because the
+		// referenced task did not succeed are not reported in the returned error.
+		invalidate := func(variable, taskName, reason string) {
+			if reason == PipelineResultReasonTaskSkipped && pipelineResult.Default != nil {
+				useDefault = true
+				return
+			}
+			validPipelineResult = false
+			resultError := InvalidPipelineResult{
+				ResultName: pipelineResult.Name,
+				Variable:   variable,
+				TaskName:   taskName,
+				Reason:     reason,
+			}
+			resultErrors = append(resultErrors, resultError)
+			if reason != PipelineResultReasonTaskSkipped && reason != PipelineResultReasonTaskFailed {
+				logger.With(
+					zap.String("pipelineResult", pipelineResult.Name),
+					zap.String("taskName", taskName),
+					zap.String("reference", variable),
+					zap.String("reason", reason),
+				).Warn("Invalid task result reference in pipeline result")
+				invalidPipelineResults = append(invalidPipelineResults, resultError)
+			}
+		}
+		// missingResultReason returns why the result of the given task is missing.
+		missingResultReason := func(taskName string) string {
+			if status, ok := taskstatus[PipelineTaskStatusPrefix+taskName+PipelineTaskStatusSuffix]; ok {
+				switch status {
+				case v1.TaskRunReasonSuccessful.String():
+				case v1.TaskRunReasonFailed.String():
+					return PipelineResultReasonTaskFailed
+				default:
+					return PipelineResultReasonTaskSkipped
+				}
+			} else if _, ok := taskRunResults[taskName]; !ok {
+				if _, ok := customTaskResults[taskName]; !ok {
+					return PipelineResultReasonTaskMissing
+				}
 			}
-			if _, isMemoized := arrayReplacements[variable]; isMemoized {
+			return PipelineResultReasonResultMissing
+		}
+		for _, variable := range variablesInPipelineResult {
+			dstType, coercible := resultCoercionType(pipelineResult, variable)
+			if coercedValue, ok := cache.coercedValues[variable]; ok && coercible {
+				coerced[variable] = coercedValue
 				continue
 			}
-			if _, isMemoized := objectReplacements[variable]; isMemoized {
+			if !coercible && cache.isCached(variable) {
 				continue
 			}
 			variableParts := strings.Split(variable, ".")
 
 			if (variableParts[0] != v1.ResultTaskPart && variableParts[0] != v1.ResultFinallyPart) || variableParts[2] != v1beta1.ResultResultPart {
-				validPipelineResult = false
-				invalidPipelineResults = append(invalidPipelineResults, pipelineResult.Name)
+				invalidate(variable, variableParts[1], PipelineResultReasonResultMissing)
 				continue
 			}
 			switch len(variableParts) {
@@ -509,9 +1706,19 @@ func ApplyTaskResultsToPipelineResults(
 			// For array result: tasks.<taskName>.results.<arrayResultName>[*], tasks.<taskName>.results.<arrayResultName>[i]
 			// For object result: tasks.<taskName>.results.<objectResultName>[*],
 			case resultsParseNumber:
-				taskName, resultName := variableParts[1], variableParts[3]
-				resultName, stringIdx := v1.ParseResultName(resultName)
-				if resultValue := taskResultValue(taskName, resultName, taskRunResults); resultValue != nil {
+				taskName := variableParts[1]
+				_, stringIdx := v1.ParseResultName(variableParts[3])
+				if resultValue := resolveResultFromState(variableParts, taskRunResults, customTaskResults); resultValue != nil {
+					if coercible && resultValue.Type != dstType {
+						coercedValue, err := CoerceResultValue(resultValue, dstType)
+						if err != nil {
+							invalidate(variable, taskName, PipelineResultReasonTypeMismatch)
+						} else {
+							coerced[variable] = coercedValue
+							cache.coercedValues[variable] = coercedValue
+						}
+						continue
+					}
 					switch resultValue.Type {
 					case v1.ParamTypeString:
 						stringReplacements[variable] = resultValue.StringVal
@@ -522,8 +1729,7 @@ func ApplyTaskResultsToPipelineResults(
 								stringReplacements[variable] = resultValue.ArrayVal[intIdx]
 							} else {
 								// referred array index out of bound
-								invalidPipelineResults = append(invalidPipelineResults, pipelineResult.Name)
-								validPipelineResult = false
+								invalidate(variable, taskName, PipelineResultReasonIndexOutOfBounds)
 							}
 						} else {
 							arrayReplacements[substitution.StripStarVarSubExpression(variable)] = resultValue.ArrayVal
@@ -531,52 +1737,35 @@ func ApplyTaskResultsToPipelineResults(
 					case v1.ParamTypeObject:
 						objectReplacements[substitution.StripStarVarSubExpression(variable)] = resultValue.ObjectVal
 					}
-				} else if resultValue := runResultValue(taskName, resultName, customTaskResults); resultValue != nil {
-					stringReplacements[variable] = *resultValue
 				} else {
-					// if the task is not successful (e.g. skipped or failed) and the results is missing, don't return error
-					if status, ok := taskstatus[PipelineTaskStatusPrefix+taskName+PipelineTaskStatusSuffix]; ok {
-						if status != v1.TaskRunReasonSuccessful.String() {
-							validPipelineResult = false
-							continue
-						}
-					}
-					// referred result name is not existent
-					invalidPipelineResults = append(invalidPipelineResults, pipelineResult.Name)
-					validPipelineResult = false
+					// the task is not successful (e.g. skipped or failed) or the referred result name is not existent
+					invalidate(variable, taskName, missingResultReason(taskName))
 				}
 			// For object type result: tasks.<taskName>.results.<objectResultName>.<individualAttribute>
 			case objectElementResultsParseNumber:
-				taskName, resultName, objectKey := variableParts[1], variableParts[3], variableParts[4]
-				resultName, _ = v1.ParseResultName(resultName)
-				if resultValue := taskResultValue(taskName, resultName, taskRunResults); resultValue != nil {
+				taskName, objectKey := variableParts[1], variableParts[4]
+				if resultValue := resolveResultFromState(variableParts, taskRunResults, customTaskResults); resultValue != nil {
 					if _, ok := resultValue.ObjectVal[objectKey]; ok {
 						stringReplacements[variable] = resultValue.ObjectVal[objectKey]
 					} else {
 						// referred object key is not existent
-						invalidPipelineResults = append(invalidPipelineResults, pipelineResult.Name)
-						validPipelineResult = false
+						invalidate(variable, taskName, PipelineResultReasonKeyMissing)
 					}
 				} else {
-					// if the task is not successful (e.g. skipped or failed) and the results is missing, don't return error
-					if status, ok := taskstatus[PipelineTaskStatusPrefix+taskName+PipelineTaskStatusSuffix]; ok {
-						if status != v1.TaskRunReasonSuccessful.String() {
-							validPipelineResult = false
-							continue
-						}
-					}
-					// referred result name is not existent
-					invalidPipelineResults = append(invalidPipelineResults, pipelineResult.Name)
-					validPipelineResult = false
+					// the task is not successful (e.g. skipped or failed) or the referred result name is not existent
+					invalidate(variable, taskName, missingResultReason(taskName))
 				}
 			default:
-				invalidPipelineResults = append(invalidPipelineResults, pipelineResult.Name)
-				validPipelineResult = false
+				invalidate(variable, variableParts[1], PipelineResultReasonResultMissing)
 			}
 		}
 		if validPipelineResult {
 			finalValue := pipelineResult.Value
-			finalValue.ApplyReplacements(stringReplacements, arrayReplacements, objectReplacements)
+			if useDefault {
+				finalValue = *pipelineResult.Default.DeepCopy()
+			} else {
+				finalValue.ApplyReplacements(withCoercedResults(coerced, stringReplacements, arrayReplacements, objectReplacements))
+			}
 			runResults = append(runResults, v1.PipelineRunResult{
 				Name:  pipelineResult.Name,
 				Value: finalValue,
@@ -585,10 +1774,107 @@ func ApplyTaskResultsToPipelineResults(
 	}
 
 	if len(invalidPipelineResults) > 0 {
-		return runResults, fmt.Errorf("invalid pipelineresults %v, the referenced results don't exist", invalidPipelineResults)
+		return runResults, resultErrors, &InvalidPipelineResultsError{Invalid: invalidPipelineResults}
 	}
 
-	return runResults, nil
+	return runResults, resultErrors, nil
+}
+
+// resultCoercionType returns the type the result referenced by the variable is coerced to in the PipelineResult, and
+// whether it can be. Coercion is opted in to by declaring the type of the PipelineResult: a whole result reference,
+// e.g. tasks.<taskName>.results.<resultName>, is coerced to a string for a string PipelineResult, and a star
+// reference, e.g. tasks.<taskName>.results.<resultName>[*], to an array for an array PipelineResult.
+func resultCoercionType(pipelineResult v1.PipelineResult, variable string) (v1.ParamType, bool) {
+	variableParts := strings.Split(variable, ".")
+	if len(variableParts) != resultsParseNumber {
+		return "", false
+	}
+	_, stringIdx := v1.ParseResultName(variableParts[3])
+	switch pipelineResult.Type {
+	case v1.ResultsTypeString:
+		return v1.ParamTypeString, stringIdx == ""
+	case v1.ResultsTypeArray:
+		return v1.ParamTypeArray, stringIdx == "*"
+	default:
+		return "", false
+	}
+}
+
+// withCoercedResults returns the replacements to apply to a PipelineResult, the coerced values of its references
+// taking precedence over the values of the same references in the given replacements, which are not modified.
+func withCoercedResults(coerced map[string]*v1.ResultValue, stringReplacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) (map[string]string, map[string][]string, map[string]map[string]string) {
+	if len(coerced) == 0 {
+		return stringReplacements, arrayReplacements, objectReplacements
+	}
+	stringReplacements, arrayReplacements, objectReplacements = maps.Clone(stringReplacements), maps.Clone(arrayReplacements), maps.Clone(objectReplacements)
+	for variable, value := range coerced {
+		stripped := substitution.StripStarVarSubExpression(variable)
+		delete(arrayReplacements, stripped)
+		delete(objectReplacements, stripped)
+		if value.Type == v1.ParamTypeArray {
+			arrayReplacements[stripped] = value.ArrayVal
+		} else {
+			stringReplacements[variable] = value.StringVal
+		}
+	}
+	return stringReplacements, arrayReplacements, objectReplacements
+}
+
+// CoerceResultValue converts the result value to the given type. Only the safe coercions are supported: an array or
+// an object is encoded to a JSON string, and a string holding a JSON array of strings is parsed to an array. A copy of
+// the value is returned if it already has the given type.
+func CoerceResultValue(src *v1.ResultValue, dstType v1.ParamType) (*v1.ResultValue, error) {
+	if src.Type == dstType {
+		return src.DeepCopy(), nil
+	}
+	switch {
+	case dstType == v1.ParamTypeString && src.Type == v1.ParamTypeArray:
+		b, err := json.Marshal(src.ArrayVal)
+		if err != nil {
+			return nil, err
+		}
+		return v1.NewStructuredValues(string(b)), nil
+	case dstType == v1.ParamTypeString && src.Type == v1.ParamTypeObject:
+		b, err := json.Marshal(src.ObjectVal)
+		if err != nil {
+			return nil, err
+		}
+		return v1.NewStructuredValues(string(b)), nil
+	case dstType == v1.ParamTypeArray && src.Type == v1.ParamTypeString:
+		var arrayVal []string
+		if err := json.Unmarshal([]byte(src.StringVal), &arrayVal); err != nil {
+			return nil, fmt.Errorf("cannot coerce string %q to an array: %w", src.StringVal, err)
+		}
+		return &v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: arrayVal}, nil
+	default:
+		return nil, fmt.Errorf("cannot coerce a result of type %q to type %q", src.Type, dstType)
+	}
+}
+
+// resolveResultFromState returns the value of the result referenced by the parts of a tasks.<taskName>.results.<resultName>
+// or finally.<taskName>.results.<resultName> reference, e.g. {"finally", "report", "results", "digest"}, an array index
+// or object key being ignored. The results of both the tasks and the finally tasks are keyed by their pipeline task
+// name, so both references are looked up the same way, first in the TaskRun results then in the CustomRun results.
+// It returns nil if the result is not found.
+func resolveResultFromState(variableParts []string, taskRunResults map[string][]v1.TaskRunResult, customTaskResults map[string][]v1beta1.CustomRunResult) *v1.ResultValue {
+	if len(variableParts) < resultsParseNumber {
+		return nil
+	}
+	taskName := variableParts[1]
+	resultName, _ := v1.ParseResultName(variableParts[3])
+	if resultValue := taskResultValue(taskName, resultName, taskRunResults); resultValue != nil {
+		return resultValue
+	}
+	// The keys of a CustomRun result holding a JSON-encoded object are accessed like the keys of an object result
+	if len(variableParts) == objectElementResultsParseNumber {
+		if objectVal := runResultObjectValue(taskName, resultName, customTaskResults); objectVal != nil {
+			return &v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: objectVal}
+		}
+	}
+	if resultValue := runResultValue(taskName, resultName, customTaskResults); resultValue != nil {
+		return v1.NewStructuredValues(*resultValue)
+	}
+	return nil
 }
 
 // taskResultValue returns the result value for a given pipeline task name and result name in a map of TaskRunResults for
@@ -615,9 +1901,49 @@ func runResultValue(taskName string, resultName string, runResults map[string][]
 	return nil
 }
 
+// runResultObjectValue returns the object held by the result for a given pipeline task name and result name in a map
+// of RunResults for pipeline task names, when its value is a JSON-encoded object of strings. It returns nil if the
+// result is not found or doesn't hold such an object.
+func runResultObjectValue(taskName string, resultName string, runResults map[string][]v1beta1.CustomRunResult) map[string]string {
+	if resultValue := runResultValue(taskName, resultName, runResults); resultValue != nil {
+		return objectFromRunResult(*resultValue)
+	}
+	return nil
+}
+
+// objectFromRunResult parses the value of a CustomRun result as a JSON-encoded object of strings, e.g.
+// {"url":"https://example.com","digest":"sha256:abc"}. It returns nil if the value isn't such an object.
+func objectFromRunResult(value string) map[string]string {
+	var objectVal map[string]string
+	if err := json.Unmarshal([]byte(value), &objectVal); err != nil {
+		return nil
+	}
+	return objectVal
+}
+
 // ApplyParametersToWorkspaceBindings applies parameters from PipelineSpec and  PipelineRun to the WorkspaceBindings in a PipelineRun. It replaces
-// placeholders in various binding types with values from provided parameters.
-func ApplyParametersToWorkspaceBindings(ctx context.Context, pr *v1.PipelineRun) {
-	parameters, _, _ := paramsFromPipelineRun(ctx, pr)
-	pr.Spec.Workspaces = workspace.ReplaceWorkspaceBindingsVars(pr.Spec.Workspaces, parameters)
+// placeholders in various binding types with values from provided parameters. The default values declared in the
+// PipelineSpec are used for the params which are not provided by the PipelineRun.
+func ApplyParametersToWorkspaceBindings(ctx context.Context, ps *v1.PipelineSpec, pr *v1.PipelineRun) error {
+	defaults, provided, err := GetParamReplacements(ctx, ps, pr)
+	if err != nil {
+		return err
+	}
+	pr.Spec.Workspaces = workspace.ReplaceWorkspaceBindingsVars(pr.Spec.Workspaces, mergeReplacements(defaults.Strings, provided.Strings))
+	return nil
+}
+
+// ApplyParametersToTaskRunSpecs applies parameters from PipelineSpec and PipelineRun to the service account names of
+// the TaskRunSpecs in a PipelineRun, e.g. $(params.environment)-sa. The default values declared in the PipelineSpec
+// are used for the params which are not provided by the PipelineRun.
+func ApplyParametersToTaskRunSpecs(ctx context.Context, ps *v1.PipelineSpec, pr *v1.PipelineRun) error {
+	defaults, provided, err := GetParamReplacements(ctx, ps, pr)
+	if err != nil {
+		return err
+	}
+	stringReplacements := mergeReplacements(defaults.Strings, provided.Strings)
+	for i := range pr.Spec.TaskRunSpecs {
+		pr.Spec.TaskRunSpecs[i].ServiceAccountName = substitution.ApplyReplacements(pr.Spec.TaskRunSpecs[i].ServiceAccountName, stringReplacements)
+	}
+	return nil
 }
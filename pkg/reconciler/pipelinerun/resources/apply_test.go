@@ -17,22 +17,35 @@ limitations under the License.
 package resources_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
 	taskresources "github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
 	"github.com/tektoncd/pipeline/test/diff"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
 )
 
 func TestApplyParameters(t *testing.T) {
@@ -103,6 +116,51 @@ func TestApplyParameters(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "parameter propagation string into step template",
+			original: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{
+					TaskSpec: &v1.EmbeddedTask{
+						TaskSpec: v1.TaskSpec{
+							StepTemplate: &v1.StepTemplate{
+								Env: []corev1.EnvVar{{Name: "REGISTRY", Value: "$(params.registry)"}},
+								EnvFrom: []corev1.EnvFromSource{{
+									ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "$(params.config)"}},
+								}},
+								VolumeMounts: []corev1.VolumeMount{{Name: "cache", MountPath: "/cache/$(params.registry)"}},
+							},
+							Steps: []v1.Step{{
+								Name:  "step1",
+								Image: "ubuntu",
+							}},
+						},
+					},
+				}},
+			},
+			params: v1.Params{
+				{Name: "registry", Value: *v1.NewStructuredValues("gcr.io")},
+				{Name: "config", Value: *v1.NewStructuredValues("build-config")},
+			},
+			expected: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{
+					TaskSpec: &v1.EmbeddedTask{
+						TaskSpec: v1.TaskSpec{
+							StepTemplate: &v1.StepTemplate{
+								Env: []corev1.EnvVar{{Name: "REGISTRY", Value: "gcr.io"}},
+								EnvFrom: []corev1.EnvFromSource{{
+									ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "build-config"}},
+								}},
+								VolumeMounts: []corev1.VolumeMount{{Name: "cache", MountPath: "/cache/gcr.io"}},
+							},
+							Steps: []v1.Step{{
+								Name:  "step1",
+								Image: "ubuntu",
+							}},
+						},
+					},
+				}},
+			},
+		},
 		{
 			name: "parameter propagation string into finally task",
 			original: v1.PipelineSpec{
@@ -855,6 +913,63 @@ func TestApplyParameters(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "array parameter expanded in the values of when expressions",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "branch", Type: v1.ParamTypeString},
+					{Name: "branches", Type: v1.ParamTypeArray},
+					{Name: "default-branches", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("main", "release")},
+				},
+				Tasks: []v1.PipelineTask{{
+					When: []v1.WhenExpression{{
+						Input:    "$(params.branch)",
+						Operator: selection.In,
+						Values:   []string{"$(params.branches)", "hotfix"},
+					}, {
+						Input:    "$(params.branch)",
+						Operator: selection.NotIn,
+						Values:   []string{"$(params.default-branches[*])"},
+					}},
+				}},
+				Finally: []v1.PipelineTask{{
+					When: []v1.WhenExpression{{
+						Input:    "$(params.branch)",
+						Operator: selection.In,
+						Values:   []string{"prod", `$(params["branches"][*])`},
+					}},
+				}},
+			},
+			params: v1.Params{
+				{Name: "branch", Value: *v1.NewStructuredValues("dev")},
+				{Name: "branches", Value: *v1.NewStructuredValues("dev", "stage")},
+			},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "branch", Type: v1.ParamTypeString},
+					{Name: "branches", Type: v1.ParamTypeArray},
+					{Name: "default-branches", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("main", "release")},
+				},
+				Tasks: []v1.PipelineTask{{
+					When: []v1.WhenExpression{{
+						Input:    "dev",
+						Operator: selection.In,
+						Values:   []string{"dev", "stage", "hotfix"},
+					}, {
+						Input:    "dev",
+						Operator: selection.NotIn,
+						Values:   []string{"main", "release"},
+					}},
+				}},
+				Finally: []v1.PipelineTask{{
+					When: []v1.WhenExpression{{
+						Input:    "dev",
+						Operator: selection.In,
+						Values:   []string{"prod", "dev", "stage"},
+					}},
+				}},
+			},
+		},
 		{
 			name: "object parameter with when expression",
 			original: v1.PipelineSpec{
@@ -1019,6 +1134,54 @@ func TestApplyParameters(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "object parameter partially provided, missing keys taken from the default",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{
+						Name: "myobject",
+						Type: v1.ParamTypeObject,
+						Properties: map[string]v1.PropertySpec{
+							"key1": {Type: "string"},
+							"key2": {Type: "string"},
+						},
+						Default: v1.NewObject(map[string]string{
+							"key1": "val1",
+						}),
+					},
+				},
+				Tasks: []v1.PipelineTask{{
+					Params: v1.Params{
+						{Name: "whole-object", Value: *v1.NewStructuredValues("$(params.myobject[*])")},
+						{Name: "first-key", Value: *v1.NewStructuredValues("$(params.myobject.key1)")},
+						{Name: "second-key", Value: *v1.NewStructuredValues("$(params.myobject.key2)")},
+					},
+				}},
+			},
+			params: v1.Params{{Name: "myobject", Value: *v1.NewObject(map[string]string{"key2": "provided2"})}},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{
+						Name: "myobject",
+						Type: v1.ParamTypeObject,
+						Properties: map[string]v1.PropertySpec{
+							"key1": {Type: "string"},
+							"key2": {Type: "string"},
+						},
+						Default: v1.NewObject(map[string]string{
+							"key1": "val1",
+						}),
+					},
+				},
+				Tasks: []v1.PipelineTask{{
+					Params: v1.Params{
+						{Name: "whole-object", Value: *v1.NewObject(map[string]string{"key1": "val1", "key2": "provided2"})},
+						{Name: "first-key", Value: *v1.NewStructuredValues("val1")},
+						{Name: "second-key", Value: *v1.NewStructuredValues("provided2")},
+					},
+				}},
+			},
+		},
 		{
 			name: "parameter evaluation with final tasks",
 			original: v1.PipelineSpec{
@@ -1370,6 +1533,88 @@ func TestApplyParameters(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "parameter in the resolver name and the resolver params",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "resolver", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("git")},
+					{Name: "revision", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					TaskRef: &v1.TaskRef{
+						ResolverRef: v1.ResolverRef{
+							Resolver: "$(params.resolver)",
+							Params: v1.Params{{
+								Name:  "revision",
+								Value: *v1.NewStructuredValues("$(params.revision)"),
+							}},
+						},
+					},
+				}},
+			},
+			params: v1.Params{{Name: "revision", Value: *v1.NewStructuredValues("3f5e9c1")}},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "resolver", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("git")},
+					{Name: "revision", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					TaskRef: &v1.TaskRef{
+						ResolverRef: v1.ResolverRef{
+							Resolver: "git",
+							Params: v1.Params{{
+								Name:  "revision",
+								Value: *v1.NewStructuredValues("3f5e9c1"),
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{
+			name: "parameters in the bundle reference of the bundles resolver",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "registry", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("gcr.io/tekton-releases")},
+					{Name: "tag", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					TaskRef: &v1.TaskRef{
+						ResolverRef: v1.ResolverRef{
+							Resolver: "bundles",
+							Params: v1.Params{{
+								Name:  "bundle",
+								Value: *v1.NewStructuredValues("$(params.registry)/catalog/git-clone:$(params.tag)"),
+							}, {
+								Name:  "name",
+								Value: *v1.NewStructuredValues("git-clone"),
+							}},
+						},
+					},
+				}},
+			},
+			params: v1.Params{{Name: "tag", Value: *v1.NewStructuredValues("0.9")}},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "registry", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("gcr.io/tekton-releases")},
+					{Name: "tag", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					TaskRef: &v1.TaskRef{
+						ResolverRef: v1.ResolverRef{
+							Resolver: "bundles",
+							Params: v1.Params{{
+								Name:  "bundle",
+								Value: *v1.NewStructuredValues("gcr.io/tekton-releases/catalog/git-clone:0.9"),
+							}, {
+								Name:  "name",
+								Value: *v1.NewStructuredValues("git-clone"),
+							}},
+						},
+					},
+				}},
+			},
+		},
 		{
 			name: "object parameter with resolver",
 			original: v1.PipelineSpec{
@@ -1771,6 +2016,38 @@ func TestApplyParameters(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "parameter/s in matrixed task display name",
+			params: v1.Params{
+				{Name: "image", Value: *v1.NewStructuredValues("app")},
+				{Name: "platform", Value: *v1.NewStructuredValues("linux")},
+				{Name: "arches", Value: *v1.NewStructuredValues("amd64", "arm64")},
+			},
+			original: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{
+					DisplayName: "Build $(params.image)-$(params.arch) on $(params.platform) with $(params.os)",
+					Matrix: &v1.Matrix{
+						Params: v1.Params{{Name: "arch", Value: *v1.NewStructuredValues("$(params.arches[*])")}},
+						Include: []v1.IncludeParams{{
+							Name:   "windows",
+							Params: v1.Params{{Name: "platform", Value: *v1.NewStructuredValues("windows")}},
+						}},
+					},
+				}},
+			},
+			expected: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{
+					DisplayName: "Build app-$(params.arch) on $(params.platform) with $(params.os)",
+					Matrix: &v1.Matrix{
+						Params: v1.Params{{Name: "arch", Value: *v1.NewStructuredValues("amd64", "arm64")}},
+						Include: []v1.IncludeParams{{
+							Name:   "windows",
+							Params: v1.Params{{Name: "platform", Value: *v1.NewStructuredValues("windows")}},
+						}},
+					},
+				}},
+			},
+		},
 		{
 			name: "parameter in onError",
 			original: v1.PipelineSpec{
@@ -1791,102 +2068,470 @@ func TestApplyParameters(t *testing.T) {
 				}},
 			},
 		},
-	} {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			ctx := context.Background()
-			if tt.wc != nil {
-				ctx = tt.wc(ctx)
-			}
-			run := &v1.PipelineRun{
-				Spec: v1.PipelineRunSpec{
-					Params: tt.params,
-				},
-			}
-			got := resources.ApplyParameters(ctx, &tt.original, run)
-			if d := cmp.Diff(&tt.expected, got); d != "" {
-				t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
-			}
-		})
-	}
-}
-
-func TestApplyParameters_ArrayIndexing(t *testing.T) {
-	for _, tt := range []struct {
-		name     string
-		original v1.PipelineSpec
-		params   []v1.Param
-		expected v1.PipelineSpec
-	}{
 		{
-			name: "single parameter",
+			name: "object parameter key in onError",
 			original: v1.PipelineSpec{
 				Params: []v1.ParamSpec{
-					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
-					{Name: "second-param", Type: v1.ParamTypeString},
+					{Name: "config", Type: v1.ParamTypeObject, Properties: map[string]v1.PropertySpec{"onError": {Type: v1.ParamTypeString}}},
 				},
 				Tasks: []v1.PipelineTask{{
-					Params: v1.Params{
-						{Name: "first-task-first-param", Value: *v1.NewStructuredValues("$(params.first-param[1])")},
-						{Name: "first-task-second-param", Value: *v1.NewStructuredValues("$(params.second-param[0])")},
-						{Name: "first-task-third-param", Value: *v1.NewStructuredValues("static value")},
-					},
+					OnError: v1.PipelineTaskOnErrorType("$(params.config.onError)"),
 				}},
 			},
-			params: v1.Params{{Name: "second-param", Value: *v1.NewStructuredValues("second-value", "second-value-again")}},
+			params: v1.Params{{Name: "config", Value: *v1.NewObject(map[string]string{"onError": "continue"})}},
 			expected: v1.PipelineSpec{
 				Params: []v1.ParamSpec{
-					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
-					{Name: "second-param", Type: v1.ParamTypeString},
+					{Name: "config", Type: v1.ParamTypeObject, Properties: map[string]v1.PropertySpec{"onError": {Type: v1.ParamTypeString}}},
 				},
 				Tasks: []v1.PipelineTask{{
-					Params: v1.Params{
-						{Name: "first-task-first-param", Value: *v1.NewStructuredValues("default-value-again")},
-						{Name: "first-task-second-param", Value: *v1.NewStructuredValues("second-value")},
-						{Name: "first-task-third-param", Value: *v1.NewStructuredValues("static value")},
-					},
+					OnError: v1.PipelineTaskContinue,
 				}},
 			},
-		}, {
-			name: "single parameter with when expression",
+		},
+		{
+			name: "parameter in timeoutString",
 			original: v1.PipelineSpec{
 				Params: []v1.ParamSpec{
-					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
-					{Name: "second-param", Type: v1.ParamTypeString},
+					{Name: "timeout", Type: v1.ParamTypeString},
 				},
 				Tasks: []v1.PipelineTask{{
-					When: []v1.WhenExpression{{
-						Input:    "$(params.first-param[1])",
-						Operator: selection.In,
-						Values:   []string{"$(params.second-param[0])"},
-					}},
+					TimeoutString: "$(params.timeout)",
+				}},
+				Finally: []v1.PipelineTask{{
+					TimeoutString: "$(params.timeout)",
 				}},
 			},
-			params: v1.Params{{Name: "second-param", Value: *v1.NewStructuredValues("second-value", "second-value-again")}},
+			params: v1.Params{{Name: "timeout", Value: *v1.NewStructuredValues("10m")}},
 			expected: v1.PipelineSpec{
 				Params: []v1.ParamSpec{
-					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
-					{Name: "second-param", Type: v1.ParamTypeString},
+					{Name: "timeout", Type: v1.ParamTypeString},
 				},
 				Tasks: []v1.PipelineTask{{
-					When: []v1.WhenExpression{{
-						Input:    "default-value-again",
-						Operator: selection.In,
-						Values:   []string{"second-value"},
-					}},
+					TimeoutString: "10m",
+				}},
+				Finally: []v1.PipelineTask{{
+					TimeoutString: "10m",
 				}},
 			},
-		}, {
-			name: "pipeline parameter nested inside task parameter",
-			original: v1.PipelineSpec{
-				Params: []v1.ParamSpec{
-					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
-					{Name: "second-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
-				},
-				Tasks: []v1.PipelineTask{{
-					Params: v1.Params{
-						{Name: "first-task-first-param", Value: *v1.NewStructuredValues("$(input.workspace.$(params.first-param[0]))")},
-						{Name: "first-task-second-param", Value: *v1.NewStructuredValues("$(input.workspace.$(params.second-param[1]))")},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			if tt.wc != nil {
+				ctx = tt.wc(ctx)
+			}
+			run := &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: tt.params,
+				},
+			}
+			got, _, err := resources.ApplyParameters(ctx, &tt.original, run)
+			if err != nil {
+				t.Fatalf("ApplyParameters() unexpected error: %v", err)
+			}
+			if d := cmp.Diff(&tt.expected, got); d != "" {
+				t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyParameters_ParamDefaults(t *testing.T) {
+	original := v1.PipelineSpec{
+		Params: v1.ParamSpecs{
+			{Name: "first-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("pipeline-default")},
+			{Name: "second-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("pipeline-default")},
+			{Name: "array-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("pipeline", "default")},
+			{Name: "object-param", Type: v1.ParamTypeObject, Default: v1.NewObject(map[string]string{"key1": "pipeline-default", "key2": "pipeline-default"})},
+			{Name: "required-param", Type: v1.ParamTypeString},
+		},
+		Tasks: []v1.PipelineTask{{
+			Params: v1.Params{
+				{Name: "first", Value: *v1.NewStructuredValues("$(params.first-param)")},
+				{Name: "second", Value: *v1.NewStructuredValues("$(params.second-param)")},
+				{Name: "array", Value: *v1.NewStructuredValues("$(params.array-param[*])")},
+				{Name: "object-key1", Value: *v1.NewStructuredValues("$(params.object-param.key1)")},
+				{Name: "object-key2", Value: *v1.NewStructuredValues("$(params.object-param.key2)")},
+				{Name: "required", Value: *v1.NewStructuredValues("$(params.required-param)")},
+				{Name: "undeclared", Value: *v1.NewStructuredValues("$(params.undeclared-param)")},
+			},
+		}},
+	}
+	for _, tc := range []struct {
+		name          string
+		paramDefaults v1.ParamSpecs
+		params        v1.Params
+		expected      v1.Params
+	}{{
+		name: "no run defaults",
+		expected: v1.Params{
+			{Name: "first", Value: *v1.NewStructuredValues("pipeline-default")},
+			{Name: "second", Value: *v1.NewStructuredValues("pipeline-default")},
+			{Name: "array", Value: *v1.NewStructuredValues("pipeline", "default")},
+			{Name: "object-key1", Value: *v1.NewStructuredValues("pipeline-default")},
+			{Name: "object-key2", Value: *v1.NewStructuredValues("pipeline-default")},
+			{Name: "required", Value: *v1.NewStructuredValues("$(params.required-param)")},
+			{Name: "undeclared", Value: *v1.NewStructuredValues("$(params.undeclared-param)")},
+		},
+	}, {
+		name: "run defaults override the pipeline defaults",
+		paramDefaults: v1.ParamSpecs{
+			{Name: "first-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("run-default")},
+			{Name: "array-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("run", "default")},
+			{Name: "object-param", Type: v1.ParamTypeObject, Default: v1.NewObject(map[string]string{"key1": "run-default", "key2": "run-default"})},
+			{Name: "required-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("run-default")},
+			{Name: "undeclared-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("run-default")},
+		},
+		expected: v1.Params{
+			{Name: "first", Value: *v1.NewStructuredValues("run-default")},
+			{Name: "second", Value: *v1.NewStructuredValues("pipeline-default")},
+			{Name: "array", Value: *v1.NewStructuredValues("run", "default")},
+			{Name: "object-key1", Value: *v1.NewStructuredValues("run-default")},
+			{Name: "object-key2", Value: *v1.NewStructuredValues("run-default")},
+			{Name: "required", Value: *v1.NewStructuredValues("run-default")},
+			{Name: "undeclared", Value: *v1.NewStructuredValues("run-default")},
+		},
+	}, {
+		name: "run params override the run defaults",
+		paramDefaults: v1.ParamSpecs{
+			{Name: "first-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("run-default")},
+			{Name: "second-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("run-default")},
+			{Name: "object-param", Type: v1.ParamTypeObject, Default: v1.NewObject(map[string]string{"key1": "run-default", "key2": "run-default"})},
+		},
+		params: v1.Params{
+			{Name: "first-param", Value: *v1.NewStructuredValues("run-value")},
+			{Name: "object-param", Value: *v1.NewObject(map[string]string{"key1": "run-value"})},
+			{Name: "required-param", Value: *v1.NewStructuredValues("run-value")},
+		},
+		expected: v1.Params{
+			{Name: "first", Value: *v1.NewStructuredValues("run-value")},
+			{Name: "second", Value: *v1.NewStructuredValues("run-default")},
+			{Name: "array", Value: *v1.NewStructuredValues("pipeline", "default")},
+			{Name: "object-key1", Value: *v1.NewStructuredValues("run-value")},
+			{Name: "object-key2", Value: *v1.NewStructuredValues("run-default")},
+			{Name: "required", Value: *v1.NewStructuredValues("run-value")},
+			{Name: "undeclared", Value: *v1.NewStructuredValues("$(params.undeclared-param)")},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			run := &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params:        tc.params,
+					ParamDefaults: tc.paramDefaults,
+				},
+			}
+			got, _, err := resources.ApplyParameters(context.Background(), original.DeepCopy(), run)
+			if err != nil {
+				t.Fatalf("ApplyParameters() unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.expected, got.Tasks[0].Params); d != "" {
+				t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff(original.Params, got.Params); d != "" {
+				t.Errorf("ApplyParameters() modified the declared params %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func BenchmarkApplyParameters_500tasks_100params(b *testing.B) {
+	ps := &v1.PipelineSpec{}
+	var params v1.Params
+	for i := range 100 {
+		name := fmt.Sprintf("param-%d", i)
+		ps.Params = append(ps.Params, v1.ParamSpec{Name: name, Type: v1.ParamTypeString, Default: v1.NewStructuredValues("default-value")})
+		if i%2 == 0 {
+			params = append(params, v1.Param{Name: name, Value: *v1.NewStructuredValues("provided-value")})
+		}
+	}
+	for i := range 500 {
+		pt := v1.PipelineTask{Name: fmt.Sprintf("task-%d", i), TaskRef: &v1.TaskRef{Name: "task"}}
+		for j := range 100 {
+			pt.Params = append(pt.Params, v1.Param{
+				Name:  fmt.Sprintf("task-param-%d", j),
+				Value: *v1.NewStructuredValues(fmt.Sprintf("$(params.param-%d)", j)),
+			})
+		}
+		ps.Tasks = append(ps.Tasks, pt)
+	}
+	pr := &v1.PipelineRun{Spec: v1.PipelineRunSpec{Params: params}}
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, _, err := resources.ApplyParameters(ctx, ps, pr); err != nil {
+			b.Fatalf("ApplyParameters() unexpected error: %v", err)
+		}
+	}
+}
+
+func TestApplyParameters_DuplicateParams(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Tasks: []v1.PipelineTask{{
+			Name:    "task",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params:  v1.Params{{Name: "p", Value: *v1.NewStructuredValues("$(params.first)")}},
+		}},
+	}
+	for _, tc := range []struct {
+		name    string
+		params  v1.Params
+		wantErr error
+	}{{
+		name: "no duplicates",
+		params: v1.Params{
+			{Name: "first", Value: *v1.NewStructuredValues("a")},
+			{Name: "second", Value: *v1.NewStructuredValues("b", "c")},
+		},
+	}, {
+		name: "duplicate string, array and object params",
+		params: v1.Params{
+			{Name: "first", Value: *v1.NewStructuredValues("a")},
+			{Name: "object", Value: *v1.NewObject(map[string]string{"key": "a"})},
+			{Name: "array", Value: *v1.NewStructuredValues("a", "b")},
+			{Name: "first", Value: *v1.NewStructuredValues("b")},
+			{Name: "array", Value: *v1.NewStructuredValues("c", "d")},
+			{Name: "object", Value: *v1.NewObject(map[string]string{"key": "b"})},
+			{Name: "first", Value: *v1.NewStructuredValues("c")},
+		},
+		wantErr: &resources.ErrDuplicateParams{Names: []string{"array", "first", "object"}},
+	}, {
+		name: "same name with different types",
+		params: v1.Params{
+			{Name: "first", Value: *v1.NewStructuredValues("a")},
+			{Name: "first", Value: *v1.NewStructuredValues("a", "b")},
+		},
+		wantErr: &resources.ErrDuplicateParams{Names: []string{"first"}},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{Spec: v1.PipelineRunSpec{Params: tc.params}}
+			_, _, err := resources.ApplyParameters(context.Background(), ps.DeepCopy(), pr)
+			if d := cmp.Diff(tc.wantErr, err); d != "" {
+				t.Errorf("ApplyParameters() error %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestDeepApplyParameters(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{
+			{Name: "revision", Type: v1.ParamTypeString},
+			{Name: "images", Type: v1.ParamTypeArray},
+			{Name: "target", Type: v1.ParamTypeObject, Properties: map[string]v1.PropertySpec{"cluster": {Type: v1.ParamTypeString}}},
+			{Name: "scoped", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("outer")},
+		},
+		Tasks: []v1.PipelineTask{{
+			Name: "child",
+			Params: v1.Params{
+				{Name: "passed", Value: *v1.NewStructuredValues("$(params.scoped)")},
+			},
+			PipelineSpec: &v1.PipelineSpec{
+				Params: v1.ParamSpecs{{Name: "scoped", Type: v1.ParamTypeString}},
+				Tasks: []v1.PipelineTask{{
+					Name:    "build",
+					TaskRef: &v1.TaskRef{Name: "build"},
+					Params: v1.Params{
+						{Name: "revision", Value: *v1.NewStructuredValues("$(params.revision)")},
+						{Name: "images", Value: *v1.NewStructuredValues("$(params.images[*])")},
+						{Name: "cluster", Value: *v1.NewStructuredValues("$(params.target.cluster)")},
+						{Name: "scoped", Value: *v1.NewStructuredValues("$(params.scoped)")},
+					},
+				}, {
+					Name: "grandchild",
+					Params: v1.Params{
+						{Name: "target", Value: *v1.NewObject(map[string]string{"cluster": "staging"})},
+					},
+					PipelineSpec: &v1.PipelineSpec{
+						Tasks: []v1.PipelineTask{{
+							Name:    "deploy",
+							TaskRef: &v1.TaskRef{Name: "deploy"},
+							Params: v1.Params{
+								{Name: "revision", Value: *v1.NewStructuredValues("$(params.revision)")},
+								{Name: "cluster", Value: *v1.NewStructuredValues("$(params.target.cluster)")},
+								{Name: "scoped", Value: *v1.NewStructuredValues("$(params.scoped)")},
+							},
+						}},
+					},
+				}},
+				Finally: []v1.PipelineTask{{
+					Name:    "notify",
+					TaskRef: &v1.TaskRef{Name: "notify"},
+					Params: v1.Params{
+						{Name: "passed", Value: *v1.NewStructuredValues("$(params.passed)")},
+					},
+				}},
+			},
+		}},
+	}
+	pr := &v1.PipelineRun{Spec: v1.PipelineRunSpec{Params: v1.Params{
+		{Name: "revision", Value: *v1.NewStructuredValues("v1")},
+		{Name: "images", Value: *v1.NewStructuredValues("a", "b")},
+		{Name: "target", Value: *v1.NewObject(map[string]string{"cluster": "production"})},
+		{Name: "passed", Value: *v1.NewStructuredValues("unused")},
+	}}}
+
+	want := ps.DeepCopy()
+	want.Tasks[0].Params[0].Value = *v1.NewStructuredValues("outer")
+	child := want.Tasks[0].PipelineSpec
+	// the params declared by the child pipeline are left to the child PipelineRun
+	child.Tasks[0].Params = v1.Params{
+		{Name: "revision", Value: *v1.NewStructuredValues("v1")},
+		{Name: "images", Value: *v1.NewStructuredValues("a", "b")},
+		{Name: "cluster", Value: *v1.NewStructuredValues("production")},
+		{Name: "scoped", Value: *v1.NewStructuredValues("$(params.scoped)")},
+	}
+	// the params passed by the pipeline task are left to the child PipelineRun
+	child.Tasks[1].PipelineSpec.Tasks[0].Params = v1.Params{
+		{Name: "revision", Value: *v1.NewStructuredValues("v1")},
+		{Name: "cluster", Value: *v1.NewStructuredValues("$(params.target.cluster)")},
+		{Name: "scoped", Value: *v1.NewStructuredValues("$(params.scoped)")},
+	}
+
+	got, _, err := resources.DeepApplyParameters(context.Background(), ps, pr)
+	if err != nil {
+		t.Fatalf("DeepApplyParameters() unexpected error: %v", err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("DeepApplyParameters() %s", diff.PrintWantGot(d))
+	}
+
+	// ApplyParameters doesn't substitute the params in the embedded pipelines
+	got, _, err = resources.ApplyParameters(context.Background(), ps, pr)
+	if err != nil {
+		t.Fatalf("ApplyParameters() unexpected error: %v", err)
+	}
+	if d := cmp.Diff(ps.Tasks[0].PipelineSpec, got.Tasks[0].PipelineSpec); d != "" {
+		t.Errorf("ApplyParameters() %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestRegisterParamPattern(t *testing.T) {
+	for _, pattern := range []string{"env.%s", "params.%s"} {
+		if err := resources.RegisterParamPattern(pattern); err != nil {
+			t.Fatalf("RegisterParamPattern(%q) unexpected error: %v", pattern, err)
+		}
+	}
+	for _, pattern := range []string{"env", "env.%d", "%s.%s", "env.%s%%"} {
+		if err := resources.RegisterParamPattern(pattern); err == nil {
+			t.Errorf("RegisterParamPattern(%q) expected an error", pattern)
+		}
+	}
+
+	original := v1.PipelineSpec{
+		Params: []v1.ParamSpec{
+			{Name: "first-param", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("default-value")},
+			{Name: "second-param", Type: v1.ParamTypeArray},
+		},
+		Tasks: []v1.PipelineTask{{
+			Params: v1.Params{
+				{Name: "first-task-first-param", Value: *v1.NewStructuredValues("$(env.first-param)")},
+				{Name: "first-task-second-param", Value: *v1.NewStructuredValues("$(env.second-param[*])")},
+				{Name: "first-task-third-param", Value: *v1.NewStructuredValues("$(params.first-param)")},
+			},
+		}},
+	}
+	expected := v1.PipelineSpec{
+		Params: original.Params,
+		Tasks: []v1.PipelineTask{{
+			Params: v1.Params{
+				{Name: "first-task-first-param", Value: *v1.NewStructuredValues("default-value")},
+				{Name: "first-task-second-param", Value: *v1.NewStructuredValues("a", "b")},
+				{Name: "first-task-third-param", Value: *v1.NewStructuredValues("default-value")},
+			},
+		}},
+	}
+	run := &v1.PipelineRun{
+		Spec: v1.PipelineRunSpec{
+			Params: v1.Params{{Name: "second-param", Value: *v1.NewStructuredValues("a", "b")}},
+		},
+	}
+	got, _, err := resources.ApplyParameters(context.Background(), &original, run)
+	if err != nil {
+		t.Fatalf("ApplyParameters() unexpected error: %v", err)
+	}
+	if d := cmp.Diff(&expected, got); d != "" {
+		t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestApplyParameters_ArrayIndexing(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		original v1.PipelineSpec
+		params   []v1.Param
+		expected v1.PipelineSpec
+	}{
+		{
+			name: "single parameter",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
+					{Name: "second-param", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					Params: v1.Params{
+						{Name: "first-task-first-param", Value: *v1.NewStructuredValues("$(params.first-param[1])")},
+						{Name: "first-task-second-param", Value: *v1.NewStructuredValues("$(params.second-param[0])")},
+						{Name: "first-task-third-param", Value: *v1.NewStructuredValues("static value")},
+					},
+				}},
+			},
+			params: v1.Params{{Name: "second-param", Value: *v1.NewStructuredValues("second-value", "second-value-again")}},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
+					{Name: "second-param", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					Params: v1.Params{
+						{Name: "first-task-first-param", Value: *v1.NewStructuredValues("default-value-again")},
+						{Name: "first-task-second-param", Value: *v1.NewStructuredValues("second-value")},
+						{Name: "first-task-third-param", Value: *v1.NewStructuredValues("static value")},
+					},
+				}},
+			},
+		}, {
+			name: "single parameter with when expression",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
+					{Name: "second-param", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					When: []v1.WhenExpression{{
+						Input:    "$(params.first-param[1])",
+						Operator: selection.In,
+						Values:   []string{"$(params.second-param[0])"},
+					}},
+				}},
+			},
+			params: v1.Params{{Name: "second-param", Value: *v1.NewStructuredValues("second-value", "second-value-again")}},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
+					{Name: "second-param", Type: v1.ParamTypeString},
+				},
+				Tasks: []v1.PipelineTask{{
+					When: []v1.WhenExpression{{
+						Input:    "default-value-again",
+						Operator: selection.In,
+						Values:   []string{"second-value"},
+					}},
+				}},
+			},
+		}, {
+			name: "pipeline parameter nested inside task parameter",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "first-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
+					{Name: "second-param", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("default-value", "default-value-again")},
+				},
+				Tasks: []v1.PipelineTask{{
+					Params: v1.Params{
+						{Name: "first-task-first-param", Value: *v1.NewStructuredValues("$(input.workspace.$(params.first-param[0]))")},
+						{Name: "first-task-second-param", Value: *v1.NewStructuredValues("$(input.workspace.$(params.second-param[1]))")},
 					},
 				}},
 			},
@@ -2108,7 +2753,10 @@ func TestApplyParameters_ArrayIndexing(t *testing.T) {
 					Params: tt.params,
 				},
 			}
-			got := resources.ApplyParameters(context.Background(), &tt.original, run)
+			got, _, err := resources.ApplyParameters(context.Background(), &tt.original, run)
+			if err != nil {
+				t.Fatalf("ApplyParameters() unexpected error: %v", err)
+			}
 			if d := cmp.Diff(&tt.expected, got); d != "" {
 				t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
 			}
@@ -2116,38 +2764,271 @@ func TestApplyParameters_ArrayIndexing(t *testing.T) {
 	}
 }
 
-func TestApplyReplacementsMatrix(t *testing.T) {
-	for _, tt := range []struct {
-		name     string
-		original v1.PipelineSpec
-		params   []v1.Param
-		expected v1.PipelineSpec
-	}{
-		{
-			name: "matrix params replacements",
-			original: v1.PipelineSpec{
-				Params: []v1.ParamSpec{{
-					Name: "foo", Type: v1.ParamTypeString,
+func TestApplyReplacements_OnErrorFromObjectReplacements(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Tasks: []v1.PipelineTask{{
+			Name:    "task",
+			OnError: v1.PipelineTaskOnErrorType("$(params.config.onError)"),
+		}},
+		Finally: []v1.PipelineTask{{
+			Name:    "final-task",
+			OnError: v1.PipelineTaskOnErrorType("$(params.config.finallyOnError)"),
+		}},
+	}
+	objectReplacements := map[string]map[string]string{
+		"params.config": {"onError": "continue", "finallyOnError": "stopAndFail"},
+	}
+	want := &v1.PipelineSpec{
+		Tasks: []v1.PipelineTask{{
+			Name:    "task",
+			OnError: v1.PipelineTaskContinue,
+		}},
+		Finally: []v1.PipelineTask{{
+			Name:    "final-task",
+			OnError: v1.PipelineTaskStopAndFail,
+		}},
+	}
+	got := resources.ApplyReplacements(ps, &resources.SubstitutionContext{ObjectReplacements: objectReplacements})
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("ApplyReplacements() %s", diff.PrintWantGot(d))
+	}
+}
+
+// pipelineSpecPropagatingObjectParam returns a PipelineSpec with a pipeline task overriding the object param
+// "obj" which is consumed by its embedded task spec.
+func pipelineSpecPropagatingObjectParam() *v1.PipelineSpec {
+	return &v1.PipelineSpec{
+		Tasks: []v1.PipelineTask{{
+			Name: "task",
+			Params: v1.Params{{
+				Name:  "obj",
+				Value: *v1.NewObject(map[string]string{"key1": "task-value"}),
+			}},
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:  "step",
+					Image: "image",
+					Args:  []string{"$(params.obj.key1)"},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestApplyReplacements_PropagatedObjectParamsConcurrently(t *testing.T) {
+	objectReplacements := map[string]map[string]string{
+		"params.obj": {"key1": "pipeline-value"},
+	}
+	want := map[string]map[string]string{
+		"params.obj": {"key1": "pipeline-value"},
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := resources.ApplyReplacements(pipelineSpecPropagatingObjectParam(), &resources.SubstitutionContext{ObjectReplacements: objectReplacements})
+			if d := cmp.Diff([]string{"task-value"}, got.Tasks[0].TaskSpec.Steps[0].Args); d != "" {
+				t.Errorf("ApplyReplacements() %s", diff.PrintWantGot(d))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if d := cmp.Diff(want, objectReplacements); d != "" {
+		t.Errorf("ApplyReplacements() modified the object replacements %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestApplyReplacementsToStepActions(t *testing.T) {
+	pt := &v1.PipelineTask{
+		Name: "task",
+		Params: v1.Params{{
+			Name:  "revision",
+			Value: *v1.NewStructuredValues("task-revision"),
+		}},
+		TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+			Steps: []v1.Step{{
+				Name: "clone",
+				Ref:  &v1.Ref{Name: "git-clone"},
+				Params: v1.Params{{
+					Name:  "url",
+					Value: *v1.NewStructuredValues("$(params.url)"),
 				}, {
-					Name: "bar", Type: v1.ParamTypeArray,
+					Name:  "revision",
+					Value: *v1.NewStructuredValues("$(params.revision)"),
 				}, {
-					Name: "rad", Type: v1.ParamTypeObject,
-				}},
-				Tasks: []v1.PipelineTask{{
-					Matrix: &v1.Matrix{
-						Params: v1.Params{{
-							// string replacements from string param, array param and object param
-							Name: "first-param", Value: *v1.NewStructuredValues("$(params.foo)", "$(params.bar[0])", "$(params.rad.key1)"),
-						}, {
-							// array replacement from array param
-							Name: "second-param", Value: *v1.NewStructuredValues("$(params.bar)"),
-						}},
-					},
+					Name:  "digest",
+					Value: *v1.NewStructuredValues("$(tasks.build.results.digest)"),
+				}, {
+					Name:  "flags",
+					Value: *v1.NewStructuredValues("$(params.flags[*])"),
 				}},
-			},
-			params: v1.Params{
-				{Name: "foo", Value: *v1.NewStructuredValues("foo")},
-				{Name: "bar", Value: *v1.NewStructuredValues("b", "a", "r")},
+			}, {
+				Name:  "inline",
+				Image: "image",
+				Args:  []string{"$(params.url)"},
+			}},
+		}},
+	}
+	stringReplacements := map[string]string{
+		"params.url":                 "https://example.com",
+		"params.revision":            "pipeline-revision",
+		"tasks.build.results.digest": "sha256:abc",
+	}
+	arrayReplacements := map[string][]string{
+		"params.flags": {"--depth", "1"},
+	}
+	want := v1.Params{{
+		Name:  "url",
+		Value: *v1.NewStructuredValues("https://example.com"),
+	}, {
+		Name:  "revision",
+		Value: *v1.NewStructuredValues("task-revision"),
+	}, {
+		Name:  "digest",
+		Value: *v1.NewStructuredValues("sha256:abc"),
+	}, {
+		Name:  "flags",
+		Value: *v1.NewStructuredValues("--depth", "1"),
+	}}
+
+	resources.ApplyReplacementsToStepActions(pt, stringReplacements, arrayReplacements, map[string]map[string]string{})
+
+	if d := cmp.Diff(want, pt.TaskSpec.Steps[0].Params); d != "" {
+		t.Errorf("ApplyReplacementsToStepActions() %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff([]string{"$(params.url)"}, pt.TaskSpec.Steps[1].Args); d != "" {
+		t.Errorf("ApplyReplacementsToStepActions() modified a step not referencing a StepAction %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff("pipeline-revision", stringReplacements["params.revision"]); d != "" {
+		t.Errorf("ApplyReplacementsToStepActions() modified the replacements %s", diff.PrintWantGot(d))
+	}
+}
+
+func BenchmarkApplyReplacements_PropagatedObjectParams(b *testing.B) {
+	ps := pipelineSpecPropagatingObjectParam()
+	objectReplacements := map[string]map[string]string{
+		"params.obj": {"key1": "pipeline-value", "key2": "pipeline-value"},
+	}
+	b.ResetTimer()
+	for range b.N {
+		resources.ApplyReplacements(ps, &resources.SubstitutionContext{ObjectReplacements: objectReplacements})
+	}
+}
+
+// pipelineTasksReferencingParams returns n PipelineTasks referencing string, array and object params in their
+// params, matrix, when expressions and embedded TaskSpec.
+func pipelineTasksReferencingParams(n int) []v1.PipelineTask {
+	tasks := make([]v1.PipelineTask, 0, n)
+	for i := range n {
+		pt := v1.PipelineTask{
+			Name:        fmt.Sprintf("task-%d", i),
+			DisplayName: "build $(params.revision)",
+			Params: v1.Params{
+				{Name: "revision", Value: *v1.NewStructuredValues("$(params.revision)")},
+				{Name: "images", Value: *v1.NewStructuredValues("$(params.images[*])")},
+				{Name: "cluster", Value: *v1.NewStructuredValues("$(params.target.cluster)")},
+			},
+			When: v1.WhenExpressions{{Input: "$(params.revision)", Operator: selection.NotIn, Values: []string{"$(params.images[*])"}}},
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{Name: "build", Image: "$(params.target.registry)/builder", Args: []string{"$(params.images[*])"}}},
+			}},
+		}
+		if i%2 == 0 {
+			pt.Matrix = &v1.Matrix{Params: v1.Params{{Name: "platform", Value: *v1.NewStructuredValues("$(params.images[*])")}}}
+		}
+		tasks = append(tasks, pt)
+	}
+	return tasks
+}
+
+func TestParallelReplaceVariablesInPipelineTasks(t *testing.T) {
+	replacements := map[string]string{
+		"params.revision":         "v1",
+		"params.target.cluster":   "production",
+		"params.target.registry":  "registry.example.com",
+		`params["revision"]`:      "v1",
+		"context.pipelineRun.uid": "uid",
+	}
+	arrayReplacements := map[string][]string{"params.images": {"a", "b"}}
+	objectReplacements := map[string]map[string]string{"params.target": {"cluster": "production", "registry": "registry.example.com"}}
+	sc := &resources.SubstitutionContext{StringReplacements: replacements, ArrayReplacements: arrayReplacements, ObjectReplacements: objectReplacements}
+
+	want := pipelineTasksReferencingParams(50)
+	resources.ParallelReplaceVariablesInPipelineTasks(want, sc, 1)
+	for _, concurrency := range []int{0, 2, 8, 100} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			got := pipelineTasksReferencingParams(50)
+			resources.ParallelReplaceVariablesInPipelineTasks(got, sc, concurrency)
+			if d := cmp.Diff(want, got); d != "" {
+				t.Errorf("ParallelReplaceVariablesInPipelineTasks() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+	if want[1].Params[0].Value.StringVal != "v1" || want[1].TaskSpec.Steps[0].Image != "registry.example.com/builder" {
+		t.Errorf("ParallelReplaceVariablesInPipelineTasks() did not substitute the params: %v", want[1])
+	}
+}
+
+func BenchmarkParallelReplaceVariablesInPipelineTasks(b *testing.B) {
+	replacements := map[string]string{"params.revision": "v1", "params.target.cluster": "production", "params.target.registry": "registry.example.com"}
+	arrayReplacements := map[string][]string{"params.images": {"a", "b"}}
+	objectReplacements := map[string]map[string]string{"params.target": {"cluster": "production", "registry": "registry.example.com"}}
+	sc := &resources.SubstitutionContext{StringReplacements: replacements, ArrayReplacements: arrayReplacements, ObjectReplacements: objectReplacements}
+	for _, n := range []int{100, 1000} {
+		for _, concurrency := range []int{1, 8} {
+			b.Run(fmt.Sprintf("%d tasks concurrency %d", n, concurrency), func(b *testing.B) {
+				tasks := pipelineTasksReferencingParams(n)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for range b.N {
+					b.StopTimer()
+					copied := make([]v1.PipelineTask, len(tasks))
+					for i := range tasks {
+						copied[i] = *tasks[i].DeepCopy()
+					}
+					b.StartTimer()
+					resources.ParallelReplaceVariablesInPipelineTasks(copied, sc, concurrency)
+				}
+			})
+		}
+	}
+}
+
+func TestApplyReplacementsMatrix(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		original v1.PipelineSpec
+		params   []v1.Param
+		expected v1.PipelineSpec
+	}{
+		{
+			name: "matrix params replacements",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{{
+					Name: "foo", Type: v1.ParamTypeString,
+				}, {
+					Name: "bar", Type: v1.ParamTypeArray,
+				}, {
+					Name: "rad", Type: v1.ParamTypeObject,
+				}},
+				Tasks: []v1.PipelineTask{{
+					Matrix: &v1.Matrix{
+						Params: v1.Params{{
+							// string replacements from string param, array param and object param
+							Name: "first-param", Value: *v1.NewStructuredValues("$(params.foo)", "$(params.bar[0])", "$(params.rad.key1)"),
+						}, {
+							// array replacement from array param
+							Name: "second-param", Value: *v1.NewStructuredValues("$(params.bar)"),
+						}},
+					},
+				}},
+			},
+			params: v1.Params{
+				{Name: "foo", Value: *v1.NewStructuredValues("foo")},
+				{Name: "bar", Value: *v1.NewStructuredValues("b", "a", "r")},
 				{Name: "rad", Value: *v1.NewObject(map[string]string{
 					"key1": "r",
 					"key2": "a",
@@ -2174,6 +3055,39 @@ func TestApplyReplacementsMatrix(t *testing.T) {
 					},
 				}},
 			},
+		}, {
+			name: "matrix params referencing a whole object param",
+			original: v1.PipelineSpec{
+				Params: []v1.ParamSpec{{
+					Name: "rad", Type: v1.ParamTypeObject,
+				}},
+				Tasks: []v1.PipelineTask{{
+					Matrix: &v1.Matrix{
+						Params: v1.Params{{
+							Name: "first-param", Value: *v1.NewStructuredValues("$(params.rad.key1)", "$(params.rad.key2)"),
+						}, {
+							Name: "second-param", Value: *v1.NewStructuredValues("$(params.rad[*])"),
+						}},
+					},
+				}},
+			},
+			params: v1.Params{
+				{Name: "rad", Value: *v1.NewObject(map[string]string{"key1": "r", "key2": "a"})},
+			},
+			expected: v1.PipelineSpec{
+				Params: []v1.ParamSpec{{
+					Name: "rad", Type: v1.ParamTypeObject,
+				}},
+				Tasks: []v1.PipelineTask{{
+					Matrix: &v1.Matrix{
+						Params: v1.Params{{
+							Name: "first-param", Value: *v1.NewStructuredValues("r", "a"),
+						}, {
+							Name: "second-param", Value: *v1.NewObject(map[string]string{"key1": "r", "key2": "a"}),
+						}},
+					},
+				}},
+			},
 		}, {
 			name: "matrix include params replacement",
 			original: v1.PipelineSpec{
@@ -2360,7 +3274,10 @@ func TestApplyReplacementsMatrix(t *testing.T) {
 					Params: tt.params,
 				},
 			}
-			got := resources.ApplyParameters(context.Background(), &tt.original, run)
+			got, _, err := resources.ApplyParameters(context.Background(), &tt.original, run)
+			if err != nil {
+				t.Fatalf("ApplyParameters() unexpected error: %v", err)
+			}
 			if d := cmp.Diff(&tt.expected, got); d != "" {
 				t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
 			}
@@ -2882,9 +3799,47 @@ func TestApplyTaskResults_MinimalExpression(t *testing.T) {
 				},
 			},
 		}},
+	}, {
+		name: "Test object key result substitution of a custom run result holding a JSON object - params",
+		resolvedResultRefs: resources.ResolvedResultRefs{{
+			Value: *v1.NewStructuredValues(`{"url":"registry.example.com/app","digest":"sha256:abc"}`),
+			ResultReference: v1.ResultRef{
+				PipelineTask: "aCustomTask",
+				Result:       "image",
+			},
+			FromRun: "aRun",
+		}},
+		targets: resources.PipelineRunState{{
+			PipelineTask: &v1.PipelineTask{
+				Name:    "bTask",
+				TaskRef: &v1.TaskRef{Name: "bTask"},
+				Params: v1.Params{{
+					Name:  "bParam",
+					Value: *v1.NewStructuredValues("$(tasks.aCustomTask.results.image.url)"),
+				}, {
+					Name:  "cParam",
+					Value: *v1.NewStructuredValues("$(tasks.aCustomTask.results.image)"),
+				}},
+			},
+		}},
+		want: resources.PipelineRunState{{
+			PipelineTask: &v1.PipelineTask{
+				Name:    "bTask",
+				TaskRef: &v1.TaskRef{Name: "bTask"},
+				Params: v1.Params{{
+					Name:  "bParam",
+					Value: *v1.NewStructuredValues("registry.example.com/app"),
+				}, {
+					Name:  "cParam",
+					Value: *v1.NewStructuredValues(`{"url":"registry.example.com/app","digest":"sha256:abc"}`),
+				}},
+			},
+		}},
 	}} {
 		t.Run(tt.name, func(t *testing.T) {
-			resources.ApplyTaskResults(tt.targets, tt.resolvedResultRefs)
+			if _, err := resources.ApplyTaskResults(context.Background(), tt.targets, tt.resolvedResultRefs); err != nil {
+				t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+			}
 			if d := cmp.Diff(tt.want, tt.targets); d != "" {
 				t.Fatalf("ApplyTaskResults() %s", diff.PrintWantGot(d))
 			}
@@ -2930,6 +3885,31 @@ func TestApplyTaskResults_EmbeddedExpression(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "Test result substitution on embedded variable substitution expression - timeoutString",
+			resolvedResultRefs: resources.ResolvedResultRefs{{
+				Value: *v1.NewStructuredValues("90"),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "aResult",
+				},
+				FromTaskRun: "aTaskRun",
+			}},
+			targets: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name:          "bTask",
+					TaskRef:       &v1.TaskRef{Name: "bTask"},
+					TimeoutString: "$(tasks.aTask.results.aResult)s",
+				},
+			}},
+			want: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name:          "bTask",
+					TaskRef:       &v1.TaskRef{Name: "bTask"},
+					TimeoutString: "90s",
+				},
+			}},
+		},
 		{
 			name: "Test array indexing result substitution on embedded variable substitution expression - params",
 			resolvedResultRefs: resources.ResolvedResultRefs{{
@@ -3304,9 +4284,171 @@ func TestApplyTaskResults_EmbeddedExpression(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "Test result substitution on embedded variable substitution expression - embedded task spec",
+			resolvedResultRefs: resources.ResolvedResultRefs{{
+				Value: *v1.NewStructuredValues("sha256:1234"),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "digest",
+				},
+				FromTaskRun: "aTaskRun",
+			}, {
+				Value: *v1.NewStructuredValues("a", "b"),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "args",
+				},
+				FromTaskRun: "aTaskRun",
+			}, {
+				Value: *v1.NewObject(map[string]string{"url": "https://example.com"}),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "repo",
+				},
+				FromTaskRun: "aTaskRun",
+			}},
+			targets: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name: "bTask",
+					TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+						Steps: []v1.Step{{
+							Name:  "step",
+							Image: "image@$(tasks.aTask.results.digest)",
+							Args:  []string{"$(tasks.aTask.results.args[*])"},
+							Env:   []corev1.EnvVar{{Name: "URL", Value: "$(tasks.aTask.results.repo.url)"}},
+						}},
+					}},
+				},
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{{
+							Name:  "step",
+							Image: "image@$(tasks.aTask.results.digest)",
+							Args:  []string{"$(tasks.aTask.results.args[1])"},
+						}},
+					},
+				},
+			}},
+			want: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name: "bTask",
+					TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+						Steps: []v1.Step{{
+							Name:  "step",
+							Image: "image@sha256:1234",
+							Args:  []string{"a", "b"},
+							Env:   []corev1.EnvVar{{Name: "URL", Value: "https://example.com"}},
+						}},
+					}},
+				},
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{{
+							Name:  "step",
+							Image: "image@sha256:1234",
+							Args:  []string{"b"},
+						}},
+					},
+				},
+			}},
+		},
+		{
+			name: "Test result substitution on embedded variable substitution expression - embedded step template env",
+			resolvedResultRefs: resources.ResolvedResultRefs{{
+				Value: *v1.NewStructuredValues("https://example.com"),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "url",
+				},
+				FromTaskRun: "aTaskRun",
+			}, {
+				Value: *v1.NewStructuredValues("token"),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "key",
+				},
+				FromTaskRun: "aTaskRun",
+			}},
+			targets: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name: "bTask",
+					TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+						StepTemplate: &v1.StepTemplate{
+							Env: []corev1.EnvVar{{
+								Name:  "URL",
+								Value: "$(tasks.aTask.results.url)",
+							}, {
+								Name: "TOKEN",
+								ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+									Key:                  "$(tasks.aTask.results.key)",
+								}},
+							}},
+						},
+						Steps: []v1.Step{{Name: "step", Image: "image"}},
+					}},
+				},
+			}},
+			want: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name: "bTask",
+					TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+						StepTemplate: &v1.StepTemplate{
+							Env: []corev1.EnvVar{{
+								Name:  "URL",
+								Value: "https://example.com",
+							}, {
+								Name: "TOKEN",
+								ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+									Key:                  "token",
+								}},
+							}},
+						},
+						Steps: []v1.Step{{Name: "step", Image: "image"}},
+					}},
+				},
+			}},
+		},
+		{
+			name: "Test result substitution is not applied to task spec of referenced task",
+			resolvedResultRefs: resources.ResolvedResultRefs{{
+				Value: *v1.NewStructuredValues("sha256:1234"),
+				ResultReference: v1.ResultRef{
+					PipelineTask: "aTask",
+					Result:       "digest",
+				},
+				FromTaskRun: "aTaskRun",
+			}},
+			targets: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name:    "bTask",
+					TaskRef: &v1.TaskRef{Name: "bTask"},
+				},
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{{Name: "step", Image: "image@$(tasks.aTask.results.digest)"}},
+					},
+				},
+			}},
+			want: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name:    "bTask",
+					TaskRef: &v1.TaskRef{Name: "bTask"},
+				},
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{{Name: "step", Image: "image@$(tasks.aTask.results.digest)"}},
+					},
+				},
+			}},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			resources.ApplyTaskResults(tt.targets, tt.resolvedResultRefs)
+			if _, err := resources.ApplyTaskResults(context.Background(), tt.targets, tt.resolvedResultRefs); err != nil {
+				t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+			}
 			if d := cmp.Diff(tt.want, tt.targets); d != "" {
 				t.Fatalf("ApplyTaskResults() %s", diff.PrintWantGot(d))
 			}
@@ -3385,27 +4527,124 @@ func TestContext(t *testing.T) {
 		expected:            v1.Param{Value: *v1.NewStructuredValues("-1")},
 		displayName:         "$(context.pipelineRun.uid)-1",
 		expectedDisplayName: "-1",
-	}} {
-		t.Run(tc.description, func(t *testing.T) {
-			orig := &v1.Pipeline{
-				ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline"},
-				Spec: v1.PipelineSpec{
-					Tasks: []v1.PipelineTask{
-						{
-							DisplayName: tc.displayName,
-							Params:      v1.Params{tc.original},
-							Matrix: &v1.Matrix{
-								Params: v1.Params{tc.original},
-							},
-						},
-					},
-					Finally: []v1.PipelineTask{{
-						DisplayName: tc.displayName,
-						Params:      v1.Params{tc.original},
-					}},
+	}, {
+		description: "context.pipelineRun.labels.<key> defined",
+		pr: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app.kubernetes.io/version": "v1.2.3"}},
+		},
+		original:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipelineRun.labels.app_kubernetes_io_version)-1")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("v1.2.3-1")},
+		displayName:         "$(context.pipelineRun.labels.app_kubernetes_io_version)-1",
+		expectedDisplayName: "v1.2.3-1",
+	}, {
+		description: "context.pipelineRun.annotations.<key> defined",
+		pr: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"gitops.io/commit-sha": "abc123"}},
+		},
+		original:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipelineRun.annotations.gitops_io_commit-sha)-1")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("abc123-1")},
+		displayName:         "$(context.pipelineRun.annotations.gitops_io_commit-sha)-1",
+		expectedDisplayName: "abc123-1",
+	}, {
+		description: "context.pipelineRun.labels.<key> shared by several labels",
+		pr: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a.b": "dot", "a/b": "slash"}},
+		},
+		original:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipelineRun.labels.a_b)-1")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipelineRun.labels.a_b)-1")},
+		displayName:         "$(context.pipelineRun.labels.a_b)-1",
+		expectedDisplayName: "$(context.pipelineRun.labels.a_b)-1",
+	}, {
+		description: "context.pipelineRun.creationTimestamp defined",
+		pr: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(time.Date(2024, 5, 6, 7, 8, 9, 0, time.FixedZone("UTC+2", 2*60*60)))},
+		},
+		original:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipelineRun.creationTimestamp)")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("2024-05-06T05:08:09Z")},
+		displayName:         "$(context.pipelineRun.creationTimestamp)",
+		expectedDisplayName: "2024-05-06T05:08:09Z",
+	}, {
+		description: "context.pipelineRun.generation defined",
+		pr: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Generation: 3},
+		},
+		original:            v1.Param{Value: *v1.NewStructuredValues("gen-$(context.pipelineRun.generation)")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("gen-3")},
+		displayName:         "gen-$(context.pipelineRun.generation)",
+		expectedDisplayName: "gen-3",
+	}, {
+		description: "context.pipelineRun.serviceAccountName defined",
+		pr: &v1.PipelineRun{
+			Spec: v1.PipelineRunSpec{
+				TaskRunTemplate: v1.PipelineTaskRunTemplate{ServiceAccountName: "audit-sa"},
+			},
+		},
+		original:            v1.Param{Value: *v1.NewStructuredValues("run as $(context.pipelineRun.serviceAccountName)")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("run as audit-sa")},
+		displayName:         "$(context.pipelineRun.serviceAccountName)",
+		expectedDisplayName: "audit-sa",
+	}, {
+		description:         "context.pipelineRun.serviceAccountName undefined",
+		pr:                  &v1.PipelineRun{},
+		original:            v1.Param{Value: *v1.NewStructuredValues("run as $(context.pipelineRun.serviceAccountName)")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("run as default")},
+		displayName:         "$(context.pipelineRun.serviceAccountName)",
+		expectedDisplayName: "default",
+	}, {
+		description:         "context.pipeline.labels.<key> defined",
+		pr:                  &v1.PipelineRun{},
+		original:            v1.Param{Value: *v1.NewStructuredValues("owned by $(context.pipeline.labels.owner-team)")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("owned by ci")},
+		displayName:         "$(context.pipeline.labels.owner-team)",
+		expectedDisplayName: "ci",
+	}, {
+		description:         "context.pipeline.annotations.<key> defined",
+		pr:                  &v1.PipelineRun{},
+		original:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipeline.annotations.example_com_sla-tier) tier")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("gold tier")},
+		displayName:         "$(context.pipeline.annotations.example_com_sla-tier)",
+		expectedDisplayName: "gold",
+	}, {
+		description:         "context.pipeline.labels.<key> undefined",
+		pr:                  &v1.PipelineRun{},
+		original:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipeline.labels.missing)")},
+		expected:            v1.Param{Value: *v1.NewStructuredValues("$(context.pipeline.labels.missing)")},
+		displayName:         "$(context.pipeline.labels.missing)",
+		expectedDisplayName: "$(context.pipeline.labels.missing)",
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			orig := &v1.Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-pipeline",
+					Labels:      map[string]string{"owner-team": "ci"},
+					Annotations: map[string]string{"example.com/sla-tier": "gold"},
+				},
+				Spec: v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{
+						{
+							DisplayName: tc.displayName,
+							Params:      v1.Params{tc.original},
+							Matrix: &v1.Matrix{
+								Params: v1.Params{tc.original, {
+									Name:  "array",
+									Value: *v1.NewStructuredValues(tc.displayName, "static"),
+								}},
+								Include: v1.IncludeParamsList{{
+									Name:   "include",
+									Params: v1.Params{tc.original},
+								}},
+							},
+						},
+					},
+					Finally: []v1.PipelineTask{{
+						DisplayName: tc.displayName,
+						Params:      v1.Params{tc.original},
+					}},
 				},
 			}
-			got := resources.ApplyContexts(&orig.Spec, orig.Name, tc.pr)
+			expectedArray := v1.Param{Name: "array", Value: *v1.NewStructuredValues(tc.expectedDisplayName, "static")}
+			ctx := cfgtesting.SetFeatureFlags(context.Background(), t, map[string]string{"enable-audit-context-variables": "true"})
+			got, _ := resources.ApplyContexts(ctx, &orig.Spec, orig, tc.pr, nil)
 			if d := cmp.Diff(tc.expected, got.Tasks[0].Params[0]); d != "" {
 				t.Error(diff.PrintWantGot(d))
 			}
@@ -3415,6 +4654,12 @@ func TestContext(t *testing.T) {
 			if d := cmp.Diff(tc.expected, got.Tasks[0].Matrix.Params[0]); d != "" {
 				t.Error(diff.PrintWantGot(d))
 			}
+			if d := cmp.Diff(expectedArray, got.Tasks[0].Matrix.Params[1]); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff(tc.expected, got.Tasks[0].Matrix.Include[0].Params[0]); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
 			if d := cmp.Diff(tc.expectedDisplayName, got.Tasks[0].DisplayName); d != "" {
 				t.Error(diff.PrintWantGot(d))
 			}
@@ -3425,14 +4670,108 @@ func TestContext(t *testing.T) {
 	}
 }
 
+func TestGetContextReplacements_AuditContextVariablesDisabled(t *testing.T) {
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "pr",
+			Generation:        3,
+			CreationTimestamp: metav1.NewTime(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
+		},
+	}
+	replacements := resources.GetContextReplacements(context.Background(), &v1.Pipeline{}, pr)
+	for _, variable := range []string{"context.pipelineRun.creationTimestamp", "context.pipelineRun.generation"} {
+		if v, ok := replacements[variable]; ok {
+			t.Errorf("expected %s not to be substituted with enable-audit-context-variables off but got %q", variable, v)
+		}
+	}
+	if got := replacements["context.pipelineRun.name"]; got != "pr" {
+		t.Errorf("expected context.pipelineRun.name to be pr but got %q", got)
+	}
+}
+
 func TestApplyPipelineTaskContexts(t *testing.T) {
 	for _, tc := range []struct {
 		description string
 		pt          v1.PipelineTask
+		prspec      v1.PipelineRunSpec
 		prstatus    v1.PipelineRunStatus
 		facts       *resources.PipelineRunFacts
 		want        v1.PipelineTask
 	}{{
+		description: "context service account name replacement",
+		pt: v1.PipelineTask{
+			Name: "audit",
+			Params: v1.Params{{
+				Name:  "serviceAccountName",
+				Value: *v1.NewStructuredValues("$(context.pipelineTask.serviceAccountName)"),
+			}},
+		},
+		prspec: v1.PipelineRunSpec{
+			TaskRunTemplate: v1.PipelineTaskRunTemplate{ServiceAccountName: "pipeline-sa"},
+		},
+		want: v1.PipelineTask{
+			Name: "audit",
+			Params: v1.Params{{
+				Name:  "serviceAccountName",
+				Value: *v1.NewStructuredValues("pipeline-sa"),
+			}},
+		},
+	}, {
+		description: "context service account name replacement from the task run specs",
+		pt: v1.PipelineTask{
+			Name: "audit",
+			Params: v1.Params{{
+				Name:  "serviceAccountName",
+				Value: *v1.NewStructuredValues("$(context.pipelineTask.serviceAccountName)"),
+			}},
+		},
+		prspec: v1.PipelineRunSpec{
+			TaskRunTemplate: v1.PipelineTaskRunTemplate{ServiceAccountName: "pipeline-sa"},
+			TaskRunSpecs: []v1.PipelineTaskRunSpec{{
+				PipelineTaskName:   "audit",
+				ServiceAccountName: "audit-sa",
+			}},
+		},
+		want: v1.PipelineTask{
+			Name: "audit",
+			Params: v1.Params{{
+				Name:  "serviceAccountName",
+				Value: *v1.NewStructuredValues("audit-sa"),
+			}},
+		},
+	}, {
+		description: "context service account name replacement defaults to the default service account",
+		pt: v1.PipelineTask{
+			Name: "audit",
+			Params: v1.Params{{
+				Name:  "serviceAccountName",
+				Value: *v1.NewStructuredValues("$(context.pipelineTask.serviceAccountName)"),
+			}},
+		},
+		want: v1.PipelineTask{
+			Name: "audit",
+			Params: v1.Params{{
+				Name:  "serviceAccountName",
+				Value: *v1.NewStructuredValues("default"),
+			}},
+		},
+	}, {
+		description: "context retry count left for the TaskRun",
+		pt: v1.PipelineTask{
+			Retries: 2,
+			Params: v1.Params{{
+				Name:  "attempt",
+				Value: *v1.NewStructuredValues("$(context.task.retry-count) of $(context.pipelineTask.retries)"),
+			}},
+		},
+		want: v1.PipelineTask{
+			Retries: 2,
+			Params: v1.Params{{
+				Name:  "attempt",
+				Value: *v1.NewStructuredValues("$(context.task.retry-count) of 2"),
+			}},
+		},
+	}, {
 		description: "context retries replacement",
 		pt: v1.PipelineTask{
 			Retries: 5,
@@ -3543,6 +4882,60 @@ func TestApplyPipelineTaskContexts(t *testing.T) {
 				Value: *v1.NewStructuredValues("9"),
 			}},
 		},
+	}, {
+		description: "matrix length context variable in when expressions",
+		pt: v1.PipelineTask{
+			When: v1.WhenExpressions{{
+				Input:    "$(tasks.matrixed-task-run.matrix.length)",
+				Operator: selection.NotIn,
+				Values:   []string{"0"},
+			}},
+		},
+		prstatus: v1.PipelineRunStatus{
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name: "matrixed-task-run",
+						Matrix: &v1.Matrix{
+							Params: v1.Params{
+								{Name: "platform", Value: *v1.NewStructuredValues("linux", "mac", "windows")},
+								{Name: "browser", Value: *v1.NewStructuredValues("chrome", "firefox", "safari")},
+							},
+						},
+					}},
+				},
+			},
+		},
+		want: v1.PipelineTask{
+			When: v1.WhenExpressions{{
+				Input:    "9",
+				Operator: selection.NotIn,
+				Values:   []string{"0"},
+			}},
+		},
+	}, {
+		description: "matrix length context variable in display name only",
+		pt: v1.PipelineTask{
+			DisplayName: "running after $(tasks.matrixed-task-run.matrix.length) combinations",
+		},
+		prstatus: v1.PipelineRunStatus{
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name: "matrixed-task-run",
+						Matrix: &v1.Matrix{
+							Params: v1.Params{
+								{Name: "platform", Value: *v1.NewStructuredValues("linux", "mac", "windows")},
+								{Name: "browser", Value: *v1.NewStructuredValues("chrome", "firefox", "safari")},
+							},
+						},
+					}},
+				},
+			},
+		},
+		want: v1.PipelineTask{
+			DisplayName: "running after 9 combinations",
+		},
 	}, {
 		description: "matrix length and matrix results length context variables in matrix include params ",
 		pt: v1.PipelineTask{
@@ -3654,7 +5047,7 @@ func TestApplyPipelineTaskContexts(t *testing.T) {
 						},
 					},
 				},
-				ResultsCache: map[string][]string{},
+				ResultsCache: &resources.ResultsCache{},
 			}},
 		},
 		want: v1.PipelineTask{
@@ -3667,9 +5060,80 @@ func TestApplyPipelineTaskContexts(t *testing.T) {
 				Value: *v1.NewStructuredValues("3"),
 			}},
 		},
+	}, {
+		description: "matrix length and matrix results length context variables of a finally task in a finally task",
+		pt: v1.PipelineTask{
+			Name: "report",
+			Params: v1.Params{{
+				Name:  "matrixlength",
+				Value: *v1.NewStructuredValues("$(tasks.final-scan.matrix.length)"),
+			}, {
+				Name:  "matrixresultslength",
+				Value: *v1.NewStructuredValues("$(tasks.final-scan.matrix.report-url.length)"),
+			}},
+		},
+		prstatus: v1.PipelineRunStatus{
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				PipelineSpec: &v1.PipelineSpec{
+					Tasks: []v1.PipelineTask{{
+						Name:    "build",
+						TaskRef: &v1.TaskRef{Name: "build"},
+					}},
+					Finally: []v1.PipelineTask{{
+						Name:    "final-scan",
+						TaskRef: &v1.TaskRef{Name: "scan"},
+						Matrix: &v1.Matrix{
+							Params: v1.Params{{
+								Name: "platform", Value: *v1.NewStructuredValues("linux", "mac"),
+							}},
+						},
+					}, {
+						Name:    "report",
+						TaskRef: &v1.TaskRef{Name: "report"},
+					}},
+				},
+			},
+		},
+		facts: &resources.PipelineRunFacts{
+			State: resources.PipelineRunState{{
+				PipelineTask: &v1.PipelineTask{
+					Name: "final-scan",
+				},
+				TaskRunNames: []string{"final-scan-0", "final-scan-1"},
+				TaskRuns: []*v1.TaskRun{{
+					ObjectMeta: metav1.ObjectMeta{Name: "final-scan-0"},
+					Status: v1.TaskRunStatus{
+						TaskRunStatusFields: v1.TaskRunStatusFields{
+							Results: []v1.TaskRunResult{{
+								Name:  "report-url",
+								Value: *v1.NewStructuredValues("https://example.com/linux"),
+							}},
+						},
+					},
+				}, {
+					ObjectMeta: metav1.ObjectMeta{Name: "final-scan-1"},
+				}},
+				ResultsCache: &resources.ResultsCache{},
+			}},
+			FinalTasksGraph: &dag.Graph{Nodes: map[string]*dag.Node{
+				"final-scan": {Key: "final-scan"},
+				"report":     {Key: "report"},
+			}},
+		},
+		want: v1.PipelineTask{
+			Name: "report",
+			Params: v1.Params{{
+				Name:  "matrixlength",
+				Value: *v1.NewStructuredValues("2"),
+			}, {
+				Name:  "matrixresultslength",
+				Value: *v1.NewStructuredValues("1"),
+			}},
+		},
 	}} {
 		t.Run(tc.description, func(t *testing.T) {
-			got := resources.ApplyPipelineTaskContexts(&tc.pt, tc.prstatus, tc.facts)
+			pr := &v1.PipelineRun{Spec: tc.prspec, Status: tc.prstatus}
+			got := resources.ApplyPipelineTaskContexts(context.Background(), &tc.pt, pr, tc.facts)
 			if d := cmp.Diff(&tc.want, got); d != "" {
 				t.Error(diff.PrintWantGot(d))
 			}
@@ -3677,6 +5141,176 @@ func TestApplyPipelineTaskContexts(t *testing.T) {
 	}
 }
 
+func TestApplyPipelineTaskContexts_AfterTaskResults(t *testing.T) {
+	rpt := &resources.ResolvedPipelineTask{
+		PipelineTask: &v1.PipelineTask{
+			Name: "deploy",
+			Params: v1.Params{{
+				Name:  "script",
+				Value: *v1.NewStructuredValues("$(tasks.build.results.script)"),
+			}, {
+				Name:  "args",
+				Value: *v1.NewStructuredValues("$(tasks.build.results.args[*])"),
+			}, {
+				Name:  "retries",
+				Value: *v1.NewStructuredValues("$(context.pipelineTask.retries)"),
+			}},
+			Retries: 2,
+		},
+	}
+	if _, err := resources.ApplyTaskResults(context.Background(), resources.PipelineRunState{rpt}, resources.ResolvedResultRefs{{
+		Value:           *v1.NewStructuredValues("echo $(context.pipelineTask.retries) $(SOME_VAR)"),
+		ResultReference: v1.ResultRef{PipelineTask: "build", Result: "script"},
+	}, {
+		Value:           *v1.NewStructuredValues("$(context.pipelineTask.retries)", "b"),
+		ResultReference: v1.ResultRef{PipelineTask: "build", Result: "args"},
+	}}); err != nil {
+		t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+	}
+
+	got := resources.ApplyPipelineTaskContexts(context.Background(), rpt.PipelineTask, &v1.PipelineRun{}, &resources.PipelineRunFacts{})
+	want := v1.Params{{
+		Name:  "script",
+		Value: *v1.NewStructuredValues("echo $(context.pipelineTask.retries) $(SOME_VAR)"),
+	}, {
+		Name:  "args",
+		Value: *v1.NewStructuredValues("$(context.pipelineTask.retries)", "b"),
+	}, {
+		Name:  "retries",
+		Value: *v1.NewStructuredValues("2"),
+	}}
+	if d := cmp.Diff(want, got.Params); d != "" {
+		t.Errorf("ApplyPipelineTaskContexts() substituted the task results again %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestApplyPipelineTaskContexts_AfterTaskResultsInMatrixAndWhen(t *testing.T) {
+	rpt := &resources.ResolvedPipelineTask{
+		PipelineTask: &v1.PipelineTask{
+			Name:        "deploy",
+			DisplayName: "deploy $(tasks.build.results.script) $(context.pipelineTask.retries)",
+			Matrix: &v1.Matrix{
+				Params: v1.Params{{
+					Name:  "args",
+					Value: *v1.NewStructuredValues("$(tasks.build.results.args[*])"),
+				}},
+				Include: []v1.IncludeParams{{
+					Name: "with-script",
+					Params: v1.Params{{
+						Name:  "script",
+						Value: *v1.NewStructuredValues("$(tasks.build.results.script)"),
+					}},
+				}},
+			},
+			When: v1.WhenExpressions{{
+				Input:    "$(tasks.build.results.script)",
+				Operator: selection.NotIn,
+				Values:   []string{"$(tasks.build.results.args[*])"},
+			}},
+			Retries: 2,
+		},
+	}
+	if _, err := resources.ApplyTaskResults(context.Background(), resources.PipelineRunState{rpt}, resources.ResolvedResultRefs{{
+		Value:           *v1.NewStructuredValues("echo $(context.pipelineTask.retries) $(SOME_VAR)"),
+		ResultReference: v1.ResultRef{PipelineTask: "build", Result: "script"},
+	}, {
+		Value:           *v1.NewStructuredValues("$(context.pipelineTask.retries)", "b"),
+		ResultReference: v1.ResultRef{PipelineTask: "build", Result: "args"},
+	}}); err != nil {
+		t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+	}
+
+	got := resources.ApplyPipelineTaskContexts(context.Background(), rpt.PipelineTask, &v1.PipelineRun{}, &resources.PipelineRunFacts{})
+	want := &v1.PipelineTask{
+		Name:        "deploy",
+		DisplayName: "deploy echo $(context.pipelineTask.retries) $(SOME_VAR) 2",
+		Matrix: &v1.Matrix{
+			Params: v1.Params{{
+				Name:  "args",
+				Value: *v1.NewStructuredValues("$(context.pipelineTask.retries)", "b"),
+			}},
+			Include: []v1.IncludeParams{{
+				Name: "with-script",
+				Params: v1.Params{{
+					Name:  "script",
+					Value: *v1.NewStructuredValues("echo $(context.pipelineTask.retries) $(SOME_VAR)"),
+				}},
+			}},
+		},
+		When: v1.WhenExpressions{{
+			Input:    "echo $(context.pipelineTask.retries) $(SOME_VAR)",
+			Operator: selection.NotIn,
+			Values:   []string{"$(context.pipelineTask.retries)", "b"},
+		}},
+		Retries: 2,
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("ApplyPipelineTaskContexts() substituted the task results again %s", diff.PrintWantGot(d))
+	}
+	if !got.When.AllowsExecution(nil) {
+		t.Errorf("Expected the when expressions to allow the execution: %v", got.When)
+	}
+}
+
+type platformSubstitutionProvider map[string]string
+
+func (p platformSubstitutionProvider) GetReplacements(_ context.Context, pr *v1.PipelineRun, pt *v1.PipelineTask) map[string]string {
+	replacements := map[string]string{"platform.target": pr.Name + "/" + pt.Name}
+	maps.Copy(replacements, p)
+	return replacements
+}
+
+func TestRegisterSubstitutionProvider(t *testing.T) {
+	resources.RegisterSubstitutionProvider(platformSubstitutionProvider{
+		"platform.buildNodePool": "default-pool",
+		"platform.costCenter":    "default-center",
+	})
+	resources.RegisterSubstitutionProvider(platformSubstitutionProvider{
+		"platform.costCenter":          "cc-1234",
+		"context.pipelineTask.retries": "42",
+	})
+
+	pt := v1.PipelineTask{
+		Name:        "build",
+		DisplayName: "build on $(platform.buildNodePool)",
+		Retries:     2,
+		Params: v1.Params{{
+			Name:  "cost-center",
+			Value: *v1.NewStructuredValues("$(platform.costCenter)"),
+		}, {
+			Name:  "retries",
+			Value: *v1.NewStructuredValues("$(context.pipelineTask.retries)"),
+		}},
+		When: v1.WhenExpressions{{
+			Input:    "$(platform.target)",
+			Operator: selection.In,
+			Values:   []string{"pr/build"},
+		}},
+	}
+	want := v1.PipelineTask{
+		Name:        "build",
+		DisplayName: "build on default-pool",
+		Retries:     2,
+		Params: v1.Params{{
+			Name:  "cost-center",
+			Value: *v1.NewStructuredValues("cc-1234"),
+		}, {
+			Name:  "retries",
+			Value: *v1.NewStructuredValues("2"),
+		}},
+		When: v1.WhenExpressions{{
+			Input:    "pr/build",
+			Operator: selection.In,
+			Values:   []string{"pr/build"},
+		}},
+	}
+	pr := &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "pr"}}
+	got := resources.ApplyPipelineTaskContexts(context.Background(), &pt, pr, nil)
+	if d := cmp.Diff(&want, got); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+}
+
 func TestApplyWorkspaces(t *testing.T) {
 	for _, tc := range []struct {
 		description         string
@@ -3703,31 +5337,156 @@ func TestApplyWorkspaces(t *testing.T) {
 		bindings:            []v1.WorkspaceBinding{},
 		variableUsage:       "$(workspaces.foo.bound)",
 		expectedReplacement: "false",
-	}} {
-		t.Run(tc.description, func(t *testing.T) {
-			p1 := v1.PipelineSpec{
-				Tasks: []v1.PipelineTask{{
-					Params: v1.Params{{Value: *v1.NewStructuredValues(tc.variableUsage)}},
-				}},
-				Workspaces: tc.declarations,
-			}
-			pr := &v1.PipelineRun{
-				Spec: v1.PipelineRunSpec{
-					PipelineRef: &v1.PipelineRef{
-						Name: "test-pipeline",
-					},
-					Workspaces: tc.bindings,
-				},
-			}
-			p2 := resources.ApplyWorkspaces(&p1, pr)
-			str := p2.Tasks[0].Params[0].Value.StringVal
-			if str != tc.expectedReplacement {
-				t.Errorf("expected %q, received %q", tc.expectedReplacement, str)
+	}, {
+		description: "claim of a workspace bound to a persistent volume claim",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name:                  "foo",
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "my-claim"},
+		}},
+		variableUsage:       "cache/$(workspaces.foo.claim)",
+		expectedReplacement: "cache/my-claim",
+	}, {
+		description: "claim of a workspace bound to an empty dir",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name:     "foo",
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}},
+		variableUsage:       "$(workspaces.foo.claim)",
+		expectedReplacement: "",
+	}, {
+		description: "volume of a workspace bound to a volume claim template",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name: "foo",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-template"},
+			},
+		}},
+		variableUsage:       "$(workspaces.foo.volume)",
+		expectedReplacement: "my-template",
+	}, {
+		description: "medium of a workspace bound to an in-memory empty dir",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name:     "foo",
+			EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory},
+		}},
+		variableUsage:       "$(workspaces.foo.medium)",
+		expectedReplacement: "Memory",
+	}, {
+		description: "medium of a workspace bound to an empty dir with the default medium",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name:     "foo",
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}},
+		variableUsage:       "$(workspaces.foo.medium)",
+		expectedReplacement: "",
+	}, {
+		description: "medium of a workspace bound to a persistent volume claim",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name:                  "foo",
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "my-claim"},
+		}},
+		variableUsage:       "$(workspaces.foo.medium)",
+		expectedReplacement: "None",
+	}, {
+		description: "medium of a workspace declared not bound",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name:     "foo",
+			Optional: true,
+		}},
+		bindings:            []v1.WorkspaceBinding{},
+		variableUsage:       "$(workspaces.foo.medium)",
+		expectedReplacement: "None",
+	}, {
+		description: "volume of a workspace not bound to a volume claim template",
+		declarations: []v1.PipelineWorkspaceDeclaration{{
+			Name: "foo",
+		}},
+		bindings: []v1.WorkspaceBinding{{
+			Name:     "foo",
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}},
+		variableUsage:       "$(workspaces.foo.volume)",
+		expectedReplacement: "$(workspaces.foo.volume)",
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			p1 := v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{
+					Params: v1.Params{{Value: *v1.NewStructuredValues(tc.variableUsage)}},
+				}},
+				Workspaces: tc.declarations,
+			}
+			pr := &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					PipelineRef: &v1.PipelineRef{
+						Name: "test-pipeline",
+					},
+					Workspaces: tc.bindings,
+				},
+			}
+			p2, _ := resources.ApplyWorkspaces(&p1, pr, nil)
+			str := p2.Tasks[0].Params[0].Value.StringVal
+			if str != tc.expectedReplacement {
+				t.Errorf("expected %q, received %q", tc.expectedReplacement, str)
 			}
 		})
 	}
 }
 
+func TestApplyWorkspaces_ClaimNotReplacedInEmbeddedTaskSpec(t *testing.T) {
+	p1 := v1.PipelineSpec{
+		Workspaces: []v1.PipelineWorkspaceDeclaration{{Name: "foo"}},
+		Tasks: []v1.PipelineTask{{
+			Name: "task",
+			Workspaces: []v1.WorkspacePipelineTaskBinding{{
+				Name:      "foo",
+				Workspace: "foo",
+				SubPath:   "$(workspaces.foo.claim)",
+			}},
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Workspaces: []v1.WorkspaceDeclaration{{Name: "foo"}},
+				Steps: []v1.Step{{
+					Name:   "step",
+					Image:  "alpine",
+					Script: "echo $(workspaces.foo.claim) $(workspaces.foo.bound)",
+				}},
+			}},
+		}},
+	}
+	pr := &v1.PipelineRun{
+		Spec: v1.PipelineRunSpec{
+			Workspaces: []v1.WorkspaceBinding{{
+				Name:                  "foo",
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "my-claim"},
+			}},
+		},
+	}
+	p2, _ := resources.ApplyWorkspaces(&p1, pr, nil)
+	if d := cmp.Diff("my-claim", p2.Tasks[0].Workspaces[0].SubPath); d != "" {
+		t.Errorf("subPath %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff("echo $(workspaces.foo.claim) true", p2.Tasks[0].TaskSpec.Steps[0].Script); d != "" {
+		t.Errorf("script %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestApplyFinallyResultsToPipelineResults(t *testing.T) {
 	for _, tc := range []struct {
 		description   string
@@ -3835,11 +5594,11 @@ func TestApplyFinallyResultsToPipelineResults(t *testing.T) {
 				},
 			},
 			expected:      nil,
-			expectedError: errors.New("invalid pipelineresults [pipeline-result-1], the referred result don't exist"),
+			expectedError: errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "pipeline-result-1": reference $(finally.pt2.results.foo) to task "pt2" is invalid: TaskMissing`),
 		},
 	} {
 		t.Run(tc.description, func(t *testing.T) {
-			received, _ := resources.ApplyTaskResultsToPipelineResults(context.Background(), tc.results, tc.taskResults, tc.runResults, nil /* skippedTasks */)
+			received, _, _ := resources.ApplyTaskResultsToPipelineResults(context.Background(), tc.results, tc.taskResults, tc.runResults, nil /* skippedTasks */, nil)
 			if d := cmp.Diff(tc.expected, received); d != "" {
 				t.Error(diff.PrintWantGot(d))
 			}
@@ -3857,6 +5616,37 @@ func TestApplyTaskResultsToPipelineResults_Success(t *testing.T) {
 		skippedTasks    []v1.SkippedTask
 		expectedResults []v1.PipelineRunResult
 	}{{
+		description: "tasks and finally references to the same results",
+		results: []v1.PipelineResult{{
+			Name:  "from-tasks",
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.foo) $(tasks.pt1.results.bar.key) $(tasks.customtask.results.baz)"),
+		}, {
+			Name:  "from-finally",
+			Value: *v1.NewStructuredValues("$(finally.pt1.results.foo) $(finally.pt1.results.bar.key) $(finally.customtask.results.baz)"),
+		}},
+		taskResults: map[string][]v1.TaskRunResult{
+			"pt1": {{
+				Name:  "foo",
+				Value: *v1.NewStructuredValues("do"),
+			}, {
+				Name:  "bar",
+				Value: *v1.NewObject(map[string]string{"key": "rae"}),
+			}},
+		},
+		runResults: map[string][]v1beta1.CustomRunResult{
+			"customtask": {{
+				Name:  "baz",
+				Value: "mi",
+			}},
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "from-tasks",
+			Value: *v1.NewStructuredValues("do rae mi"),
+		}, {
+			Name:  "from-finally",
+			Value: *v1.NewStructuredValues("do rae mi"),
+		}},
+	}, {
 		description: "non-reference-results",
 		results: []v1.PipelineResult{{
 			Name:  "pipeline-result-1",
@@ -3931,6 +5721,34 @@ func TestApplyTaskResultsToPipelineResults_Success(t *testing.T) {
 				"key2": "val2",
 			}),
 		}},
+	}, {
+		description: "apply-whole-object-results-without-star",
+		results: []v1.PipelineResult{{
+			Name:  "pipeline-result-1",
+			Type:  v1.ResultsTypeObject,
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.foo)"),
+		}},
+		taskResults: map[string][]v1.TaskRunResult{
+			"pt1": {
+				{
+					Name: "foo",
+					Value: *v1.NewObject(map[string]string{
+						"key1": "val1",
+						"key2": "val2",
+					}),
+				},
+			},
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name: "pipeline-result-1",
+			Value: v1.ResultValue{
+				Type: v1.ParamTypeObject,
+				ObjectVal: map[string]string{
+					"key1": "val1",
+					"key2": "val2",
+				},
+			},
+		}},
 	}, {
 		description: "object-results-from-array-indexing-and-object-element",
 		results: []v1.PipelineResult{{
@@ -4116,6 +5934,28 @@ func TestApplyTaskResultsToPipelineResults_Success(t *testing.T) {
 			Name:  "pipeline-result-2",
 			Value: *v1.NewStructuredValues("do, rae, mi, rae, do"),
 		}},
+	}, {
+		description: "object-keys-of-customtask-result",
+		results: []v1.PipelineResult{{
+			Name:  "pipeline-result-1",
+			Value: *v1.NewStructuredValues("$(tasks.customtask.results.image.url)@$(tasks.customtask.results.image.digest)"),
+		}, {
+			Name:  "pipeline-result-2",
+			Value: *v1.NewStructuredValues("$(tasks.customtask.results.image)"),
+		}},
+		runResults: map[string][]v1beta1.CustomRunResult{
+			"customtask": {{
+				Name:  "image",
+				Value: `{"url":"registry.example.com/app","digest":"sha256:abc"}`,
+			}},
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "pipeline-result-1",
+			Value: *v1.NewStructuredValues("registry.example.com/app@sha256:abc"),
+		}, {
+			Name:  "pipeline-result-2",
+			Value: *v1.NewStructuredValues(`{"url":"registry.example.com/app","digest":"sha256:abc"}`),
+		}},
 	}, {
 		description: "multiple-results-skipped-and-normal-tasks",
 		results: []v1.PipelineResult{{
@@ -4170,9 +6010,80 @@ func TestApplyTaskResultsToPipelineResults_Success(t *testing.T) {
 			Name:  "foo",
 			Value: *v1.NewStructuredValues("do", "rae", "mi"),
 		}},
+	}, {
+		description: "skipped-task-with-default",
+		results: []v1.PipelineResult{{
+			Name:    "foo",
+			Value:   *v1.NewStructuredValues("$(tasks.pt1.results.foo) and $(tasks.pt2.results.bar)"),
+			Default: v1.NewStructuredValues("fallback"),
+		}},
+		taskResults: map[string][]v1.TaskRunResult{
+			"pt2": {{
+				Name:  "bar",
+				Value: *v1.NewStructuredValues("rae"),
+			}},
+		},
+		taskstatus: map[string]string{resources.PipelineTaskStatusPrefix + "pt1" + resources.PipelineTaskStatusSuffix: resources.PipelineTaskStateNone},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "foo",
+			Value: *v1.NewStructuredValues("fallback"),
+		}},
+	}, {
+		description: "failed-task-with-default",
+		results: []v1.PipelineResult{{
+			Name:    "foo",
+			Value:   *v1.NewStructuredValues("$(tasks.pt1.results.foo)"),
+			Default: v1.NewStructuredValues("fallback"),
+		}},
+		taskResults:     map[string][]v1.TaskRunResult{},
+		taskstatus:      map[string]string{resources.PipelineTaskStatusPrefix + "pt1" + resources.PipelineTaskStatusSuffix: v1.TaskRunReasonFailed.String()},
+		expectedResults: nil,
+	}, {
+		description: "multi-line and delimiter-containing values are not truncated nor substituted again",
+		results: []v1.PipelineResult{{
+			Name:  "cert",
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.cert)"),
+		}, {
+			Name:  "embedded",
+			Value: *v1.NewStructuredValues("script: $(tasks.pt1.results.script) cert:\n$(tasks.pt1.results.cert)"),
+		}, {
+			Name:  "array",
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.lines[*])"),
+		}, {
+			Name:  "object-key",
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.obj.script)"),
+		}},
+		taskResults: map[string][]v1.TaskRunResult{
+			"pt1": {{
+				Name:  "cert",
+				Value: *v1.NewStructuredValues("-----BEGIN CERTIFICATE-----\nMIIB\n(abc)\n-----END CERTIFICATE-----\n"),
+			}, {
+				Name:  "script",
+				Value: *v1.NewStructuredValues("echo $(tasks.pt1.results.cert) $(SOME_VAR) )"),
+			}, {
+				Name:  "lines",
+				Value: *v1.NewStructuredValues("a\nb", "$(tasks.pt1.results.script)", ")"),
+			}, {
+				Name:  "obj",
+				Value: *v1.NewObject(map[string]string{"script": "$(\n)\n$(tasks.pt1.results.cert)"}),
+			}},
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "cert",
+			Value: *v1.NewStructuredValues("-----BEGIN CERTIFICATE-----\nMIIB\n(abc)\n-----END CERTIFICATE-----\n"),
+		}, {
+			Name:  "embedded",
+			Value: *v1.NewStructuredValues("script: echo $(tasks.pt1.results.cert) $(SOME_VAR) ) cert:\n-----BEGIN CERTIFICATE-----\nMIIB\n(abc)\n-----END CERTIFICATE-----\n"),
+		}, {
+			Name:  "array",
+			Value: *v1.NewStructuredValues("a\nb", "$(tasks.pt1.results.script)", ")"),
+		}, {
+			Name:  "object-key",
+			Value: *v1.NewStructuredValues("$(\n)\n$(tasks.pt1.results.cert)"),
+		}},
 	}} {
 		t.Run(tc.description, func(t *testing.T) {
-			received, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), tc.results, tc.taskResults, tc.runResults, tc.taskstatus)
+			received, _, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), tc.results, tc.taskResults, tc.runResults, tc.taskstatus, nil)
 			if err != nil {
 				t.Errorf("Got unecpected error:%v", err)
 			}
@@ -4206,7 +6117,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [pipeline-result-1], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "pipeline-result-1": reference $(tasks.pt1.results.foo[4]) to task "pt1" is invalid: IndexOutOfBounds`),
 	}, {
 		description: "object-reference-key-not-exist",
 		results: []v1.PipelineResult{{
@@ -4225,7 +6136,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [pipeline-result-1], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "pipeline-result-1": reference $(tasks.pt1.results.foo.key3) to task "pt1" is invalid: KeyMissing`),
 	}, {
 		description: "object-results-resultname-not-exist",
 		results: []v1.PipelineResult{{
@@ -4244,7 +6155,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [pipeline-result-1], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "pipeline-result-1": reference $(tasks.pt1.results.bar.key1) to task "pt1" is invalid: ResultMissing`),
 	}, {
 		description: "invalid-result-variable-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4258,7 +6169,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			}},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.pt1_results.foo) to task "pt1_results" is invalid: ResultMissing`),
 	}, {
 		description: "no-taskrun-results-no-returned-results",
 		results: []v1.PipelineResult{{
@@ -4269,7 +6180,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			"pt1": {},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.pt1.results.foo) to task "pt1" is invalid: ResultMissing`),
 	}, {
 		description: "invalid-taskrun-name-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4283,7 +6194,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			}},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.pt1.results.foo) to task "pt1" is invalid: TaskMissing`),
 	}, {
 		description: "invalid-result-name-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4297,7 +6208,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			}},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.pt1.results.foo) to task "pt1" is invalid: ResultMissing`),
 	}, {
 		description: "unsuccessful-taskrun-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4306,7 +6217,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 		}},
 		taskResults:     map[string][]v1.TaskRunResult{},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.pt1.results.foo) to task "pt1" is invalid: TaskMissing`),
 	}, {
 		description: "mixed-success-tasks-some-returned-results",
 		results: []v1.PipelineResult{{
@@ -4326,7 +6237,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			Name:  "bar",
 			Value: *v1.NewStructuredValues("rae"),
 		}},
-		expectedError: errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError: errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.pt1.results.foo) to task "pt1" is invalid: TaskMissing`),
 	}, {
 		description: "no-run-results-no-returned-results",
 		results: []v1.PipelineResult{{
@@ -4335,7 +6246,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 		}},
 		runResults:      map[string][]v1beta1.CustomRunResult{},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.customtask.results.foo) to task "customtask" is invalid: TaskMissing`),
 	}, {
 		description: "wrong-customtask-name-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4349,7 +6260,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			}},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.customtask.results.foo) to task "customtask" is invalid: TaskMissing`),
 	}, {
 		description: "right-customtask-name-wrong-result-name-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4363,7 +6274,7 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			}},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.customtask.results.foo) to task "customtask" is invalid: ResultMissing`),
 	}, {
 		description: "unsuccessful-run-no-returned-result",
 		results: []v1.PipelineResult{{
@@ -4374,7 +6285,35 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			"customtask": {},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.customtask.results.foo) to task "customtask" is invalid: ResultMissing`),
+	}, {
+		description: "missing-object-key-of-customtask-result",
+		results: []v1.PipelineResult{{
+			Name:  "foo",
+			Value: *v1.NewStructuredValues("$(tasks.customtask.results.image.tag)"),
+		}},
+		runResults: map[string][]v1beta1.CustomRunResult{
+			"customtask": {{
+				Name:  "image",
+				Value: `{"url":"registry.example.com/app"}`,
+			}},
+		},
+		expectedResults: nil,
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.customtask.results.image.tag) to task "customtask" is invalid: KeyMissing`),
+	}, {
+		description: "object-key-of-customtask-result-not-holding-an-object",
+		results: []v1.PipelineResult{{
+			Name:  "foo",
+			Value: *v1.NewStructuredValues("$(tasks.customtask.results.image.url)"),
+		}},
+		runResults: map[string][]v1beta1.CustomRunResult{
+			"customtask": {{
+				Name:  "image",
+				Value: "registry.example.com/app",
+			}},
+		},
+		expectedResults: nil,
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.customtask.results.image.url) to task "customtask" is invalid: KeyMissing`),
 	}, {
 		description: "wrong-result-reference-expression",
 		results: []v1.PipelineResult{{
@@ -4385,10 +6324,10 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 			"customtask": {},
 		},
 		expectedResults: nil,
-		expectedError:   errors.New("invalid pipelineresults [foo], the referenced results don't exist"),
+		expectedError:   errors.New(`invalid pipelineresults, the referenced results don't exist: pipeline result "foo": reference $(tasks.task.results.foo.foo.foo) to task "task" is invalid: ResultMissing`),
 	}} {
 		t.Run(tc.description, func(t *testing.T) {
-			received, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), tc.results, tc.taskResults, tc.runResults, nil /*skipped tasks*/)
+			received, _, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), tc.results, tc.taskResults, tc.runResults, nil /*skipped tasks*/, nil)
 			if err == nil {
 				t.Errorf("Expect error but got nil")
 				return
@@ -4405,66 +6344,708 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 	}
 }
 
-func TestApplyTaskRunContext(t *testing.T) {
-	r := map[string]string{
-		"tasks.task1.status": "succeeded",
-		"tasks.task3.status": "none",
+func TestApplyTaskResultsToPipelineResults_ResultErrors(t *testing.T) {
+	taskResults := map[string][]v1.TaskRunResult{
+		"pt1": {{
+			Name:  "array",
+			Value: *v1.NewStructuredValues("do", "rae", "mi"),
+		}, {
+			Name:  "object",
+			Value: *v1.NewObject(map[string]string{"key1": "val1"}),
+		}},
 	}
-	state := resources.PipelineRunState{{
-		PipelineTask: &v1.PipelineTask{
-			Name:        "task4",
-			DisplayName: "Task 1 $(tasks.task1.status) but Task 3 exited with $(tasks.task3.status)",
-			TaskRef:     &v1.TaskRef{Name: "task"},
-			Params: v1.Params{{
-				Name:  "task1",
-				Value: *v1.NewStructuredValues("$(tasks.task1.status)"),
-			}, {
-				Name:  "task3",
-				Value: *v1.NewStructuredValues("$(tasks.task3.status)"),
-			}},
-			When: v1.WhenExpressions{{
-				Input:    "$(tasks.task1.status)",
-				Operator: selection.In,
-				Values:   []string{"$(tasks.task3.status)"},
-			}},
-		},
-	}}
-	expectedState := resources.PipelineRunState{{
-		PipelineTask: &v1.PipelineTask{
-			Name:        "task4",
-			DisplayName: "Task 1 succeeded but Task 3 exited with none",
-			TaskRef:     &v1.TaskRef{Name: "task"},
-			Params: v1.Params{{
-				Name:  "task1",
-				Value: *v1.NewStructuredValues("succeeded"),
-			}, {
-				Name:  "task3",
-				Value: *v1.NewStructuredValues("none"),
-			}},
-			When: v1.WhenExpressions{{
-				Input:    "succeeded",
-				Operator: selection.In,
-				Values:   []string{"none"},
-			}},
-		},
-	}}
-	resources.ApplyPipelineTaskStateContext(state, r)
-	if d := cmp.Diff(expectedState, state); d != "" {
-		t.Fatalf("ApplyTaskRunContext() %s", diff.PrintWantGot(d))
+	taskstatus := map[string]string{
+		resources.PipelineTaskStatusPrefix + "pt1" + resources.PipelineTaskStatusSuffix:     v1.TaskRunReasonSuccessful.String(),
+		resources.PipelineTaskStatusPrefix + "failed" + resources.PipelineTaskStatusSuffix:  v1.TaskRunReasonFailed.String(),
+		resources.PipelineTaskStatusPrefix + "skipped" + resources.PipelineTaskStatusSuffix: resources.PipelineTaskStateNone,
 	}
-}
-
-func TestPropagateResults(t *testing.T) {
-	for _, tt := range []struct {
-		name                 string
-		resolvedTask         *resources.ResolvedPipelineTask
-		runStates            resources.PipelineRunState
-		expectedResolvedTask *resources.ResolvedPipelineTask
-	}{
-		{
-			name: "propagate string result",
-			resolvedTask: &resources.ResolvedPipelineTask{
-				ResolvedTask: &taskresources.ResolvedTask{
+	for _, tc := range []struct {
+		description          string
+		value                string
+		expectedResultErrors []resources.InvalidPipelineResult
+		wantErr              bool
+	}{{
+		description: "skipped task",
+		value:       "$(tasks.skipped.results.foo)",
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.skipped.results.foo", TaskName: "skipped", Reason: resources.PipelineResultReasonTaskSkipped,
+		}},
+	}, {
+		description: "failed task",
+		value:       "$(tasks.failed.results.foo)",
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.failed.results.foo", TaskName: "failed", Reason: resources.PipelineResultReasonTaskFailed,
+		}},
+	}, {
+		description: "missing task",
+		value:       "$(tasks.pt2.results.array)",
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.pt2.results.array", TaskName: "pt2", Reason: resources.PipelineResultReasonTaskMissing,
+		}},
+		wantErr: true,
+	}, {
+		description: "misspelled result name",
+		value:       "$(tasks.pt1.results.arary)",
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.pt1.results.arary", TaskName: "pt1", Reason: resources.PipelineResultReasonResultMissing,
+		}},
+		wantErr: true,
+	}, {
+		description: "array index out of bounds",
+		value:       "$(tasks.pt1.results.array[3])",
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.pt1.results.array[3]", TaskName: "pt1", Reason: resources.PipelineResultReasonIndexOutOfBounds,
+		}},
+		wantErr: true,
+	}, {
+		description: "object key missing",
+		value:       "$(tasks.pt1.results.object.key2)",
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.pt1.results.object.key2", TaskName: "pt1", Reason: resources.PipelineResultReasonKeyMissing,
+		}},
+		wantErr: true,
+	}, {
+		description: "valid reference",
+		value:       "$(tasks.pt1.results.object.key1)",
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			results := []v1.PipelineResult{{
+				Name:  "pipeline-result",
+				Value: *v1.NewStructuredValues(tc.value),
+			}}
+			_, resultErrors, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), results, taskResults, nil, taskstatus, nil)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ApplyTaskResultsToPipelineResults() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if d := cmp.Diff(tc.expectedResultErrors, resultErrors); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyTaskResultsToPipelineResults_Cache(t *testing.T) {
+	results := []v1.PipelineResult{{
+		Name:  "string-result",
+		Value: *v1.NewStructuredValues("$(tasks.pt1.results.foo)"),
+	}, {
+		Name:  "array-result",
+		Value: *v1.NewStructuredValues("$(tasks.pt1.results.bar[*])"),
+	}, {
+		Name:  "pending-result",
+		Value: *v1.NewStructuredValues("$(tasks.pt2.results.baz)"),
+	}}
+	taskResults := map[string][]v1.TaskRunResult{
+		"pt1": {
+			{Name: "foo", Value: *v1.NewStructuredValues("do")},
+			{Name: "bar", Value: *v1.NewStructuredValues("rae", "mi")},
+		},
+	}
+	cache := resources.NewSubstitutionCache()
+	first, _, _ := resources.ApplyTaskResultsToPipelineResults(context.Background(), results, taskResults, nil, nil, cache)
+	want := []v1.PipelineRunResult{
+		{Name: "string-result", Value: *v1.NewStructuredValues("do")},
+		{Name: "array-result", Value: *v1.NewStructuredValues("rae", "mi")},
+	}
+	if d := cmp.Diff(want, first); d != "" {
+		t.Errorf("ApplyTaskResultsToPipelineResults() first call %s", diff.PrintWantGot(d))
+	}
+
+	// the results of pt1 come from the cache, the one of pt2 which could not be resolved before is now
+	second, _, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), results, map[string][]v1.TaskRunResult{
+		"pt2": {{Name: "baz", Value: *v1.NewStructuredValues("fa")}},
+	}, nil, nil, cache)
+	if err != nil {
+		t.Fatalf("ApplyTaskResultsToPipelineResults() unexpected error: %v", err)
+	}
+	want = append(want, v1.PipelineRunResult{Name: "pending-result", Value: *v1.NewStructuredValues("fa")})
+	if d := cmp.Diff(want, second); d != "" {
+		t.Errorf("ApplyTaskResultsToPipelineResults() second call %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestApplyTaskResultsToPipelineResults_Coercion(t *testing.T) {
+	taskResults := map[string][]v1.TaskRunResult{
+		"pt1": {
+			{Name: "array", Value: *v1.NewStructuredValues("do", "rae")},
+			{Name: "object", Value: *v1.NewObject(map[string]string{"key": "mi"})},
+			{Name: "json", Value: *v1.NewStructuredValues(`["fa","so"]`)},
+			{Name: "string", Value: *v1.NewStructuredValues("la")},
+		},
+	}
+	for _, tc := range []struct {
+		description          string
+		result               v1.PipelineResult
+		expectedResults      []v1.PipelineRunResult
+		expectedResultErrors []resources.InvalidPipelineResult
+	}{{
+		description: "array coerced to string",
+		result: v1.PipelineResult{
+			Name:  "pipeline-result",
+			Type:  v1.ResultsTypeString,
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.array)"),
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "pipeline-result",
+			Value: *v1.NewStructuredValues(`["do","rae"]`),
+		}},
+	}, {
+		description: "object coerced to string",
+		result: v1.PipelineResult{
+			Name:  "pipeline-result",
+			Type:  v1.ResultsTypeString,
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.object)"),
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "pipeline-result",
+			Value: *v1.NewStructuredValues(`{"key":"mi"}`),
+		}},
+	}, {
+		description: "string coerced to array",
+		result: v1.PipelineResult{
+			Name:  "pipeline-result",
+			Type:  v1.ResultsTypeArray,
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.json[*])"),
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "pipeline-result",
+			Value: *v1.NewStructuredValues("fa", "so"),
+		}},
+	}, {
+		description: "string which is not a json array",
+		result: v1.PipelineResult{
+			Name:  "pipeline-result",
+			Type:  v1.ResultsTypeArray,
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.string[*])"),
+		},
+		expectedResultErrors: []resources.InvalidPipelineResult{{
+			ResultName: "pipeline-result", Variable: "tasks.pt1.results.string[*]", TaskName: "pt1", Reason: resources.PipelineResultReasonTypeMismatch,
+		}},
+	}, {
+		description: "no coercion without the type",
+		result: v1.PipelineResult{
+			Name:  "pipeline-result",
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.json[*])"),
+		},
+		expectedResults: []v1.PipelineRunResult{{
+			Name:  "pipeline-result",
+			Value: *v1.NewStructuredValues("$(tasks.pt1.results.json[*])"),
+		}},
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			received, resultErrors, _ := resources.ApplyTaskResultsToPipelineResults(context.Background(), []v1.PipelineResult{tc.result}, taskResults, nil, nil, nil)
+			if d := cmp.Diff(tc.expectedResults, received); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff(tc.expectedResultErrors, resultErrors); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyTaskResultsToPipelineResults_CoercionCache(t *testing.T) {
+	// the same reference is coerced in the typed result only, including when it comes from the cache
+	results := []v1.PipelineResult{{
+		Name:  "untyped",
+		Value: *v1.NewStructuredValues("$(tasks.pt1.results.array[*])"),
+	}, {
+		Name:  "typed",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues("$(tasks.pt1.results.array)"),
+	}}
+	taskResults := map[string][]v1.TaskRunResult{
+		"pt1": {{Name: "array", Value: *v1.NewStructuredValues("do", "rae")}},
+	}
+	want := []v1.PipelineRunResult{
+		{Name: "untyped", Value: *v1.NewStructuredValues("do", "rae")},
+		{Name: "typed", Value: *v1.NewStructuredValues(`["do","rae"]`)},
+	}
+	cache := resources.NewSubstitutionCache()
+	for _, trResults := range []map[string][]v1.TaskRunResult{taskResults, nil} {
+		received, _, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), results, trResults, nil, nil, cache)
+		if err != nil {
+			t.Fatalf("ApplyTaskResultsToPipelineResults() unexpected error: %v", err)
+		}
+		if d := cmp.Diff(want, received); d != "" {
+			t.Error(diff.PrintWantGot(d))
+		}
+	}
+}
+
+func TestCoerceResultValue(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		src     *v1.ResultValue
+		dstType v1.ParamType
+		want    *v1.ResultValue
+		wantErr bool
+	}{{
+		name:    "same type",
+		src:     v1.NewStructuredValues("do"),
+		dstType: v1.ParamTypeString,
+		want:    v1.NewStructuredValues("do"),
+	}, {
+		name:    "array to string",
+		src:     v1.NewStructuredValues("do", "rae"),
+		dstType: v1.ParamTypeString,
+		want:    v1.NewStructuredValues(`["do","rae"]`),
+	}, {
+		name:    "object to string",
+		src:     v1.NewObject(map[string]string{"b": "rae", "a": "do"}),
+		dstType: v1.ParamTypeString,
+		want:    v1.NewStructuredValues(`{"a":"do","b":"rae"}`),
+	}, {
+		name:    "string to array",
+		src:     v1.NewStructuredValues(`["do","rae"]`),
+		dstType: v1.ParamTypeArray,
+		want:    &v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{"do", "rae"}},
+	}, {
+		name:    "string which is not a json array to array",
+		src:     v1.NewStructuredValues(`{"a":"do"}`),
+		dstType: v1.ParamTypeArray,
+		wantErr: true,
+	}, {
+		name:    "string to object",
+		src:     v1.NewStructuredValues(`{"a":"do"}`),
+		dstType: v1.ParamTypeObject,
+		wantErr: true,
+	}, {
+		name:    "array to object",
+		src:     v1.NewStructuredValues("do", "rae"),
+		dstType: v1.ParamTypeObject,
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resources.CoerceResultValue(tc.src, tc.dstType)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("CoerceResultValue() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyTaskResultsToPipelineResults_Matrix(t *testing.T) {
+	taskRun := func(name, digest string) *v1.TaskRun {
+		return &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{{Name: "digest", Type: v1.ResultsTypeString, Value: *v1.NewStructuredValues(digest)}},
+				},
+			},
+		}
+	}
+	state := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:    "build",
+			TaskRef: &v1.TaskRef{Name: "build"},
+			Matrix: &v1.Matrix{
+				Params: v1.Params{{Name: "platform", Value: *v1.NewStructuredValues("linux", "mac")}},
+			},
+		},
+		TaskRunNames: []string{"build-0", "build-1"},
+		TaskRuns:     []*v1.TaskRun{taskRun("build-0", "sha256:linux"), taskRun("build-1", "sha256:mac")},
+	}}
+	results := []v1.PipelineResult{{
+		Name:  "digests",
+		Type:  v1.ResultsTypeArray,
+		Value: *v1.NewStructuredValues("$(tasks.build.results.digest[*])"),
+	}}
+
+	// the string results of the TaskRuns fanned out from the matrix are aggregated into an array
+	got, _, err := resources.ApplyTaskResultsToPipelineResults(context.Background(), results, state.GetTaskRunsResults().ToMap(), nil, nil, nil)
+	if err != nil {
+		t.Fatalf("ApplyTaskResultsToPipelineResults() unexpected error: %v", err)
+	}
+	want := []v1.PipelineRunResult{{Name: "digests", Value: *v1.NewStructuredValues("sha256:linux", "sha256:mac")}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("ApplyTaskResultsToPipelineResults() %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestApplyTaskResultsToPipelineResults_LogsInvalidReferences(t *testing.T) {
+	var logs bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&logs), zap.DebugLevel)
+	ctx := logging.WithLogger(context.Background(), zap.New(core).Sugar())
+	results := []v1.PipelineResult{{
+		Name:  "pipeline-result",
+		Value: *v1.NewStructuredValues("$(tasks.pt1.results.arary)"),
+	}, {
+		Name:  "skipped-result",
+		Value: *v1.NewStructuredValues("$(tasks.skipped.results.foo)"),
+	}}
+	taskResults := map[string][]v1.TaskRunResult{
+		"pt1": {{Name: "array", Value: *v1.NewStructuredValues("do", "rae", "mi")}},
+	}
+	taskstatus := map[string]string{
+		resources.PipelineTaskStatusPrefix + "pt1" + resources.PipelineTaskStatusSuffix:     v1.TaskRunReasonSuccessful.String(),
+		resources.PipelineTaskStatusPrefix + "skipped" + resources.PipelineTaskStatusSuffix: resources.PipelineTaskStateNone,
+	}
+
+	_, _, err := resources.ApplyTaskResultsToPipelineResults(ctx, results, taskResults, nil, taskstatus, nil)
+
+	var invalidErr *resources.InvalidPipelineResultsError
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("ApplyTaskResultsToPipelineResults() error = %v, expected an InvalidPipelineResultsError", err)
+	}
+	if d := cmp.Diff(`invalid pipelineresults, the referenced results don't exist: pipeline result "pipeline-result": reference $(tasks.pt1.results.arary) to task "pt1" is invalid: ResultMissing`, err.Error()); d != "" {
+		t.Errorf("ApplyTaskResultsToPipelineResults() error %s", diff.PrintWantGot(d))
+	}
+	metadata, err := json.Marshal(invalidErr)
+	if err != nil {
+		t.Fatalf("failed to marshal the error: %v", err)
+	}
+	if d := cmp.Diff(`{"invalid":[{"pipelineResult":"pipeline-result","variable":"tasks.pt1.results.arary","taskName":"pt1","reason":"ResultMissing"}]}`, string(metadata)); d != "" {
+		t.Errorf("InvalidPipelineResultsError JSON %s", diff.PrintWantGot(d))
+	}
+
+	// only the invalid reference is logged, the result of the skipped task is not
+	var entry map[string]interface{}
+	if err := json.Unmarshal(logs.Bytes(), &entry); err != nil {
+		t.Fatalf("expected a single JSON log entry but got %q: %v", logs.String(), err)
+	}
+	for k, want := range map[string]interface{}{
+		"level":          "warn",
+		"pipelineResult": "pipeline-result",
+		"taskName":       "pt1",
+		"reference":      "tasks.pt1.results.arary",
+		"reason":         resources.PipelineResultReasonResultMissing,
+	} {
+		if d := cmp.Diff(want, entry[k]); d != "" {
+			t.Errorf("log entry field %q %s", k, diff.PrintWantGot(d))
+		}
+	}
+}
+
+func TestApplyTaskRunContext(t *testing.T) {
+	r := map[string]string{
+		"tasks.task1.status": "succeeded",
+		"tasks.task3.status": "none",
+	}
+	state := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:        "task4",
+			DisplayName: "Task 1 $(tasks.task1.status) but Task 3 exited with $(tasks.task3.status)",
+			TaskRef:     &v1.TaskRef{Name: "task"},
+			Params: v1.Params{{
+				Name:  "task1",
+				Value: *v1.NewStructuredValues("$(tasks.task1.status)"),
+			}, {
+				Name:  "task3",
+				Value: *v1.NewStructuredValues("$(tasks.task3.status)"),
+			}},
+			When: v1.WhenExpressions{{
+				Input:    "$(tasks.task1.status)",
+				Operator: selection.In,
+				Values:   []string{"$(tasks.task3.status)"},
+			}},
+		},
+	}}
+	expectedState := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:        "task4",
+			DisplayName: "Task 1 succeeded but Task 3 exited with none",
+			TaskRef:     &v1.TaskRef{Name: "task"},
+			Params: v1.Params{{
+				Name:  "task1",
+				Value: *v1.NewStructuredValues("succeeded"),
+			}, {
+				Name:  "task3",
+				Value: *v1.NewStructuredValues("none"),
+			}},
+			When: v1.WhenExpressions{{
+				Input:    "succeeded",
+				Operator: selection.In,
+				Values:   []string{"none"},
+			}},
+		},
+	}}
+	resources.ApplyPipelineTaskStateContext(state, r, &v1.PipelineRun{})
+	if d := cmp.Diff(expectedState, state); d != "" {
+		t.Fatalf("ApplyTaskRunContext() %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestApplyResultsToFinallyTasks(t *testing.T) {
+	taskRunWithResult := func(status corev1.ConditionStatus, value string) *v1.TaskRun {
+		return &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{
+						Type:   apis.ConditionSucceeded,
+						Status: status,
+					}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{{
+						Name:  "r1",
+						Type:  v1.ResultsTypeString,
+						Value: *v1.NewStructuredValues(value),
+					}},
+				},
+			},
+		}
+	}
+	dagTasks := []v1.PipelineTask{{Name: "dag-task"}}
+	finallyTasks := []v1.PipelineTask{{Name: "final-1"}, {Name: "final-2"}, {Name: "final-3"}}
+	state := resources.PipelineRunState{{
+		PipelineTask: &dagTasks[0],
+		TaskRuns:     []*v1.TaskRun{taskRunWithResult(corev1.ConditionTrue, "dag-value")},
+	}, {
+		PipelineTask: &finallyTasks[0],
+		TaskRuns:     []*v1.TaskRun{taskRunWithResult(corev1.ConditionTrue, "final-value")},
+	}, {
+		PipelineTask: &finallyTasks[1],
+		TaskRuns:     []*v1.TaskRun{taskRunWithResult(corev1.ConditionUnknown, "")},
+	}}
+	d, err := dag.Build(v1.PipelineTaskList(dagTasks), v1.PipelineTaskList(dagTasks).Deps())
+	if err != nil {
+		t.Fatalf("Unexpected error while building DAG for pipelineTasks %v: %v", dagTasks, err)
+	}
+	dfinally, err := dag.Build(v1.PipelineTaskList(finallyTasks), map[string][]string{})
+	if err != nil {
+		t.Fatalf("Unexpected error while building DAG for final pipelineTasks %v: %v", finallyTasks, err)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		params  v1.Params
+		want    v1.Params
+		wantErr string
+	}{{
+		name: "dag task and sibling finally task results",
+		params: v1.Params{
+			{Name: "p1", Value: *v1.NewStructuredValues("$(tasks.dag-task.results.r1)")},
+			{Name: "p2", Value: *v1.NewStructuredValues("$(finally.final-1.results.r1)")},
+		},
+		want: v1.Params{
+			{Name: "p1", Value: *v1.NewStructuredValues("dag-value")},
+			{Name: "p2", Value: *v1.NewStructuredValues("final-value")},
+		},
+	}, {
+		name: "results are only resolved in the section of the referenced task",
+		params: v1.Params{
+			{Name: "p1", Value: *v1.NewStructuredValues("$(finally.dag-task.results.r1)")},
+			{Name: "p2", Value: *v1.NewStructuredValues("$(tasks.final-1.results.r1)")},
+		},
+		want: v1.Params{
+			{Name: "p1", Value: *v1.NewStructuredValues("$(finally.dag-task.results.r1)")},
+			{Name: "p2", Value: *v1.NewStructuredValues("$(tasks.final-1.results.r1)")},
+		},
+	}, {
+		name: "result of a running finally task",
+		params: v1.Params{
+			{Name: "p1", Value: *v1.NewStructuredValues("$(tasks.dag-task.results.r1)")},
+			{Name: "p2", Value: *v1.NewStructuredValues("$(finally.final-2.results.r1)")},
+		},
+		wantErr: `finally task "final-3" references result "r1" of finally task "final-2" which is not available yet`,
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &resources.ResolvedPipelineTask{
+				PipelineTask: &v1.PipelineTask{
+					Name:    "final-3",
+					TaskRef: &v1.TaskRef{Name: "task"},
+					Params:  tt.params,
+				},
+			}
+			facts := &resources.PipelineRunFacts{
+				State:           append(resources.PipelineRunState{target}, state...),
+				TasksGraph:      d,
+				FinalTasksGraph: dfinally,
+			}
+			err := resources.ApplyResultsToFinallyTasks(resources.PipelineRunState{target}, facts)
+			if tt.wantErr != "" {
+				if err == nil {
+					t.Fatal("ApplyResultsToFinallyTasks() expected error but got none")
+				}
+				if d := cmp.Diff(tt.wantErr, err.Error()); d != "" {
+					t.Errorf("ApplyResultsToFinallyTasks() error %s", diff.PrintWantGot(d))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyResultsToFinallyTasks() unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tt.want, target.PipelineTask.Params); d != "" {
+				t.Errorf("ApplyResultsToFinallyTasks() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyPipelineTaskTimeout(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		pt   v1.PipelineTask
+		want *metav1.Duration
+	}{{
+		name: "no timeoutString",
+		pt: v1.PipelineTask{
+			Name:    "task",
+			Timeout: &metav1.Duration{Duration: time.Hour},
+		},
+		want: &metav1.Duration{Duration: time.Hour},
+	}, {
+		name: "timeoutString is parsed into timeout",
+		pt: v1.PipelineTask{
+			Name:          "task",
+			TimeoutString: "1h30m",
+		},
+		want: &metav1.Duration{Duration: 90 * time.Minute},
+	}, {
+		name: "zero timeoutString",
+		pt: v1.PipelineTask{
+			Name:          "task",
+			TimeoutString: "0s",
+		},
+		want: &metav1.Duration{Duration: 0},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := resources.ApplyPipelineTaskTimeout(&tt.pt); err != nil {
+				t.Fatalf("ApplyPipelineTaskTimeout() unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tt.want, tt.pt.Timeout); d != "" {
+				t.Errorf("ApplyPipelineTaskTimeout() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyPipelineTaskTimeout_Error(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		pt      v1.PipelineTask
+		wantErr string
+	}{{
+		name: "unresolved result reference",
+		pt: v1.PipelineTask{
+			Name:          "task",
+			TimeoutString: "$(tasks.profile.results.timeout)",
+		},
+		wantErr: `timeoutString "$(tasks.profile.results.timeout)" of pipeline task "task" contains unresolved variable references`,
+	}, {
+		name: "invalid duration",
+		pt: v1.PipelineTask{
+			Name:          "task",
+			TimeoutString: "forever",
+		},
+		wantErr: `timeoutString "forever" of pipeline task "task" is not a valid duration: time: invalid duration "forever"`,
+	}, {
+		name: "negative duration",
+		pt: v1.PipelineTask{
+			Name:          "task",
+			TimeoutString: "-5m",
+		},
+		wantErr: `timeoutString "-5m" of pipeline task "task" should be >= 0`,
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resources.ApplyPipelineTaskTimeout(&tt.pt)
+			if err == nil {
+				t.Fatal("ApplyPipelineTaskTimeout() expected error but got none")
+			}
+			if d := cmp.Diff(tt.wantErr, err.Error()); d != "" {
+				t.Errorf("ApplyPipelineTaskTimeout() error %s", diff.PrintWantGot(d))
+			}
+			if tt.pt.Timeout != nil {
+				t.Errorf("ApplyPipelineTaskTimeout() expected timeout to be unset but got %v", tt.pt.Timeout)
+			}
+		})
+	}
+}
+
+func TestGetStatusContextReplacements(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)))
+	completionTime := metav1.NewTime(time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC))
+	for _, tt := range []struct {
+		name string
+		pr   *v1.PipelineRun
+		want map[string]string
+	}{{
+		name: "times not set",
+		pr:   &v1.PipelineRun{},
+		want: map[string]string{
+			"context.pipelineRun.startTime":      "",
+			"context.pipelineRun.completionTime": "",
+		},
+	}, {
+		name: "start time set",
+		pr: &v1.PipelineRun{
+			Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{StartTime: &startTime}},
+		},
+		want: map[string]string{
+			"context.pipelineRun.startTime":      "2024-05-01T08:00:00Z",
+			"context.pipelineRun.completionTime": "",
+		},
+	}, {
+		name: "start and completion times set",
+		pr: &v1.PipelineRun{
+			Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{StartTime: &startTime, CompletionTime: &completionTime}},
+		},
+		want: map[string]string{
+			"context.pipelineRun.startTime":      "2024-05-01T08:00:00Z",
+			"context.pipelineRun.completionTime": "2024-05-01T08:30:00Z",
+		},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resources.GetStatusContextReplacements(tt.pr)
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("GetStatusContextReplacements() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyPipelineTaskStateContext_StatusContext(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC))
+	pr := &v1.PipelineRun{
+		Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{StartTime: &startTime}},
+	}
+	state := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:    "notify",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{{
+				Name:  "message",
+				Value: *v1.NewStructuredValues("$(tasks.status) from $(context.pipelineRun.startTime) to $(context.pipelineRun.completionTime)"),
+			}},
+		},
+	}}
+	expectedState := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:    "notify",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{{
+				Name:  "message",
+				Value: *v1.NewStructuredValues("Succeeded from 2024-05-01T08:00:00Z to "),
+			}},
+		},
+	}}
+	replacements := map[string]string{"tasks.status": "Succeeded"}
+	resources.ApplyPipelineTaskStateContext(state, replacements, pr)
+	if d := cmp.Diff(expectedState, state); d != "" {
+		t.Fatalf("ApplyPipelineTaskStateContext() %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(map[string]string{"tasks.status": "Succeeded"}, replacements); d != "" {
+		t.Errorf("ApplyPipelineTaskStateContext() should not modify the replacements %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestPropagateResults(t *testing.T) {
+	for _, tt := range []struct {
+		name                 string
+		resolvedTask         *resources.ResolvedPipelineTask
+		runStates            resources.PipelineRunState
+		expectedResolvedTask *resources.ResolvedPipelineTask
+	}{
+		{
+			name: "propagate string result",
+			resolvedTask: &resources.ResolvedPipelineTask{
+				ResolvedTask: &taskresources.ResolvedTask{
 					TaskSpec: &v1.TaskSpec{
 						Steps: []v1.Step{
 							{
@@ -4691,9 +7272,155 @@ func TestPropagateResults(t *testing.T) {
 							},
 						},
 					},
-				}, {
+				}, {
+					PipelineTask: &v1.PipelineTask{
+						Name: "pt2",
+					},
+					TaskRuns: []*v1.TaskRun{
+						{
+							Status: v1.TaskRunStatus{
+								Status: duckv1.Status{
+									Conditions: duckv1.Conditions{
+										{
+											Type:   apis.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								TaskRunStatusFields: v1.TaskRunStatusFields{
+									Results: []v1.TaskRunResult{
+										{
+											Name: "r1",
+											Type: v1.ResultsTypeObject,
+											Value: v1.ResultValue{
+												ObjectVal: map[string]string{
+													"arg1": "echo",
+													"arg2": "arg1",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResolvedTask: &resources.ResolvedPipelineTask{
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{
+							{
+								Command: []string{"bash", "-c"},
+								Args:    []string{"echo", "arg1"},
+							},
+						},
+					},
+				},
+			},
+		}, {
+			name: "propagate whole object result into step params",
+			resolvedTask: &resources.ResolvedPipelineTask{
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{
+							{
+								Ref: &v1.Ref{Name: "git-clone"},
+								Params: v1.Params{{
+									Name:  "repo",
+									Value: *v1.NewStructuredValues("$(tasks.pt1.results.r1[*])"),
+								}, {
+									Name:  "repo-no-star",
+									Value: *v1.NewStructuredValues("$(tasks.pt1.results.r1)"),
+								}, {
+									Name:  "commit",
+									Value: *v1.NewStructuredValues("$(tasks.pt1.results.r1.commit)"),
+								}},
+							},
+						},
+					},
+				},
+			},
+			runStates: resources.PipelineRunState{
+				{
+					PipelineTask: &v1.PipelineTask{
+						Name: "pt1",
+					},
+					TaskRuns: []*v1.TaskRun{
+						{
+							Status: v1.TaskRunStatus{
+								Status: duckv1.Status{
+									Conditions: duckv1.Conditions{
+										{
+											Type:   apis.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								TaskRunStatusFields: v1.TaskRunStatusFields{
+									Results: []v1.TaskRunResult{
+										{
+											Name: "r1",
+											Type: v1.ResultsTypeObject,
+											Value: v1.ResultValue{
+												Type: v1.ParamTypeObject,
+												ObjectVal: map[string]string{
+													"url":    "https://github.com/tektoncd/pipeline",
+													"commit": "abc123",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResolvedTask: &resources.ResolvedPipelineTask{
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{
+							{
+								Ref: &v1.Ref{Name: "git-clone"},
+								Params: v1.Params{{
+									Name: "repo",
+									Value: *v1.NewObject(map[string]string{
+										"url":    "https://github.com/tektoncd/pipeline",
+										"commit": "abc123",
+									}),
+								}, {
+									Name: "repo-no-star",
+									Value: *v1.NewObject(map[string]string{
+										"url":    "https://github.com/tektoncd/pipeline",
+										"commit": "abc123",
+									}),
+								}, {
+									Name:  "commit",
+									Value: *v1.NewStructuredValues("abc123"),
+								}},
+							},
+						},
+					},
+				},
+			},
+		}, {
+			name: "not propagate whole object result or missing object key into strings",
+			resolvedTask: &resources.ResolvedPipelineTask{
+				ResolvedTask: &taskresources.ResolvedTask{
+					TaskSpec: &v1.TaskSpec{
+						Steps: []v1.Step{
+							{
+								Args: []string{"$(tasks.pt1.results.r1[*])", "$(tasks.pt1.results.r1.missing)", "$(tasks.pt1.results.r1.url)"},
+							},
+						},
+					},
+				},
+			},
+			runStates: resources.PipelineRunState{
+				{
 					PipelineTask: &v1.PipelineTask{
-						Name: "pt2",
+						Name: "pt1",
 					},
 					TaskRuns: []*v1.TaskRun{
 						{
@@ -4712,9 +7439,10 @@ func TestPropagateResults(t *testing.T) {
 											Name: "r1",
 											Type: v1.ResultsTypeObject,
 											Value: v1.ResultValue{
+												Type: v1.ParamTypeObject,
 												ObjectVal: map[string]string{
-													"arg1": "echo",
-													"arg2": "arg1",
+													"url":    "https://github.com/tektoncd/pipeline",
+													"commit": "abc123",
 												},
 											},
 										},
@@ -4730,8 +7458,7 @@ func TestPropagateResults(t *testing.T) {
 					TaskSpec: &v1.TaskSpec{
 						Steps: []v1.Step{
 							{
-								Command: []string{"bash", "-c"},
-								Args:    []string{"echo", "arg1"},
+								Args: []string{"$(tasks.pt1.results.r1[*])", "$(tasks.pt1.results.r1.missing)", "https://github.com/tektoncd/pipeline"},
 							},
 						},
 					},
@@ -4762,7 +7489,9 @@ func TestPropagateResults(t *testing.T) {
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			resources.PropagateResults(tt.resolvedTask, tt.runStates)
+			if _, err := resources.PropagateResults(context.Background(), tt.resolvedTask, tt.runStates); err != nil {
+				t.Fatalf("PropagateResults() unexpected error: %v", err)
+			}
 			if d := cmp.Diff(tt.expectedResolvedTask, tt.resolvedTask); d != "" {
 				t.Fatalf("PropagateResults() %s", diff.PrintWantGot(d))
 			}
@@ -4916,7 +7645,7 @@ func TestPropagateArtifacts(t *testing.T) {
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			err := resources.PropagateArtifacts(tt.resolvedTask, tt.runStates)
+			_, err := resources.PropagateArtifacts(tt.resolvedTask, tt.runStates)
 			if tt.wantErr != (err != nil) {
 				t.Fatalf("Failed to check err want %t, got %v", tt.wantErr, err)
 			}
@@ -4927,6 +7656,188 @@ func TestPropagateArtifacts(t *testing.T) {
 	}
 }
 
+func TestSubstitutionReports(t *testing.T) {
+	runStates := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{Name: "pt1"},
+		TaskRuns: []*v1.TaskRun{{
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{{Name: "digest", Type: v1.ResultsTypeString, Value: *v1.NewStructuredValues("sha256:abc")}},
+					Artifacts: &v1.Artifacts{
+						Outputs: []v1.Artifact{{Name: "image", Values: []v1.ArtifactValue{{Uri: "pkg:oci/image"}}}},
+					},
+				},
+			},
+		}},
+	}}
+	resolvedTask := func() *resources.ResolvedPipelineTask {
+		return &resources.ResolvedPipelineTask{
+			PipelineTask: &v1.PipelineTask{
+				Name: "pt2",
+				Params: v1.Params{
+					{Name: "digest", Value: *v1.NewStructuredValues("$(tasks.pt1.results.digest)")},
+					{Name: "tag", Value: *v1.NewStructuredValues("$(tasks.pt0.results.tag)")},
+				},
+			},
+			ResolvedTask: &taskresources.ResolvedTask{
+				TaskSpec: &v1.TaskSpec{
+					Steps: []v1.Step{{
+						Name: "step",
+						Args: []string{"$(tasks.pt1.results.digest)", "$(tasks.pt0.results.tag)", "$(tasks.pt1.outputs.image)", "$(tasks.pt0.outputs.image)"},
+					}},
+				},
+			},
+		}
+	}
+	want := resources.SubstitutionReport{Applied: 1, Unresolved: []string{"tasks.pt0.results.tag"}}
+
+	t.Run("ApplyTaskResults", func(t *testing.T) {
+		report, err := resources.ApplyTaskResults(context.Background(), resources.PipelineRunState{resolvedTask()}, resources.ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("sha256:abc"),
+			ResultReference: v1.ResultRef{PipelineTask: "pt1", Result: "digest"},
+			FromTaskRun:     "pt1-taskrun",
+		}})
+		if err != nil {
+			t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+		}
+		if d := cmp.Diff(want, report); d != "" {
+			t.Errorf("ApplyTaskResults() %s", diff.PrintWantGot(d))
+		}
+	})
+	t.Run("ApplyTaskResults with conflicting values", func(t *testing.T) {
+		report, err := resources.ApplyTaskResults(context.Background(), resources.PipelineRunState{resolvedTask()}, resources.ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("sha256:abc"),
+			ResultReference: v1.ResultRef{PipelineTask: "pt1", Result: "digest"},
+		}, {
+			Value:           *v1.NewStructuredValues("sha256:def"),
+			ResultReference: v1.ResultRef{PipelineTask: "pt1", Result: "digest"},
+		}})
+		if err == nil {
+			t.Fatal("ApplyTaskResults() expected an error for conflicting values")
+		}
+		if d := cmp.Diff([]string{"tasks.pt1.results.digest"}, []string{report.Errors[0].Variable}); d != "" {
+			t.Errorf("ApplyTaskResults() errors %s", diff.PrintWantGot(d))
+		}
+	})
+	t.Run("ApplyTaskResults with when expression left without values", func(t *testing.T) {
+		rpt := &resources.ResolvedPipelineTask{
+			PipelineTask: &v1.PipelineTask{
+				Name: "pt2",
+				When: v1.WhenExpressions{{
+					Input:    "$(tasks.pt1.results.digest)",
+					Operator: selection.In,
+					Values:   []string{"$(tasks.pt1.results.allowed[*])"},
+				}},
+			},
+		}
+		report, err := resources.ApplyTaskResults(context.Background(), resources.PipelineRunState{rpt}, resources.ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("sha256:abc"),
+			ResultReference: v1.ResultRef{PipelineTask: "pt1", Result: "digest"},
+		}, {
+			Value:           v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{}},
+			ResultReference: v1.ResultRef{PipelineTask: "pt1", Result: "allowed"},
+		}})
+		if err != nil {
+			t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+		}
+		if d := cmp.Diff("sha256:abc", rpt.PipelineTask.When[0].Input); d != "" {
+			t.Errorf("ApplyTaskResults() input %s", diff.PrintWantGot(d))
+		}
+		if len(report.Errors) != 1 {
+			t.Fatalf("ApplyTaskResults() expected 1 error, got %v", report.Errors)
+		}
+		if d := cmp.Diff("tasks.pt1.results.allowed", report.Errors[0].Variable); d != "" {
+			t.Errorf("ApplyTaskResults() errors %s", diff.PrintWantGot(d))
+		}
+	})
+	t.Run("PropagateResults", func(t *testing.T) {
+		report, err := resources.PropagateResults(context.Background(), resolvedTask(), runStates)
+		if err != nil {
+			t.Fatalf("PropagateResults() unexpected error: %v", err)
+		}
+		if d := cmp.Diff(want, report); d != "" {
+			t.Errorf("PropagateResults() %s", diff.PrintWantGot(d))
+		}
+	})
+	t.Run("PropagateResults with upstream task still running", func(t *testing.T) {
+		running := append(resources.PipelineRunState{{
+			PipelineTask: &v1.PipelineTask{Name: "pt0"},
+			TaskRuns: []*v1.TaskRun{{
+				Status: v1.TaskRunStatus{
+					Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown}}},
+				},
+			}},
+		}}, runStates...)
+		report, err := resources.PropagateResults(context.Background(), resolvedTask(), running)
+		if !errors.Is(err, resources.ErrUpstreamResultNotAvailable) {
+			t.Fatalf("PropagateResults() expected ErrUpstreamResultNotAvailable, got %v", err)
+		}
+		wantErrors := []resources.SubstitutionError{{
+			Variable: "tasks.pt0.results.tag",
+			Reason:   `pipeline task "pt0" has not completed yet`,
+		}}
+		if d := cmp.Diff(wantErrors, report.Errors); d != "" {
+			t.Errorf("PropagateResults() errors %s", diff.PrintWantGot(d))
+		}
+	})
+	t.Run("PropagateArtifacts", func(t *testing.T) {
+		report, err := resources.PropagateArtifacts(resolvedTask(), runStates)
+		if err != nil {
+			t.Fatalf("PropagateArtifacts() unexpected error: %v", err)
+		}
+		want := resources.SubstitutionReport{Applied: 1, Unresolved: []string{"tasks.pt0.outputs.image"}}
+		if d := cmp.Diff(want, report); d != "" {
+			t.Errorf("PropagateArtifacts() %s", diff.PrintWantGot(d))
+		}
+	})
+}
+
+func TestApplyArtifactsToFinallyTasks(t *testing.T) {
+	runStates := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{Name: "pt1"},
+		TaskRuns: []*v1.TaskRun{{
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Artifacts: &v1.Artifacts{
+						Outputs: []v1.Artifact{{Name: "image", Values: []v1.ArtifactValue{{Digest: map[v1.Algorithm]string{"sha256": "df85b9e3983fe2ce20ef76ad675ecf435cc99fc9350adc54fa230bae8c32ce48"}, Uri: "pkg:balba"}}}},
+					},
+				},
+			},
+		}},
+	}}
+	targets := resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{Name: "notify"},
+		ResolvedTask: &taskresources.ResolvedTask{
+			TaskSpec: &v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name: "notify",
+					Args: []string{"$(tasks.pt1.outputs.image)", "$(tasks.pt1.outputs)"},
+				}},
+			},
+		},
+	}, {
+		PipelineTask: &v1.PipelineTask{Name: "unresolved"},
+	}}
+	expected := []string{
+		`[{"digest":{"sha256":"df85b9e3983fe2ce20ef76ad675ecf435cc99fc9350adc54fa230bae8c32ce48"},"uri":"pkg:balba"}]`,
+		`[{"digest":{"sha256":"df85b9e3983fe2ce20ef76ad675ecf435cc99fc9350adc54fa230bae8c32ce48"},"uri":"pkg:balba"}]`,
+	}
+
+	if err := resources.ApplyArtifactsToFinallyTasks(targets, runStates); err != nil {
+		t.Fatalf("ApplyArtifactsToFinallyTasks() unexpected error: %v", err)
+	}
+	if d := cmp.Diff(expected, targets[0].ResolvedTask.TaskSpec.Steps[0].Args); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+	if targets[1].ResolvedTask != nil {
+		t.Errorf("expected unresolved finally task to be left untouched, got %v", targets[1].ResolvedTask)
+	}
+}
+
 func TestApplyParametersToWorkspaceBindings(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -5290,11 +8201,97 @@ func TestApplyParametersToWorkspaceBindings(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "default param values",
+			ps: &v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "config-map-name", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("default-config-map")},
+					{Name: "sub-paths", Type: v1.ParamTypeArray, Default: v1.NewStructuredValues("first", "second")},
+					{Name: "pvc", Type: v1.ParamTypeObject, Default: v1.NewObject(map[string]string{"name": "default-claim"})},
+				},
+			},
+			pr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							Name:    "config",
+							SubPath: "$(params.sub-paths[1])",
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "$(params.config-map-name)"},
+							},
+						},
+						{
+							Name: "source",
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "$(params.pvc.name)",
+							},
+						},
+					},
+				},
+			},
+			expectedPr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							Name:    "config",
+							SubPath: "second",
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "default-config-map"},
+							},
+						},
+						{
+							Name: "source",
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "default-claim",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pipelinerun param values override default param values",
+			ps: &v1.PipelineSpec{
+				Params: []v1.ParamSpec{
+					{Name: "config-map-name", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("default-config-map")},
+				},
+			},
+			pr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: []v1.Param{
+						{Name: "config-map-name", Value: *v1.NewStructuredValues("config-map")},
+					},
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "$(params.config-map-name)"},
+							},
+						},
+					},
+				},
+			},
+			expectedPr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Params: []v1.Param{
+						{Name: "config-map-name", Value: *v1.NewStructuredValues("config-map")},
+					},
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "config-map"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			resources.ApplyParametersToWorkspaceBindings(context.TODO(), tt.pr)
+			if err := resources.ApplyParametersToWorkspaceBindings(context.TODO(), tt.ps, tt.pr); err != nil {
+				t.Fatalf("ApplyParametersToWorkspaceBindings() unexpected error: %v", err)
+			}
 			if d := cmp.Diff(tt.expectedPr, tt.pr); d != "" {
 				t.Fatalf("TestApplyParametersToWorkspaceBindings() %s, got: %v", tt.name, diff.PrintWantGot(d))
 			}
@@ -5302,6 +8299,49 @@ func TestApplyParametersToWorkspaceBindings(t *testing.T) {
 	}
 }
 
+func TestApplyParametersToTaskRunSpecs(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params: []v1.ParamSpec{
+			{Name: "environment", Type: v1.ParamTypeString},
+			{Name: "team", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("build")},
+		},
+	}
+	pr := &v1.PipelineRun{
+		Spec: v1.PipelineRunSpec{
+			Params: []v1.Param{
+				{Name: "environment", Value: *v1.NewStructuredValues("staging")},
+			},
+			TaskRunSpecs: []v1.PipelineTaskRunSpec{{
+				PipelineTaskName:   "deploy",
+				ServiceAccountName: "$(params.environment)-sa",
+			}, {
+				PipelineTaskName:   "build",
+				ServiceAccountName: "$(params.team)-$(params.environment)",
+			}, {
+				PipelineTaskName:   "test",
+				ServiceAccountName: "tester",
+			}},
+		},
+	}
+	expected := []v1.PipelineTaskRunSpec{{
+		PipelineTaskName:   "deploy",
+		ServiceAccountName: "staging-sa",
+	}, {
+		PipelineTaskName:   "build",
+		ServiceAccountName: "build-staging",
+	}, {
+		PipelineTaskName:   "test",
+		ServiceAccountName: "tester",
+	}}
+
+	if err := resources.ApplyParametersToTaskRunSpecs(context.Background(), ps, pr); err != nil {
+		t.Fatalf("ApplyParametersToTaskRunSpecs() unexpected error: %v", err)
+	}
+	if d := cmp.Diff(expected, pr.Spec.TaskRunSpecs); d != "" {
+		t.Errorf("ApplyParametersToTaskRunSpecs() got diff %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -5548,9 +8588,122 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 			trResults: map[string][]v1.TaskRunResult{
 				"task6": {
 					{
-						Name:  "driver-name",
-						Type:  v1.ResultsTypeString,
-						Value: v1.ResultValue{StringVal: "driver-value"},
+						Name:  "driver-name",
+						Type:  v1.ResultsTypeString,
+						Value: v1.ResultValue{StringVal: "driver-value"},
+					},
+				},
+			},
+			pr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							CSI: &corev1.CSIVolumeSource{
+								Driver: "$(tasks.task6.results.driver-name)",
+							},
+						},
+					},
+				},
+			},
+			expectedPr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							CSI: &corev1.CSIVolumeSource{
+								Driver: "driver-value",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "csi-NodePublishSecretRef-name",
+			trResults: map[string][]v1.TaskRunResult{
+				"task6": {
+					{
+						Name:  "ref-name",
+						Type:  v1.ResultsTypeString,
+						Value: v1.ResultValue{StringVal: "ref-value"},
+					},
+				},
+			},
+			pr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							CSI: &corev1.CSIVolumeSource{
+								Driver:               "driver",
+								NodePublishSecretRef: &corev1.LocalObjectReference{Name: "$(tasks.task6.results.ref-name)"},
+							},
+						},
+					},
+				},
+			},
+			expectedPr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							CSI: &corev1.CSIVolumeSource{
+								Driver:               "driver",
+								NodePublishSecretRef: &corev1.LocalObjectReference{Name: "ref-value"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pvc object-result",
+			trResults: map[string][]v1.TaskRunResult{
+				"task1": {
+					{
+						Name: "pvc-object",
+						Type: v1.ResultsTypeObject,
+						Value: v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{
+							"name": "pvc-object-value",
+						}},
+					},
+				},
+			},
+			pr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "$(tasks.task1.results.pvc-object.name)",
+							},
+						},
+					},
+				},
+			},
+			expectedPr: &v1.PipelineRun{
+				Spec: v1.PipelineRunSpec{
+					Workspaces: []v1.WorkspaceBinding{
+						{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "pvc-object-value",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pvc object-result - along with array-result (no effect)",
+			trResults: map[string][]v1.TaskRunResult{
+				"task1": {
+					{
+						Name: "pvc-object",
+						Type: v1.ResultsTypeObject,
+						Value: v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{
+							"name": "pvc-object-value",
+						}},
+					},
+					{
+						Name:  "pvc-array",
+						Type:  v1.ResultsTypeArray,
+						Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{"name-1", "name-2"}},
 					},
 				},
 			},
@@ -5558,8 +8711,8 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							CSI: &corev1.CSIVolumeSource{
-								Driver: "$(tasks.task6.results.driver-name)",
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "$(tasks.task1.results.pvc-object.name)",
 							},
 						},
 					},
@@ -5569,8 +8722,8 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							CSI: &corev1.CSIVolumeSource{
-								Driver: "driver-value",
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "pvc-object-value",
 							},
 						},
 					},
@@ -5578,13 +8731,13 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 			},
 		},
 		{
-			name: "csi-NodePublishSecretRef-name",
+			name: "subPath array-result index",
 			trResults: map[string][]v1.TaskRunResult{
-				"task6": {
+				"build": {
 					{
-						Name:  "ref-name",
-						Type:  v1.ResultsTypeString,
-						Value: v1.ResultValue{StringVal: "ref-value"},
+						Name:  "paths",
+						Type:  v1.ResultsTypeArray,
+						Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{"first/path", "second/path"}},
 					},
 				},
 			},
@@ -5592,9 +8745,12 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							CSI: &corev1.CSIVolumeSource{
-								Driver:               "driver",
-								NodePublishSecretRef: &corev1.LocalObjectReference{Name: "$(tasks.task6.results.ref-name)"},
+							EmptyDir: &corev1.EmptyDirVolumeSource{},
+							SubPath:  "$(tasks.build.results.paths[0])",
+						},
+						{
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "$(tasks.build.results.paths[1])"},
 							},
 						},
 					},
@@ -5604,9 +8760,12 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							CSI: &corev1.CSIVolumeSource{
-								Driver:               "driver",
-								NodePublishSecretRef: &corev1.LocalObjectReference{Name: "ref-value"},
+							EmptyDir: &corev1.EmptyDirVolumeSource{},
+							SubPath:  "first/path",
+						},
+						{
+							ConfigMap: &corev1.ConfigMapVolumeSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "second/path"},
 							},
 						},
 					},
@@ -5614,15 +8773,13 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 			},
 		},
 		{
-			name: "pvc object-result",
+			name: "secret.secretName and projected array-result index",
 			trResults: map[string][]v1.TaskRunResult{
 				"task1": {
 					{
-						Name: "pvc-object",
-						Type: v1.ResultsTypeObject,
-						Value: v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{
-							"name": "pvc-object-value",
-						}},
+						Name:  "names",
+						Type:  v1.ResultsTypeArray,
+						Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{"secret-value", "projected-value"}},
 					},
 				},
 			},
@@ -5630,8 +8787,19 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-								ClaimName: "$(tasks.task1.results.pvc-object.name)",
+							Secret: &corev1.SecretVolumeSource{
+								SecretName: "$(tasks.task1.results.names[0])",
+							},
+						},
+						{
+							Projected: &corev1.ProjectedVolumeSource{
+								Sources: []corev1.VolumeProjection{
+									{
+										Secret: &corev1.SecretProjection{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "$(tasks.task1.results.names[1])"},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -5641,29 +8809,54 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-								ClaimName: "pvc-object-value",
+							Secret: &corev1.SecretVolumeSource{
+								SecretName: "secret-value",
+							},
+						},
+						{
+							Projected: &corev1.ProjectedVolumeSource{
+								Sources: []corev1.VolumeProjection{
+									{
+										Secret: &corev1.SecretProjection{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "projected-value"},
+										},
+									},
+								},
 							},
 						},
 					},
 				},
 			},
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := resources.ApplyResultsToWorkspaceBindings(tc.trResults, tc.pr); err != nil {
+				t.Fatalf("TestApplyResultsToWorkspaceBindings() %s, unexpected error: %v", tc.name, err)
+			}
+			if d := cmp.Diff(tc.pr, tc.expectedPr); d != "" {
+				t.Fatalf("TestApplyResultsToWorkspaceBindings() %s, %v", tc.name, diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyResultsToWorkspaceBindings_Error(t *testing.T) {
+	testCases := []struct {
+		name      string
+		trResults map[string][]v1.TaskRunResult
+		pr        *v1.PipelineRun
+		wantErr   string
+	}{
 		{
-			name: "pvc object-result - along with array-result (no effect)",
+			name: "subPath array-result index out of bound",
 			trResults: map[string][]v1.TaskRunResult{
-				"task1": {
-					{
-						Name: "pvc-object",
-						Type: v1.ResultsTypeObject,
-						Value: v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{
-							"name": "pvc-object-value",
-						}},
-					},
+				"build": {
 					{
-						Name:  "pvc-array",
+						Name:  "paths",
 						Type:  v1.ResultsTypeArray,
-						Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{"name-1", "name-2"}},
+						Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{"first/path"}},
 					},
 				},
 			},
@@ -5671,33 +8864,504 @@ func TestApplyResultsToWorkspaceBindings(t *testing.T) {
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-								ClaimName: "$(tasks.task1.results.pvc-object.name)",
-							},
+							EmptyDir: &corev1.EmptyDirVolumeSource{},
+							SubPath:  "$(tasks.build.results.paths[1])",
 						},
 					},
 				},
 			},
-			expectedPr: &v1.PipelineRun{
+			wantErr: "array index 1 out of bound in workspace binding reference $(tasks.build.results.paths[1]), the result has length 1",
+		},
+		{
+			name: "empty array-result in projected configmap",
+			trResults: map[string][]v1.TaskRunResult{
+				"build": {
+					{
+						Name:  "names",
+						Type:  v1.ResultsTypeArray,
+						Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{}},
+					},
+				},
+			},
+			pr: &v1.PipelineRun{
 				Spec: v1.PipelineRunSpec{
 					Workspaces: []v1.WorkspaceBinding{
 						{
-							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-								ClaimName: "pvc-object-value",
+							Projected: &corev1.ProjectedVolumeSource{
+								Sources: []corev1.VolumeProjection{
+									{
+										ConfigMap: &corev1.ConfigMapProjection{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "$(tasks.build.results.names[0])"},
+										},
+									},
+								},
 							},
 						},
 					},
 				},
 			},
+			wantErr: "array index 0 out of bound in workspace binding reference $(tasks.build.results.names[0]), the result has length 0",
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			resources.ApplyResultsToWorkspaceBindings(tc.trResults, tc.pr)
-			if d := cmp.Diff(tc.pr, tc.expectedPr); d != "" {
-				t.Fatalf("TestApplyResultsToWorkspaceBindings() %s, %v", tc.name, diff.PrintWantGot(d))
+			err := resources.ApplyResultsToWorkspaceBindings(tc.trResults, tc.pr)
+			if err == nil {
+				t.Fatalf("expected error %q but got none", tc.wantErr)
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Errorf("unexpected error %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestPendingWorkspaceBindings(t *testing.T) {
+	wbs := []v1.WorkspaceBinding{
+		{Name: "source", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		{Name: "cache", EmptyDir: &corev1.EmptyDirVolumeSource{}, SubPath: "$(tasks.build.results.path)"},
+		{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "$(params.config)"}}},
+	}
+	got, err := resources.PendingWorkspaceBindings(wbs)
+	if err != nil {
+		t.Fatalf("PendingWorkspaceBindings() unexpected error: %v", err)
+	}
+	if d := cmp.Diff([]string{"cache"}, got.List()); d != "" {
+		t.Errorf("PendingWorkspaceBindings() %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestResolvePendingWorkspaceBindings(t *testing.T) {
+	taskRun := func(name string, status corev1.ConditionStatus, results ...v1.TaskRunResult) *v1.TaskRun {
+		return &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1.TaskRunStatus{
+				Status:              duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status}}},
+				TaskRunStatusFields: v1.TaskRunStatusFields{Results: results},
+			},
+		}
+	}
+	tasks := []v1.PipelineTask{
+		{Name: "build", TaskRef: &v1.TaskRef{Name: "build"}},
+		{Name: "scan", TaskRef: &v1.TaskRef{Name: "scan"}},
+		{Name: "deploy", TaskRef: &v1.TaskRef{Name: "deploy"}, Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "output", Workspace: "cache"}}},
+		{Name: "report", TaskRef: &v1.TaskRef{Name: "report"}, Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "reports"}}},
+		{Name: "lint", TaskRef: &v1.TaskRef{Name: "lint"}, Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "source"}}},
+	}
+	state := resources.PipelineRunState{{
+		PipelineTask: &tasks[0],
+		TaskRunNames: []string{"build"},
+		TaskRuns: []*v1.TaskRun{taskRun("build", corev1.ConditionTrue, v1.TaskRunResult{
+			Name: "path", Type: v1.ResultsTypeString, Value: *v1.NewStructuredValues("cache/build"),
+		})},
+	}, {
+		PipelineTask: &tasks[1],
+		TaskRunNames: []string{"scan"},
+		TaskRuns:     []*v1.TaskRun{taskRun("scan", corev1.ConditionUnknown)},
+	}, {
+		PipelineTask: &tasks[2],
+	}, {
+		PipelineTask: &tasks[3],
+	}, {
+		PipelineTask: &tasks[4],
+	}}
+	d, err := dag.Build(v1.PipelineTaskList(tasks), v1.PipelineTaskList(tasks).Deps())
+	if err != nil {
+		t.Fatalf("unexpected error building the DAG: %v", err)
+	}
+	facts := &resources.PipelineRunFacts{State: state, TasksGraph: d, FinalTasksGraph: &dag.Graph{}}
+	newPipelineRun := func() *v1.PipelineRun {
+		return &v1.PipelineRun{Spec: v1.PipelineRunSpec{Workspaces: []v1.WorkspaceBinding{
+			{Name: "source", EmptyDir: &corev1.EmptyDirVolumeSource{}, SubPath: "$(tasks.scan.results.path)"},
+			{Name: "cache", EmptyDir: &corev1.EmptyDirVolumeSource{}, SubPath: "$(tasks.build.results.path)"},
+			{Name: "reports", EmptyDir: &corev1.EmptyDirVolumeSource{}, SubPath: "$(tasks.scan.results.path)"},
+		}}}
+	}
+	for _, tc := range []struct {
+		name         string
+		pipelineTask *v1.PipelineTask
+		wantSubPaths []string
+		wantErr      bool
+	}{{
+		name:         "results of a completed task",
+		pipelineTask: &tasks[2],
+		wantSubPaths: []string{"$(tasks.scan.results.path)", "cache/build", "$(tasks.scan.results.path)"},
+	}, {
+		name:         "results of a running task",
+		pipelineTask: &tasks[3],
+		wantSubPaths: []string{"$(tasks.scan.results.path)", "$(tasks.build.results.path)", "$(tasks.scan.results.path)"},
+		wantErr:      true,
+	}, {
+		name:         "binding which is not pending",
+		pipelineTask: &tasks[4],
+		wantSubPaths: []string{"$(tasks.scan.results.path)", "$(tasks.build.results.path)", "$(tasks.scan.results.path)"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := newPipelineRun()
+			// the source binding is not pending, e.g. as if it was resolved before
+			pending := sets.NewString("cache", "reports")
+			err := resources.ResolvePendingWorkspaceBindings(context.Background(), tc.pipelineTask, pending, facts, pr)
+			if tc.wantErr != errors.Is(err, resources.ErrUpstreamResultNotAvailable) {
+				t.Errorf("ResolvePendingWorkspaceBindings() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("ResolvePendingWorkspaceBindings() unexpected error: %v", err)
+			}
+			var subPaths []string
+			for _, wb := range pr.Spec.Workspaces {
+				subPaths = append(subPaths, wb.SubPath)
+			}
+			if d := cmp.Diff(tc.wantSubPaths, subPaths); d != "" {
+				t.Errorf("ResolvePendingWorkspaceBindings() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestFindUnresolvedVariables(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		spec *v1.PipelineSpec
+		want []resources.UnresolvedVar
+	}{{
+		name: "all variables resolved",
+		spec: &v1.PipelineSpec{
+			Tasks: []v1.PipelineTask{{
+				Name:   "deploy",
+				Params: v1.Params{{Name: "digest", Value: *v1.NewStructuredValues("sha256:abc")}},
+			}},
+		},
+	}, {
+		name: "unresolved variables in params, matrix and when expressions",
+		spec: &v1.PipelineSpec{
+			Tasks: []v1.PipelineTask{{
+				Name: "deploy",
+				Params: v1.Params{
+					{Name: "digest", Value: *v1.NewStructuredValues("$(tasks.build.results.digest)")},
+					{Name: "run", Value: *v1.NewStructuredValues("$(context.taskRun.name)")},
+				},
+				Matrix: &v1.Matrix{
+					Params: v1.Params{{Name: "platform", Value: *v1.NewStructuredValues("$(tasks.build.results.platforms[*])")}},
+					Include: v1.IncludeParamsList{{
+						Name:   "extra",
+						Params: v1.Params{{Name: "flags", Value: *v1.NewStructuredValues("$(params.flags)")}},
+					}},
+				},
+				When: v1.WhenExpressions{{
+					Input:    "$(tasks.test.results.status)",
+					Operator: selection.In,
+					Values:   []string{"passed"},
+				}},
+			}},
+		},
+		want: []resources.UnresolvedVar{
+			{PipelineTask: "deploy", Field: "params.digest", Variable: "tasks.build.results.digest"},
+			{PipelineTask: "deploy", Field: "matrix.params.platform", Variable: "tasks.build.results.platforms[*]"},
+			{PipelineTask: "deploy", Field: "matrix.include[0].params.flags", Variable: "params.flags"},
+			{PipelineTask: "deploy", Field: "when[0]", Variable: "tasks.test.results.status"},
+		},
+	}, {
+		name: "unresolved task results in the embedded task spec of a finally task",
+		spec: &v1.PipelineSpec{
+			Finally: []v1.PipelineTask{{
+				Name: "notify",
+				TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+					Params: v1.ParamSpecs{{Name: "channel", Type: v1.ParamTypeString}},
+					Steps: []v1.Step{{
+						Name:   "notify",
+						Image:  "alpine",
+						Script: "notify $(params.channel) $(tasks.build.results.digest)",
+					}},
+					Sidecars: []v1.Sidecar{{
+						Name:  "proxy",
+						Image: "$(finally.setup.results.image)",
+					}},
+				}},
+			}},
+		},
+		want: []resources.UnresolvedVar{
+			{PipelineTask: "notify", Field: "taskSpec.steps[0]", Variable: "tasks.build.results.digest"},
+			{PipelineTask: "notify", Field: "taskSpec.sidecars[0]", Variable: "finally.setup.results.image"},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := resources.FindUnresolvedVariables(tc.spec)
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("FindUnresolvedVariables() %s", diff.PrintWantGot(d))
 			}
 		})
 	}
 }
+
+func TestParamReplacements_Merge(t *testing.T) {
+	defaults := resources.ParamReplacements{
+		Strings: map[string]string{"params.first": "default-first", "params.second": "default-second"},
+		Arrays:  map[string][]string{"params.list": {"a", "b"}},
+		Objects: map[string]map[string]string{"params.obj": {"key": "default"}},
+	}
+	provided := resources.ParamReplacements{
+		Strings: map[string]string{"params.second": "provided-second", "params.third": "provided-third"},
+		Objects: map[string]map[string]string{"params.obj": {"key": "provided"}},
+	}
+	want := resources.ParamReplacements{
+		Strings: map[string]string{"params.first": "default-first", "params.second": "provided-second", "params.third": "provided-third"},
+		Arrays:  map[string][]string{"params.list": {"a", "b"}},
+		Objects: map[string]map[string]string{"params.obj": {"key": "provided"}},
+	}
+
+	got := defaults.Merge(provided)
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("Merge() %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff("default-second", defaults.Strings["params.second"]); d != "" {
+		t.Errorf("Merge() modified the receiver %s", diff.PrintWantGot(d))
+	}
+}
+
+// endedSpans is a SpanProcessor recording the spans which ended.
+type endedSpans struct {
+	mu    sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (e *endedSpans) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+func (e *endedSpans) OnEnd(s sdktrace.ReadOnlySpan) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, s)
+}
+
+func (e *endedSpans) Shutdown(context.Context) error { return nil }
+
+func (e *endedSpans) ForceFlush(context.Context) error { return nil }
+
+func TestApplySpans(t *testing.T) {
+	spans := &endedSpans{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "reconcile")
+
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr", Labels: map[string]string{"tekton.dev/pipeline": "build"}},
+		Spec:       v1.PipelineRunSpec{Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app")}}},
+	}
+	ps := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{{Name: "image", Type: v1.ParamTypeString}},
+		Tasks: []v1.PipelineTask{{
+			Name:   "build",
+			Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("$(params.image)")}},
+		}},
+	}
+	if _, _, err := resources.ApplyParameters(ctx, ps, pr); err != nil {
+		t.Fatalf("ApplyParameters() unexpected error: %v", err)
+	}
+	if _, err := resources.ApplyTaskResults(ctx, resources.PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:   "test",
+			Params: v1.Params{{Name: "digest", Value: *v1.NewStructuredValues("$(tasks.build.results.digest)")}},
+		},
+	}}, resources.ResolvedResultRefs{{
+		Value:           *v1.NewStructuredValues("sha256:abc"),
+		ResultReference: v1.ResultRef{PipelineTask: "build", Result: "digest"},
+	}}); err != nil {
+		t.Fatalf("ApplyTaskResults() unexpected error: %v", err)
+	}
+	parent.End()
+
+	type span struct {
+		Name       string
+		Parent     bool
+		Attributes map[string]attribute.Value
+	}
+	var got []span
+	for _, s := range spans.spans {
+		attributes := map[string]attribute.Value{}
+		for _, kv := range s.Attributes() {
+			attributes[string(kv.Key)] = kv.Value
+		}
+		got = append(got, span{
+			Name:       s.Name(),
+			Parent:     s.Parent().SpanID() == parent.SpanContext().SpanID(),
+			Attributes: attributes,
+		})
+	}
+	want := []span{{
+		Name:   "ApplyParameters",
+		Parent: true,
+		Attributes: map[string]attribute.Value{
+			"pipeline":     attribute.StringValue("build"),
+			"tasks":        attribute.IntValue(1),
+			"replacements": attribute.IntValue(1),
+		},
+	}, {
+		Name:   "ApplyTaskResults",
+		Parent: true,
+		Attributes: map[string]attribute.Value{
+			"tasks":        attribute.IntValue(1),
+			"replacements": attribute.IntValue(1),
+		},
+	}, {
+		Name:       "reconcile",
+		Attributes: map[string]attribute.Value{},
+	}}
+	if d := cmp.Diff(want, got, cmp.Comparer(func(x, y attribute.Value) bool { return x.Emit() == y.Emit() })); d != "" {
+		t.Errorf("Unexpected spans %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestSkipSpans(t *testing.T) {
+	spans := &endedSpans{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "reconcile")
+
+	tasks := []v1.PipelineTask{{
+		Name:    "build",
+		TaskRef: &v1.TaskRef{Name: "build"},
+	}, {
+		Name:    "test",
+		TaskRef: &v1.TaskRef{Name: "test"},
+		Params:  v1.Params{{Name: "digest", Value: *v1.NewStructuredValues("$(tasks.build.results.digest)")}},
+	}}
+	state := resources.PipelineRunState{{
+		PipelineTask: &tasks[0],
+		TaskRunNames: []string{"pr-build"},
+		TaskRuns: []*v1.TaskRun{{
+			ObjectMeta: metav1.ObjectMeta{Name: "pr-build"},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}}},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{{Name: "digest", Value: *v1.NewStructuredValues("sha256:abc")}},
+				},
+			},
+		}},
+	}, {
+		PipelineTask: &tasks[1],
+		TaskRunNames: []string{"pr-test"},
+	}}
+	d, err := dag.Build(v1.PipelineTaskList(tasks), v1.PipelineTaskList(tasks).Deps())
+	if err != nil {
+		t.Fatalf("dag.Build() unexpected error: %v", err)
+	}
+	facts := &resources.PipelineRunFacts{State: state, TasksGraph: d, FinalTasksGraph: &dag.Graph{}}
+	if got := state[1].Skip(ctx, facts); got.IsSkipped {
+		t.Fatalf("expected the task not to be skipped, got %v", got)
+	}
+	parent.End()
+
+	// the result references of the task are substituted within the span of the reconcile
+	var applied int
+	for _, s := range spans.spans {
+		if s.Name() != "ApplyTaskResults" {
+			continue
+		}
+		applied++
+		if s.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("expected the ApplyTaskResults span to be a child of the reconcile span, got the parent %v", s.Parent().SpanID())
+		}
+	}
+	if applied != 1 {
+		t.Errorf("expected one ApplyTaskResults span, got %d", applied)
+	}
+}
+
+func TestApplyParameters_ReplacementsSpanAttribute(t *testing.T) {
+	if err := resources.RegisterParamPattern("env.%s"); err != nil {
+		t.Fatalf("RegisterParamPattern() unexpected error: %v", err)
+	}
+	spans := &endedSpans{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "reconcile")
+
+	pr := &v1.PipelineRun{
+		Spec: v1.PipelineRunSpec{Params: v1.Params{
+			{Name: "image", Value: *v1.NewStructuredValues("app")},
+			{Name: "args", Value: *v1.NewStructuredValues("a", "b")},
+		}},
+	}
+	ps := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{
+			{Name: "image", Type: v1.ParamTypeString},
+			{Name: "args", Type: v1.ParamTypeArray},
+		},
+		Tasks: []v1.PipelineTask{{
+			Name: "build",
+			Params: v1.Params{
+				{Name: "image", Value: *v1.NewStructuredValues("$(params.image)")},
+				{Name: "quoted", Value: *v1.NewStructuredValues(`$(params["image"]) $(params['image'])`)},
+				{Name: "registered", Value: *v1.NewStructuredValues("$(env.image)")},
+				{Name: "args", Value: *v1.NewStructuredValues("$(params.args[*])")},
+				{Name: "unknown", Value: *v1.NewStructuredValues("$(params.unknown) $(tasks.build.results.digest)")},
+			},
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{Name: "build", Image: "$(params.image)", Args: []string{"$(params.args[0])"}}},
+			}},
+		}},
+		Finally: []v1.PipelineTask{{
+			Name: "notify",
+			When: v1.WhenExpressions{{Input: "$(params.image)", Operator: selection.In, Values: []string{"app"}}},
+			// the params of the embedded PipelineSpec are substituted in the child PipelineRun
+			PipelineSpec: &v1.PipelineSpec{Tasks: []v1.PipelineTask{{
+				Name:   "inner",
+				Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("$(params.image)")}},
+			}}},
+		}},
+	}
+	if _, _, err := resources.ApplyParameters(ctx, ps, pr); err != nil {
+		t.Fatalf("ApplyParameters() unexpected error: %v", err)
+	}
+	parent.End()
+
+	for _, s := range spans.spans {
+		if s.Name() != "ApplyParameters" {
+			continue
+		}
+		for _, kv := range s.Attributes() {
+			if kv.Key == "replacements" && kv.Value.AsInt64() != 8 {
+				t.Errorf("expected 8 replacements, got %d", kv.Value.AsInt64())
+			}
+		}
+		return
+	}
+	t.Error("expected an ApplyParameters span")
+}
+
+func TestParamConsumers(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Tasks: []v1.PipelineTask{{
+			Name: "build",
+			Params: v1.Params{
+				{Name: "image", Value: *v1.NewStructuredValues(`$(params["image"])`)},
+				{Name: "first", Value: *v1.NewStructuredValues("$(params.args[0])")},
+				{Name: "imagename", Value: *v1.NewStructuredValues("$(params.imagename)")},
+			},
+		}, {
+			Name: "deploy",
+			Matrix: &v1.Matrix{Params: v1.Params{
+				{Name: "config", Value: *v1.NewStructuredValues("$(params.config.env)")},
+			}},
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{Name: "deploy", Image: "$(params['image'])", Args: []string{"$(params.args[*])"}}},
+			}},
+		}, {
+			Name: "unrelated",
+		}},
+		Finally: []v1.PipelineTask{{
+			Name: "notify",
+			PipelineSpec: &v1.PipelineSpec{Tasks: []v1.PipelineTask{{
+				Name:   "inner",
+				Params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("$(params.image)")}},
+			}}},
+		}},
+	}
+	want := map[string][]string{
+		"args":      {"build", "deploy"},
+		"config":    {"deploy"},
+		"image":     {"build", "deploy", "notify"},
+		"imagename": {"build"},
+	}
+	got := resources.ParamConsumers(ps, []string{"args", "config", "image", "imagename", "unused"})
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("ParamConsumers() %s", diff.PrintWantGot(d))
+	}
+}
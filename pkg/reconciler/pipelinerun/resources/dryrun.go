@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+)
+
+// DryRunPipelineRun returns the PipelineSpec of the PipelineRun with the variables substituted as they would be
+// when the PipelineRun is reconciled: params, context variables, workspaces, the results of the tasks given in
+// trResults, keyed by pipeline task name, and the $(context.pipelineTask.*) variables. References to results
+// which are not in trResults are left as they are. No TaskRuns are created, no events are emitted and neither
+// the PipelineRun nor the PipelineSpec are modified.
+func DryRunPipelineRun(ctx context.Context, pr *v1.PipelineRun, ps *v1.PipelineSpec, trResults map[string][]v1.TaskRunResult) (*v1.PipelineSpec, error) {
+	// dry-run: don't emit the warnings for undeclared params
+	ctx = controller.WithEventRecorder(ctx, nil)
+
+	spec, sc, err := ApplyParameters(ctx, ps, pr)
+	if err != nil {
+		return nil, err
+	}
+	spec, sc = ApplyContexts(ctx, spec, &v1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: PipelineNameFromPipelineRun(pr)}}, pr, sc)
+	spec, _ = ApplyWorkspaces(spec, pr, sc)
+
+	facts := &PipelineRunFacts{}
+	for _, tasks := range [][]v1.PipelineTask{spec.Tasks, spec.Finally} {
+		for i := range tasks {
+			rpt := &ResolvedPipelineTask{PipelineTask: &tasks[i]}
+			if _, err := ApplyTaskResults(ctx, PipelineRunState{rpt}, dryRunResultRefs(rpt.PipelineTask, trResults)); err != nil {
+				return nil, err
+			}
+			tasks[i] = *ApplyPipelineTaskContexts(ctx, rpt.PipelineTask, pr, facts)
+		}
+	}
+	return spec, nil
+}
+
+// dryRunResultRefs resolves the task result references of the PipelineTask from the given results.
+func dryRunResultRefs(pt *v1.PipelineTask, trResults map[string][]v1.TaskRunResult) ResolvedResultRefs {
+	var resolved ResolvedResultRefs
+	for _, ref := range v1.PipelineTaskResultRefs(pt) {
+		for _, result := range trResults[ref.PipelineTask] {
+			if result.Name == ref.Result {
+				resolved = append(resolved, &ResolvedResultRef{
+					Value:           result.Value,
+					ResultReference: *ref,
+				})
+				break
+			}
+		}
+	}
+	return resolved
+}
+
+// PipelineNameFromPipelineRun returns the name of the Pipeline as exposed by $(context.pipeline.name): the name of
+// the referenced Pipeline, or the name of the PipelineRun for an embedded PipelineSpec.
+func PipelineNameFromPipelineRun(pr *v1.PipelineRun) string {
+	if pr.Spec.PipelineRef != nil && pr.Spec.PipelineRef.Name != "" {
+		return pr.Spec.PipelineRef.Name
+	}
+	return pr.Name
+}
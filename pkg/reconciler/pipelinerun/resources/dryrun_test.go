@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+)
+
+func TestDryRunPipelineRun(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params: v1.ParamSpecs{{
+			Name:    "image",
+			Type:    v1.ParamTypeString,
+			Default: v1.NewStructuredValues("registry/image"),
+		}},
+		Workspaces: []v1.PipelineWorkspaceDeclaration{{Name: "source"}, {Name: "cache"}},
+		Tasks: []v1.PipelineTask{{
+			Name:    "build",
+			Retries: 2,
+			Params: v1.Params{
+				{Name: "image", Value: *v1.NewStructuredValues("$(params.image)")},
+				{Name: "run", Value: *v1.NewStructuredValues("$(context.pipelineRun.name)")},
+				{Name: "retries", Value: *v1.NewStructuredValues("$(context.pipelineTask.retries)")},
+				{Name: "cached", Value: *v1.NewStructuredValues("$(workspaces.cache.bound)")},
+			},
+		}, {
+			Name: "deploy",
+			Params: v1.Params{
+				{Name: "digest", Value: *v1.NewStructuredValues("$(tasks.build.results.digest)")},
+				{Name: "url", Value: *v1.NewStructuredValues("$(tasks.test.results.url)")},
+			},
+		}},
+		Finally: []v1.PipelineTask{{
+			Name: "notify",
+			Params: v1.Params{
+				{Name: "pipeline", Value: *v1.NewStructuredValues("$(context.pipeline.name)")},
+			},
+		}},
+	}
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "ns"},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "release"},
+			Params: v1.Params{
+				{Name: "image", Value: *v1.NewStructuredValues("registry/other")},
+				{Name: "undeclared", Value: *v1.NewStructuredValues("value")},
+			},
+			Workspaces: []v1.WorkspaceBinding{{Name: "source"}},
+		},
+	}
+	trResults := map[string][]v1.TaskRunResult{
+		"build": {{Name: "digest", Value: *v1.NewStructuredValues("sha256:abc")}},
+	}
+	original := ps.DeepCopy()
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+	got, err := resources.DryRunPipelineRun(ctx, pr, ps, trResults)
+	if err != nil {
+		t.Fatalf("DryRunPipelineRun() unexpected error: %v", err)
+	}
+
+	want := original.DeepCopy()
+	want.Tasks[0].Params = v1.Params{
+		{Name: "image", Value: *v1.NewStructuredValues("registry/other")},
+		{Name: "run", Value: *v1.NewStructuredValues("pr")},
+		{Name: "retries", Value: *v1.NewStructuredValues("2")},
+		{Name: "cached", Value: *v1.NewStructuredValues("false")},
+	}
+	want.Tasks[1].Params = v1.Params{
+		{Name: "digest", Value: *v1.NewStructuredValues("sha256:abc")},
+		{Name: "url", Value: *v1.NewStructuredValues("$(tasks.test.results.url)")},
+	}
+	want.Finally[0].Params = v1.Params{
+		{Name: "pipeline", Value: *v1.NewStructuredValues("release")},
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("DryRunPipelineRun() %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(original, ps); d != "" {
+		t.Errorf("DryRunPipelineRun() modified the PipelineSpec %s", diff.PrintWantGot(d))
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("DryRunPipelineRun() emitted %d events, expected none", len(recorder.Events))
+	}
+}
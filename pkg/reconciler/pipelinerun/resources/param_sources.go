@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pipelineErrors "github.com/tektoncd/pipeline/pkg/apis/pipeline/errors"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// ErrInvalidParamSource indicates that a ParamSource selects a Secret, a ConfigMap or a key which doesn't exist.
+var ErrInvalidParamSource = pipelineErrors.WrapUserError(errors.New("invalid param source"))
+
+// ResolveParamSources fetches the values of the ParamSources of the PipelineRun from the Secrets and ConfigMaps of
+// its namespace and returns them as string Params. The Secrets are read from the lister, which the controller
+// already caches, while the ConfigMaps are fetched with the kubeclient so that the controller doesn't watch all
+// the ConfigMaps of the cluster. The sources marked as optional whose Secret, ConfigMap or key doesn't exist are
+// skipped, so that the default value of the param applies. The returned errors never contain the values.
+func ResolveParamSources(ctx context.Context, kubeclient kubernetes.Interface, secretLister corev1listers.SecretLister, pr *v1.PipelineRun) (v1.Params, error) {
+	var params v1.Params
+	for _, ps := range pr.Spec.ParamSources {
+		value, found, err := resolveParamSource(ctx, kubeclient, secretLister, pr.Namespace, ps.ValueFrom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve param %q: %w", ps.Name, err)
+		}
+		if found {
+			params = append(params, v1.Param{Name: ps.Name, Value: *v1.NewStructuredValues(value)})
+		}
+	}
+	return params, nil
+}
+
+// resolveParamSource returns the value selected by the ParamValueSource, and whether it was found.
+func resolveParamSource(ctx context.Context, kubeclient kubernetes.Interface, secretLister corev1listers.SecretLister, namespace string, source v1.ParamValueSource) (string, bool, error) {
+	switch {
+	case source.SecretKeyRef != nil:
+		ref := source.SecretKeyRef
+		optional := ref.Optional != nil && *ref.Optional
+		secret, err := secretLister.Secrets(namespace).Get(ref.Name)
+		switch {
+		case k8serrors.IsNotFound(err) && optional:
+			return "", false, nil
+		case k8serrors.IsNotFound(err):
+			return "", false, fmt.Errorf("%w: secret %q not found in namespace %q", ErrInvalidParamSource, ref.Name, namespace)
+		case err != nil:
+			return "", false, err
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok && !optional {
+			return "", false, fmt.Errorf("%w: key %q not found in secret %q", ErrInvalidParamSource, ref.Key, ref.Name)
+		}
+		return string(value), ok, nil
+	case source.ConfigMapKeyRef != nil:
+		ref := source.ConfigMapKeyRef
+		optional := ref.Optional != nil && *ref.Optional
+		cm, err := kubeclient.CoreV1().ConfigMaps(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		switch {
+		case k8serrors.IsNotFound(err) && optional:
+			return "", false, nil
+		case k8serrors.IsNotFound(err):
+			return "", false, fmt.Errorf("%w: configmap %q not found in namespace %q", ErrInvalidParamSource, ref.Name, namespace)
+		case err != nil:
+			return "", false, err
+		}
+		value, ok := cm.Data[ref.Key]
+		if !ok && !optional {
+			return "", false, fmt.Errorf("%w: key %q not found in configmap %q", ErrInvalidParamSource, ref.Key, ref.Name)
+		}
+		return value, ok, nil
+	default:
+		return "", false, fmt.Errorf("%w: neither secretKeyRef nor configMapKeyRef is set", ErrInvalidParamSource)
+	}
+}
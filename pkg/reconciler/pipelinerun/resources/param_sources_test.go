@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func paramSourceClients(t *testing.T) (kubernetes.Interface, corev1listers.SecretLister) {
+	t.Helper()
+	secrets := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := secrets.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "foo"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	kubeclient := fakek8s.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "foo"},
+		Data:       map[string]string{"region": "eu-west-1"},
+	})
+	return kubeclient, corev1listers.NewSecretLister(secrets)
+}
+
+func secretParamSource(name, secret, key string, optional bool) v1.ParamSource {
+	return v1.ParamSource{
+		Name: name,
+		ValueFrom: v1.ParamValueSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				Key:                  key,
+				Optional:             &optional,
+			},
+		},
+	}
+}
+
+func configMapParamSource(name, configMap, key string, optional bool) v1.ParamSource {
+	return v1.ParamSource{
+		Name: name,
+		ValueFrom: v1.ParamValueSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMap},
+				Key:                  key,
+				Optional:             &optional,
+			},
+		},
+	}
+}
+
+func TestResolveParamSources(t *testing.T) {
+	kubeclient, secretLister := paramSourceClients(t)
+
+	for _, tc := range []struct {
+		name    string
+		sources []v1.ParamSource
+		want    v1.Params
+	}{{
+		name: "no param sources",
+	}, {
+		name: "secret and configmap",
+		sources: []v1.ParamSource{
+			secretParamSource("token", "my-secret", "token", false),
+			configMapParamSource("region", "my-config", "region", false),
+		},
+		want: v1.Params{{
+			Name:  "token",
+			Value: *v1.NewStructuredValues("s3cr3t"),
+		}, {
+			Name:  "region",
+			Value: *v1.NewStructuredValues("eu-west-1"),
+		}},
+	}, {
+		name: "optional sources not found are skipped",
+		sources: []v1.ParamSource{
+			secretParamSource("missing-secret", "other-secret", "token", true),
+			secretParamSource("missing-secret-key", "my-secret", "other", true),
+			configMapParamSource("missing-configmap", "other-config", "region", true),
+			configMapParamSource("missing-configmap-key", "my-config", "other", true),
+			configMapParamSource("region", "my-config", "region", true),
+		},
+		want: v1.Params{{
+			Name:  "region",
+			Value: *v1.NewStructuredValues("eu-west-1"),
+		}},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"},
+				Spec:       v1.PipelineRunSpec{ParamSources: tc.sources},
+			}
+			got, err := resources.ResolveParamSources(context.Background(), kubeclient, secretLister, pr)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestResolveParamSources_Error(t *testing.T) {
+	kubeclient, secretLister := paramSourceClients(t)
+
+	for _, tc := range []struct {
+		name    string
+		sources []v1.ParamSource
+		wantErr string
+	}{{
+		name: "secret not found",
+		sources: []v1.ParamSource{
+			secretParamSource("token", "other-secret", "token", false),
+		},
+		wantErr: `failed to resolve param "token": invalid param source: secret "other-secret" not found in namespace "foo"`,
+	}, {
+		name: "key not found in secret",
+		sources: []v1.ParamSource{
+			secretParamSource("token", "my-secret", "other", false),
+		},
+		wantErr: `failed to resolve param "token": invalid param source: key "other" not found in secret "my-secret"`,
+	}, {
+		name: "configmap not found",
+		sources: []v1.ParamSource{
+			configMapParamSource("region", "other-config", "region", false),
+		},
+		wantErr: `failed to resolve param "region": invalid param source: configmap "other-config" not found in namespace "foo"`,
+	}, {
+		name: "key not found in configmap",
+		sources: []v1.ParamSource{
+			configMapParamSource("region", "my-config", "other", false),
+		},
+		wantErr: `failed to resolve param "region": invalid param source: key "other" not found in configmap "my-config"`,
+	}, {
+		name: "stops at the first invalid source",
+		sources: []v1.ParamSource{
+			secretParamSource("region", "my-config", "region", false),
+			secretParamSource("token", "my-secret", "token", false),
+		},
+		wantErr: `failed to resolve param "region": invalid param source: secret "my-config" not found in namespace "foo"`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"},
+				Spec:       v1.PipelineRunSpec{ParamSources: tc.sources},
+			}
+			_, err := resources.ResolveParamSources(context.Background(), kubeclient, secretLister, pr)
+			if err == nil {
+				t.Fatal("Expected an error but got none")
+			}
+			if !errors.Is(err, resources.ErrInvalidParamSource) {
+				t.Errorf("Expected an ErrInvalidParamSource but got %v", err)
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+			if strings.Contains(err.Error(), "s3cr3t") {
+				t.Errorf("The error must not contain the value of the secret: %v", err)
+			}
+		})
+	}
+}
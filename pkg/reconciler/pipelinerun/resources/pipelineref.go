@@ -66,8 +66,12 @@ func GetPipelineFunc(ctx context.Context, k8s kubernetes.Interface, tekton clien
 	switch {
 	case pr != nil && pr.Resolver != "" && requester != nil:
 		return func(ctx context.Context, name string) (*v1.Pipeline, *v1.RefSource, *trustedresources.VerificationResult, error) {
-			stringReplacements, arrayReplacements, objectReplacements := paramsFromPipelineRun(ctx, pipelineRun)
-			for k, v := range GetContextReplacements("", pipelineRun) {
+			// the PipelineSpec is not resolved yet, so no param declarations are known
+			stringReplacements, arrayReplacements, objectReplacements, err := paramsFromPipelineRun(nil, pipelineRun)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			for k, v := range GetContextReplacements(ctx, nil, pipelineRun) {
 				stringReplacements[k] = v
 			}
 			replacedParams := pr.Params.ReplaceVariables(stringReplacements, arrayReplacements, objectReplacements)
@@ -20,8 +20,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
+	"maps"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/google/cel-go/cel"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
@@ -35,6 +37,7 @@ import (
 	"github.com/tektoncd/pipeline/pkg/resolution/resource"
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
 )
@@ -61,7 +64,7 @@ func (e *TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Couldn't retrieve Task %q: %s", e.Name, e.Msg)
 }
 
-// ResolvedPipelineTask contains a PipelineTask and its associated TaskRun(s) or CustomRuns, if they exist.
+// ResolvedPipelineTask contains a PipelineTask and its associated TaskRun(s), CustomRuns or child PipelineRuns, if they exist.
 type ResolvedPipelineTask struct {
 	TaskRunNames []string
 	TaskRuns     []*v1.TaskRun
@@ -69,9 +72,13 @@ type ResolvedPipelineTask struct {
 	CustomTask     bool
 	CustomRunNames []string
 	CustomRuns     []*v1beta1.CustomRun
-	PipelineTask   *v1.PipelineTask
-	ResolvedTask   *resources.ResolvedTask
-	ResultsCache   map[string][]string
+	// If the PipelineTask references a Pipeline, ChildPipelineRunNames and ChildPipelineRuns will be set.
+	ChildPipelineRunNames []string
+	ChildPipelineRuns     []*v1.PipelineRun
+	PipelineTask          *v1.PipelineTask
+	ResolvedTask          *resources.ResolvedTask
+	// ResultsCache holds the results fanned out from the TaskRuns of a matrixed PipelineTask.
+	ResultsCache *ResultsCache
 	// EvaluatedCEL is used to store the results of evaluated CEL expression
 	EvaluatedCEL map[string]bool
 }
@@ -91,7 +98,8 @@ func (t *ResolvedPipelineTask) EvaluateCEL() error {
 				// The error is omitted because not environment declarations are passed in.
 				env, _ := cel.NewEnv()
 				// Parse and Check the CEL to get the Abstract Syntax Tree
-				ast, iss := env.Compile(we.CEL)
+				// the task results substituted in the expression are escaped in the PipelineTask
+				ast, iss := env.Compile(substitution.UnescapeSubstitutionResult(we.CEL))
 				if iss.Err() != nil {
 					return iss.Err()
 				}
@@ -119,12 +127,15 @@ func (t *ResolvedPipelineTask) EvaluateCEL() error {
 }
 
 // isDone returns true only if the task is skipped, succeeded or failed
-func (t ResolvedPipelineTask) isDone(facts *PipelineRunFacts) bool {
-	return t.Skip(facts).IsSkipped || t.isSuccessful() || t.isFailure() || t.isValidationFailed(facts.ValidationFailedTask)
+func (t ResolvedPipelineTask) isDone(ctx context.Context, facts *PipelineRunFacts) bool {
+	return t.Skip(ctx, facts).IsSkipped || t.isSuccessful() || t.isFailure() || t.isValidationFailed(facts.ValidationFailedTask)
 }
 
 // IsRunning returns true only if the task is neither succeeded, cancelled nor failed
 func (t ResolvedPipelineTask) IsRunning() bool {
+	if t.IsChildPipeline() {
+		return len(t.ChildPipelineRuns) != 0 && !t.isSuccessful() && !t.isFailure()
+	}
 	if t.IsCustomTask() && len(t.CustomRuns) == 0 {
 		return false
 	}
@@ -139,10 +150,26 @@ func (t ResolvedPipelineTask) IsCustomTask() bool {
 	return t.CustomTask
 }
 
+// IsChildPipeline returns true if the PipelineTask references a Pipeline, which is run in child PipelineRuns.
+func (t ResolvedPipelineTask) IsChildPipeline() bool {
+	return t.PipelineTask != nil && (t.PipelineTask.PipelineRef != nil || t.PipelineTask.PipelineSpec != nil)
+}
+
 // getReason returns the latest reason if the run has completed successfully
 // If the PipelineTask has a Matrix, getReason returns the failure reason for any failure
 // otherwise, it returns an empty string
 func (t ResolvedPipelineTask) getReason() string {
+	if t.IsChildPipeline() {
+		for _, pipelineRun := range t.ChildPipelineRuns {
+			if c := pipelineRun.Status.GetCondition(apis.ConditionSucceeded); c != nil && !c.IsTrue() {
+				return c.Reason
+			}
+		}
+		if len(t.ChildPipelineRuns) >= 1 && len(t.ChildPipelineRuns[0].Status.Conditions) >= 1 {
+			return t.ChildPipelineRuns[0].Status.Conditions[0].Reason
+		}
+		return ""
+	}
 	if t.IsCustomTask() {
 		if len(t.CustomRuns) == 0 {
 			return ""
@@ -170,9 +197,120 @@ func (t ResolvedPipelineTask) getReason() string {
 	return ""
 }
 
+// getMessage returns the message of the first failed run, truncated to maxPipelineTaskMessageLength characters.
+// If the PipelineTask has a Matrix, getMessage returns the failure message for any failure
+// otherwise, it returns an empty string
+func (t ResolvedPipelineTask) getMessage() string {
+	var message string
+	switch {
+	case t.IsChildPipeline():
+		for _, pipelineRun := range t.ChildPipelineRuns {
+			if c := pipelineRun.Status.GetCondition(apis.ConditionSucceeded); c != nil && !c.IsTrue() {
+				message = c.Message
+				break
+			}
+		}
+	case t.IsCustomTask():
+		for _, run := range t.CustomRuns {
+			if !run.IsSuccessful() && len(run.Status.Conditions) >= 1 {
+				message = run.Status.Conditions[0].Message
+				break
+			}
+		}
+	default:
+		for _, taskRun := range t.TaskRuns {
+			if !taskRun.IsSuccessful() && len(taskRun.Status.Conditions) >= 1 {
+				message = taskRun.Status.Conditions[0].Message
+				break
+			}
+		}
+	}
+	if runes := []rune(message); len(runes) > maxPipelineTaskMessageLength {
+		return string(runes[:maxPipelineTaskMessageLength])
+	}
+	return message
+}
+
+// getPodName returns the name of the pod of the first failed TaskRun, or else of the first TaskRun.
+// It returns an empty string if the PipelineTask references a Custom Task or a Pipeline, as their runs have no pod.
+func (t ResolvedPipelineTask) getPodName() string {
+	if t.IsCustomTask() || t.IsChildPipeline() || len(t.TaskRuns) == 0 {
+		return ""
+	}
+	for _, taskRun := range t.TaskRuns {
+		if !taskRun.IsSuccessful() && len(taskRun.Status.Conditions) >= 1 {
+			return taskRun.Status.PodName
+		}
+	}
+	return t.TaskRuns[0].Status.PodName
+}
+
+// getStartTime returns the earliest start time of the runs of the PipelineTask, nil if none of them started.
+func (t ResolvedPipelineTask) getStartTime() *metav1.Time {
+	var startTime *metav1.Time
+	for _, rt := range t.runTimes() {
+		if rt.start != nil && (startTime == nil || rt.start.Before(startTime)) {
+			startTime = rt.start
+		}
+	}
+	return startTime
+}
+
+// getCompletionTime returns the latest completion time of the runs of the PipelineTask, nil if any of them
+// did not complete.
+func (t ResolvedPipelineTask) getCompletionTime() *metav1.Time {
+	var completionTime *metav1.Time
+	for _, rt := range t.runTimes() {
+		if rt.completion == nil {
+			return nil
+		}
+		if completionTime == nil || completionTime.Before(rt.completion) {
+			completionTime = rt.completion
+		}
+	}
+	return completionTime
+}
+
+// runTimes holds the start and completion times of a TaskRun, CustomRun or child PipelineRun.
+type runTimes struct {
+	start      *metav1.Time
+	completion *metav1.Time
+}
+
+// runTimes returns the start and completion times of the TaskRuns, CustomRuns or child PipelineRuns of the PipelineTask.
+func (t ResolvedPipelineTask) runTimes() []runTimes {
+	var times []runTimes
+	switch {
+	case t.IsChildPipeline():
+		for _, pipelineRun := range t.ChildPipelineRuns {
+			times = append(times, runTimes{start: pipelineRun.Status.StartTime, completion: pipelineRun.Status.CompletionTime})
+		}
+	case t.IsCustomTask():
+		for _, run := range t.CustomRuns {
+			times = append(times, runTimes{start: run.Status.StartTime, completion: run.Status.CompletionTime})
+		}
+	default:
+		for _, taskRun := range t.TaskRuns {
+			times = append(times, runTimes{start: taskRun.Status.StartTime, completion: taskRun.Status.CompletionTime})
+		}
+	}
+	return times
+}
+
 // isSuccessful returns true only if the run has completed successfully
 // If the PipelineTask has a Matrix, isSuccessful returns true if all runs have completed successfully
 func (t ResolvedPipelineTask) isSuccessful() bool {
+	if t.IsChildPipeline() {
+		if len(t.ChildPipelineRuns) == 0 {
+			return false
+		}
+		for _, pipelineRun := range t.ChildPipelineRuns {
+			if !pipelineRun.Status.GetCondition(apis.ConditionSucceeded).IsTrue() {
+				return false
+			}
+		}
+		return true
+	}
 	if t.IsCustomTask() {
 		if len(t.CustomRuns) == 0 {
 			return false
@@ -199,6 +337,16 @@ func (t ResolvedPipelineTask) isSuccessful() bool {
 // If the PipelineTask has a Matrix, isFailure returns true if any run has failed and all other runs are done.
 func (t ResolvedPipelineTask) isFailure() bool {
 	var isDone bool
+	if t.IsChildPipeline() {
+		if len(t.ChildPipelineRuns) == 0 {
+			return false
+		}
+		isDone = true
+		for _, pipelineRun := range t.ChildPipelineRuns {
+			isDone = isDone && pipelineRun.IsDone()
+		}
+		return t.haveAnyChildPipelineRunsFailed() && isDone
+	}
 	if t.IsCustomTask() {
 		if len(t.CustomRuns) == 0 {
 			return false
@@ -267,6 +415,20 @@ func (t ResolvedPipelineTask) isCancelledForTimeOut() bool {
 // isCancelled returns true only if the run is cancelled
 // If the PipelineTask has a Matrix, isCancelled returns true if any run is cancelled and all other runs are done.
 func (t ResolvedPipelineTask) isCancelled() bool {
+	if t.IsChildPipeline() {
+		if len(t.ChildPipelineRuns) == 0 {
+			return false
+		}
+		isDone := true
+		atLeastOneCancelled := false
+		for _, pipelineRun := range t.ChildPipelineRuns {
+			isDone = isDone && pipelineRun.IsDone()
+			c := pipelineRun.Status.GetCondition(apis.ConditionSucceeded)
+			pipelineRunCancelled := c.IsFalse() && c.Reason == v1.PipelineRunReasonCancelled.String()
+			atLeastOneCancelled = atLeastOneCancelled || pipelineRunCancelled
+		}
+		return atLeastOneCancelled && isDone
+	}
 	if t.IsCustomTask() {
 		if len(t.CustomRuns) == 0 {
 			return false
@@ -295,17 +457,23 @@ func (t ResolvedPipelineTask) isCancelled() bool {
 	return atLeastOneCancelled && isDone
 }
 
-// isScheduled returns true when the PipelineRunTask itself has any TaskRuns/CustomRuns
-// or a singular TaskRun/CustomRun associated.
+// isScheduled returns true when the PipelineRunTask itself has any TaskRuns/CustomRuns/child PipelineRuns
+// or a singular TaskRun/CustomRun/child PipelineRun associated.
 func (t ResolvedPipelineTask) isScheduled() bool {
+	if t.IsChildPipeline() {
+		return len(t.ChildPipelineRuns) > 0
+	}
 	if t.IsCustomTask() {
 		return len(t.CustomRuns) > 0
 	}
 	return len(t.TaskRuns) > 0
 }
 
-// haveAnyRunsFailed returns true when any of the taskRuns/customRuns have succeeded condition with status set to false
+// haveAnyRunsFailed returns true when any of the taskRuns/customRuns/child pipelineRuns have succeeded condition with status set to false
 func (t ResolvedPipelineTask) haveAnyRunsFailed() bool {
+	if t.IsChildPipeline() {
+		return t.haveAnyChildPipelineRunsFailed()
+	}
 	if t.IsCustomTask() {
 		return t.haveAnyCustomRunsFailed()
 	}
@@ -332,21 +500,31 @@ func (t ResolvedPipelineTask) haveAnyCustomRunsFailed() bool {
 	return false
 }
 
-func (t *ResolvedPipelineTask) checkParentsDone(facts *PipelineRunFacts) bool {
+// haveAnyChildPipelineRunsFailed returns true when a child PipelineRun has succeeded condition with status set to false
+func (t ResolvedPipelineTask) haveAnyChildPipelineRunsFailed() bool {
+	for _, pipelineRun := range t.ChildPipelineRuns {
+		if pipelineRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *ResolvedPipelineTask) checkParentsDone(ctx context.Context, facts *PipelineRunFacts) bool {
 	if facts.isFinalTask(t.PipelineTask.Name) {
 		return true
 	}
 	stateMap := facts.State.ToMap()
 	node := facts.TasksGraph.Nodes[t.PipelineTask.Name]
 	for _, p := range node.Prev {
-		if !stateMap[p.Key].isDone(facts) {
+		if !stateMap[p.Key].isDone(ctx, facts) {
 			return false
 		}
 	}
 	return true
 }
 
-func (t *ResolvedPipelineTask) skip(facts *PipelineRunFacts) TaskSkipStatus {
+func (t *ResolvedPipelineTask) skip(ctx context.Context, facts *PipelineRunFacts) TaskSkipStatus {
 	var skippingReason v1.SkippingReason
 
 	switch {
@@ -358,15 +536,15 @@ func (t *ResolvedPipelineTask) skip(facts *PipelineRunFacts) TaskSkipStatus {
 		skippingReason = v1.GracefullyCancelledSkip
 	case facts.IsGracefullyStopped():
 		skippingReason = v1.GracefullyStoppedSkip
-	case t.skipBecauseWhenExpressionsEvaluatedToFalse(facts):
+	case t.skipBecauseWhenExpressionsEvaluatedToFalse(ctx, facts):
 		skippingReason = v1.WhenExpressionsSkip
-	case t.skipBecauseParentTaskWasSkipped(facts):
+	case t.skipBecauseParentTaskWasSkipped(ctx, facts):
 		skippingReason = v1.ParentTasksSkip
-	case t.skipBecauseResultReferencesAreMissing(facts):
-		skippingReason = v1.MissingResultsSkip
-	case t.skipBecausePipelineRunPipelineTimeoutReached(facts):
+	case t.skipBecauseResultReferencesAreMissing(ctx, facts):
+		skippingReason = t.missingResultsSkippingReason(facts)
+	case t.skipBecausePipelineRunPipelineTimeoutReached(ctx, facts):
 		skippingReason = v1.PipelineTimedOutSkip
-	case t.skipBecausePipelineRunTasksTimeoutReached(facts):
+	case t.skipBecausePipelineRunTasksTimeoutReached(ctx, facts):
 		skippingReason = v1.TasksTimedOutSkip
 	case t.skipBecauseEmptyArrayInMatrixParams():
 		skippingReason = v1.EmptyArrayInMatrixParams
@@ -386,20 +564,20 @@ func (t *ResolvedPipelineTask) skip(facts *PipelineRunFacts) TaskSkipStatus {
 // (3) its parent task was skipped
 // (4) Pipeline is in stopping state (one of the PipelineTasks failed)
 // (5) Pipeline is gracefully cancelled or stopped
-func (t *ResolvedPipelineTask) Skip(facts *PipelineRunFacts) TaskSkipStatus {
+func (t *ResolvedPipelineTask) Skip(ctx context.Context, facts *PipelineRunFacts) TaskSkipStatus {
 	if facts.SkipCache == nil {
 		facts.SkipCache = make(map[string]TaskSkipStatus)
 	}
 	if _, cached := facts.SkipCache[t.PipelineTask.Name]; !cached {
-		facts.SkipCache[t.PipelineTask.Name] = t.skip(facts)
+		facts.SkipCache[t.PipelineTask.Name] = t.skip(ctx, facts)
 	}
 	return facts.SkipCache[t.PipelineTask.Name]
 }
 
 // skipBecauseWhenExpressionsEvaluatedToFalse confirms that the when expressions have completed evaluating, and
 // it returns true if any of the when expressions evaluate to false
-func (t *ResolvedPipelineTask) skipBecauseWhenExpressionsEvaluatedToFalse(facts *PipelineRunFacts) bool {
-	if t.checkParentsDone(facts) {
+func (t *ResolvedPipelineTask) skipBecauseWhenExpressionsEvaluatedToFalse(ctx context.Context, facts *PipelineRunFacts) bool {
+	if t.checkParentsDone(ctx, facts) {
 		if !t.PipelineTask.When.AllowsExecution(t.EvaluatedCEL) {
 			return true
 		}
@@ -413,12 +591,12 @@ func (t *ResolvedPipelineTask) skipBecauseWhenExpressionsEvaluatedToFalse(facts
 //	    if yes, it ignores this parent skip and continue evaluating other parent tasks
 //	    if no, it returns true to skip the current task because this parent task was skipped
 //	if no, it continues checking the other parent tasks
-func (t *ResolvedPipelineTask) skipBecauseParentTaskWasSkipped(facts *PipelineRunFacts) bool {
+func (t *ResolvedPipelineTask) skipBecauseParentTaskWasSkipped(ctx context.Context, facts *PipelineRunFacts) bool {
 	stateMap := facts.State.ToMap()
 	node := facts.TasksGraph.Nodes[t.PipelineTask.Name]
 	for _, p := range node.Prev {
 		parentTask := stateMap[p.Key]
-		if parentSkipStatus := parentTask.Skip(facts); parentSkipStatus.IsSkipped {
+		if parentSkipStatus := parentTask.Skip(ctx, facts); parentSkipStatus.IsSkipped {
 			// if the parent task was skipped due to its `when` expressions,
 			// then we should ignore that and continue evaluating if we should skip because of other parent tasks
 			if parentSkipStatus.SkippingReason == v1.WhenExpressionsSkip {
@@ -432,27 +610,40 @@ func (t *ResolvedPipelineTask) skipBecauseParentTaskWasSkipped(facts *PipelineRu
 
 // skipBecauseResultReferencesAreMissing checks if the task references results that cannot be resolved, which is a
 // reason for skipping the task, and applies result references if found
-func (t *ResolvedPipelineTask) skipBecauseResultReferencesAreMissing(facts *PipelineRunFacts) bool {
-	if t.checkParentsDone(facts) && t.hasResultReferences() {
+func (t *ResolvedPipelineTask) skipBecauseResultReferencesAreMissing(ctx context.Context, facts *PipelineRunFacts) bool {
+	if t.checkParentsDone(ctx, facts) && t.hasResultReferences() {
 		resolvedResultRefs, pt, err := ResolveResultRefs(facts.State, PipelineRunState{t})
 		rpt := facts.State.ToMap()[pt]
 		if rpt != nil {
 			if err != nil &&
 				(t.PipelineTask.OnError == v1.PipelineTaskContinue ||
-					(t.IsFinalTask(facts) || rpt.Skip(facts).SkippingReason == v1.WhenExpressionsSkip)) {
+					(t.IsFinalTask(facts) || rpt.Skip(ctx, facts).SkippingReason == v1.WhenExpressionsSkip)) {
 				return true
 			}
 		}
-		ApplyTaskResults(PipelineRunState{t}, resolvedResultRefs)
+		if _, err := ApplyTaskResults(ctx, PipelineRunState{t}, resolvedResultRefs); err != nil {
+			return true
+		}
 		facts.ResetSkippedCache()
 	}
 	return false
 }
 
+// missingResultsSkippingReason returns the reason for skipping the task because of its missing result references:
+// ParentTasksFailedSkip if the missing results are the ones of a parent task that failed, MissingResultsSkip otherwise
+func (t *ResolvedPipelineTask) missingResultsSkippingReason(facts *PipelineRunFacts) v1.SkippingReason {
+	if _, pt, err := ResolveResultRefs(facts.State, PipelineRunState{t}); err != nil {
+		if rpt := facts.State.ToMap()[pt]; rpt != nil && rpt.isFailure() {
+			return v1.ParentTasksFailedSkip
+		}
+	}
+	return v1.MissingResultsSkip
+}
+
 // skipBecausePipelineRunPipelineTimeoutReached returns true if the task shouldn't be launched because the elapsed time since
 // the PipelineRun started is greater than the PipelineRun's pipeline timeout
-func (t *ResolvedPipelineTask) skipBecausePipelineRunPipelineTimeoutReached(facts *PipelineRunFacts) bool {
-	if t.checkParentsDone(facts) {
+func (t *ResolvedPipelineTask) skipBecausePipelineRunPipelineTimeoutReached(ctx context.Context, facts *PipelineRunFacts) bool {
+	if t.checkParentsDone(ctx, facts) {
 		if facts.TimeoutsState.PipelineTimeout != nil && *facts.TimeoutsState.PipelineTimeout != config.NoTimeoutDuration && facts.TimeoutsState.StartTime != nil {
 			// If the elapsed time since the PipelineRun's start time is greater than the PipelineRun's Pipeline timeout, skip.
 			return facts.TimeoutsState.Clock.Since(*facts.TimeoutsState.StartTime) > *facts.TimeoutsState.PipelineTimeout
@@ -464,8 +655,8 @@ func (t *ResolvedPipelineTask) skipBecausePipelineRunPipelineTimeoutReached(fact
 
 // skipBecausePipelineRunTasksTimeoutReached returns true if the task shouldn't be launched because the elapsed time since
 // the PipelineRun started is greater than the PipelineRun's tasks timeout
-func (t *ResolvedPipelineTask) skipBecausePipelineRunTasksTimeoutReached(facts *PipelineRunFacts) bool {
-	if t.checkParentsDone(facts) && !t.IsFinalTask(facts) {
+func (t *ResolvedPipelineTask) skipBecausePipelineRunTasksTimeoutReached(ctx context.Context, facts *PipelineRunFacts) bool {
+	if t.checkParentsDone(ctx, facts) && !t.IsFinalTask(facts) {
 		if facts.TimeoutsState.TasksTimeout != nil && *facts.TimeoutsState.TasksTimeout != config.NoTimeoutDuration && facts.TimeoutsState.StartTime != nil {
 			// If the elapsed time since the PipelineRun's start time is greater than the PipelineRun's Tasks timeout, skip.
 			return facts.TimeoutsState.Clock.Since(*facts.TimeoutsState.StartTime) > *facts.TimeoutsState.TasksTimeout
@@ -477,8 +668,8 @@ func (t *ResolvedPipelineTask) skipBecausePipelineRunTasksTimeoutReached(facts *
 
 // skipBecausePipelineRunFinallyTimeoutReached returns true if the task shouldn't be launched because the elapsed time since
 // finally tasks started being executed is greater than the PipelineRun's finally timeout
-func (t *ResolvedPipelineTask) skipBecausePipelineRunFinallyTimeoutReached(facts *PipelineRunFacts) bool {
-	if t.checkParentsDone(facts) && t.IsFinalTask(facts) {
+func (t *ResolvedPipelineTask) skipBecausePipelineRunFinallyTimeoutReached(ctx context.Context, facts *PipelineRunFacts) bool {
+	if t.checkParentsDone(ctx, facts) && t.IsFinalTask(facts) {
 		if facts.TimeoutsState.FinallyTimeout != nil && *facts.TimeoutsState.FinallyTimeout != config.NoTimeoutDuration && facts.TimeoutsState.FinallyStartTime != nil {
 			// If the elapsed time since the PipelineRun's finally start time is greater than the PipelineRun's finally timeout, skip.
 			return facts.TimeoutsState.Clock.Since(*facts.TimeoutsState.FinallyStartTime) > *facts.TimeoutsState.FinallyTimeout
@@ -507,21 +698,21 @@ func (t *ResolvedPipelineTask) IsFinalTask(facts *PipelineRunFacts) bool {
 }
 
 // IsFinallySkipped returns true if a finally task is not executed and skipped due to task result validation failure
-func (t *ResolvedPipelineTask) IsFinallySkipped(facts *PipelineRunFacts) TaskSkipStatus {
+func (t *ResolvedPipelineTask) IsFinallySkipped(ctx context.Context, facts *PipelineRunFacts) TaskSkipStatus {
 	var skippingReason v1.SkippingReason
 
 	switch {
 	case t.isScheduled():
 		skippingReason = v1.None
-	case facts.checkDAGTasksDone() && facts.isFinalTask(t.PipelineTask.Name):
+	case facts.checkDAGTasksDone(ctx) && facts.isFinalTask(t.PipelineTask.Name):
 		switch {
-		case t.skipBecauseResultReferencesAreMissing(facts):
-			skippingReason = v1.MissingResultsSkip
-		case t.skipBecauseWhenExpressionsEvaluatedToFalse(facts):
+		case t.skipBecauseResultReferencesAreMissing(ctx, facts):
+			skippingReason = t.missingResultsSkippingReason(facts)
+		case t.skipBecauseWhenExpressionsEvaluatedToFalse(ctx, facts):
 			skippingReason = v1.WhenExpressionsSkip
-		case t.skipBecausePipelineRunPipelineTimeoutReached(facts):
+		case t.skipBecausePipelineRunPipelineTimeoutReached(ctx, facts):
 			skippingReason = v1.PipelineTimedOutSkip
-		case t.skipBecausePipelineRunFinallyTimeoutReached(facts):
+		case t.skipBecausePipelineRunFinallyTimeoutReached(ctx, facts):
 			skippingReason = v1.FinallyTimedOutSkip
 		case t.skipBecauseEmptyArrayInMatrixParams():
 			skippingReason = v1.EmptyArrayInMatrixParams
@@ -541,6 +732,9 @@ func (t *ResolvedPipelineTask) IsFinallySkipped(facts *PipelineRunFacts) TaskSki
 // GetRun is a function that will retrieve a CustomRun by name.
 type GetRun func(name string) (*v1beta1.CustomRun, error)
 
+// GetPipelineRun is a function that will retrieve a child PipelineRun by name.
+type GetPipelineRun func(name string) (*v1.PipelineRun, error)
+
 // ValidateWorkspaceBindings validates that the Workspaces expected by a Pipeline are provided by a PipelineRun.
 func ValidateWorkspaceBindings(p *v1.PipelineSpec, pr *v1.PipelineRun) error {
 	pipelineRunWorkspaces := make(map[string]v1.WorkspaceBinding)
@@ -587,12 +781,17 @@ func ValidateTaskRunSpecs(p *v1.PipelineSpec, pr *v1.PipelineRun) error {
 //
 // If the Pipeline Task is a Custom Task, it retrieves any CustomRuns and updates the ResolvedPipelineTask with this information.
 // It also sets the ResolvedPipelineTask's RunName(s) with the names of CustomRuns that should be or already have been created.
+//
+// If the Pipeline Task references a Pipeline, it retrieves any child PipelineRuns and updates the ResolvedPipelineTask
+// with this information. It also sets the ResolvedPipelineTask's ChildPipelineRunNames with the names of the child
+// PipelineRuns that should be or already have been created, one for each combination of the Matrix.
 func ResolvePipelineTask(
 	ctx context.Context,
 	pipelineRun v1.PipelineRun,
 	getTask resources.GetTask,
 	getTaskRun resources.GetTaskRun,
 	getRun GetRun,
+	getPipelineRun GetPipelineRun,
 	pipelineTask v1.PipelineTask,
 	pst PipelineRunState,
 ) (*ResolvedPipelineTask, error) {
@@ -609,12 +808,33 @@ func ResolvePipelineTask(
 		return nil, err
 	}
 
-	ApplyTaskResults(PipelineRunState{&rpt}, resolvedResultRefs)
+	if _, err := ApplyTaskResults(ctx, PipelineRunState{&rpt}, resolvedResultRefs); err != nil {
+		return nil, err
+	}
 
 	if rpt.PipelineTask.IsMatrixed() {
 		numCombinations = rpt.PipelineTask.Matrix.CountCombinations()
 	}
-	if rpt.IsCustomTask() {
+	switch {
+	case rpt.IsChildPipeline():
+		rpt.ChildPipelineRunNames = getNamesOfChildPipelineRuns(pipelineRun.Status.ChildReferences, pipelineTask.Name, pipelineRun.Name, numCombinations)
+		for _, pipelineRunName := range rpt.ChildPipelineRunNames {
+			pr, err := getPipelineRun(pipelineRunName)
+			if err != nil && !kerrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error retrieving PipelineRun %s: %w", pipelineRunName, err)
+			}
+			if pr != nil {
+				rpt.ChildPipelineRuns = append(rpt.ChildPipelineRuns, pr)
+			}
+		}
+		if rpt.PipelineTask.IsMatrixed() {
+			// Sort the child PipelineRuns by name to ensure the order is deterministic
+			slices.SortFunc(rpt.ChildPipelineRuns, func(a, b *v1.PipelineRun) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+			rpt.ResultsCache = &ResultsCache{}
+		}
+	case rpt.IsCustomTask():
 		rpt.CustomRunNames = getNamesOfCustomRuns(pipelineRun.Status.ChildReferences, pipelineTask.Name, pipelineRun.Name, numCombinations)
 		for _, runName := range rpt.CustomRunNames {
 			run, err := getRun(runName)
@@ -625,13 +845,21 @@ func ResolvePipelineTask(
 				rpt.CustomRuns = append(rpt.CustomRuns, run)
 			}
 		}
-	} else {
+	default:
 		rpt.TaskRunNames = GetNamesOfTaskRuns(pipelineRun.Status.ChildReferences, pipelineTask.Name, pipelineRun.Name, numCombinations)
 		for _, taskRunName := range rpt.TaskRunNames {
-			if err := rpt.setTaskRunsAndResolvedTask(ctx, taskRunName, getTask, getTaskRun, pipelineTask); err != nil {
+			if err := rpt.setTaskRunsAndResolvedTask(ctx, taskRunName, getTask, getTaskRun, *rpt.PipelineTask); err != nil {
 				return nil, err
 			}
 		}
+		if rpt.PipelineTask.IsMatrixed() {
+			// Sort the taskRuns by name to ensure the order is deterministic
+			slices.SortFunc(rpt.TaskRuns, func(a, b *v1.TaskRun) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+			// the cache is populated from the TaskRuns on first use and shared by the readers of the results
+			rpt.ResultsCache = &ResultsCache{}
+		}
 	}
 	return &rpt, nil
 }
@@ -709,10 +937,8 @@ func resolveTask(
 	case pipelineTask.TaskSpec != nil:
 		rt.TaskSpec = &pipelineTask.TaskSpec.TaskSpec
 	default:
-		// If the alpha feature is enabled, and the user has configured pipelineSpec or pipelineRef, it will enter here.
-		// Currently, the controller is not yet adapted, and to avoid a panic, an error message is provided here.
-		// TODO: Adjust the logic here once the feature is supported in the future.
-		return nil, fmt.Errorf("Currently, Task %q does not support PipelineRef or PipelineSpec, please use TaskRef or TaskSpec instead", pipelineTask.Name)
+		// The PipelineTasks referencing a Pipeline are run in child PipelineRuns and have no Task to resolve.
+		return nil, fmt.Errorf("PipelineTask %q does not reference a Task, please use TaskRef or TaskSpec instead", pipelineTask.Name)
 	}
 	rt.TaskSpec.SetDefaults(ctx)
 	return rt, nil
@@ -807,6 +1033,21 @@ func getRunNamesFromChildRefs(childRefs []v1.ChildStatusReference, ptName string
 	return runNames
 }
 
+// getNamesOfChildPipelineRuns should return unique names for child `PipelineRuns` if they have not already been
+// defined, and the existing ones otherwise.
+func getNamesOfChildPipelineRuns(childRefs []v1.ChildStatusReference, ptName, prName string, numberOfRuns int) []string {
+	var pipelineRunNames []string
+	for _, cr := range childRefs {
+		if cr.Kind == pipeline.PipelineRunControllerName && cr.PipelineTaskName == ptName {
+			pipelineRunNames = append(pipelineRunNames, cr.Name)
+		}
+	}
+	if pipelineRunNames != nil {
+		return pipelineRunNames
+	}
+	return getNewRunNames(ptName, prName, numberOfRuns)
+}
+
 func (t *ResolvedPipelineTask) hasResultReferences() bool {
 	var matrixParams v1.Params
 	if t.PipelineTask.IsMatrixed() {
@@ -842,7 +1083,21 @@ func CheckMissingResultReferences(pipelineRunState PipelineRunState, target *Res
 		if !ok {
 			return fmt.Errorf("Result reference error: Could not find ref \"%s\" in internal pipelineRunState", resultRef.PipelineTask)
 		}
-		if referencedPipelineTask.IsCustomTask() {
+		switch {
+		case referencedPipelineTask.IsChildPipeline():
+			if len(referencedPipelineTask.ChildPipelineRuns) == 0 {
+				return fmt.Errorf("Result reference error: Internal result ref \"%s\" has zero-length ChildPipelineRuns", resultRef.PipelineTask)
+			}
+			if referencedPipelineTask.PipelineTask.IsMatrixed() {
+				if _, err := findResultValuesForMatrix(referencedPipelineTask, resultRef); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := findPipelineResultForParam(referencedPipelineTask.ChildPipelineRuns[0], resultRef); err != nil {
+				return err
+			}
+		case referencedPipelineTask.IsCustomTask():
 			if len(referencedPipelineTask.CustomRuns) == 0 {
 				return fmt.Errorf("Result reference error: Internal result ref \"%s\" has zero-length CustomRuns", resultRef.PipelineTask)
 			}
@@ -851,7 +1106,7 @@ func CheckMissingResultReferences(pipelineRunState PipelineRunState, target *Res
 			if err != nil {
 				return err
 			}
-		} else {
+		default:
 			if len(referencedPipelineTask.TaskRuns) == 0 {
 				return fmt.Errorf("Result reference error: Internal result ref \"%s\" has zero-length TaskRuns", resultRef.PipelineTask)
 			}
@@ -865,17 +1120,96 @@ func CheckMissingResultReferences(pipelineRunState PipelineRunState, target *Res
 	return nil
 }
 
+// ResultsCache is a concurrency-safe cache of the results of the TaskRuns of a matrixed PipelineTask,
+// with the result name as the key and the values fanned out from the TaskRuns as the value.
+// It is populated at most once, see ResolvedPipelineTask.GetResultsCache.
+type ResultsCache struct {
+	once    sync.Once
+	results sync.Map
+}
+
+// NewResultsCache returns a ResultsCache populated with the results.
+func NewResultsCache(results map[string][]string) *ResultsCache {
+	c := &ResultsCache{}
+	c.once.Do(func() {
+		c.store(results)
+	})
+	return c
+}
+
+func (c *ResultsCache) store(results map[string][]string) {
+	for name, values := range results {
+		c.results.Store(name, slices.Clone(values))
+	}
+}
+
+// Get returns the values of the named result, and whether the result is in the cache.
+func (c *ResultsCache) Get(name string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	values, ok := c.results.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return slices.Clone(values.([]string)), true
+}
+
+// ToMap returns a snapshot of the results in the cache.
+func (c *ResultsCache) ToMap() map[string][]string {
+	results := map[string][]string{}
+	if c == nil {
+		return results
+	}
+	c.results.Range(func(name, values any) bool {
+		results[name.(string)] = slices.Clone(values.([]string))
+		return true
+	})
+	return results
+}
+
+// Equal reports whether both caches hold the same results. A nil ResultsCache is equal to an empty one.
+func (c *ResultsCache) Equal(other *ResultsCache) bool {
+	return maps.EqualFunc(c.ToMap(), other.ToMap(), slices.Equal[[]string])
+}
+
+// GetResultsCache returns the ResultsCache of the matrixed PipelineTask, populating it from the TaskRuns on
+// first use. The ResultsCache set by ResolvePipelineTask is shared by all the readers of the ResolvedPipelineTask,
+// otherwise a new ResultsCache is returned on each call.
+func (t *ResolvedPipelineTask) GetResultsCache() *ResultsCache {
+	c := t.ResultsCache
+	if c == nil {
+		c = &ResultsCache{}
+	}
+	c.once.Do(func() {
+		c.store(createResultsCacheMatrixedTaskRuns(t))
+	})
+	return c
+}
+
 // createResultsCacheMatrixedTaskRuns creates a cache of results that have been fanned out from a
 // referenced matrixed PipelintTask so that you can easily access these results in subsequent Pipeline Tasks
-func createResultsCacheMatrixedTaskRuns(rpt *ResolvedPipelineTask) (resultsCache map[string][]string) {
-	if len(rpt.ResultsCache) == 0 {
-		resultsCache = make(map[string][]string)
+func createResultsCacheMatrixedTaskRuns(rpt *ResolvedPipelineTask) map[string][]string {
+	resultsCache := make(map[string][]string)
+	if rpt.IsChildPipeline() {
+		pipelineRuns := slices.Clone(rpt.ChildPipelineRuns)
+		slices.SortFunc(pipelineRuns, func(a, b *v1.PipelineRun) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		for _, pipelineRun := range pipelineRuns {
+			for _, result := range pipelineRun.Status.Results {
+				resultsCache[result.Name] = append(resultsCache[result.Name], result.Value.StringVal)
+			}
+		}
+		return resultsCache
 	}
-	// Sort the taskRuns by name to ensure the order is deterministic
-	sort.Slice(rpt.TaskRuns, func(i, j int) bool {
-		return rpt.TaskRuns[i].Name < rpt.TaskRuns[j].Name
+	// Sort a copy of the taskRuns by name to ensure the order is deterministic, without
+	// modifying the ResolvedPipelineTask which may be read concurrently
+	taskRuns := slices.Clone(rpt.TaskRuns)
+	slices.SortFunc(taskRuns, func(a, b *v1.TaskRun) int {
+		return strings.Compare(a.Name, b.Name)
 	})
-	for _, taskRun := range rpt.TaskRuns {
+	for _, taskRun := range taskRuns {
 		results := taskRun.Status.Results
 		for _, result := range results {
 			resultsCache[result.Name] = append(resultsCache[result.Name], result.Value.StringVal)
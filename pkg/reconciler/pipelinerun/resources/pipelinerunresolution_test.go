@@ -21,7 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
-	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -51,6 +51,10 @@ func nopGetCustomRun(string) (*v1beta1.CustomRun, error) {
 	return nil, errors.New("GetRun should not be called")
 }
 
+func nopGetPipelineRun(string) (*v1.PipelineRun, error) {
+	return nil, errors.New("GetPipelineRun should not be called")
+}
+
 func nopGetTask(context.Context, string) (*v1.Task, *v1.RefSource, *trustedresources.VerificationResult, error) {
 	return nil, nil, nil, errors.New("GetTask should not be called")
 }
@@ -1243,7 +1247,7 @@ func TestIsSkipped(t *testing.T) {
 				if rpt == nil {
 					t.Fatalf("Could not get task %s from the state: %v", taskName, tc.state)
 				}
-				if d := cmp.Diff(isSkipped, rpt.Skip(&facts).IsSkipped); d != "" {
+				if d := cmp.Diff(isSkipped, rpt.Skip(context.Background(), &facts).IsSkipped); d != "" {
 					t.Errorf("Didn't get expected isSkipped from task %s: %s", taskName, diff.PrintWantGot(d))
 				}
 			}
@@ -2326,7 +2330,7 @@ func TestSkipBecauseParentTaskWasSkipped(t *testing.T) {
 				if rpt == nil {
 					t.Fatalf("Could not get task %s from the state: %v", taskName, tc.state)
 				}
-				if d := cmp.Diff(isSkipped, rpt.skipBecauseParentTaskWasSkipped(&facts)); d != "" {
+				if d := cmp.Diff(isSkipped, rpt.skipBecauseParentTaskWasSkipped(context.Background(), &facts)); d != "" {
 					t.Errorf("Didn't get expected isSkipped from task %s: %s", taskName, diff.PrintWantGot(d))
 				}
 			}
@@ -2334,6 +2338,61 @@ func TestSkipBecauseParentTaskWasSkipped(t *testing.T) {
 	}
 }
 
+func TestSkipBecauseResultReferencesAreMissing_SkippingReason(t *testing.T) {
+	// the PipelineRun continues when the parent task fails to produce the result referenced by the child task
+	parent := pts[0].DeepCopy()
+	parent.OnError = v1.PipelineTaskContinue
+	child := pts[14].DeepCopy()
+	child.OnError = v1.PipelineTaskContinue
+	for _, tc := range []struct {
+		name       string
+		parentRun  *v1.TaskRun
+		wantReason v1.SkippingReason
+	}{{
+		name:       "parent task failed",
+		parentRun:  makeFailed(trs[0]),
+		wantReason: v1.ParentTasksFailedSkip,
+	}, {
+		name:       "parent task succeeded without the result",
+		parentRun:  makeSucceeded(trs[0]),
+		wantReason: v1.MissingResultsSkip,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := PipelineRunState{{
+				PipelineTask: parent,
+				TaskRunNames: []string{"pipelinerun-mytask1"},
+				TaskRuns:     []*v1.TaskRun{tc.parentRun},
+				ResolvedTask: &resources.ResolvedTask{
+					TaskSpec: &task.Spec,
+				},
+			}, {
+				PipelineTask: child,
+				TaskRunNames: []string{"pipelinerun-mytask15"},
+				ResolvedTask: &resources.ResolvedTask{
+					TaskSpec: &task.Spec,
+				},
+			}}
+			d, err := dagFromState(state)
+			if err != nil {
+				t.Fatalf("Could not get a dag from the TC state %#v: %v", state, err)
+			}
+			facts := PipelineRunFacts{
+				State:           state,
+				TasksGraph:      d,
+				FinalTasksGraph: &dag.Graph{},
+				TimeoutsState: PipelineRunTimeoutsState{
+					Clock: testClock,
+				},
+			}
+			got := state.ToMap()[child.Name].Skip(context.Background(), &facts)
+			want := TaskSkipStatus{IsSkipped: true, SkippingReason: tc.wantReason}
+			if d := cmp.Diff(want, got); d != "" {
+				t.Errorf("Didn't get expected skip status from task %s: %s", child.Name, diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func getExpectedMessage(runName string, specStatus v1.PipelineRunSpecStatus, status corev1.ConditionStatus,
 	successful, incomplete, skipped, failed, cancelled int,
 ) string {
@@ -2382,7 +2441,7 @@ func TestResolvePipelineRun_CustomTask(t *testing.T) {
 	cfg := config.NewStore(logtesting.TestLogger(t))
 	ctx = cfg.ToContext(ctx)
 	for _, task := range pts {
-		ps, err := ResolvePipelineTask(ctx, pr, nopGetTask, nopGetTaskRun, getRun, task, nil)
+		ps, err := ResolvePipelineTask(ctx, pr, nopGetTask, nopGetTaskRun, getRun, nopGetPipelineRun, task, nil)
 		if err != nil {
 			t.Fatalf("ResolvePipelineTask: %v", err)
 		}
@@ -2433,7 +2492,7 @@ func TestResolvePipelineRun_PipelineTaskHasNoResources(t *testing.T) {
 	}
 	pipelineState := PipelineRunState{}
 	for _, task := range pts {
-		ps, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, task, nil)
+		ps, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, nopGetPipelineRun, task, nil)
 		if err != nil {
 			t.Errorf("Error getting tasks for fake pipeline %s: %s", p.ObjectMeta.Name, err)
 		}
@@ -2458,24 +2517,49 @@ func TestResolvePipelineRun_PipelineTaskHasPipelineRef(t *testing.T) {
 	pt := v1.PipelineTask{
 		Name:        "pipeline-in-pipeline",
 		PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+		Matrix: &v1.Matrix{
+			Params: v1.Params{{
+				Name:  "suite",
+				Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"unit", "e2e"}},
+			}},
+		},
 	}
-
-	getTask := func(ctx context.Context, name string) (*v1.Task, *v1.RefSource, *trustedresources.VerificationResult, error) {
-		return task, nil, nil, nil
+	childPipelineRuns := map[string]*v1.PipelineRun{
+		"pipelinerun-pipeline-in-pipeline-1": {ObjectMeta: metav1.ObjectMeta{Name: "pipelinerun-pipeline-in-pipeline-1"}},
+		"pipelinerun-pipeline-in-pipeline-0": {ObjectMeta: metav1.ObjectMeta{Name: "pipelinerun-pipeline-in-pipeline-0"}},
+	}
+	getPipelineRun := func(name string) (*v1.PipelineRun, error) {
+		if pr, ok := childPipelineRuns[name]; ok {
+			return pr, nil
+		}
+		return nil, kerrors.NewNotFound(v1.Resource("pipelinerun"), name)
 	}
-	getTaskRun := func(name string) (*v1.TaskRun, error) { return &trs[0], nil }
 	pr := v1.PipelineRun{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "pipelinerun",
 		},
 	}
 
-	_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, pt, nil)
-	if err == nil {
-		t.Errorf("Error getting tasks for fake pipeline %s, expected an error but got nil.", p.ObjectMeta.Name)
+	rpt, err := ResolvePipelineTask(context.Background(), pr, nopGetTask, nopGetTaskRun, nopGetCustomRun, getPipelineRun, pt, nil)
+	if err != nil {
+		t.Fatalf("Error resolving the pipeline task referencing a pipeline: %v", err)
+	}
+	if !rpt.IsChildPipeline() {
+		t.Errorf("Expected the pipeline task to be resolved as a child pipeline")
+	}
+	if rpt.ResolvedTask != nil {
+		t.Errorf("Expected no resolved task but got %v", rpt.ResolvedTask)
+	}
+	wantNames := []string{"pipelinerun-pipeline-in-pipeline-0", "pipelinerun-pipeline-in-pipeline-1"}
+	if d := cmp.Diff(wantNames, rpt.ChildPipelineRunNames); d != "" {
+		t.Errorf("Unexpected child PipelineRun names %s", diff.PrintWantGot(d))
 	}
-	if !strings.Contains(err.Error(), "does not support PipelineRef or PipelineSpec") {
-		t.Errorf("Error getting tasks for fake pipeline %s: expected contains keyword but got %s", p.ObjectMeta.Name, err)
+	var gotNames []string
+	for _, childPipelineRun := range rpt.ChildPipelineRuns {
+		gotNames = append(gotNames, childPipelineRun.Name)
+	}
+	if d := cmp.Diff(wantNames, gotNames); d != "" {
+		t.Errorf("Unexpected child PipelineRuns %s", diff.PrintWantGot(d))
 	}
 }
 
@@ -2522,7 +2606,7 @@ func TestResolvePipelineRun_TaskDoesntExist(t *testing.T) {
 		},
 	}
 	for _, pt := range pts {
-		_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, pt, nil)
+		_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, nopGetPipelineRun, pt, nil)
 		var tnf *TaskNotFoundError
 		switch {
 		case err == nil:
@@ -2566,7 +2650,7 @@ func TestResolvePipelineRun_VerificationFailed(t *testing.T) {
 		},
 	}
 	for _, pt := range pts {
-		rt, _ := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, pt, nil)
+		rt, _ := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, nopGetPipelineRun, pt, nil)
 		if d := cmp.Diff(verificationResult, rt.ResolvedTask.VerificationResult, cmpopts.EquateErrors()); d != "" {
 			t.Error(diff.PrintWantGot(d))
 		}
@@ -2588,7 +2672,7 @@ func TestResolvePipelineRun_TransientError(t *testing.T) {
 			Name: "pipelinerun",
 		},
 	}
-	_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, pt, nil)
+	_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, nopGetPipelineRun, pt, nil)
 	if !resolutioncommon.IsErrTransient(err) {
 		t.Error("Transient error while getting Task did not result in a transient error")
 	}
@@ -2831,13 +2915,76 @@ func TestResolvePipeline_WhenExpressions(t *testing.T) {
 	}
 
 	t.Run("When Expressions exist", func(t *testing.T) {
-		_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, pt, nil)
+		_, err := ResolvePipelineTask(context.Background(), pr, getTask, getTaskRun, nopGetCustomRun, nopGetPipelineRun, pt, nil)
 		if err != nil {
 			t.Fatalf("Did not expect error when resolving PipelineRun: %v", err)
 		}
 	})
 }
 
+func TestResolvePipelineTask_EmbeddedTaskResults(t *testing.T) {
+	names.TestingSeed()
+	buildTaskRun := makeSucceeded(v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "pipelinerun-build",
+		},
+	})
+	buildTaskRun.Status.Results = []v1.TaskRunResult{{
+		Name:  "image-digest",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues("sha256:1234"),
+	}, {
+		Name:  "tags",
+		Type:  v1.ResultsTypeArray,
+		Value: *v1.NewStructuredValues("latest", "v1"),
+	}}
+	state := PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:    "build",
+			TaskRef: &v1.TaskRef{Name: "task"},
+		},
+		TaskRunNames: []string{"pipelinerun-build"},
+		TaskRuns:     []*v1.TaskRun{buildTaskRun},
+	}}
+	pt := v1.PipelineTask{
+		Name: "deploy",
+		TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+			Steps: []v1.Step{{
+				Name:  "deploy",
+				Image: "registry.io/app@$(tasks.build.results.image-digest)",
+				Args:  []string{"$(tasks.build.results.tags[*])"},
+				Env:   []corev1.EnvVar{{Name: "TAG", Value: "$(tasks.build.results.tags[1])"}},
+			}},
+		}},
+	}
+	pr := v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pipelinerun",
+		},
+	}
+	getTaskRun := func(name string) (*v1.TaskRun, error) {
+		return nil, kerrors.NewNotFound(v1.Resource("taskrun"), name)
+	}
+
+	rpt, err := ResolvePipelineTask(context.Background(), pr, nopGetTask, getTaskRun, nopGetCustomRun, nopGetPipelineRun, pt, state)
+	if err != nil {
+		t.Fatalf("Did not expect error when resolving PipelineTask: %v", err)
+	}
+	expectedSteps := []v1.Step{{
+		Name:  "deploy",
+		Image: "registry.io/app@sha256:1234",
+		Args:  []string{"latest", "v1"},
+		Env:   []corev1.EnvVar{{Name: "TAG", Value: "v1"}},
+	}}
+	if d := cmp.Diff(expectedSteps, rpt.PipelineTask.TaskSpec.Steps); d != "" {
+		t.Errorf("PipelineTask steps %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(expectedSteps, rpt.ResolvedTask.TaskSpec.Steps); d != "" {
+		t.Errorf("ResolvedTask steps %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestIsCustomTask(t *testing.T) {
 	pr := v1.PipelineRun{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2933,7 +3080,7 @@ func TestIsCustomTask(t *testing.T) {
 			ctx := context.Background()
 			cfg := config.NewStore(logtesting.TestLogger(t))
 			ctx = cfg.ToContext(ctx)
-			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, getRun, tc.pt, nil)
+			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, getRun, nopGetPipelineRun, tc.pt, nil)
 			if err != nil {
 				t.Fatalf("Did not expect error when resolving PipelineRun: %v", err)
 			}
@@ -3168,7 +3315,7 @@ func TestResolvedPipelineRunTask_IsFinallySkipped(t *testing.T) {
 			for i := range state {
 				if i > 0 { // first one is a dag task that produces a result
 					finallyTaskName := state[i].PipelineTask.Name
-					if d := cmp.Diff(tc.expected[finallyTaskName], state[i].IsFinallySkipped(facts).IsSkipped); d != "" {
+					if d := cmp.Diff(tc.expected[finallyTaskName], state[i].IsFinallySkipped(context.Background(), facts).IsSkipped); d != "" {
 						t.Fatalf("Didn't get expected isFinallySkipped from finally task %s: %s", finallyTaskName, diff.PrintWantGot(d))
 					}
 				}
@@ -3298,7 +3445,7 @@ func TestResolvedPipelineRunTask_IsFinallySkippedByCondition(t *testing.T) {
 			}
 
 			for _, state := range tc.state[1:] {
-				got := state.IsFinallySkipped(facts)
+				got := state.IsFinallySkipped(context.Background(), facts)
 				if d := cmp.Diff(tc.want, got); d != "" {
 					t.Errorf("IsFinallySkipped: %s", diff.PrintWantGot(d))
 				}
@@ -3681,7 +3828,7 @@ func TestIsMatrixed(t *testing.T) {
 				},
 			})
 			ctx = cfg.ToContext(ctx)
-			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, getRun, tc.pt, nil)
+			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, getRun, nopGetPipelineRun, tc.pt, nil)
 			if err != nil {
 				t.Fatalf("Did not expect error when resolving PipelineRun: %v", err)
 			}
@@ -3840,7 +3987,7 @@ func TestResolvePipelineRunTask_WithMatrix(t *testing.T) {
 				},
 			})
 			ctx = cfg.ToContext(ctx)
-			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, getRun, tc.pt, tc.pst)
+			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, getRun, nopGetPipelineRun, tc.pt, tc.pst)
 			if err != nil {
 				t.Fatalf("Did not expect error when resolving PipelineRun: %v", err)
 			}
@@ -4008,7 +4155,7 @@ func TestResolvePipelineRunTask_WithMatrixedCustomTask(t *testing.T) {
 			if tc.getRun == nil {
 				tc.getRun = getRun
 			}
-			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, tc.getRun, tc.pt, tc.pst)
+			rpt, err := ResolvePipelineTask(ctx, pr, getTask, getTaskRun, tc.getRun, nopGetPipelineRun, tc.pt, tc.pst)
 			if err != nil {
 				t.Fatalf("Did not expect error when resolving PipelineRun: %v", err)
 			}
@@ -5095,6 +5242,52 @@ func TestCreateResultsCacheMatrixedTaskRuns(t *testing.T) {
 	}
 }
 
+func TestGetResultsCacheConcurrently(t *testing.T) {
+	taskRun := func(name, browser string) *v1.TaskRun {
+		return &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: name},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{{
+						Name:  "browser",
+						Type:  "string",
+						Value: *v1.NewStructuredValues(browser),
+					}},
+				},
+			},
+		}
+	}
+	rpt := &ResolvedPipelineTask{
+		PipelineTask: matrixedPipelineTask,
+		TaskRuns:     []*v1.TaskRun{taskRun("matrixed-task-run-1", "safari"), taskRun("matrixed-task-run-0", "chrome")},
+		ResultsCache: &ResultsCache{},
+	}
+	want := []string{"chrome", "safari"}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, ok := rpt.GetResultsCache().Get("browser")
+			if !ok {
+				t.Error("GetResultsCache() did not cache the browser result")
+			}
+			if d := cmp.Diff(want, got); d != "" {
+				t.Errorf("GetResultsCache() %s", diff.PrintWantGot(d))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if d := cmp.Diff([]string{"matrixed-task-run-1", "matrixed-task-run-0"}, []string{rpt.TaskRuns[0].Name, rpt.TaskRuns[1].Name}); d != "" {
+		t.Errorf("GetResultsCache() reordered the TaskRuns %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(NewResultsCache(map[string][]string{"browser": want}), rpt.ResultsCache); d != "" {
+		t.Errorf("ResultsCache %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestEvaluateCEL_valid(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -5249,6 +5442,69 @@ func TestEvaluateCEL_valid(t *testing.T) {
 	}
 }
 
+func TestEvaluateCEL_substituted(t *testing.T) {
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr"},
+		Spec: v1.PipelineRunSpec{
+			Params: v1.Params{{Name: "env", Value: *v1.NewStructuredValues("dev")}},
+		},
+	}
+	for _, tc := range []struct {
+		name  string
+		cel   string
+		score string
+		want  map[string]bool
+	}{{
+		name:  "numeric comparison of a substituted result",
+		cel:   "$(tasks.test.results.score) > 80",
+		score: "85",
+		want:  map[string]bool{"85 > 80": true},
+	}, {
+		name:  "numeric comparison of a substituted result below the threshold",
+		cel:   "$(tasks.test.results.score) > 80",
+		score: "75",
+		want:  map[string]bool{"75 > 80": false},
+	}, {
+		name: "substituted param in a list",
+		cel:  "'$(params.env)' in ['prod', 'staging']",
+		want: map[string]bool{"'dev' in ['prod', 'staging']": false},
+	}, {
+		name:  "substituted param and result",
+		cel:   "'$(params.env)' == 'dev' && $(tasks.test.results.score) >= 90",
+		score: "90",
+		want:  map[string]bool{"'dev' == 'dev' && 90 >= 90": true},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &v1.PipelineSpec{
+				Params: v1.ParamSpecs{{Name: "env", Type: v1.ParamTypeString}},
+				Tasks: []v1.PipelineTask{{
+					Name:    "deploy",
+					TaskRef: &v1.TaskRef{Name: "deploy"},
+					When:    v1.WhenExpressions{{CEL: tc.cel}},
+				}},
+			}
+			spec, _, err := ApplyParameters(context.Background(), spec, pr)
+			if err != nil {
+				t.Fatalf("ApplyParameters() = %v", err)
+			}
+			rpt := &ResolvedPipelineTask{PipelineTask: &spec.Tasks[0]}
+			if _, err := ApplyTaskResults(context.Background(), PipelineRunState{rpt}, ResolvedResultRefs{{
+				Value:           *v1.NewStructuredValues(tc.score),
+				ResultReference: v1.ResultRef{PipelineTask: "test", Result: "score"},
+				FromTaskRun:     "test-taskrun",
+			}}); err != nil {
+				t.Fatalf("ApplyTaskResults() = %v", err)
+			}
+			if err := rpt.EvaluateCEL(); err != nil {
+				t.Fatalf("EvaluateCEL() = %v", err)
+			}
+			if d := cmp.Diff(tc.want, rpt.EvaluatedCEL); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestEvaluateCEL_invalid(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -5491,6 +5747,33 @@ func TestValidateParamEnumSubset_Valid(t *testing.T) {
 				},
 			},
 			rt: nil,
+		}, {
+			name: "nested object key - pass",
+			params: []v1.Param{
+				{
+					Name: "resolved-task-p1",
+					Value: v1.ParamValue{
+						StringVal: "$(params.p1.aaa.bbb)",
+					},
+				},
+			},
+			pipelinePs: []v1.ParamSpec{
+				{
+					Name:       "p1",
+					Type:       v1.ParamTypeObject,
+					Properties: map[string]v1.PropertySpec{"aaa": {Type: v1.ParamTypeString}},
+				},
+			},
+			rt: &resources.ResolvedTask{
+				TaskSpec: &v1.TaskSpec{
+					Params: v1.ParamSpecs{
+						{
+							Name: "resolved-task-p1",
+							Enum: []string{"v1", "v2"},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -5565,18 +5848,6 @@ func TestValidateParamEnumSubset_Invalid(t *testing.T) {
 			},
 		},
 		wantErr: errors.New("pipeline param \"p1\" has no enum, but referenced in \"ref1\" task has enums: [v1 v3]"),
-	}, {
-		name: "invalid param syntax - failure",
-		params: []v1.Param{
-			{
-				Name: "resolved-task-p1",
-				Value: v1.ParamValue{
-					StringVal: "$(params.p1.aaa.bbb)",
-				},
-			},
-		},
-		rt:      &resources.ResolvedTask{},
-		wantErr: errors.New("unexpected error in ExtractVariablesFromString: Invalid referencing of parameters in \"$(params.p1.aaa.bbb)\"! Only two dot-separated components after the prefix \"params\" are allowed."),
 	}}
 
 	for _, tc := range tcs {
@@ -19,6 +19,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -44,6 +45,17 @@ const (
 	// PipelineTaskStatusSuffix is a suffix of the param representing execution state of pipelineTask
 	PipelineTaskStatusSuffix = ".status"
 	PipelineTaskReasonSuffix = ".reason"
+	// PipelineTaskMessageSuffix is a suffix of the param representing the failure message of a pipelineTask
+	PipelineTaskMessageSuffix = ".message"
+	// PipelineTaskStartTimeSuffix is a suffix of the param representing the start time of a pipelineTask
+	PipelineTaskStartTimeSuffix = ".startTime"
+	// PipelineTaskCompletionTimeSuffix is a suffix of the param representing the completion time of a pipelineTask
+	PipelineTaskCompletionTimeSuffix = ".completionTime"
+	// PipelineTaskPodNameSuffix is a suffix of the param representing the name of the pod of a pipelineTask
+	PipelineTaskPodNameSuffix = ".podName"
+
+	// maxPipelineTaskMessageLength is the maximum number of characters of the failure message of a pipelineTask
+	maxPipelineTaskMessageLength = 256
 )
 
 // PipelineRunState is a slice of ResolvedPipelineRunTasks the represents the current execution
@@ -126,7 +138,7 @@ func (state PipelineRunState) ToMap() map[string]*ResolvedPipelineTask {
 // IsBeforeFirstTaskRun returns true if the PipelineRun has not yet started its first TaskRun
 func (state PipelineRunState) IsBeforeFirstTaskRun() bool {
 	for _, t := range state {
-		if len(t.CustomRuns) > 0 || len(t.TaskRuns) > 0 {
+		if len(t.CustomRuns) > 0 || len(t.TaskRuns) > 0 || len(t.ChildPipelineRuns) > 0 {
 			return false
 		}
 	}
@@ -155,14 +167,40 @@ func (state PipelineRunState) AdjustStartTime(unadjustedStartTime *metav1.Time)
 				adjustedStartTime = &taskRun.CreationTimestamp
 			}
 		}
+		for _, pipelineRun := range rpt.ChildPipelineRuns {
+			if pipelineRun.CreationTimestamp.Time.Before(adjustedStartTime.Time) {
+				adjustedStartTime = &pipelineRun.CreationTimestamp
+			}
+		}
 	}
 	return adjustedStartTime.DeepCopy()
 }
 
-// GetTaskRunsResults returns a map of all successfully completed TaskRuns in the state, with the pipeline task name as
-// the key and the results from the corresponding TaskRun as the value. It only includes tasks which have completed successfully.
-func (state PipelineRunState) GetTaskRunsResults() map[string][]v1.TaskRunResult {
-	results := make(map[string][]v1.TaskRunResult)
+// PipelineTaskResults holds the results of the TaskRuns or child PipelineRuns of a pipeline task.
+type PipelineTaskResults struct {
+	// PipelineTaskName is the name of the pipeline task.
+	PipelineTaskName string
+	// Results are the results of the pipeline task.
+	Results []v1.TaskRunResult
+}
+
+// TaskRunsResults holds the results of the pipeline tasks, sorted by pipeline task name so that the
+// substitutions performed while iterating over them are reproducible.
+type TaskRunsResults []PipelineTaskResults
+
+// ToMap returns the results keyed by pipeline task name.
+func (r TaskRunsResults) ToMap() map[string][]v1.TaskRunResult {
+	results := make(map[string][]v1.TaskRunResult, len(r))
+	for _, ptr := range r {
+		results[ptr.PipelineTaskName] = ptr.Results
+	}
+	return results
+}
+
+// GetTaskRunsResults returns the results of all successfully completed TaskRuns and child PipelineRuns in the state,
+// sorted by pipeline task name. It only includes tasks which have completed successfully.
+func (state PipelineRunState) GetTaskRunsResults() TaskRunsResults {
+	var results TaskRunsResults
 	for _, rpt := range state {
 		if rpt.IsCustomTask() {
 			continue
@@ -170,15 +208,24 @@ func (state PipelineRunState) GetTaskRunsResults() map[string][]v1.TaskRunResult
 		if !rpt.isSuccessful() {
 			continue
 		}
-		if rpt.PipelineTask.IsMatrixed() {
-			taskRunResults := ConvertResultsMapToTaskRunResults(rpt.ResultsCache)
+		switch {
+		case rpt.PipelineTask.IsMatrixed():
+			taskRunResults := ConvertResultsMapToTaskRunResults(rpt.GetResultsCache().ToMap())
 			if len(taskRunResults) > 0 {
-				results[rpt.PipelineTask.Name] = taskRunResults
+				results = append(results, PipelineTaskResults{PipelineTaskName: rpt.PipelineTask.Name, Results: taskRunResults})
 			}
-		} else {
-			results[rpt.PipelineTask.Name] = rpt.TaskRuns[0].Status.Results
+		case rpt.IsChildPipeline():
+			results = append(results, PipelineTaskResults{
+				PipelineTaskName: rpt.PipelineTask.Name,
+				Results:          convertPipelineRunResultsToTaskRunResults(rpt.ChildPipelineRuns[0].Status.Results),
+			})
+		default:
+			results = append(results, PipelineTaskResults{PipelineTaskName: rpt.PipelineTask.Name, Results: rpt.TaskRuns[0].Status.Results})
 		}
 	}
+	slices.SortFunc(results, func(a, b PipelineTaskResults) int {
+		return strings.Compare(a.PipelineTaskName, b.PipelineTaskName)
+	})
 	return results
 }
 
@@ -187,7 +234,7 @@ func (state PipelineRunState) GetTaskRunsResults() map[string][]v1.TaskRunResult
 func (state PipelineRunState) GetTaskRunsArtifacts() map[string]*v1.Artifacts {
 	results := make(map[string]*v1.Artifacts)
 	for _, rpt := range state {
-		if rpt.IsCustomTask() {
+		if rpt.IsCustomTask() || rpt.IsChildPipeline() {
 			continue
 		}
 		if !rpt.isSuccessful() {
@@ -207,7 +254,7 @@ func (state PipelineRunState) GetTaskRunsArtifacts() map[string]*v1.Artifacts {
 }
 
 // ConvertResultsMapToTaskRunResults converts the map of results from Matrixed PipelineTasks to a list
-// of TaskRunResults to standard the format
+// of TaskRunResults to standard the format, sorted by result name
 func ConvertResultsMapToTaskRunResults(resultsMap map[string][]string) []v1.TaskRunResult {
 	var taskRunResults []v1.TaskRunResult
 	for result, val := range resultsMap {
@@ -221,6 +268,23 @@ func ConvertResultsMapToTaskRunResults(resultsMap map[string][]string) []v1.Task
 		}
 		taskRunResults = append(taskRunResults, taskRunResult)
 	}
+	slices.SortFunc(taskRunResults, func(a, b v1.TaskRunResult) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return taskRunResults
+}
+
+// convertPipelineRunResultsToTaskRunResults converts the results of a child PipelineRun to TaskRunResults, so that
+// they are referenced by the other PipelineTasks as the results of a TaskRun are.
+func convertPipelineRunResultsToTaskRunResults(pipelineRunResults []v1.PipelineRunResult) []v1.TaskRunResult {
+	var taskRunResults []v1.TaskRunResult
+	for _, result := range pipelineRunResults {
+		taskRunResults = append(taskRunResults, v1.TaskRunResult{
+			Name:  result.Name,
+			Type:  v1.ResultsType(result.Value.Type),
+			Value: result.Value,
+		})
+	}
 	return taskRunResults
 }
 
@@ -245,17 +309,43 @@ func (state PipelineRunState) GetRunsResults() map[string][]v1beta1.CustomRunRes
 	return results
 }
 
+// GetResolvedPipelineSpec returns a copy of the PipelineSpec, in which the params, context variables and workspaces
+// are already substituted, with the PipelineTasks of the state, to which the results of the other PipelineTasks are
+// applied, and with the $(context.pipelineTask.*) variables substituted for the PipelineTasks which have started.
+func (facts *PipelineRunFacts) GetResolvedPipelineSpec(ctx context.Context, spec *v1.PipelineSpec, pr *v1.PipelineRun) *v1.PipelineSpec {
+	resolved := facts.State.ToMap()
+	spec = spec.DeepCopy()
+	for _, tasks := range [][]v1.PipelineTask{spec.Tasks, spec.Finally} {
+		for i := range tasks {
+			rpt, ok := resolved[tasks[i].Name]
+			if !ok {
+				continue
+			}
+			pt := rpt.PipelineTask.DeepCopy()
+			if rpt.isScheduled() {
+				pt = ApplyPipelineTaskContexts(ctx, pt, pr, facts)
+			} else {
+				// the values of the task results are otherwise unescaped with the pipeline task contexts
+				pt = unescapePipelineTask(pt)
+			}
+			tasks[i] = *pt
+		}
+	}
+	return spec
+}
+
 // GetChildReferences returns a slice of references, including version, kind, name, and pipeline task name, for all
-// TaskRuns and Runs in the state.
-func (facts *PipelineRunFacts) GetChildReferences() []v1.ChildStatusReference {
+// TaskRuns, Runs and child PipelineRuns in the state.
+func (facts *PipelineRunFacts) GetChildReferences(ctx context.Context) []v1.ChildStatusReference {
 	var childRefs []v1.ChildStatusReference
 
 	for _, rpt := range facts.State {
 		// try to replace the parameters of the reference result of whenexpression in the taskrun that has ended
-		if rpt.isDone(facts) {
+		if rpt.isDone(ctx, facts) {
 			resolvedResultRefs, _, err := ResolveResultRefs(facts.State, PipelineRunState{rpt})
 			if err == nil {
-				ApplyTaskResults(facts.State, resolvedResultRefs)
+				// results resolved to conflicting values are left unapplied, as unresolvable ones are
+				_, _ = ApplyTaskResults(ctx, facts.State, resolvedResultRefs)
 			}
 		}
 
@@ -270,18 +360,22 @@ func (facts *PipelineRunFacts) GetChildReferences() []v1.ChildStatusReference {
 			for _, run := range rpt.CustomRuns {
 				childRefs = append(childRefs, rpt.getChildRefForRun(run))
 			}
+		case len(rpt.ChildPipelineRuns) != 0:
+			for _, pipelineRun := range rpt.ChildPipelineRuns {
+				childRefs = append(childRefs, rpt.getChildRefForPipelineRun(pipelineRun))
+			}
 		}
 	}
 	return childRefs
 }
 
-func (t *ResolvedPipelineTask) getDisplayName(customRun *v1beta1.CustomRun, taskRun *v1.TaskRun, c v1.ChildStatusReference) v1.ChildStatusReference {
+// getDisplayName substitutes the display name of the child reference with the string params of the CustomRun,
+// or else with the params of the TaskRun or child PipelineRun.
+func (t *ResolvedPipelineTask) getDisplayName(customRun *v1beta1.CustomRun, params v1.Params, c v1.ChildStatusReference) v1.ChildStatusReference {
 	replacements := make(map[string]string)
-	if taskRun != nil {
-		for _, p := range taskRun.Spec.Params {
-			if p.Value.Type == v1.ParamTypeString {
-				replacements[fmt.Sprintf("%s.%s", v1.ParamsPrefix, p.Name)] = p.Value.StringVal
-			}
+	for _, p := range params {
+		if p.Value.Type == v1.ParamTypeString {
+			replacements[fmt.Sprintf("%s.%s", v1.ParamsPrefix, p.Name)] = p.Value.StringVal
 		}
 	}
 	if customRun != nil {
@@ -293,7 +387,7 @@ func (t *ResolvedPipelineTask) getDisplayName(customRun *v1beta1.CustomRun, task
 	}
 
 	if t.PipelineTask.DisplayName != "" {
-		c.DisplayName = substitution.ApplyReplacements(t.PipelineTask.DisplayName, replacements)
+		c.DisplayName = substitution.UnescapeSubstitutionResult(substitution.ApplyReplacements(t.PipelineTask.DisplayName, replacements))
 	}
 	if t.PipelineTask.Matrix != nil {
 		var dn string
@@ -304,7 +398,7 @@ func (t *ResolvedPipelineTask) getDisplayName(customRun *v1beta1.CustomRun, task
 			match := true
 			for _, ip := range i.Params {
 				v, ok := replacements[fmt.Sprintf("%s.%s", v1.ParamsPrefix, ip.Name)]
-				if !ok || (ip.Value.Type == v1.ParamTypeString && ip.Value.StringVal != v) {
+				if !ok || (ip.Value.Type == v1.ParamTypeString && substitution.UnescapeSubstitutionResult(ip.Value.StringVal) != v) {
 					match = false
 					break
 				}
@@ -328,7 +422,7 @@ func (t *ResolvedPipelineTask) getChildRefForRun(customRun *v1beta1.CustomRun) v
 		},
 		Name:             customRun.GetObjectMeta().GetName(),
 		PipelineTaskName: t.PipelineTask.Name,
-		WhenExpressions:  t.PipelineTask.When,
+		WhenExpressions:  unescapeWhenExpressions(t.PipelineTask.When),
 	}
 	return t.getDisplayName(customRun, nil, c)
 }
@@ -341,9 +435,22 @@ func (t *ResolvedPipelineTask) getChildRefForTaskRun(taskRun *v1.TaskRun) v1.Chi
 		},
 		Name:             taskRun.Name,
 		PipelineTaskName: t.PipelineTask.Name,
-		WhenExpressions:  t.PipelineTask.When,
+		WhenExpressions:  unescapeWhenExpressions(t.PipelineTask.When),
 	}
-	return t.getDisplayName(nil, taskRun, c)
+	return t.getDisplayName(nil, taskRun.Spec.Params, c)
+}
+
+func (t *ResolvedPipelineTask) getChildRefForPipelineRun(pipelineRun *v1.PipelineRun) v1.ChildStatusReference {
+	c := v1.ChildStatusReference{
+		TypeMeta: runtime.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       pipeline.PipelineRunControllerName,
+		},
+		Name:             pipelineRun.Name,
+		PipelineTaskName: t.PipelineTask.Name,
+		WhenExpressions:  unescapeWhenExpressions(t.PipelineTask.When),
+	}
+	return t.getDisplayName(nil, pipelineRun.Spec.Params, c)
 }
 
 // getNextTasks returns a list of tasks which should be executed next i.e.
@@ -353,7 +460,7 @@ func (state PipelineRunState) getNextTasks(candidateTasks sets.String) []*Resolv
 	tasks := []*ResolvedPipelineTask{}
 	for _, t := range state {
 		if _, ok := candidateTasks[t.PipelineTask.Name]; ok {
-			if len(t.TaskRuns) == 0 && len(t.CustomRuns) == 0 {
+			if len(t.TaskRuns) == 0 && len(t.CustomRuns) == 0 && len(t.ChildPipelineRuns) == 0 {
 				tasks = append(tasks, t)
 			}
 		}
@@ -402,7 +509,7 @@ func (facts *PipelineRunFacts) IsGracefullyStopped() bool {
 }
 
 // DAGExecutionQueue returns a list of DAG tasks which needs to be scheduled next
-func (facts *PipelineRunFacts) DAGExecutionQueue() (PipelineRunState, error) {
+func (facts *PipelineRunFacts) DAGExecutionQueue(ctx context.Context) (PipelineRunState, error) {
 	var tasks PipelineRunState
 	// when pipelinerun is cancelled or gracefully cancelled, do not schedule any new tasks,
 	// and only wait for all running tasks to complete (without exhausting retries).
@@ -411,7 +518,7 @@ func (facts *PipelineRunFacts) DAGExecutionQueue() (PipelineRunState, error) {
 	}
 	// candidateTasks is initialized to DAG root nodes to start pipeline execution
 	// candidateTasks is derived based on successfully finished tasks and/or skipped tasks
-	candidateTasks, err := dag.GetCandidateTasks(facts.TasksGraph, facts.completedOrSkippedDAGTasks()...)
+	candidateTasks, err := dag.GetCandidateTasks(facts.TasksGraph, facts.completedOrSkippedDAGTasks(ctx)...)
 	if err != nil {
 		return tasks, err
 	}
@@ -447,12 +554,12 @@ func (facts *PipelineRunFacts) GetTaskNames() sets.String {
 
 // GetFinalTasks returns a list of final tasks which needs to be executed next
 // GetFinalTasks returns final tasks only when all DAG tasks have finished executing or have been skipped
-func (facts *PipelineRunFacts) GetFinalTasks() PipelineRunState {
+func (facts *PipelineRunFacts) GetFinalTasks(ctx context.Context) PipelineRunState {
 	tasks := PipelineRunState{}
 	finalCandidates := sets.NewString()
 	// check either pipeline has finished executing all DAG pipelineTasks,
 	// where "finished executing" means succeeded, failed, or skipped.
-	if facts.checkDAGTasksDone() {
+	if facts.checkDAGTasksDone(ctx) {
 		// return list of tasks with all final tasks
 		for _, t := range facts.State {
 			if facts.isFinalTask(t.PipelineTask.Name) {
@@ -465,10 +572,10 @@ func (facts *PipelineRunFacts) GetFinalTasks() PipelineRunState {
 }
 
 // IsFinalTaskStarted returns true if all DAG pipelineTasks is finished and one or more final tasks have been created.
-func (facts *PipelineRunFacts) IsFinalTaskStarted() bool {
+func (facts *PipelineRunFacts) IsFinalTaskStarted(ctx context.Context) bool {
 	// check either pipeline has finished executing all DAG pipelineTasks,
 	// where "finished executing" means succeeded, failed, or skipped.
-	if facts.checkDAGTasksDone() {
+	if facts.checkDAGTasksDone(ctx) {
 		// return list of tasks with all final tasks
 		for _, t := range facts.State {
 			if facts.isFinalTask(t.PipelineTask.Name) && t.isScheduled() {
@@ -499,7 +606,7 @@ func (facts *PipelineRunFacts) GetPipelineConditionStatus(ctx context.Context, p
 
 	// report the count in PipelineRun Status
 	// get the count of successful tasks, failed tasks, cancelled tasks, skipped task, and incomplete tasks
-	s := facts.getPipelineTasksCount()
+	s := facts.getPipelineTasksCount(ctx)
 	// completed task is a collection of successful, failed, cancelled tasks
 	// (skipped tasks and validation failed tasks are reported separately)
 	cmTasks := s.Succeeded + s.Failed + s.Cancelled + s.IgnoredFailed
@@ -565,7 +672,7 @@ func (facts *PipelineRunFacts) GetPipelineConditionStatus(ctx context.Context, p
 	case pr.IsGracefullyStopped():
 		// Transition pipeline into running finally state, when graceful stop is in progress
 		reason = v1.PipelineRunReasonStoppedRunningFinally.String()
-	case s.Cancelled > 0 || (s.Failed > 0 && facts.checkFinalTasksDone()):
+	case s.Cancelled > 0 || (s.Failed > 0 && facts.checkFinalTasksDone(ctx)):
 		// Transition pipeline into stopping state when one of the tasks(dag/final) cancelled or one of the dag tasks failed
 		// for a pipeline with final tasks, single dag task failure does not transition to interim stopping state
 		// pipeline stays in running state until all final tasks are done before transitioning to failed state
@@ -583,26 +690,26 @@ func (facts *PipelineRunFacts) GetPipelineConditionStatus(ctx context.Context, p
 }
 
 // GetSkippedTasks constructs a list of SkippedTask struct to be included in the PipelineRun Status
-func (facts *PipelineRunFacts) GetSkippedTasks() []v1.SkippedTask {
+func (facts *PipelineRunFacts) GetSkippedTasks(ctx context.Context) []v1.SkippedTask {
 	var skipped []v1.SkippedTask
 	for _, rpt := range facts.State {
-		if rpt.Skip(facts).IsSkipped {
+		if rpt.Skip(ctx, facts).IsSkipped {
 			skippedTask := v1.SkippedTask{
 				Name:            rpt.PipelineTask.Name,
-				Reason:          rpt.Skip(facts).SkippingReason,
-				WhenExpressions: rpt.PipelineTask.When,
+				Reason:          rpt.Skip(ctx, facts).SkippingReason,
+				WhenExpressions: unescapeWhenExpressions(rpt.PipelineTask.When),
 			}
 			skipped = append(skipped, skippedTask)
 		}
-		if rpt.IsFinallySkipped(facts).IsSkipped {
+		if rpt.IsFinallySkipped(ctx, facts).IsSkipped {
 			skippedTask := v1.SkippedTask{
 				Name:   rpt.PipelineTask.Name,
-				Reason: rpt.IsFinallySkipped(facts).SkippingReason,
+				Reason: rpt.IsFinallySkipped(ctx, facts).SkippingReason,
 			}
 			// include the when expressions only when the finally task was skipped because
 			// its when expressions evaluated to false (not because results variables were missing)
-			if rpt.IsFinallySkipped(facts).SkippingReason == v1.WhenExpressionsSkip {
-				skippedTask.WhenExpressions = rpt.PipelineTask.When
+			if rpt.IsFinallySkipped(ctx, facts).SkippingReason == v1.WhenExpressionsSkip {
+				skippedTask.WhenExpressions = unescapeWhenExpressions(rpt.PipelineTask.When)
 			}
 			skipped = append(skipped, skippedTask)
 		}
@@ -612,7 +719,7 @@ func (facts *PipelineRunFacts) GetSkippedTasks() []v1.SkippedTask {
 
 // GetPipelineTaskStatus returns the status of a PipelineTask depending on its taskRun
 // the checks are implemented such that the finally tasks are requesting status of the dag tasks
-func (facts *PipelineRunFacts) GetPipelineTaskStatus() map[string]string {
+func (facts *PipelineRunFacts) GetPipelineTaskStatus(ctx context.Context) map[string]string {
 	// construct a map of tasks.<pipelineTask>.status and its state
 	tStatus := make(map[string]string)
 	for _, t := range facts.State {
@@ -631,24 +738,31 @@ func (facts *PipelineRunFacts) GetPipelineTaskStatus() map[string]string {
 			}
 			tStatus[PipelineTaskStatusPrefix+t.PipelineTask.Name+PipelineTaskStatusSuffix] = s
 			tStatus[PipelineTaskStatusPrefix+t.PipelineTask.Name+PipelineTaskReasonSuffix] = t.getReason()
+			// the failure message is only known once the pipelineTask is done, it is empty if it didn't fail
+			if t.isDone(ctx, facts) {
+				tStatus[PipelineTaskStatusPrefix+t.PipelineTask.Name+PipelineTaskMessageSuffix] = t.getMessage()
+				tStatus[PipelineTaskStatusPrefix+t.PipelineTask.Name+PipelineTaskStartTimeSuffix] = formatTime(t.getStartTime())
+				tStatus[PipelineTaskStatusPrefix+t.PipelineTask.Name+PipelineTaskCompletionTimeSuffix] = formatTime(t.getCompletionTime())
+				tStatus[PipelineTaskStatusPrefix+t.PipelineTask.Name+PipelineTaskPodNameSuffix] = t.getPodName()
+			}
 		}
 	}
 	// initialize aggregate status of all dag tasks to None
 	aggregateStatus := PipelineTaskStateNone
-	if facts.checkDAGTasksDone() {
+	if facts.checkDAGTasksDone(ctx) {
 		// all dag tasks are done, change the aggregate status to succeeded
 		// will reset it to failed/skipped if needed
 		aggregateStatus = v1.PipelineRunReasonSuccessful.String()
 		for _, t := range facts.State {
 			if facts.isDAGTask(t.PipelineTask.Name) {
 				// if any of the dag task failed, change the aggregate status to failed and return
-				if !t.IsCustomTask() && t.haveAnyTaskRunsFailed() || t.IsCustomTask() && t.haveAnyCustomRunsFailed() {
+				if t.haveAnyRunsFailed() {
 					aggregateStatus = v1.PipelineRunReasonFailed.String()
 					break
 				}
 				// if any of the dag task skipped, change the aggregate status to completed
 				// but continue checking for any other failure
-				if t.Skip(facts).IsSkipped {
+				if t.Skip(ctx, facts).IsSkipped {
 					aggregateStatus = v1.PipelineRunReasonCompleted.String()
 				}
 			}
@@ -659,7 +773,7 @@ func (facts *PipelineRunFacts) GetPipelineTaskStatus() map[string]string {
 }
 
 // GetPipelineFinalTaskStatus returns the status of a PipelineFinalTask depending on its taskRun
-func (facts *PipelineRunFacts) GetPipelineFinalTaskStatus() map[string]string {
+func (facts *PipelineRunFacts) GetPipelineFinalTaskStatus(ctx context.Context) map[string]string {
 	// construct a map of tasks.<pipelineTask>.status and its state
 	tStatus := make(map[string]string)
 	for _, t := range facts.State {
@@ -684,11 +798,11 @@ func (facts *PipelineRunFacts) GetPipelineFinalTaskStatus() map[string]string {
 
 // completedOrSkippedTasks returns a list of the names of all of the PipelineTasks in state
 // which have completed or skipped
-func (facts *PipelineRunFacts) completedOrSkippedDAGTasks() []string {
+func (facts *PipelineRunFacts) completedOrSkippedDAGTasks(ctx context.Context) []string {
 	tasks := []string{}
 	for _, t := range facts.State {
 		if facts.isDAGTask(t.PipelineTask.Name) {
-			if t.isDone(facts) {
+			if t.isDone(ctx, facts) {
 				tasks = append(tasks, t.PipelineTask.Name)
 			}
 		}
@@ -698,10 +812,10 @@ func (facts *PipelineRunFacts) completedOrSkippedDAGTasks() []string {
 
 // checkTasksDone returns true if all tasks from the specified graph are finished executing
 // a task is considered done if it has failed/succeeded/skipped
-func (facts *PipelineRunFacts) checkTasksDone(d *dag.Graph) bool {
+func (facts *PipelineRunFacts) checkTasksDone(ctx context.Context, d *dag.Graph) bool {
 	for _, t := range facts.State {
 		if isTaskInGraph(t.PipelineTask.Name, d) {
-			if !t.isDone(facts) {
+			if !t.isDone(ctx, facts) {
 				return false
 			}
 		}
@@ -710,17 +824,17 @@ func (facts *PipelineRunFacts) checkTasksDone(d *dag.Graph) bool {
 }
 
 // check if all DAG tasks done executing (succeeded, failed, or skipped)
-func (facts *PipelineRunFacts) checkDAGTasksDone() bool {
-	return facts.checkTasksDone(facts.TasksGraph)
+func (facts *PipelineRunFacts) checkDAGTasksDone(ctx context.Context) bool {
+	return facts.checkTasksDone(ctx, facts.TasksGraph)
 }
 
 // check if all finally tasks done executing (succeeded or failed)
-func (facts *PipelineRunFacts) checkFinalTasksDone() bool {
-	return facts.checkTasksDone(facts.FinalTasksGraph)
+func (facts *PipelineRunFacts) checkFinalTasksDone(ctx context.Context) bool {
+	return facts.checkTasksDone(ctx, facts.FinalTasksGraph)
 }
 
 // getPipelineTasksCount returns the count of successful tasks, failed tasks, cancelled tasks, skipped task, and incomplete tasks
-func (facts *PipelineRunFacts) getPipelineTasksCount() pipelineRunStatusCount {
+func (facts *PipelineRunFacts) getPipelineTasksCount(ctx context.Context) pipelineRunStatusCount {
 	s := pipelineRunStatusCount{
 		Skipped:             0,
 		Succeeded:           0,
@@ -752,16 +866,16 @@ func (facts *PipelineRunFacts) getPipelineTasksCount() pipelineRunStatusCount {
 		case t.isValidationFailed(facts.ValidationFailedTask):
 			s.ValidationFailed++
 		// increment skipped and skipped due to timeout counters since the task was skipped due to the pipeline, tasks, or finally timeout being reached before the task was launched
-		case t.Skip(facts).SkippingReason == v1.PipelineTimedOutSkip ||
-			t.Skip(facts).SkippingReason == v1.TasksTimedOutSkip ||
-			t.IsFinallySkipped(facts).SkippingReason == v1.FinallyTimedOutSkip:
+		case t.Skip(ctx, facts).SkippingReason == v1.PipelineTimedOutSkip ||
+			t.Skip(ctx, facts).SkippingReason == v1.TasksTimedOutSkip ||
+			t.IsFinallySkipped(ctx, facts).SkippingReason == v1.FinallyTimedOutSkip:
 			s.Skipped++
 			s.SkippedDueToTimeout++
 		// increment skip counter since the task is skipped
-		case t.Skip(facts).IsSkipped:
+		case t.Skip(ctx, facts).IsSkipped:
 			s.Skipped++
 		// checking if any finally tasks were referring to invalid/missing task results
-		case t.IsFinallySkipped(facts).IsSkipped:
+		case t.IsFinallySkipped(ctx, facts).IsSkipped:
 			s.Skipped++
 		// increment incomplete counter since the task is pending and not executed yet
 		default:
@@ -19,6 +19,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -215,12 +216,12 @@ func TestPipelineRunFacts_CheckDAGTasksDoneDone(t *testing.T) {
 				},
 			}
 
-			isDone := facts.checkTasksDone(d)
+			isDone := facts.checkTasksDone(context.Background(), d)
 			if d := cmp.Diff(tc.expected, isDone); d != "" {
 				t.Errorf("Didn't get expected checkTasksDone %s", diff.PrintWantGot(d))
 			}
 			for i, pt := range tc.state {
-				isDone = pt.isDone(&facts)
+				isDone = pt.isDone(context.Background(), &facts)
 				if d := cmp.Diff(tc.ptExpected[i], isDone); d != "" {
 					t.Errorf("Didn't get expected (ResolvedPipelineTask) isDone %s", diff.PrintWantGot(d))
 				}
@@ -1013,7 +1014,7 @@ func TestDAGExecutionQueue(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			queue, err := facts.DAGExecutionQueue()
+			queue, err := facts.DAGExecutionQueue(context.Background())
 			if err != nil {
 				t.Errorf("unexpected error getting DAG execution queue: %s", err)
 			}
@@ -1103,7 +1104,7 @@ func TestDAGExecutionQueueSequentialTasks(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			queue, err := facts.DAGExecutionQueue()
+			queue, err := facts.DAGExecutionQueue(context.Background())
 			if err != nil {
 				t.Errorf("unexpected error getting DAG execution queue but got error %s", err)
 			}
@@ -1196,7 +1197,7 @@ func TestDAGExecutionQueueSequentialRuns(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			queue, err := facts.DAGExecutionQueue()
+			queue, err := facts.DAGExecutionQueue(context.Background())
 			if err != nil {
 				t.Errorf("unexpected error getting DAG execution queue but got error %s", err)
 			}
@@ -1296,7 +1297,7 @@ func TestPipelineRunState_CompletedOrSkippedDAGTasks(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			names := facts.completedOrSkippedDAGTasks()
+			names := facts.completedOrSkippedDAGTasks(context.Background())
 			if d := cmp.Diff(tc.expectedNames, names); d != "" {
 				t.Errorf("Expected to get completed names %v but got something different %s", tc.expectedNames, diff.PrintWantGot(d))
 			}
@@ -1527,7 +1528,7 @@ func TestPipelineRunState_GetFinalTasksAndNames(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			next := facts.GetFinalTasks()
+			next := facts.GetFinalTasks(context.Background())
 			if d := cmp.Diff(tc.expectedFinalTasks, next); d != "" {
 				t.Errorf("Didn't get expected final Tasks for %s (%s): %s", tc.name, tc.desc, diff.PrintWantGot(d))
 			}
@@ -1608,7 +1609,7 @@ func TestPipelineRunState_IsFinalTaskStarted(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			started := facts.IsFinalTaskStarted()
+			started := facts.IsFinalTaskStarted(context.Background())
 			if d := cmp.Diff(tc.expectedFinalTaskStarted, started); d != "" {
 				t.Errorf("Didn't get expected (IsFinalTaskStarted) started for %s (%s):%s", tc.name, tc.desc, diff.PrintWantGot(d))
 			}
@@ -2372,33 +2373,53 @@ func TestPipelineRunFacts_GetPipelineTaskStatus(t *testing.T) {
 		state:    oneFinishedState,
 		dagTasks: []v1.PipelineTask{pts[0], pts[1]},
 		expectedStatus: map[string]string{
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix: v1.TaskRunReasonSuccessful.String(),
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix: "Succeeded",
-			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStatusSuffix: PipelineTaskStateNone,
-			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskReasonSuffix: "",
-			v1.PipelineTasksAggregateStatus:                                   PipelineTaskStateNone,
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix:         v1.TaskRunReasonSuccessful.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix:         "Succeeded",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskPodNameSuffix:        "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStatusSuffix:         PipelineTaskStateNone,
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskReasonSuffix:         "",
+			v1.PipelineTasksAggregateStatus:                                           PipelineTaskStateNone,
 		},
 	}, {
 		name:     "one-task-failed",
 		state:    oneFailedState,
 		dagTasks: []v1.PipelineTask{pts[0], pts[1]},
 		expectedStatus: map[string]string{
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix: v1.TaskRunReasonFailed.String(),
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix: "Failed",
-			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStatusSuffix: PipelineTaskStateNone,
-			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskReasonSuffix: "",
-			v1.PipelineTasksAggregateStatus:                                   v1.PipelineRunReasonFailed.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix:         v1.TaskRunReasonFailed.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix:         "Failed",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskPodNameSuffix:        "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStatusSuffix:         PipelineTaskStateNone,
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskReasonSuffix:         "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskPodNameSuffix:        "",
+			v1.PipelineTasksAggregateStatus:                                           v1.PipelineRunReasonFailed.String(),
 		},
 	}, {
 		name:     "all-finished",
 		state:    allFinishedState,
 		dagTasks: []v1.PipelineTask{pts[0], pts[1]},
 		expectedStatus: map[string]string{
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix: v1.TaskRunReasonSuccessful.String(),
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix: "Succeeded",
-			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStatusSuffix: v1.TaskRunReasonSuccessful.String(),
-			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskReasonSuffix: "Succeeded",
-			v1.PipelineTasksAggregateStatus:                                   v1.PipelineRunReasonSuccessful.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix:         v1.TaskRunReasonSuccessful.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix:         "Succeeded",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskPodNameSuffix:        "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStatusSuffix:         v1.TaskRunReasonSuccessful.String(),
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskReasonSuffix:         "Succeeded",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[1].Name + PipelineTaskPodNameSuffix:        "",
+			v1.PipelineTasksAggregateStatus:                                           v1.PipelineRunReasonSuccessful.String(),
 		},
 	}, {
 		name: "task-with-when-expressions-passed",
@@ -2427,9 +2448,13 @@ func TestPipelineRunFacts_GetPipelineTaskStatus(t *testing.T) {
 		}},
 		dagTasks: []v1.PipelineTask{pts[10]},
 		expectedStatus: map[string]string{
-			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskStatusSuffix: PipelineTaskStateNone,
-			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskReasonSuffix: "",
-			v1.PipelineTasksAggregateStatus:                                    v1.PipelineRunReasonCompleted.String(),
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskStatusSuffix:         PipelineTaskStateNone,
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskReasonSuffix:         "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskPodNameSuffix:        "",
+			v1.PipelineTasksAggregateStatus:                                            v1.PipelineRunReasonCompleted.String(),
 		},
 	}, {
 		name: "when-expression-task-with-parent-started",
@@ -2481,11 +2506,19 @@ func TestPipelineRunFacts_GetPipelineTaskStatus(t *testing.T) {
 		}},
 		dagTasks: []v1.PipelineTask{pts[0], pts[10]},
 		expectedStatus: map[string]string{
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix:  v1.PipelineRunReasonFailed.String(),
-			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix:  v1.PipelineRunReasonFailed.String(),
-			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskStatusSuffix: PipelineTaskStateNone,
-			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskReasonSuffix: "",
-			v1.PipelineTasksAggregateStatus:                                    v1.PipelineRunReasonFailed.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStatusSuffix:          v1.PipelineRunReasonFailed.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskReasonSuffix:          v1.PipelineRunReasonFailed.String(),
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskMessageSuffix:         "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskStartTimeSuffix:       "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskCompletionTimeSuffix:  "",
+			PipelineTaskStatusPrefix + pts[0].Name + PipelineTaskPodNameSuffix:         "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskStatusSuffix:         PipelineTaskStateNone,
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskReasonSuffix:         "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskMessageSuffix:        "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskStartTimeSuffix:      "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskCompletionTimeSuffix: "",
+			PipelineTaskStatusPrefix + pts[10].Name + PipelineTaskPodNameSuffix:        "",
+			v1.PipelineTasksAggregateStatus:                                            v1.PipelineRunReasonFailed.String(),
 		},
 	}}
 	for _, tc := range tcs {
@@ -2502,7 +2535,7 @@ func TestPipelineRunFacts_GetPipelineTaskStatus(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			s := facts.GetPipelineTaskStatus()
+			s := facts.GetPipelineTaskStatus(context.Background())
 			if d := cmp.Diff(tc.expectedStatus, s); d != "" {
 				t.Fatalf("Test failed: %s Mismatch in pipelineTask execution state %s", tc.name, diff.PrintWantGot(d))
 			}
@@ -2510,6 +2543,152 @@ func TestPipelineRunFacts_GetPipelineTaskStatus(t *testing.T) {
 	}
 }
 
+func TestPipelineRunFacts_GetPipelineTaskStatus_Message(t *testing.T) {
+	failed := makeFailed(trs[0])
+	failed.Status.Conditions[0].Message = strings.Repeat("a", maxPipelineTaskMessageLength) + "truncated"
+	started := makeStarted(trs[1])
+	started.Status.Conditions[0].Message = "still running"
+	state := PipelineRunState{{
+		PipelineTask: &pts[0],
+		TaskRunNames: []string{"pipelinerun-mytask1"},
+		TaskRuns:     []*v1.TaskRun{failed},
+		ResolvedTask: &resources.ResolvedTask{
+			TaskSpec: &task.Spec,
+		},
+	}, {
+		PipelineTask: &pts[1],
+		TaskRunNames: []string{"pipelinerun-mytask2"},
+		TaskRuns:     []*v1.TaskRun{started},
+		ResolvedTask: &resources.ResolvedTask{
+			TaskSpec: &task.Spec,
+		},
+	}}
+	dagTasks := []v1.PipelineTask{pts[0], pts[1]}
+	d, err := dag.Build(v1.PipelineTaskList(dagTasks), v1.PipelineTaskList(dagTasks).Deps())
+	if err != nil {
+		t.Fatalf("Unexpected error while building graph for DAG tasks %v: %v", dagTasks, err)
+	}
+	facts := PipelineRunFacts{
+		State:           state,
+		TasksGraph:      d,
+		FinalTasksGraph: &dag.Graph{},
+		TimeoutsState: PipelineRunTimeoutsState{
+			Clock: testClock,
+		},
+	}
+
+	s := facts.GetPipelineTaskStatus(context.Background())
+	if d := cmp.Diff(strings.Repeat("a", maxPipelineTaskMessageLength), s[PipelineTaskStatusPrefix+pts[0].Name+PipelineTaskMessageSuffix]); d != "" {
+		t.Errorf("Unexpected message of the failed pipelineTask %s", diff.PrintWantGot(d))
+	}
+	if message, ok := s[PipelineTaskStatusPrefix+pts[1].Name+PipelineTaskMessageSuffix]; ok {
+		t.Errorf("Expected no message for the running pipelineTask but got %q", message)
+	}
+}
+
+func TestPipelineRunFacts_GetPipelineTaskStatus_Times(t *testing.T) {
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	withTimes := func(tr *v1.TaskRun, startOffset, completionOffset time.Duration) *v1.TaskRun {
+		tr.Status.StartTime = &metav1.Time{Time: start.Add(startOffset)}
+		tr.Status.CompletionTime = &metav1.Time{Time: start.Add(completionOffset)}
+		return tr
+	}
+	running := makeStarted(trs[1])
+	running.Status.StartTime = &metav1.Time{Time: start}
+	state := PipelineRunState{{
+		// the earliest start time and the latest completion time of the TaskRuns of the pipelineTask are used
+		PipelineTask: &pts[0],
+		TaskRunNames: []string{"pipelinerun-mytask1-0", "pipelinerun-mytask1-1"},
+		TaskRuns: []*v1.TaskRun{
+			withTimes(makeSucceeded(trs[0]), time.Minute, 5*time.Minute),
+			withTimes(makeSucceeded(trs[0]), 0, 3*time.Minute),
+		},
+		ResolvedTask: &resources.ResolvedTask{
+			TaskSpec: &task.Spec,
+		},
+	}, {
+		PipelineTask: &pts[1],
+		TaskRunNames: []string{"pipelinerun-mytask2"},
+		TaskRuns:     []*v1.TaskRun{running},
+		ResolvedTask: &resources.ResolvedTask{
+			TaskSpec: &task.Spec,
+		},
+	}}
+	dagTasks := []v1.PipelineTask{pts[0], pts[1]}
+	d, err := dag.Build(v1.PipelineTaskList(dagTasks), v1.PipelineTaskList(dagTasks).Deps())
+	if err != nil {
+		t.Fatalf("Unexpected error while building graph for DAG tasks %v: %v", dagTasks, err)
+	}
+	facts := PipelineRunFacts{
+		State:           state,
+		TasksGraph:      d,
+		FinalTasksGraph: &dag.Graph{},
+		TimeoutsState: PipelineRunTimeoutsState{
+			Clock: testClock,
+		},
+	}
+
+	s := facts.GetPipelineTaskStatus(context.Background())
+	if d := cmp.Diff("2025-03-01T10:00:00Z", s[PipelineTaskStatusPrefix+pts[0].Name+PipelineTaskStartTimeSuffix]); d != "" {
+		t.Errorf("Unexpected start time of the pipelineTask %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff("2025-03-01T10:05:00Z", s[PipelineTaskStatusPrefix+pts[0].Name+PipelineTaskCompletionTimeSuffix]); d != "" {
+		t.Errorf("Unexpected completion time of the pipelineTask %s", diff.PrintWantGot(d))
+	}
+	for _, suffix := range []string{PipelineTaskStartTimeSuffix, PipelineTaskCompletionTimeSuffix} {
+		if value, ok := s[PipelineTaskStatusPrefix+pts[1].Name+suffix]; ok {
+			t.Errorf("Expected no %s for the running pipelineTask but got %q", suffix, value)
+		}
+	}
+}
+
+func TestPipelineRunFacts_GetPipelineTaskStatus_PodName(t *testing.T) {
+	withPodName := func(tr *v1.TaskRun, podName string) *v1.TaskRun {
+		tr.Status.PodName = podName
+		return tr
+	}
+	state := PipelineRunState{{
+		// the pod of the failed TaskRun of the pipelineTask is used
+		PipelineTask: &pts[0],
+		TaskRunNames: []string{"pipelinerun-mytask1-0", "pipelinerun-mytask1-1"},
+		TaskRuns: []*v1.TaskRun{
+			withPodName(makeSucceeded(trs[0]), "pipelinerun-mytask1-0-pod"),
+			withPodName(makeFailed(trs[0]), "pipelinerun-mytask1-1-pod"),
+		},
+		ResolvedTask: &resources.ResolvedTask{
+			TaskSpec: &task.Spec,
+		},
+	}, {
+		PipelineTask: &pts[1],
+		TaskRunNames: []string{"pipelinerun-mytask2"},
+		TaskRuns:     []*v1.TaskRun{withPodName(makeStarted(trs[1]), "pipelinerun-mytask2-pod")},
+		ResolvedTask: &resources.ResolvedTask{
+			TaskSpec: &task.Spec,
+		},
+	}}
+	dagTasks := []v1.PipelineTask{pts[0], pts[1]}
+	d, err := dag.Build(v1.PipelineTaskList(dagTasks), v1.PipelineTaskList(dagTasks).Deps())
+	if err != nil {
+		t.Fatalf("Unexpected error while building graph for DAG tasks %v: %v", dagTasks, err)
+	}
+	facts := PipelineRunFacts{
+		State:           state,
+		TasksGraph:      d,
+		FinalTasksGraph: &dag.Graph{},
+		TimeoutsState: PipelineRunTimeoutsState{
+			Clock: testClock,
+		},
+	}
+
+	s := facts.GetPipelineTaskStatus(context.Background())
+	if d := cmp.Diff("pipelinerun-mytask1-1-pod", s[PipelineTaskStatusPrefix+pts[0].Name+PipelineTaskPodNameSuffix]); d != "" {
+		t.Errorf("Unexpected pod name of the failed pipelineTask %s", diff.PrintWantGot(d))
+	}
+	if podName, ok := s[PipelineTaskStatusPrefix+pts[1].Name+PipelineTaskPodNameSuffix]; ok {
+		t.Errorf("Expected no pod name for the running pipelineTask but got %q", podName)
+	}
+}
+
 func TestPipelineRunFacts_GetPipelineFinalTaskStatus(t *testing.T) {
 	tcs := []struct {
 		name           string
@@ -2645,7 +2824,7 @@ func TestPipelineRunFacts_GetPipelineFinalTaskStatus(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			s := facts.GetPipelineFinalTaskStatus()
+			s := facts.GetPipelineFinalTaskStatus(context.Background())
 			if d := cmp.Diff(tc.expectedStatus, s); d != "" {
 				t.Fatalf("Test failed: %s Mismatch in pipelineFinalTask execution state %s", tc.name, diff.PrintWantGot(d))
 			}
@@ -2674,7 +2853,7 @@ func TestPipelineRunFacts_GetSkippedTasks(t *testing.T) {
 			Reason: v1.StoppingSkip,
 		}},
 	}, {
-		name: "missing-results-skip-finally",
+		name: "parent-tasks-failed-skip-finally",
 		state: PipelineRunState{{
 			TaskRunNames: []string{"task0taskrun"},
 			PipelineTask: &pts[0],
@@ -2684,6 +2863,21 @@ func TestPipelineRunFacts_GetSkippedTasks(t *testing.T) {
 		}},
 		dagTasks:     []v1.PipelineTask{pts[0]},
 		finallyTasks: []v1.PipelineTask{pts[14]},
+		expectedSkippedTasks: []v1.SkippedTask{{
+			Name:   pts[14].Name,
+			Reason: v1.ParentTasksFailedSkip,
+		}},
+	}, {
+		name: "missing-results-skip-finally",
+		state: PipelineRunState{{
+			TaskRunNames: []string{"task0taskrun"},
+			PipelineTask: &pts[0],
+			TaskRuns:     []*v1.TaskRun{makeSucceeded(trs[0])},
+		}, {
+			PipelineTask: &pts[14],
+		}},
+		dagTasks:     []v1.PipelineTask{pts[0]},
+		finallyTasks: []v1.PipelineTask{pts[14]},
 		expectedSkippedTasks: []v1.SkippedTask{{
 			Name:   pts[14].Name,
 			Reason: v1.MissingResultsSkip,
@@ -2721,7 +2915,7 @@ func TestPipelineRunFacts_GetSkippedTasks(t *testing.T) {
 					Clock: testClock,
 				},
 			}
-			actualSkippedTasks := facts.GetSkippedTasks()
+			actualSkippedTasks := facts.GetSkippedTasks(context.Background())
 			if d := cmp.Diff(tc.expectedSkippedTasks, actualSkippedTasks); d != "" {
 				t.Fatalf("Mismatch skipped tasks %s", diff.PrintWantGot(d))
 			}
@@ -3013,10 +3207,10 @@ func TestPipelineRunState_GetResultsFuncs(t *testing.T) {
 				}}},
 			},
 		}},
-		ResultsCache: map[string][]string{
+		ResultsCache: NewResultsCache(map[string][]string{
 			"browser":  {"chrome", "safari"},
 			"platform": {"linux"},
-		},
+		}),
 	}, {
 		CustomRunNames: []string{
 			"matrixed-run-0",
@@ -3116,8 +3310,9 @@ func TestPipelineRunState_GetResultsFuncs(t *testing.T) {
 		},
 	}}
 
-	expectedTaskResults := map[string][]v1.TaskRunResult{
-		"matrixed-task-with-results-cache": {{
+	expectedTaskResults := TaskRunsResults{{
+		PipelineTaskName: "matrixed-task-with-results-cache",
+		Results: []v1.TaskRunResult{{
 			Name:  "browser",
 			Type:  "array",
 			Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"chrome", "safari"}},
@@ -3126,15 +3321,18 @@ func TestPipelineRunState_GetResultsFuncs(t *testing.T) {
 			Type:  "array",
 			Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"linux"}},
 		}},
-		"successful-task-without-results-1": nil,
-		"successful-task-with-results-1": {{
+	}, {
+		PipelineTaskName: "successful-task-with-results-1",
+		Results: []v1.TaskRunResult{{
 			Name:  "foo",
 			Value: *v1.NewStructuredValues("oof"),
 		}, {
 			Name:  "bar",
 			Value: *v1.NewStructuredValues("rab"),
 		}},
-	}
+	}, {
+		PipelineTaskName: "successful-task-without-results-1",
+	}}
 	expectedRunResults := map[string][]v1beta1.CustomRunResult{
 		"successful-run-without-results-1": nil,
 		"successful-run-with-results-1": {{
@@ -3147,11 +3345,8 @@ func TestPipelineRunState_GetResultsFuncs(t *testing.T) {
 	}
 
 	actualTaskResults := state.GetTaskRunsResults()
-	sortTaskRunResults := func(x, y v1.TaskRunResult) bool {
-		return x.Name < y.Name
-	}
-	if d := cmp.Diff(actualTaskResults, expectedTaskResults, cmpopts.SortSlices(sortTaskRunResults)); d != "" {
-		t.Errorf("Didn't get expected TaskRun results map: %s", diff.PrintWantGot(d))
+	if d := cmp.Diff(expectedTaskResults, actualTaskResults); d != "" {
+		t.Errorf("Didn't get expected TaskRun results: %s", diff.PrintWantGot(d))
 	}
 
 	actualRunResults := state.GetRunsResults()
@@ -4440,7 +4635,7 @@ func TestPipelineRunState_GetChildReferences(t *testing.T) {
 				TimeoutsState: PipelineRunTimeoutsState{
 					Clock: testClock,
 				},
-			}).GetChildReferences()
+			}).GetChildReferences(context.Background())
 			if d := cmp.Diff(tc.childRefs, childRefs); d != "" {
 				t.Errorf("Didn't get expected child references for %s: %s", tc.name, diff.PrintWantGot(d))
 			}
@@ -25,6 +25,7 @@ import (
 	pipelineErrors "github.com/tektoncd/pipeline/pkg/apis/pipeline/errors"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 )
 
 // ErrInvalidTaskResultReference indicates that the reason for the failure status is that there
@@ -42,6 +43,7 @@ type ResolvedResultRef struct {
 	ResultReference v1.ResultRef
 	FromTaskRun     string
 	FromRun         string
+	FromPipelineRun string
 }
 
 // ResolveResultRef resolves any ResultReference that are found in the target ResolvedPipelineTask
@@ -78,6 +80,29 @@ func validateArrayResultsIndex(allResolvedResultRefs ResolvedResultRefs) error {
 	return nil
 }
 
+// Deduplicate collapses the ResolvedResultRefs which resolve the same result variable, i.e.
+// $(tasks.<pipelineTask>.results.<result>), into a single entry, keeping the first occurrence.
+// An error is returned if two entries resolve the same variable to different values.
+func (rs ResolvedResultRefs) Deduplicate() (ResolvedResultRefs, error) {
+	if rs == nil {
+		return nil, nil
+	}
+	deduped := make(ResolvedResultRefs, 0, len(rs))
+	seen := make(map[string]*ResolvedResultRef, len(rs))
+	for _, r := range rs {
+		variable := r.getReplaceTarget()[0]
+		if existing, ok := seen[variable]; ok {
+			if !equality.Semantic.DeepEqual(existing.Value, r.Value) {
+				return nil, SubstitutionError{Variable: variable, Reason: fmt.Sprintf("resolved to conflicting values %v and %v", existing.Value, r.Value)}
+			}
+			continue
+		}
+		seen[variable] = r
+		deduped = append(deduped, r)
+	}
+	return deduped, nil
+}
+
 func removeDup(refs ResolvedResultRefs) ResolvedResultRefs {
 	if refs == nil {
 		return nil
@@ -123,38 +148,80 @@ func convertToResultRefs(pipelineRunState PipelineRunState, target *ResolvedPipe
 		if !referencedPipelineTask.isSuccessful() && !referencedPipelineTask.isFailure() {
 			return nil, resultRef.PipelineTask, fmt.Errorf("task %q referenced by result was not finished", referencedPipelineTask.PipelineTask.Name)
 		}
-		// Custom Task
-		switch {
-		case referencedPipelineTask.IsCustomTask():
-			resolved, err := resolveCustomResultRef(referencedPipelineTask.CustomRuns, resultRef)
-			if err != nil {
-				return nil, resultRef.PipelineTask, err
-			}
-			resolvedResultRefs = append(resolvedResultRefs, resolved)
-		default:
-			// Matrixed referenced Pipeline Task
-			if referencedPipelineTask.PipelineTask.IsMatrixed() {
-				arrayValues, err := findResultValuesForMatrix(referencedPipelineTask, resultRef)
-				if err != nil {
-					return nil, resultRef.PipelineTask, err
-				}
-				for _, taskRun := range referencedPipelineTask.TaskRuns {
-					resolved := createMatrixedTaskResultForParam(taskRun.Name, arrayValues, resultRef)
-					resolvedResultRefs = append(resolvedResultRefs, resolved)
-				}
-			} else {
-				// Regular PipelineTask
-				resolved, err := resolveResultRef(referencedPipelineTask.TaskRuns, resultRef)
-				if err != nil {
-					return nil, resultRef.PipelineTask, err
-				}
-				resolvedResultRefs = append(resolvedResultRefs, resolved)
-			}
+		resolved, err := resolveResultRefFromTask(referencedPipelineTask, resultRef)
+		if err != nil {
+			return nil, resultRef.PipelineTask, err
 		}
+		resolvedResultRefs = append(resolvedResultRefs, resolved...)
 	}
 	return resolvedResultRefs, "", nil
 }
 
+// resolveResultRefFromTask resolves the result reference to the value(s) of the result
+// emitted by the finished referencedPipelineTask.
+func resolveResultRefFromTask(referencedPipelineTask *ResolvedPipelineTask, resultRef *v1.ResultRef) (ResolvedResultRefs, error) {
+	switch {
+	// Pipeline Task referencing a Pipeline
+	case referencedPipelineTask.IsChildPipeline():
+		return resolveChildPipelineResultRef(referencedPipelineTask, resultRef)
+	// Custom Task
+	case referencedPipelineTask.IsCustomTask():
+		resolved, err := resolveCustomResultRef(referencedPipelineTask.CustomRuns, resultRef)
+		if err != nil {
+			return nil, err
+		}
+		return ResolvedResultRefs{resolved}, nil
+	// Matrixed referenced Pipeline Task
+	case referencedPipelineTask.PipelineTask.IsMatrixed():
+		arrayValues, err := findResultValuesForMatrix(referencedPipelineTask, resultRef)
+		if err != nil {
+			return nil, err
+		}
+		var resolvedResultRefs ResolvedResultRefs
+		for _, taskRun := range referencedPipelineTask.TaskRuns {
+			resolvedResultRefs = append(resolvedResultRefs, createMatrixedTaskResultForParam(taskRun.Name, arrayValues, resultRef))
+		}
+		return resolvedResultRefs, nil
+	// Regular PipelineTask
+	default:
+		resolved, err := resolveResultRef(referencedPipelineTask.TaskRuns, resultRef)
+		if err != nil {
+			return nil, err
+		}
+		return ResolvedResultRefs{resolved}, nil
+	}
+}
+
+// resolveChildPipelineResultRef resolves the result reference to the value of the result of the child PipelineRun,
+// or to the array of the values of the results fanned in from the child PipelineRuns of a matrixed PipelineTask.
+func resolveChildPipelineResultRef(referencedPipelineTask *ResolvedPipelineTask, resultRef *v1.ResultRef) (ResolvedResultRefs, error) {
+	if referencedPipelineTask.PipelineTask.IsMatrixed() {
+		arrayValues, err := findResultValuesForMatrix(referencedPipelineTask, resultRef)
+		if err != nil {
+			return nil, err
+		}
+		var resolvedResultRefs ResolvedResultRefs
+		for _, pipelineRun := range referencedPipelineTask.ChildPipelineRuns {
+			resolvedResultRefs = append(resolvedResultRefs, &ResolvedResultRef{
+				Value:           arrayValues,
+				FromPipelineRun: pipelineRun.Name,
+				ResultReference: *resultRef,
+			})
+		}
+		return resolvedResultRefs, nil
+	}
+	pipelineRun := referencedPipelineTask.ChildPipelineRuns[0]
+	resultValue, err := findPipelineResultForParam(pipelineRun, resultRef)
+	if err != nil {
+		return nil, err
+	}
+	return ResolvedResultRefs{{
+		Value:           resultValue,
+		FromPipelineRun: pipelineRun.Name,
+		ResultReference: *resultRef,
+	}}, nil
+}
+
 func resolveCustomResultRef(customRuns []*v1beta1.CustomRun, resultRef *v1.ResultRef) (*ResolvedResultRef, error) {
 	customRun := customRuns[0]
 	runName := customRun.GetObjectMeta().GetName()
@@ -221,16 +288,20 @@ func findTaskResultForParam(taskRun *v1.TaskRun, reference *v1.ResultRef) (v1.Re
 	return v1.ResultValue{}, err
 }
 
-// findResultValuesForMatrix checks the resultsCache of the referenced Matrixed TaskRun to retrieve the resultValues and aggregate them into
-// arrayValues. If the resultCache is empty, it will create the ResultCache so that the results can be accessed in subsequent tasks.
-func findResultValuesForMatrix(referencedPipelineTask *ResolvedPipelineTask, resultRef *v1.ResultRef) (v1.ParamValue, error) {
-	var resultsCache *map[string][]string
-	if len(referencedPipelineTask.ResultsCache) == 0 {
-		cache := createResultsCacheMatrixedTaskRuns(referencedPipelineTask)
-		resultsCache = &cache
-		referencedPipelineTask.ResultsCache = *resultsCache
+func findPipelineResultForParam(pipelineRun *v1.PipelineRun, reference *v1.ResultRef) (v1.ResultValue, error) {
+	for _, result := range pipelineRun.Status.Results {
+		if result.Name == reference.Result {
+			return result.Value, nil
+		}
 	}
-	if arrayValues, ok := referencedPipelineTask.ResultsCache[resultRef.Result]; ok {
+	err := fmt.Errorf("%w: Could not find result with name %s for task %s", ErrInvalidTaskResultReference, reference.Result, reference.PipelineTask)
+	return v1.ResultValue{}, err
+}
+
+// findResultValuesForMatrix checks the ResultsCache of the referenced Matrixed TaskRun to retrieve the resultValues and aggregate them into
+// arrayValues. The ResultsCache is populated on first use so that the results can be accessed in subsequent tasks.
+func findResultValuesForMatrix(referencedPipelineTask *ResolvedPipelineTask, resultRef *v1.ResultRef) (v1.ParamValue, error) {
+	if arrayValues, ok := referencedPipelineTask.GetResultsCache().Get(resultRef.Result); ok {
 		return v1.ParamValue{
 			Type:     v1.ParamTypeArray,
 			ArrayVal: arrayValues,
@@ -272,6 +343,11 @@ func (rs ResolvedResultRefs) getStringReplacements() map[string]string {
 			for _, target := range r.getReplaceTarget() {
 				replacements[target] = r.Value.StringVal
 			}
+			for key, element := range r.runObjectValue() {
+				for _, target := range r.getReplaceTargetfromObjectKey(key) {
+					replacements[target] = element
+				}
+			}
 		}
 	}
 	return replacements
@@ -301,6 +377,17 @@ func (rs ResolvedResultRefs) getObjectReplacements() map[string]map[string]strin
 	return replacements
 }
 
+// runObjectValue returns the object held by the string result of a CustomRun when it is a JSON-encoded object of
+// strings, so that its keys can be referenced like the keys of an object result. The whole result is still
+// substituted as a string, not as an object, so that the type of the params referencing it doesn't change. It
+// returns nil otherwise, e.g. for the results of the TaskRuns.
+func (r *ResolvedResultRef) runObjectValue() map[string]string {
+	if r.FromRun == "" || r.Value.Type != v1.ParamTypeString {
+		return nil
+	}
+	return objectFromRunResult(r.Value.StringVal)
+}
+
 func (r *ResolvedResultRef) getReplaceTarget() []string {
 	return []string{
 		fmt.Sprintf("%s.%s.%s.%s", v1.ResultTaskPart, r.ResultReference.PipelineTask, v1.ResultResultPart, r.ResultReference.Result),
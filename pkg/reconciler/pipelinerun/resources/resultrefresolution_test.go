@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -986,3 +987,88 @@ func TestParamValueFromCustomRunResult(t *testing.T) {
 		})
 	}
 }
+
+func TestResolvedResultRefs_Deduplicate(t *testing.T) {
+	idx := 1
+	for _, tt := range []struct {
+		name string
+		refs ResolvedResultRefs
+		want ResolvedResultRefs
+	}{{
+		name: "nil refs",
+	}, {
+		name: "no duplicates",
+		refs: ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("a"),
+			ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult"},
+			FromTaskRun:     "aTaskRun",
+		}, {
+			Value:           *v1.NewStructuredValues("b"),
+			ResultReference: v1.ResultRef{PipelineTask: "bTask", Result: "aResult"},
+			FromTaskRun:     "bTaskRun",
+		}},
+		want: ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("a"),
+			ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult"},
+			FromTaskRun:     "aTaskRun",
+		}, {
+			Value:           *v1.NewStructuredValues("b"),
+			ResultReference: v1.ResultRef{PipelineTask: "bTask", Result: "aResult"},
+			FromTaskRun:     "bTaskRun",
+		}},
+	}, {
+		name: "duplicates of the same result variable are collapsed",
+		refs: ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("a", "b"),
+			ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult"},
+			FromTaskRun:     "aTaskRun-0",
+		}, {
+			Value:           *v1.NewStructuredValues("a", "b"),
+			ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult", ResultsIndex: &idx},
+			FromTaskRun:     "aTaskRun-1",
+		}},
+		want: ResolvedResultRefs{{
+			Value:           *v1.NewStructuredValues("a", "b"),
+			ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult"},
+			FromTaskRun:     "aTaskRun-0",
+		}},
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.refs.Deduplicate()
+			if err != nil {
+				t.Fatalf("Deduplicate() unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("Deduplicate() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestResolvedResultRefs_Deduplicate_Error(t *testing.T) {
+	refs := ResolvedResultRefs{{
+		Value:           *v1.NewStructuredValues("a"),
+		ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult"},
+		FromTaskRun:     "aTaskRun",
+	}, {
+		Value:           *v1.NewStructuredValues("b"),
+		ResultReference: v1.ResultRef{PipelineTask: "aTask", Result: "aResult"},
+		FromRun:         "aRun",
+	}}
+	if _, err := refs.Deduplicate(); err == nil {
+		t.Fatal("Deduplicate() expected error for conflicting values but got none")
+	}
+	targets := PipelineRunState{{
+		PipelineTask: &v1.PipelineTask{
+			Name:   "bTask",
+			Params: v1.Params{{Name: "p", Value: *v1.NewStructuredValues("$(tasks.aTask.results.aResult)")}},
+		},
+	}}
+	want := targets[0].PipelineTask.DeepCopy()
+	if _, err := ApplyTaskResults(context.Background(), targets, refs); err == nil {
+		t.Fatal("ApplyTaskResults() expected error for conflicting values but got none")
+	}
+	if d := cmp.Diff(want, targets[0].PipelineTask); d != "" {
+		t.Errorf("ApplyTaskResults() should not apply conflicting results %s", diff.PrintWantGot(d))
+	}
+}
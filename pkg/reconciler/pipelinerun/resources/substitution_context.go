@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/substitution"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// SubstitutionSourceParams is the source of the replacements of the params of the PipelineRun and their defaults.
+	SubstitutionSourceParams = "Params"
+	// SubstitutionSourceContext is the source of the replacements of the $(context.(pipelineRun|pipeline).*) variables.
+	SubstitutionSourceContext = "Context"
+	// SubstitutionSourceWorkspaces is the source of the replacements of the $(workspaces.<name>.*) variables.
+	SubstitutionSourceWorkspaces = "Workspaces"
+	// SubstitutionSourceTaskResults is the source of the replacements of the results of the pipeline tasks.
+	SubstitutionSourceTaskResults = "TaskResults"
+)
+
+// SubstitutionContext holds the string, array and object replacements applied by a step of the substitution of
+// the variables of a PipelineSpec, and where their values come from. Each step links to the context of the step
+// applied before it, so that the full history of the substitutions can be walked from the last one.
+type SubstitutionContext struct {
+	StringReplacements map[string]string
+	ArrayReplacements  map[string][]string
+	ObjectReplacements map[string]map[string]string
+	// Source is where the values of the replacements come from, e.g. SubstitutionSourceParams.
+	Source string
+	// Previous is the context of the substitution step applied before this one, nil for the first step.
+	Previous *SubstitutionContext
+}
+
+// NewSubstitutionContext returns an empty SubstitutionContext for the given source, following previous which
+// may be nil.
+func NewSubstitutionContext(source string, previous *SubstitutionContext) *SubstitutionContext {
+	return &SubstitutionContext{
+		StringReplacements: map[string]string{},
+		ArrayReplacements:  map[string][]string{},
+		ObjectReplacements: map[string]map[string]string{},
+		Source:             source,
+		Previous:           previous,
+	}
+}
+
+// History returns the contexts of the substitution steps up to sc, the first step applied first. It returns
+// nil if sc is nil.
+func (sc *SubstitutionContext) History() []*SubstitutionContext {
+	var history []*SubstitutionContext
+	for c := sc; c != nil; c = c.Previous {
+		history = append([]*SubstitutionContext{c}, history...)
+	}
+	return history
+}
+
+// escaped returns a copy of the context with the values of the replacements escaped by
+// substitution.EscapeSubstitutionResult, so that they are not substituted again by the later substitutions.
+func (sc *SubstitutionContext) escaped() *SubstitutionContext {
+	escaped := NewSubstitutionContext(sc.Source, sc.Previous)
+	for k, v := range sc.StringReplacements {
+		escaped.StringReplacements[k] = substitution.EscapeSubstitutionResult(v)
+	}
+	for k, values := range sc.ArrayReplacements {
+		escapedValues := make([]string, len(values))
+		for i, v := range values {
+			escapedValues[i] = substitution.EscapeSubstitutionResult(v)
+		}
+		escaped.ArrayReplacements[k] = escapedValues
+	}
+	for k, object := range sc.ObjectReplacements {
+		escapedObject := make(map[string]string, len(object))
+		for key, v := range object {
+			escapedObject[key] = substitution.EscapeSubstitutionResult(v)
+		}
+		escaped.ObjectReplacements[k] = escapedObject
+	}
+	return escaped
+}
+
+// has returns true if the context has a replacement for the variable, e.g. params.foo, params.foo[*] or
+// params.foo[0] for the array param foo.
+func (sc *SubstitutionContext) has(variable string) bool {
+	if _, ok := sc.StringReplacements[variable]; ok {
+		return true
+	}
+	variable = strings.TrimSuffix(variable, "[*]")
+	if _, ok := sc.ArrayReplacements[variable]; ok {
+		return true
+	}
+	_, ok := sc.ObjectReplacements[variable]
+	return ok
+}
+
+// withoutParams returns a copy of the context without the replacements of the given params, including the ones
+// of the keys of object params, e.g. params.foo.key. sc is not modified.
+func (sc *SubstitutionContext) withoutParams(names sets.Set[string]) *SubstitutionContext {
+	if names.Len() == 0 {
+		return sc
+	}
+	isScoped := func(variable string) bool {
+		for name := range names {
+			for _, pattern := range paramPatterns {
+				reference := fmt.Sprintf(pattern, name)
+				if variable == reference || strings.HasPrefix(variable, reference+".") || strings.HasPrefix(variable, reference+"[") {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return &SubstitutionContext{
+		StringReplacements: withoutVariables(sc.StringReplacements, isScoped),
+		ArrayReplacements:  withoutVariables(sc.ArrayReplacements, isScoped),
+		ObjectReplacements: withoutVariables(sc.ObjectReplacements, isScoped),
+		Source:             sc.Source,
+		Previous:           sc.Previous,
+	}
+}
+
+// withoutVariables returns a copy of the replacements without the variables for which exclude returns true.
+func withoutVariables[V any](replacements map[string]V, exclude func(string) bool) map[string]V {
+	kept := make(map[string]V, len(replacements))
+	for k, v := range replacements {
+		if !exclude(k) {
+			kept[k] = v
+		}
+	}
+	return kept
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSubstitutionContext_History(t *testing.T) {
+	var nilContext *resources.SubstitutionContext
+	if history := nilContext.History(); history != nil {
+		t.Errorf("History() of a nil SubstitutionContext, expected nil but got %v", history)
+	}
+
+	params := resources.NewSubstitutionContext(resources.SubstitutionSourceParams, nil)
+	contexts := resources.NewSubstitutionContext(resources.SubstitutionSourceContext, params)
+	workspaces := resources.NewSubstitutionContext(resources.SubstitutionSourceWorkspaces, contexts)
+	want := []*resources.SubstitutionContext{params, contexts, workspaces}
+	if d := cmp.Diff(want, workspaces.History()); d != "" {
+		t.Errorf("History() %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestSubstitutionContext_ApplyChain(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params:     v1.ParamSpecs{{Name: "revision", Type: v1.ParamTypeString}},
+		Workspaces: []v1.PipelineWorkspaceDeclaration{{Name: "source"}},
+		Tasks: []v1.PipelineTask{{
+			Name:    "build",
+			TaskRef: &v1.TaskRef{Name: "build"},
+			Params: v1.Params{{
+				Name:  "revision",
+				Value: *v1.NewStructuredValues("$(params.revision)"),
+			}, {
+				Name:  "run",
+				Value: *v1.NewStructuredValues("$(context.pipelineRun.name)"),
+			}, {
+				Name:  "bound",
+				Value: *v1.NewStructuredValues("$(workspaces.source.bound)"),
+			}},
+		}},
+	}
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"},
+		Spec: v1.PipelineRunSpec{
+			Params: v1.Params{{Name: "revision", Value: *v1.NewStructuredValues("v1")}},
+		},
+	}
+
+	spec, sc, err := resources.ApplyParameters(context.Background(), ps, pr)
+	if err != nil {
+		t.Fatalf("ApplyParameters() unexpected error: %v", err)
+	}
+	spec, sc = resources.ApplyContexts(context.Background(), spec, &v1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline"}}, pr, sc)
+	spec, sc = resources.ApplyWorkspaces(spec, pr, sc)
+
+	wantParams := v1.Params{{
+		Name:  "revision",
+		Value: *v1.NewStructuredValues("v1"),
+	}, {
+		Name:  "run",
+		Value: *v1.NewStructuredValues("pr"),
+	}, {
+		Name:  "bound",
+		Value: *v1.NewStructuredValues("false"),
+	}}
+	if d := cmp.Diff(wantParams, spec.Tasks[0].Params); d != "" {
+		t.Errorf("substituted params %s", diff.PrintWantGot(d))
+	}
+
+	var sources []string
+	for _, step := range sc.History() {
+		sources = append(sources, step.Source)
+	}
+	wantSources := []string{resources.SubstitutionSourceParams, resources.SubstitutionSourceContext, resources.SubstitutionSourceWorkspaces}
+	if d := cmp.Diff(wantSources, sources); d != "" {
+		t.Errorf("History() sources %s", diff.PrintWantGot(d))
+	}
+	history := sc.History()
+	if got := history[0].StringReplacements["params.revision"]; got != "v1" {
+		t.Errorf("expected the params step to record params.revision=v1 but got %q", got)
+	}
+	if got := history[1].StringReplacements["context.pipelineRun.name"]; got != "pr" {
+		t.Errorf("expected the context step to record context.pipelineRun.name=pr but got %q", got)
+	}
+	if got := history[2].StringReplacements["workspaces.source.bound"]; got != "false" {
+		t.Errorf("expected the workspaces step to record workspaces.source.bound=false but got %q", got)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	trresources "github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
+)
+
+// ValidateSubstitutionPolicy returns an error if the embedded TaskSpecs of the PipelineSpec reference a variable,
+// e.g. $(context.pipelineRun.name), which the policy denies substituting in the referencing field. The variables
+// of the Pipeline are substituted in the embedded TaskSpecs before their TaskRuns are created, so they cannot be
+// checked by the TaskRuns.
+func ValidateSubstitutionPolicy(policy *config.SubstitutionPolicy, ps *v1.PipelineSpec) error {
+	for _, pt := range slices.Concat(ps.Tasks, ps.Finally) {
+		if pt.TaskSpec == nil {
+			continue
+		}
+		if err := trresources.ValidateSubstitutionPolicy(policy, &pt.TaskSpec.TaskSpec); err != nil {
+			return fmt.Errorf("pipeline task %q: %w", pt.Name, err)
+		}
+	}
+	return nil
+}
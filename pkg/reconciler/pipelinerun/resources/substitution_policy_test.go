@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+)
+
+func TestValidateSubstitutionPolicy(t *testing.T) {
+	policy := &config.SubstitutionPolicy{
+		DeniedVariablePatterns: []string{`^context\.pipelineRun\.name$`},
+	}
+	for _, tc := range []struct {
+		name    string
+		spec    *v1.PipelineSpec
+		wantErr string
+	}{{
+		name: "denied variable in the embedded task spec of a finally task",
+		spec: &v1.PipelineSpec{
+			Tasks: []v1.PipelineTask{{
+				Name:    "build",
+				TaskRef: &v1.TaskRef{Name: "build"},
+			}},
+			Finally: []v1.PipelineTask{{
+				Name: "notify",
+				TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+					Steps: []v1.Step{{
+						Name:   "notify",
+						Script: "notify $(context.pipelineRun.name)",
+					}},
+				}},
+			}},
+		},
+		wantErr: `pipeline task "notify": step 0 "notify": substituting $(context.pipelineRun.name) in steps[].script is denied by the substitution policy`,
+	}, {
+		name: "denied variable in the params of a pipeline task",
+		spec: &v1.PipelineSpec{
+			Tasks: []v1.PipelineTask{{
+				Name:    "build",
+				TaskRef: &v1.TaskRef{Name: "build"},
+				Params: v1.Params{{
+					Name:  "run",
+					Value: *v1.NewStructuredValues("$(context.pipelineRun.name)"),
+				}},
+			}},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resources.ValidateSubstitutionPolicy(policy, tc.spec)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", tc.wantErr)
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
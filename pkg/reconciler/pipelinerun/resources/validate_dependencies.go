@@ -66,10 +66,10 @@ func validateResultRef(ref *v1.ResultRef, ptMap map[string]*ResolvedPipelineTask
 		return fmt.Errorf("referenced pipeline task %q does not exist", ref.PipelineTask)
 	}
 	taskProvidesResult := false
-	if ptMap[ref.PipelineTask].CustomTask {
-		// We're not able to validate results pointing to custom tasks because
-		// there's no facility to check what the result names will be before the
-		// custom task executes.
+	if ptMap[ref.PipelineTask].CustomTask || ptMap[ref.PipelineTask].IsChildPipeline() {
+		// We're not able to validate results pointing to custom tasks or child pipelines
+		// because there's no facility to check what the result names will be before the
+		// custom task or the child pipeline executes.
 		return nil
 	}
 	if ptMap[ref.PipelineTask].ResolvedTask == nil || ptMap[ref.PipelineTask].ResolvedTask.TaskSpec == nil {
@@ -24,9 +24,15 @@ import (
 	"github.com/tektoncd/pipeline/pkg/list"
 	"github.com/tektoncd/pipeline/pkg/reconciler/taskrun"
 	trresources "github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/version"
 )
 
-// ValidateParamTypesMatching validate that parameters in PipelineRun override corresponding parameters in Pipeline of the same type.
+// PipelineVersionLabelKey is the label holding the version of a Pipeline, which is compared to the versions in which
+// its deprecated params are removed.
+const PipelineVersionLabelKey = "app.kubernetes.io/version"
+
+// ValidateParamTypesMatching validate that parameters and parameter defaults in PipelineRun override corresponding parameters in Pipeline of the same type.
 func ValidateParamTypesMatching(p *v1.PipelineSpec, pr *v1.PipelineRun) error {
 	// Build a map of parameter names/types declared in p.
 	paramTypes := make(map[string]v1.ParamType)
@@ -43,6 +49,13 @@ func ValidateParamTypesMatching(p *v1.PipelineSpec, pr *v1.PipelineRun) error {
 			}
 		}
 	}
+	for _, param := range pr.Spec.ParamDefaults {
+		if paramType, ok := paramTypes[param.Name]; ok && param.Default != nil {
+			if param.Default.Type != paramType {
+				wrongTypeParamNames = append(wrongTypeParamNames, param.Name)
+			}
+		}
+	}
 
 	// Return an error with the misconfigured parameters' names, or return nil if there are none.
 	if len(wrongTypeParamNames) != 0 {
@@ -77,6 +90,61 @@ func ValidateRequiredParametersProvided(pipelineParameters *v1.ParamSpecs, pipel
 	return nil
 }
 
+// UndeclaredParams returns the names of the params provided by the PipelineRun which are not declared in the
+// PipelineSpec, in the order of the PipelineRun. They are still used for substitutions. The params of a
+// PipelineRun with an embedded PipelineSpec are propagated on purpose, so none are returned for it.
+func UndeclaredParams(ps *v1.PipelineSpec, pr *v1.PipelineRun) []string {
+	if pr.Spec.PipelineSpec != nil {
+		return nil
+	}
+	declared := sets.NewString(ps.Params.GetNames()...)
+	var undeclared []string
+	for _, p := range pr.Spec.Params {
+		if !declared.Has(p.Name) {
+			undeclared = append(undeclared, p.Name)
+		}
+	}
+	return undeclared
+}
+
+// ValidateRemovedParams validates that the PipelineRun doesn't provide any of the deprecated parameters which are
+// removed in the given version of the Pipeline, or before it. Nothing is validated if the version of the Pipeline is
+// unknown or can't be parsed.
+func ValidateRemovedParams(ps *v1.PipelineSpec, pipelineVersion string, params v1.Params) error {
+	current, err := version.ParseGeneric(pipelineVersion)
+	if err != nil {
+		return nil //nolint:nilerr // the Pipelines without a valid version don't remove their deprecated params
+	}
+	provided := params.ExtractNames()
+	var removedParams []string
+	for _, param := range ps.Params {
+		if param.Deprecated == nil || param.Deprecated.RemovedIn == "" || !provided.Has(param.Name) {
+			continue
+		}
+		// the versions which can't be parsed are rejected by the validation of the Pipeline
+		if removedIn, err := version.ParseGeneric(param.Deprecated.RemovedIn); err == nil && current.AtLeast(removedIn) {
+			removedParams = append(removedParams, param.Name)
+		}
+	}
+	if len(removedParams) != 0 {
+		return pipelineErrors.WrapUserError(fmt.Errorf("pipelineRun provides parameters removed in version %s of the pipeline: %s", pipelineVersion, removedParams))
+	}
+	return nil
+}
+
+// DeprecatedParams returns the deprecation warnings of the params declared in the PipelineSpec which are provided
+// by the PipelineRun, in the order of the PipelineSpec.
+func DeprecatedParams(ps *v1.PipelineSpec, params v1.Params) []string {
+	provided := params.ExtractNames()
+	var warnings []string
+	for i := range ps.Params {
+		if warning := ps.Params[i].DeprecationWarning(); warning != "" && provided.Has(ps.Params[i].Name) {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
 // ValidateObjectParamRequiredKeys validates that the required keys of all the object parameters expected by the Pipeline are provided by the PipelineRun.
 func ValidateObjectParamRequiredKeys(pipelineParameters []v1.ParamSpec, pipelineRunParameters []v1.Param) error {
 	missings := taskrun.MissingKeysObjectParamNames(pipelineParameters, pipelineRunParameters)
@@ -75,6 +75,7 @@ func TestValidateParamTypesMatching_Invalid(t *testing.T) {
 		description string
 		pp          []v1.ParamSpec
 		prp         []v1.Param
+		prd         []v1.ParamSpec
 	}{{
 		name: "string-array mismatch",
 		pp: []v1.ParamSpec{
@@ -99,12 +100,24 @@ func TestValidateParamTypesMatching_Invalid(t *testing.T) {
 			{Name: "correct-type-2", Value: arrayValue},
 			{Name: "incorrect-type", Value: stringValue},
 		},
+	}, {
+		name: "param defaults mismatch",
+		pp: []v1.ParamSpec{
+			{Name: "correct-type-1", Type: v1.ParamTypeString},
+			{Name: "incorrect-type", Type: v1.ParamTypeString},
+		},
+		prp: v1.Params{
+			{Name: "correct-type-1", Value: stringValue},
+		},
+		prd: v1.ParamSpecs{
+			{Name: "incorrect-type", Type: v1.ParamTypeArray, Default: &arrayValue},
+		},
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			ps := &v1.PipelineSpec{Params: tc.pp}
 			pr := &v1.PipelineRun{
 				ObjectMeta: metav1.ObjectMeta{Name: "pipeline"},
-				Spec:       v1.PipelineRunSpec{Params: tc.prp},
+				Spec:       v1.PipelineRunSpec{Params: tc.prp, ParamDefaults: tc.prd},
 			}
 
 			if err := resources.ValidateParamTypesMatching(ps, pr); err == nil {
@@ -190,6 +203,128 @@ func TestValidateRequiredParametersProvided_Invalid(t *testing.T) {
 	}
 }
 
+func TestValidateRemovedParams(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params: []v1.ParamSpec{{
+			Name: "image",
+			Type: v1.ParamTypeString,
+		}, {
+			Name:       "old-image",
+			Type:       v1.ParamTypeString,
+			Default:    v1.NewStructuredValues(""),
+			Deprecated: &v1.ParamDeprecation{Message: "use image instead", RemovedIn: "v1.5"},
+		}, {
+			Name:       "registry",
+			Type:       v1.ParamTypeString,
+			Default:    v1.NewStructuredValues(""),
+			Deprecated: &v1.ParamDeprecation{Message: "the registry is part of the image"},
+		}},
+	}
+	params := v1.Params{
+		{Name: "image", Value: *v1.NewStructuredValues("app")},
+		{Name: "old-image", Value: *v1.NewStructuredValues("app")},
+		{Name: "registry", Value: *v1.NewStructuredValues("registry.example.com")},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		pipelineVersion string
+		params          v1.Params
+		wantErr         string
+	}{{
+		name:            "pipeline without version",
+		pipelineVersion: "",
+		params:          params,
+	}, {
+		name:            "pipeline with an invalid version",
+		pipelineVersion: "latest",
+		params:          params,
+	}, {
+		name:            "pipeline version before the removal",
+		pipelineVersion: "v1.4.2",
+		params:          params,
+	}, {
+		name:            "removed params not provided",
+		pipelineVersion: "v1.5",
+		params:          v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app")}},
+	}, {
+		name:            "pipeline version of the removal",
+		pipelineVersion: "1.5.0",
+		params:          params,
+		wantErr:         "pipelineRun provides parameters removed in version 1.5.0 of the pipeline: [old-image]",
+	}, {
+		name:            "pipeline version after the removal",
+		pipelineVersion: "v2.0",
+		params:          params,
+		wantErr:         "pipelineRun provides parameters removed in version v2.0 of the pipeline: [old-image]",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resources.ValidateRemovedParams(ps, tc.pipelineVersion, tc.params)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("Didn't expect to see error when validating the removed parameters but got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected to see error %q when validating the removed parameters but saw none", tc.wantErr)
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestUndeclaredParams(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params: []v1.ParamSpec{{Name: "declared", Type: v1.ParamTypeString}},
+	}
+	for _, tc := range []struct {
+		name string
+		pr   *v1.PipelineRun
+		want []string
+	}{{
+		name: "all params declared",
+		pr: &v1.PipelineRun{
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+				Params:      v1.Params{{Name: "declared", Value: *v1.NewStructuredValues("value")}},
+			},
+		},
+	}, {
+		name: "undeclared params",
+		pr: &v1.PipelineRun{
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+				Params: v1.Params{
+					{Name: "extra", Value: *v1.NewStructuredValues("value")},
+					{Name: "declared", Value: *v1.NewStructuredValues("value")},
+					{Name: "extra-array", Value: *v1.NewStructuredValues("a", "b")},
+				},
+			},
+		},
+		want: []string{"extra", "extra-array"},
+	}, {
+		name: "params propagated to embedded pipeline spec",
+		pr: &v1.PipelineRun{
+			Spec: v1.PipelineRunSpec{
+				PipelineSpec: ps,
+				Params: v1.Params{
+					{Name: "declared", Value: *v1.NewStructuredValues("value")},
+					{Name: "extra", Value: *v1.NewStructuredValues("value")},
+				},
+			},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := cmp.Diff(tc.want, resources.UndeclaredParams(ps, tc.pr)); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestValidateObjectParamRequiredKeys_Invalid(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -363,6 +498,21 @@ func TestValidatePipelineParameterTypes(t *testing.T) {
 			},
 		}},
 		wantErrs: "parameters of type array only are allowed, but param \"foo\" has type \"string\" in pipelineTask \"task\"",
+	}, {
+		desc: "parameter in matrix is an object",
+		state: resources.PipelineRunState{{
+			PipelineTask: &v1.PipelineTask{
+				Name: "task",
+				Matrix: &v1.Matrix{
+					Params: v1.Params{{
+						Name: "foobar", Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"foo", "bar"}},
+					}, {
+						Name: "obj", Value: v1.ParamValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{"key1": "foo"}},
+					}},
+				},
+			},
+		}},
+		wantErrs: "parameters of type array only are allowed, but param \"obj\" has type \"object\" in pipelineTask \"task\"",
 	}, {
 		desc: "parameters in include matrix are strings",
 		state: resources.PipelineRunState{{
@@ -845,3 +995,47 @@ func TestValidateParamArrayIndex_invalid(t *testing.T) {
 		})
 	}
 }
+
+func TestDeprecatedParams(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Params: []v1.ParamSpec{{
+			Name: "image",
+			Type: v1.ParamTypeString,
+		}, {
+			Name:       "old-image",
+			Type:       v1.ParamTypeString,
+			Default:    v1.NewStructuredValues(""),
+			Deprecated: &v1.ParamDeprecation{Message: "use image instead", RemovedIn: "v1.5"},
+		}, {
+			Name:       "registry",
+			Type:       v1.ParamTypeString,
+			Default:    v1.NewStructuredValues(""),
+			Deprecated: &v1.ParamDeprecation{},
+		}},
+	}
+	for _, tc := range []struct {
+		name   string
+		params v1.Params
+		want   []string
+	}{{
+		name:   "no deprecated params provided",
+		params: v1.Params{{Name: "image", Value: *v1.NewStructuredValues("app")}},
+	}, {
+		name: "deprecated params provided",
+		params: v1.Params{
+			{Name: "registry", Value: *v1.NewStructuredValues("registry.example.com")},
+			{Name: "image", Value: *v1.NewStructuredValues("app")},
+			{Name: "old-image", Value: *v1.NewStructuredValues("app")},
+		},
+		want: []string{
+			`parameter "old-image" is deprecated and will be removed in v1.5: use image instead`,
+			`parameter "registry" is deprecated`,
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := cmp.Diff(tc.want, resources.DeprecatedParams(ps, tc.params)); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
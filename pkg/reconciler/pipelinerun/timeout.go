@@ -117,7 +117,7 @@ func timeoutPipelineTasks(ctx context.Context, logger *zap.SugaredLogger, pr *v1
 func timeoutPipelineTasksForTaskNames(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, clientSet clientset.Interface, taskNames sets.String) []string {
 	errs := []string{}
 
-	trNames, customRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
+	trNames, customRunNames, pipelineRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
@@ -143,5 +143,15 @@ func timeoutPipelineTasksForTaskNames(ctx context.Context, logger *zap.SugaredLo
 			continue
 		}
 	}
+
+	// the child PipelineRuns have no timeout status message, they are cancelled
+	for _, pipelineRunName := range pipelineRunNames {
+		logger.Infof("cancelling child PipelineRun %s for timeout", pipelineRunName)
+
+		if err := cancelChildPipelineRun(ctx, pipelineRunName, pr.Namespace, clientSet); err != nil {
+			errs = append(errs, fmt.Errorf("failed to patch PipelineRun `%s` with timeout: %w", pipelineRunName, err).Error())
+			continue
+		}
+	}
 	return errs
 }
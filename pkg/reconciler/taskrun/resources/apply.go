@@ -38,11 +38,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
-const (
-	// objectIndividualVariablePattern is the reference pattern for object individual keys params.<object_param_name>.<key_name>
-	objectIndividualVariablePattern = "params.%s.%s"
-)
-
 var (
 	paramPatterns = []string{
 		"params.%s",
@@ -395,37 +390,14 @@ func ApplyParameters(spec *v1.TaskSpec, tr *v1.TaskRun, defaults ...v1.ParamSpec
 }
 
 func replacementsFromDefaultParams(defaults v1.ParamSpecs) (map[string]string, map[string][]string, map[string]map[string]string) {
-	stringReplacements := map[string]string{}
-	arrayReplacements := map[string][]string{}
-	objectReplacements := map[string]map[string]string{}
-
 	// First pass: collect all non-reference default values
+	var values v1.Params
 	for _, p := range defaults {
 		if p.Default != nil && !strings.Contains(p.Default.StringVal, "$(params.") {
-			switch p.Default.Type {
-			case v1.ParamTypeArray:
-				for _, pattern := range paramPatterns {
-					for i := range len(p.Default.ArrayVal) {
-						stringReplacements[fmt.Sprintf(pattern+"[%d]", p.Name, i)] = p.Default.ArrayVal[i]
-					}
-					arrayReplacements[fmt.Sprintf(pattern, p.Name)] = p.Default.ArrayVal
-				}
-			case v1.ParamTypeObject:
-				for _, pattern := range paramPatterns {
-					objectReplacements[fmt.Sprintf(pattern, p.Name)] = p.Default.ObjectVal
-				}
-				for k, v := range p.Default.ObjectVal {
-					stringReplacements[fmt.Sprintf(objectIndividualVariablePattern, p.Name, k)] = v
-				}
-			case v1.ParamTypeString:
-				fallthrough
-			default:
-				for _, pattern := range paramPatterns {
-					stringReplacements[fmt.Sprintf(pattern, p.Name)] = p.Default.StringVal
-				}
-			}
+			values = append(values, v1.Param{Name: p.Name, Value: *p.Default})
 		}
 	}
+	stringReplacements, arrayReplacements, objectReplacements := values.ConvertToReplacementMaps(paramPatterns)
 
 	// Second pass: handle parameter references in default values
 	for _, p := range defaults {
@@ -458,36 +430,7 @@ func replacementsFromDefaultParams(defaults v1.ParamSpecs) (map[string]string, m
 func replacementsFromParams(params v1.Params) (map[string]string, map[string][]string, map[string]map[string]string) {
 	// stringReplacements is used for standard single-string stringReplacements, while arrayReplacements contains arrays
 	// and objectReplacements contains objects that need to be further processed.
-	stringReplacements := map[string]string{}
-	arrayReplacements := map[string][]string{}
-	objectReplacements := map[string]map[string]string{}
-
-	for _, p := range params {
-		switch p.Value.Type {
-		case v1.ParamTypeArray:
-			for _, pattern := range paramPatterns {
-				for i := range len(p.Value.ArrayVal) {
-					stringReplacements[fmt.Sprintf(pattern+"[%d]", p.Name, i)] = p.Value.ArrayVal[i]
-				}
-				arrayReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.ArrayVal
-			}
-		case v1.ParamTypeObject:
-			for _, pattern := range paramPatterns {
-				objectReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.ObjectVal
-			}
-			for k, v := range p.Value.ObjectVal {
-				stringReplacements[fmt.Sprintf(objectIndividualVariablePattern, p.Name, k)] = v
-			}
-		case v1.ParamTypeString:
-			fallthrough
-		default:
-			for _, pattern := range paramPatterns {
-				stringReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.StringVal
-			}
-		}
-	}
-
-	return stringReplacements, arrayReplacements, objectReplacements
+	return params.ConvertToReplacementMaps(paramPatterns)
 }
 
 func getContextReplacements(taskName string, tr *v1.TaskRun) map[string]string {
@@ -672,6 +615,8 @@ func ApplyCredentialsPath(spec *v1.TaskSpec, path string) *v1.TaskSpec {
 // ApplyReplacements replaces placeholders for declared parameters with the specified replacements.
 func ApplyReplacements(spec *v1.TaskSpec, stringReplacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) *v1.TaskSpec {
 	spec = spec.DeepCopy()
+	// the keys of object params holding JSON objects can be accessed with a dot path, e.g. $(params.obj.key.subkey)
+	stringReplacements = substitution.DeepObjectReplacements(stringReplacements, objectReplacements)
 
 	// Apply variable expansion to steps fields.
 	steps := spec.Steps
@@ -973,6 +973,35 @@ func TestApplyObjectParameters(t *testing.T) {
 	}
 }
 
+func TestApplyObjectParameters_NestedKeys(t *testing.T) {
+	tr := &v1.TaskRun{
+		Spec: v1.TaskRunSpec{
+			Params: []v1.Param{{
+				Name: "myObject",
+				Value: *v1.NewObject(map[string]string{
+					"image":   `{"registry":"gcr.io","name":{"repository":"foo/bar","tag":"v1"}}`,
+					"key.dot": "literal",
+				}),
+			}},
+		},
+	}
+	ts := &v1.TaskSpec{
+		Steps: []v1.Step{{
+			Name:  "step1",
+			Image: "$(params.myObject.image.registry)/$(params.myObject.image.name.repository):$(params.myObject.image.name.tag)",
+			Args:  []string{"$(params.myObject.image.name)", "$(params.myObject.key.dot)", "$(params.myObject.image.missing)"},
+		}},
+	}
+	want := applyMutation(ts, func(spec *v1.TaskSpec) {
+		spec.Steps[0].Image = "gcr.io/foo/bar:v1"
+		spec.Steps[0].Args = []string{`{"repository":"foo/bar","tag":"v1"}`, "literal", "$(params.myObject.image.missing)"}
+	})
+	got := resources.ApplyParameters(ts, tr)
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestApplyStepParameters(t *testing.T) {
 	// define the taskrun to test values provided by taskrun can overwrite the values provided in spec's default
 	tr := &v1.TaskRun{
@@ -1489,6 +1518,32 @@ func TestContext(t *testing.T) {
 	}
 }
 
+func TestApplyParametersAndContexts_RetryCountInParam(t *testing.T) {
+	// a PipelineTask can pass $(context.task.retry-count) in a param, it is resolved for each attempt of the TaskRun
+	spec := &v1.TaskSpec{
+		Params: v1.ParamSpecs{{Name: "attempt", Type: v1.ParamTypeString}},
+		Steps: []v1.Step{{
+			Name:   "tag",
+			Image:  "alpine",
+			Script: "tag attempt-$(params.attempt)",
+		}},
+	}
+	tr := &v1.TaskRun{
+		Spec: v1.TaskRunSpec{
+			Params: v1.Params{{Name: "attempt", Value: *v1.NewStructuredValues("$(context.task.retry-count)")}},
+		},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				RetriesStatus: []v1.TaskRunStatus{{}},
+			},
+		},
+	}
+	got := resources.ApplyContexts(resources.ApplyParameters(spec, tr), "task", tr)
+	if d := cmp.Diff("tag attempt-1", got.Steps[0].Script); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+}
+
 func TestTaskResults(t *testing.T) {
 	names.TestingSeed()
 	ts := &v1.TaskSpec{
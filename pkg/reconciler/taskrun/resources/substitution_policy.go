@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ValidateSubstitutionPolicy returns an error if the steps, the step template or the sidecars of the TaskSpec
+// reference a variable which the policy denies substituting in the referencing field.
+func ValidateSubstitutionPolicy(policy *config.SubstitutionPolicy, ts *v1.TaskSpec) error {
+	if policy == nil || len(policy.DeniedVariablePatterns) == 0 || ts == nil {
+		return nil
+	}
+	for i, step := range ts.Steps {
+		if err := validateContainerSubstitutions(policy, "steps[]", step.Image, step.Script, step.Command, step.Args, step.Env, step.WorkingDir); err != nil {
+			return fmt.Errorf("step %d %q: %w", i, step.Name, err)
+		}
+	}
+	if st := ts.StepTemplate; st != nil {
+		if err := validateContainerSubstitutions(policy, "stepTemplate", st.Image, "", st.Command, st.Args, st.Env, st.WorkingDir); err != nil {
+			return fmt.Errorf("step template: %w", err)
+		}
+	}
+	for i, sidecar := range ts.Sidecars {
+		if err := validateContainerSubstitutions(policy, "sidecars[]", sidecar.Image, sidecar.Script, sidecar.Command, sidecar.Args, sidecar.Env, sidecar.WorkingDir); err != nil {
+			return fmt.Errorf("sidecar %d %q: %w", i, sidecar.Name, err)
+		}
+	}
+	return nil
+}
+
+// substitutionPolicyField holds the values of a field of a container governed by the substitution policy.
+type substitutionPolicyField struct {
+	name   string
+	values []string
+}
+
+func validateContainerSubstitutions(policy *config.SubstitutionPolicy, prefix, image, script string, command, args []string, env []corev1.EnvVar, workingDir string) error {
+	envValues := make([]string, 0, len(env))
+	for _, e := range env {
+		envValues = append(envValues, e.Value)
+	}
+	fields := []substitutionPolicyField{
+		{name: "image", values: []string{image}},
+		{name: "script", values: []string{script}},
+		{name: "command", values: command},
+		{name: "args", values: args},
+		{name: "env", values: envValues},
+		{name: "workingDir", values: []string{workingDir}},
+	}
+	for _, field := range fields {
+		for _, value := range field.values {
+			for _, expression := range v1.VariableSubstitutionRegex.FindAllString(value, -1) {
+				variable := strings.TrimSuffix(strings.TrimPrefix(expression, "$("), ")")
+				if err := policy.Check(prefix+"."+field.name, variable); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
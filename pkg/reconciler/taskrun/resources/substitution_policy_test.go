@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateSubstitutionPolicy(t *testing.T) {
+	policy := &config.SubstitutionPolicy{
+		AllowedFields:          []string{"steps[].env"},
+		DeniedVariablePatterns: []string{`^context\.taskRun\.name$`},
+	}
+	for _, tc := range []struct {
+		name     string
+		policy   *config.SubstitutionPolicy
+		taskSpec *v1.TaskSpec
+		wantErr  string
+	}{{
+		name:   "denied variable in the script of a step",
+		policy: policy,
+		taskSpec: &v1.TaskSpec{
+			Steps: []v1.Step{{
+				Name:   "print",
+				Script: "echo $(params.message)",
+			}, {
+				Name:   "log",
+				Script: "echo running $(context.taskRun.name)",
+			}},
+		},
+		wantErr: `step 1 "log": substituting $(context.taskRun.name) in steps[].script is denied by the substitution policy`,
+	}, {
+		name:   "denied variable in the args of the step template",
+		policy: policy,
+		taskSpec: &v1.TaskSpec{
+			StepTemplate: &v1.StepTemplate{
+				Args: []string{"--run", "$(context.taskRun.name)"},
+			},
+		},
+		wantErr: `step template: substituting $(context.taskRun.name) in stepTemplate.args is denied by the substitution policy`,
+	}, {
+		name:   "denied variable in the env of a sidecar",
+		policy: policy,
+		taskSpec: &v1.TaskSpec{
+			Sidecars: []v1.Sidecar{{
+				Name: "proxy",
+				Env:  []corev1.EnvVar{{Name: "RUN", Value: "$(context.taskRun.name)"}},
+			}},
+		},
+		wantErr: `sidecar 0 "proxy": substituting $(context.taskRun.name) in sidecars[].env is denied by the substitution policy`,
+	}, {
+		name:   "denied variable in an allowed field",
+		policy: policy,
+		taskSpec: &v1.TaskSpec{
+			Steps: []v1.Step{{
+				Name:   "log",
+				Env:    []corev1.EnvVar{{Name: "RUN", Value: "$(context.taskRun.name)"}},
+				Script: `echo running "${RUN}"`,
+			}},
+		},
+	}, {
+		name:   "denied variable in a field not governed by the policy",
+		policy: policy,
+		taskSpec: &v1.TaskSpec{
+			Volumes: []corev1.Volume{{
+				Name: "$(context.taskRun.name)",
+			}},
+		},
+	}, {
+		name: "default policy",
+		taskSpec: &v1.TaskSpec{
+			Steps: []v1.Step{{
+				Name:   "log",
+				Script: "echo running $(context.taskRun.name)",
+			}},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resources.ValidateSubstitutionPolicy(tc.policy, tc.taskSpec)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", tc.wantErr)
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -521,6 +521,12 @@ func (c *Reconciler) prepare(ctx context.Context, tr *v1.TaskRun) (*v1.TaskSpec,
 		return nil, nil, controller.NewPermanentError(err)
 	}
 
+	if err := resources.ValidateSubstitutionPolicy(config.FromContextOrDefaults(ctx).SubstitutionPolicy, rtr.TaskSpec); err != nil {
+		logger.Errorf("TaskRun %q variable references are denied: %v", tr.Name, err)
+		tr.Status.MarkResourceFailed(v1.TaskRunReasonFailedValidation, err)
+		return nil, nil, controller.NewPermanentError(err)
+	}
+
 	if err := c.updateTaskRunWithDefaultWorkspaces(ctx, tr, taskSpec); err != nil {
 		logger.Errorf("Failed to update taskrun %s with default workspace: %v", tr.Name, err)
 		tr.Status.MarkResourceFailed(v1.TaskRunReasonFailedResolution, err)
@@ -1378,12 +1378,28 @@ spec:
   taskRef:
     name: notask
 `)
-	taskRuns := []*v1.TaskRun{noTaskRun}
+	deniedSubstitutionTaskRun := parse.MustParseV1TaskRun(t, `
+metadata:
+  name: denied-substitution
+  namespace: foo
+spec:
+  taskSpec:
+    steps:
+    - image: busybox
+      script: echo running $(context.taskRun.name)
+`)
+	taskRuns := []*v1.TaskRun{noTaskRun, deniedSubstitutionTaskRun}
 	tasks := []*v1.Task{simpleTask}
 
 	d := test.Data{
 		TaskRuns: taskRuns,
 		Tasks:    tasks,
+		ConfigMaps: []*corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{Name: config.GetSubstitutionPolicyConfigName(), Namespace: system.Namespace()},
+			Data: map[string]string{
+				"denied-variable-patterns": `^context\.taskRun\.name$`,
+			},
+		}},
 	}
 
 	testcases := []struct {
@@ -1400,6 +1416,15 @@ spec:
 			"Warning Failed",
 			"Warning InternalError",
 		},
+	}, {
+		name:    "task run referencing a variable denied by the substitution policy",
+		taskRun: deniedSubstitutionTaskRun,
+		reason:  podconvert.ReasonFailedValidation,
+		wantEvents: []string{
+			"Normal Started",
+			"Warning Failed",
+			"Warning InternalError",
+		},
 	}}
 
 	for _, tc := range testcases {
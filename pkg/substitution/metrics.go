@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package substitution
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"knative.dev/pkg/metrics"
+)
+
+var (
+	substitutionsTotal = stats.Int64("pipelinerun_substitutions_total",
+		"Number of variable substitutions performed while reconciling pipelineruns",
+		stats.UnitDimensionless)
+	substitutionsTotalView *view.View
+
+	substitutionDuration = stats.Float64("pipelinerun_substitution_duration_seconds",
+		"The time spent performing variable substitutions while reconciling pipelineruns, in seconds",
+		stats.UnitDimensionless)
+	substitutionDurationView *view.View
+
+	// registerOnce registers the views the first time metrics are recorded, so that importing the package
+	// doesn't register them.
+	registerOnce sync.Once
+)
+
+func viewRegister() {
+	substitutionsTotalView = &view.View{
+		Description: substitutionsTotal.Description(),
+		Measure:     substitutionsTotal,
+		Aggregation: view.Sum(),
+	}
+	substitutionDurationView = &view.View{
+		Description: substitutionDuration.Description(),
+		Measure:     substitutionDuration,
+		Aggregation: view.Distribution(0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1),
+	}
+	// an error is only returned if views with the same name but a different definition are registered
+	_ = view.Register(substitutionsTotalView, substitutionDurationView)
+}
+
+// RecordSubstitutionMetrics records that count variable substitutions were performed in the given duration.
+// They are exposed as the pipelinerun_substitutions_total sum and the
+// pipelinerun_substitution_duration_seconds histogram.
+func RecordSubstitutionMetrics(ctx context.Context, count int, duration time.Duration) {
+	registerOnce.Do(viewRegister)
+	metrics.Record(ctx, substitutionsTotal.M(int64(count)))
+	metrics.Record(ctx, substitutionDuration.M(duration.Seconds()))
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2024 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package substitution_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/pipeline/pkg/substitution"
+	"knative.dev/pkg/metrics/metricstest" // Required to setup metrics env for testing
+	_ "knative.dev/pkg/metrics/testing"
+)
+
+func TestRecordSubstitutionMetrics(t *testing.T) {
+	substitution.RecordSubstitutionMetrics(context.Background(), 3, 10*time.Millisecond)
+	substitution.RecordSubstitutionMetrics(context.Background(), 4, 20*time.Millisecond)
+
+	metricstest.CheckSumData(t, "pipelinerun_substitutions_total", map[string]string{}, 7)
+	metricstest.CheckDistributionData(t, "pipelinerun_substitution_duration_seconds", map[string]string{}, 2, 0.01, 0.02)
+}
@@ -17,10 +17,38 @@ limitations under the License.
 package substitution
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
 
+const (
+	// escapeMarker is inserted into the escaped "$(" so that they are not matched by the variable references. It is
+	// a character of the Unicode private use area, doubled when it appears in the escaped value.
+	escapeMarker = "\uE000"
+)
+
+var (
+	resultEscaper   = strings.NewReplacer(escapeMarker, escapeMarker+escapeMarker, "$(", "$"+escapeMarker+"(")
+	resultUnescaper = strings.NewReplacer(escapeMarker+escapeMarker, escapeMarker, "$"+escapeMarker+"(", "$(")
+)
+
+// EscapeSubstitutionResult returns the value a variable was substituted with, escaped so that the "$(...)" it may
+// contain, e.g. "$(SOME_VAR)" in a task result, are not substituted again by the later substitutions. The value must
+// be unescaped by UnescapeSubstitutionResult once all the substitutions are applied.
+func EscapeSubstitutionResult(value string) string {
+	return resultEscaper.Replace(value)
+}
+
+// UnescapeSubstitutionResult returns the value escaped by EscapeSubstitutionResult. The other values are returned
+// as is.
+func UnescapeSubstitutionResult(value string) string {
+	if !strings.Contains(value, escapeMarker) {
+		return value
+	}
+	return resultUnescaper.Replace(value)
+}
+
 // ApplyReplacements returns a string with references to parameters replaced,
 // based on the mapping provided in replacements.
 // For example, if the input string is "foo: $(params.foo)", and replacements maps "params.foo" to "bar",
@@ -59,3 +87,48 @@ func ApplyArrayReplacements(in string, stringReplacements map[string]string, arr
 	// Otherwise return a size-1 array containing the input string with standard stringReplacements applied.
 	return []string{ApplyReplacements(in, stringReplacements)}
 }
+
+// DeepObjectReplacements returns a copy of stringReplacements which also contains an entry for each nested key of
+// the object keys in objectReplacements holding a JSON object, e.g. "params.obj.key.subkey" for the key "subkey"
+// of the JSON object held by the key "key" of "params.obj". Nested strings are replaced as is, other nested values
+// by their JSON encoding. Entries already present in stringReplacements take precedence, so that object keys which
+// contain dots keep their own value.
+func DeepObjectReplacements(stringReplacements map[string]string, objectReplacements map[string]map[string]string) map[string]string {
+	replacements := make(map[string]string, len(stringReplacements))
+	for k, v := range stringReplacements {
+		replacements[k] = v
+	}
+	for variable, object := range objectReplacements {
+		// only the dot notation can be extended with nested keys
+		if strings.ContainsAny(variable, "[]") {
+			continue
+		}
+		for key, value := range object {
+			var nested map[string]interface{}
+			if err := json.Unmarshal([]byte(value), &nested); err != nil {
+				continue
+			}
+			addNestedReplacements(replacements, fmt.Sprintf("%s.%s", variable, key), nested)
+		}
+	}
+	return replacements
+}
+
+// addNestedReplacements adds an entry to replacements for each key of object, and recursively of the nested objects,
+// unless replacements already has one.
+func addNestedReplacements(replacements map[string]string, path string, object map[string]interface{}) {
+	for key, value := range object {
+		keyPath := fmt.Sprintf("%s.%s", path, key)
+		if nested, ok := value.(map[string]interface{}); ok {
+			addNestedReplacements(replacements, keyPath, nested)
+		}
+		if _, ok := replacements[keyPath]; ok {
+			continue
+		}
+		if str, ok := value.(string); ok {
+			replacements[keyPath] = str
+		} else if encoded, err := json.Marshal(value); err == nil {
+			replacements[keyPath] = string(encoded)
+		}
+	}
+}
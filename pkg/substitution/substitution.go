@@ -19,6 +19,7 @@ limitations under the License.
 package substitution
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -39,6 +40,10 @@ const (
 	paramIndexing = `\$\(params(\.[_a-zA-Z0-9.-]+|\[\'[_a-zA-Z0-9.-\/]+\'\]|\[\"[_a-zA-Z0-9.-\/]+\"\])\[[0-9]+\]\)`
 	// intIndex will match all `[int]` expressions
 	intIndex = `\[[0-9]+\]`
+	// variableSegment matches a single dot-separated segment of a variable expression, e.g. `results`
+	variableSegment = `^[_a-zA-Z0-9-]+$`
+	// variableIndex matches the array index suffix of a variable expression, `[*]` or `[int]`
+	variableIndex = `^\[(\*|[0-9]+)\]$`
 )
 
 // arrayIndexingRegex is used to match `[int]` and `[*]`
@@ -50,6 +55,12 @@ var paramIndexingRegex = regexp.MustCompile(paramIndexing)
 // intIndexRegex will match all `[int]` for param expression
 var intIndexRegex = regexp.MustCompile(intIndex)
 
+// variableSegmentRegex is used to match a single segment of a variable expression
+var variableSegmentRegex = regexp.MustCompile(variableSegment)
+
+// variableIndexRegex is used to match the `[*]` and `[int]` suffix of a variable expression
+var variableIndexRegex = regexp.MustCompile(variableIndex)
+
 // ValidateNoReferencesToUnknownVariables returns an error if the input string contains references to unknown variables
 // Inputs:
 // - value: a string containing a reference to a variable that can be substituted, e.g. "echo $(params.foo)"
@@ -248,14 +259,16 @@ func ExtractVariablesFromString(s, prefix string) ([]string, bool, string) {
 		groups := matchGroups(match, re)
 		for j, v := range []string{"var1", "var2", "var3"} {
 			val := groups[v]
-			// If using the dot notation, the number of dot-separated components is restricted up to 2.
+			// If using the dot notation, the number of dot-separated components is restricted up to 2,
+			// unless the prefix is the one of params, whose object keys may hold nested JSON objects.
 			// Valid Examples:
 			//  - extract "aString" from <prefix>.aString
 			//  - extract "anObject" from <prefix>.anObject.key
+			//  - extract "anObject" from params.anObject.key.subkey
 			// Invalid Examples:
 			//  - <prefix>.foo.bar.baz....
 			if j == 0 && strings.Contains(val, ".") {
-				if len(strings.Split(val, ".")) > 2 {
+				if len(strings.Split(val, ".")) > 2 && !strings.HasPrefix(prefix, "params") {
 					errString = fmt.Sprintf(`Invalid referencing of parameters in "%s"! Only two dot-separated components after the prefix "%s" are allowed.`, s, prefix)
 					return vars, true, errString
 				}
@@ -346,3 +359,34 @@ func ExtractIndex(s string) (int, error) {
 func StripStarVarSubExpression(s string) string {
 	return strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(s, "$("), ")"), "[*]")
 }
+
+// ValidateSubstitutionSyntax returns an error if the variable expression, e.g. "tasks.build.results.digest" as
+// extracted from "$(tasks.build.results.digest)", is malformed. A well-formed expression is made of non-empty
+// dot-separated segments of alphanumeric characters, '_' and '-', optionally followed by a single "[*]" or
+// "[<int>]" array index. A task result reference must also name the result, e.g. "tasks.build.results" is
+// malformed. Expressions using the bracket notation, e.g. params["name"], are not supported.
+func ValidateSubstitutionSyntax(expr string) error {
+	if expr == "" {
+		return errors.New("empty variable expression")
+	}
+	path, index := expr, ""
+	if i := strings.Index(expr, "["); i >= 0 {
+		path, index = expr[:i], expr[i:]
+		if !variableIndexRegex.MatchString(index) {
+			return fmt.Errorf("invalid array index %q in variable %q, must be [*] or [<int>]", index, expr)
+		}
+	}
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("empty segment in variable %q", expr)
+		}
+		if !variableSegmentRegex.MatchString(segment) {
+			return fmt.Errorf("invalid characters in segment %q of variable %q, only alphanumeric characters, '_' and '-' are allowed", segment, expr)
+		}
+	}
+	if (segments[0] == "tasks" || segments[0] == "finally") && len(segments) == 3 && segments[2] == "results" {
+		return fmt.Errorf("missing result name in variable %q", expr)
+	}
+	return nil
+}
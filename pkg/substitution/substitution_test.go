@@ -62,6 +62,33 @@ func TestValidateNoReferencesToUnknownVariables(t *testing.T) {
 			vars:   sets.NewString("baz"),
 		},
 		expectedError: nil,
+	}, {
+		name: "valid variable with double quote bracket and hyphens",
+		args: args{
+			input:  "--flag=$(params[\"my-special-param\"])",
+			prefix: "params",
+			vars:   sets.NewString("my-special-param"),
+		},
+		expectedError: nil,
+	}, {
+		name: "valid array variable with double quote bracket and index",
+		args: args{
+			input:  "--flag=$(params[\"my-array\"][1]) $(params['my-array'][*])",
+			prefix: "params",
+			vars:   sets.NewString("my-array"),
+		},
+		expectedError: nil,
+	}, {
+		name: "undefined variable with double quote bracket",
+		args: args{
+			input:  "--flag=$(params[\"my-special-param\"])",
+			prefix: "params",
+			vars:   sets.NewString("my-param"),
+		},
+		expectedError: &apis.FieldError{
+			Message: `non-existent variable in "--flag=$(params[\"my-special-param\"])"`,
+			Paths:   []string{""},
+		},
 	}, {
 		name: "valid variable with double quote bracket and dots",
 		args: args{
@@ -86,7 +113,7 @@ func TestValidateNoReferencesToUnknownVariables(t *testing.T) {
 			vars:   sets.NewString("foo.bar.baz"),
 		},
 		expectedError: &apis.FieldError{
-			Message: fmt.Sprintf(`Invalid referencing of parameters in "%s"! Only two dot-separated components after the prefix "%s" are allowed.`, "--flag=$(params.foo.bar.baz)", "params"),
+			Message: `non-existent variable in "--flag=$(params.foo.bar.baz)"`,
 			Paths:   []string{""},
 		},
 	}, {
@@ -183,7 +210,7 @@ func TestValidateNoReferencesToUnknownVariables(t *testing.T) {
 			got := substitution.ValidateNoReferencesToUnknownVariables(tc.args.input, tc.args.prefix, tc.args.vars)
 
 			if d := cmp.Diff(tc.expectedError, got, cmp.AllowUnexported(apis.FieldError{})); d != "" {
-				t.Errorf("ValidateVariableP() error did not match expected error %s", diff.PrintWantGot(d))
+				t.Errorf("ValidateNoReferencesToUnknownVariables() error did not match expected error %s", diff.PrintWantGot(d))
 			}
 		})
 	}
@@ -226,7 +253,7 @@ func TestValidateNoReferencesToUnknownVariablesWithDetail(t *testing.T) {
 			got := substitution.ValidateNoReferencesToUnknownVariablesWithDetail(tc.args.input, tc.args.prefix, tc.args.vars)
 
 			if d := cmp.Diff(tc.expectedError, got, cmp.AllowUnexported(apis.FieldError{})); d != "" {
-				t.Errorf("ValidateVariableP() error did not match expected error %s", diff.PrintWantGot(d))
+				t.Errorf("ValidateNoReferencesToUnknownVariablesWithDetail() error did not match expected error %s", diff.PrintWantGot(d))
 			}
 		})
 	}
@@ -585,6 +612,78 @@ func TestApplyArrayReplacements(t *testing.T) {
 	}
 }
 
+func TestDeepObjectReplacements(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		stringReplacements map[string]string
+		objectReplacements map[string]map[string]string
+		want               map[string]string
+	}{{
+		name:               "no JSON object",
+		stringReplacements: map[string]string{"params.obj.key": "value"},
+		objectReplacements: map[string]map[string]string{"params.obj": {"key": "value"}},
+		want:               map[string]string{"params.obj.key": "value"},
+	}, {
+		name:               "nested keys",
+		stringReplacements: map[string]string{"params.obj.key": `{"sub":"value","num":1,"list":["a"],"deep":{"key":true}}`},
+		objectReplacements: map[string]map[string]string{
+			"params.obj":      {"key": `{"sub":"value","num":1,"list":["a"],"deep":{"key":true}}`},
+			`params["obj"]`:   {"key": `{"sub":"value"}`},
+			"params['other']": {"key": `{"sub":"value"}`},
+		},
+		want: map[string]string{
+			"params.obj.key":          `{"sub":"value","num":1,"list":["a"],"deep":{"key":true}}`,
+			"params.obj.key.sub":      "value",
+			"params.obj.key.num":      "1",
+			"params.obj.key.list":     `["a"]`,
+			"params.obj.key.deep":     `{"key":true}`,
+			"params.obj.key.deep.key": "true",
+		},
+	}, {
+		name:               "keys containing dots take precedence",
+		stringReplacements: map[string]string{"params.obj.key": `{"sub":"nested"}`, "params.obj.key.sub": "literal"},
+		objectReplacements: map[string]map[string]string{"params.obj": {"key": `{"sub":"nested"}`, "key.sub": "literal"}},
+		want:               map[string]string{"params.obj.key": `{"sub":"nested"}`, "params.obj.key.sub": "literal"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := substitution.DeepObjectReplacements(tc.stringReplacements, tc.objectReplacements)
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Errorf("DeepObjectReplacements() output did not match expected value %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestEscapeSubstitutionResult(t *testing.T) {
+	replacements := map[string]string{"context.pipelineTask.retries": "3"}
+	for _, tc := range []struct {
+		name  string
+		value string
+	}{{
+		name:  "no variable",
+		value: "plain value",
+	}, {
+		name:  "variable",
+		value: "echo $(context.pipelineTask.retries)",
+	}, {
+		name:  "shell variables",
+		value: "$(SOME_VAR) and $$(OTHER) and $(",
+	}, {
+		name:  "escape marker",
+		value: "\uE000$(context.pipelineTask.retries)\uE000\uE000 $\uE000(",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			escaped := substitution.EscapeSubstitutionResult(tc.value)
+			if got := substitution.ApplyReplacements(escaped, replacements); got != escaped {
+				t.Errorf("ApplyReplacements() substituted the escaped value %q: %q", escaped, got)
+			}
+			if d := cmp.Diff(tc.value, substitution.UnescapeSubstitutionResult(escaped)); d != "" {
+				t.Errorf("UnescapeSubstitutionResult() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestExtractArrayParamsExpressionsExpressions(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -785,6 +884,13 @@ func TestExtractVariablesFromString(t *testing.T) {
 		want:      []string{""},
 		extracted: true,
 		err:       `Invalid referencing of parameters in "--flag=$(inputs.params.foo.baz.bar)"! Only two dot-separated components after the prefix "inputs.params" are allowed.`,
+	}, {
+		name:      "nested object key of params",
+		s:         "--flag=$(params.foo.baz.bar)",
+		prefix:    "params",
+		want:      []string{"foo"},
+		extracted: true,
+		err:       "",
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -801,3 +907,65 @@ func TestExtractVariablesFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSubstitutionSyntax(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{{
+		name: "task result reference",
+		expr: "tasks.build.results.digest",
+	}, {
+		name: "object result key reference",
+		expr: "finally.notify_1.results.report.url",
+	}, {
+		name: "array result star reference",
+		expr: "tasks.build.results.images[*]",
+	}, {
+		name: "array param index reference",
+		expr: "params.images[10]",
+	}, {
+		name: "execution status reference",
+		expr: "tasks.status",
+	}, {
+		name:    "empty expression",
+		expr:    "",
+		wantErr: "empty variable expression",
+	}, {
+		name:    "empty task name",
+		expr:    "tasks..results.digest",
+		wantErr: `empty segment in variable "tasks..results.digest"`,
+	}, {
+		name:    "trailing dot",
+		expr:    "tasks.build.results.",
+		wantErr: `empty segment in variable "tasks.build.results."`,
+	}, {
+		name:    "missing result name",
+		expr:    "tasks.build.results",
+		wantErr: `missing result name in variable "tasks.build.results"`,
+	}, {
+		name:    "invalid characters",
+		expr:    "params.foo$bar",
+		wantErr: `invalid characters in segment "foo$bar" of variable "params.foo$bar", only alphanumeric characters, '_' and '-' are allowed`,
+	}, {
+		name:    "invalid array index",
+		expr:    "params.images[-1]",
+		wantErr: `invalid array index "[-1]" in variable "params.images[-1]", must be [*] or [<int>]`,
+	}, {
+		name:    "index not at the end",
+		expr:    "tasks.build.results.images[0].name",
+		wantErr: `invalid array index "[0].name" in variable "tasks.build.results.images[0].name", must be [*] or [<int>]`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := substitution.ValidateSubstitutionSyntax(tc.expr)
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if d := cmp.Diff(tc.wantErr, gotErr); d != "" {
+				t.Errorf("ValidateSubstitutionSyntax(%q) %s", tc.expr, diff.PrintWantGot(d))
+			}
+		})
+	}
+}
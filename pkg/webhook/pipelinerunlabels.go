@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook contains the admission controllers of Tekton Pipelines which are not generated from the
+// resource semantics of the CRDs.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	clientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	pipelineclient "github.com/tektoncd/pipeline/pkg/client/injection/client"
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis/duck"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmap"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/webhook"
+	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
+)
+
+// defaultingReconciler is the reconciler of knative's defaulting admission controller, which keeps the rules, the CA
+// bundle and the path of its MutatingWebhookConfiguration up to date.
+type defaultingReconciler interface {
+	controller.Reconciler
+	pkgreconciler.LeaderAware
+	webhook.AdmissionController
+}
+
+// pipelineRunLabels is the admission controller which propagates the labels of the Pipelines to the PipelineRuns
+// which reference them by name, as they are created. Its MutatingWebhookConfiguration is reconciled by knative's
+// defaulting admission controller, whose Admit it replaces.
+type pipelineRunLabels struct {
+	defaultingReconciler
+	webhook.StatelessAdmissionImpl
+
+	pipelineClient clientset.Interface
+}
+
+var (
+	_ controller.Reconciler                = (*pipelineRunLabels)(nil)
+	_ pkgreconciler.LeaderAware            = (*pipelineRunLabels)(nil)
+	_ webhook.AdmissionController          = (*pipelineRunLabels)(nil)
+	_ webhook.StatelessAdmissionController = (*pipelineRunLabels)(nil)
+)
+
+// NewPipelineRunLabelsAdmissionController constructs the admission controller of the MutatingWebhookConfiguration
+// with the given name, served on the given path, which merges the labels of the Pipeline referenced by name by a
+// PipelineRun onto it when it is created. The labels of the PipelineRun take precedence over the ones of the
+// Pipeline, and only the labels listed in the tekton.dev/propagate-labels annotation of the Pipeline are merged
+// if it has one.
+func NewPipelineRunLabelsAdmissionController(ctx context.Context, name, path string) *controller.Impl {
+	// the callbacks only register the PipelineRuns in the rules of the MutatingWebhookConfiguration, the labels
+	// are propagated by Admit
+	register := defaulting.NewCallback(func(context.Context, *unstructured.Unstructured) error { return nil }, webhook.Create)
+	impl := defaulting.NewAdmissionController(ctx, name, path, nil, nil, false, map[schema.GroupVersionKind]defaulting.Callback{
+		v1beta1.SchemeGroupVersion.WithKind(pipeline.PipelineRunControllerName): register,
+		v1.SchemeGroupVersion.WithKind(pipeline.PipelineRunControllerName):      register,
+	})
+	impl.Reconciler = &pipelineRunLabels{
+		defaultingReconciler: impl.Reconciler.(defaultingReconciler),
+		pipelineClient:       pipelineclient.Get(ctx),
+	}
+	return impl
+}
+
+// pipelineRunMetadata holds the fields shared by the v1beta1 and v1 PipelineRuns which the admission controller
+// needs.
+type pipelineRunMetadata struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              struct {
+		PipelineRef *struct {
+			Name string `json:"name,omitempty"`
+		} `json:"pipelineRef,omitempty"`
+	} `json:"spec,omitempty"`
+}
+
+// Admit implements AdmissionController, it patches the labels of the Pipeline referenced by name by the
+// PipelineRun being created onto it, and records that they were propagated in its
+// tekton.dev/pipeline-labels-propagated annotation so that the reconciler doesn't propagate them again. The
+// PipelineRuns referencing a remote Pipeline or embedding their PipelineSpec are let through as is, as well as the
+// ones whose Pipeline can't be fetched: the reconciler propagates the labels of their Pipeline.
+func (ac *pipelineRunLabels) Admit(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	logger := logging.FromContext(ctx)
+	if request.Operation != admissionv1.Create || request.Kind.Kind != pipeline.PipelineRunControllerName {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	var pr pipelineRunMetadata
+	if err := json.Unmarshal(request.Object.Raw, &pr); err != nil {
+		return webhook.MakeErrorStatus("cannot decode incoming PipelineRun: %v", err)
+	}
+	if pr.Spec.PipelineRef == nil || pr.Spec.PipelineRef.Name == "" {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+	p, err := ac.pipelineClient.TektonV1().Pipelines(request.Namespace).Get(ctx, pr.Spec.PipelineRef.Name, metav1.GetOptions{})
+	switch {
+	case k8serrors.IsNotFound(err):
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	case err != nil:
+		logger.Warnf("Failed to get Pipeline %s/%s, its labels are left to the reconciler: %v", request.Namespace, pr.Spec.PipelineRef.Name, err)
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	before := pipelineRunMetadata{ObjectMeta: pr.ObjectMeta}
+	after := pipelineRunMetadata{ObjectMeta: *pr.ObjectMeta.DeepCopy()}
+	after.Labels = kmap.Union(propagatedLabels(&p.ObjectMeta), pr.Labels)
+	after.Annotations = kmap.Union(pr.Annotations, map[string]string{pipeline.PipelineLabelsPropagatedAnnotationKey: "true"})
+	patch, err := duck.CreatePatch(before, after)
+	if err != nil {
+		return webhook.MakeErrorStatus("mutation failed: %v", err)
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return webhook.MakeErrorStatus("mutation failed: %v", err)
+	}
+	logger.Infof("Propagating the labels of Pipeline %s/%s, PatchBytes: %v", request.Namespace, p.Name, string(patchBytes))
+
+	patchType := admissionv1.PatchTypeJSONPatch
+	return &admissionv1.AdmissionResponse{
+		Patch:     patchBytes,
+		Allowed:   true,
+		PatchType: &patchType,
+	}
+}
+
+// propagatedLabels returns the labels of the Pipeline to propagate to its PipelineRuns: all of them, or only the
+// ones listed in its tekton.dev/propagate-labels annotation if it has one.
+func propagatedLabels(meta *metav1.ObjectMeta) map[string]string {
+	keys, ok := meta.Annotations[pipeline.PropagateLabelsAnnotationKey]
+	if !ok {
+		return meta.Labels
+	}
+	propagated := sets.NewString()
+	for _, key := range strings.Split(keys, ",") {
+		propagated.Insert(strings.TrimSpace(key))
+	}
+	return kmap.Filter(meta.Labels, func(key string) bool {
+		return !propagated.Has(key)
+	})
+}
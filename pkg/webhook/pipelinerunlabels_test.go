@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	clientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	fakepipelineclientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+	pipelineclient "github.com/tektoncd/pipeline/pkg/client/injection/client"
+	"github.com/tektoncd/pipeline/test/diff"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	mwhinformer "knative.dev/pkg/client/injection/kube/informers/admissionregistration/v1/mutatingwebhookconfiguration"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	logtesting "knative.dev/pkg/logging/testing"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+
+	_ "knative.dev/pkg/system/testing" // Setup system.Namespace()
+)
+
+const (
+	webhookName = "labels.webhook.pipeline.tekton.dev"
+	secretName  = "webhook-certs"
+)
+
+func TestAdmit(t *testing.T) {
+	pipelineClient := fakepipelineclientset.NewSimpleClientset(&v1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "foo", Labels: map[string]string{"cost-center": "1234", "team": "ci"}},
+	}, &v1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "filtered",
+			Namespace:   "foo",
+			Labels:      map[string]string{"cost-center": "1234", "team": "ci", "app.kubernetes.io/managed-by": "gitops"},
+			Annotations: map[string]string{pipeline.PropagateLabelsAnnotationKey: "cost-center, team"},
+		},
+	})
+	ac := &pipelineRunLabels{pipelineClient: pipelineClient}
+
+	for _, tc := range []struct {
+		name      string
+		operation admissionv1.Operation
+		pr        metav1.Object
+		want      []jsonpatch.JsonPatchOperation
+	}{{
+		name:      "v1 PipelineRun referencing a Pipeline by name",
+		operation: admissionv1.Create,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo", Labels: map[string]string{"team": "cd"}},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+		},
+		want: []jsonpatch.JsonPatchOperation{{
+			Operation: "add", Path: "/metadata/labels/cost-center", Value: "1234",
+		}, {
+			Operation: "add", Path: "/metadata/annotations", Value: map[string]interface{}{pipeline.PipelineLabelsPropagatedAnnotationKey: "true"},
+		}},
+	}, {
+		name:      "v1beta1 PipelineRun referencing a Pipeline by name",
+		operation: admissionv1.Create,
+		pr: &v1beta1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1beta1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo", Annotations: map[string]string{"foo": "bar"}},
+			Spec:       v1beta1.PipelineRunSpec{PipelineRef: &v1beta1.PipelineRef{Name: "build"}},
+		},
+		want: []jsonpatch.JsonPatchOperation{{
+			Operation: "add", Path: "/metadata/labels", Value: map[string]interface{}{"cost-center": "1234", "team": "ci"},
+		}, {
+			Operation: "add", Path: "/metadata/annotations/tekton.dev~1pipeline-labels-propagated", Value: "true",
+		}},
+	}, {
+		name:      "only the labels listed in the propagate-labels annotation are propagated",
+		operation: admissionv1.Create,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "filtered"}},
+		},
+		want: []jsonpatch.JsonPatchOperation{{
+			Operation: "add", Path: "/metadata/labels", Value: map[string]interface{}{"cost-center": "1234", "team": "ci"},
+		}, {
+			Operation: "add", Path: "/metadata/annotations", Value: map[string]interface{}{pipeline.PipelineLabelsPropagatedAnnotationKey: "true"},
+		}},
+	}, {
+		name:      "missing Pipeline",
+		operation: admissionv1.Create,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo", Labels: map[string]string{"team": "cd"}},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "missing"}},
+		},
+	}, {
+		name:      "Pipeline in another namespace",
+		operation: admissionv1.Create,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "bar"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+		},
+	}, {
+		name:      "remote Pipeline",
+		operation: admissionv1.Create,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{ResolverRef: v1.ResolverRef{Resolver: "git"}}},
+		},
+	}, {
+		name:      "embedded PipelineSpec",
+		operation: admissionv1.Create,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo"},
+			Spec:       v1.PipelineRunSpec{PipelineSpec: &v1.PipelineSpec{}},
+		},
+	}, {
+		name:      "update",
+		operation: admissionv1.Update,
+		pr: &v1.PipelineRun{
+			TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.pr)
+			if err != nil {
+				t.Fatalf("Failed to marshal the PipelineRun: %v", err)
+			}
+			resp := ac.Admit(context.Background(), &admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Kind:      metav1.GroupVersionKind{Group: pipeline.GroupName, Version: "v1", Kind: "PipelineRun"},
+				Namespace: tc.pr.GetNamespace(),
+				Object:    runtime.RawExtension{Raw: raw},
+			})
+			if !resp.Allowed {
+				t.Fatalf("Admit() = %v, wanted the PipelineRun to be allowed", resp.Result)
+			}
+
+			var got []jsonpatch.JsonPatchOperation
+			if len(resp.Patch) > 0 {
+				if err := json.Unmarshal(resp.Patch, &got); err != nil {
+					t.Fatalf("Failed to unmarshal the patch: %v", err)
+				}
+			}
+			sortOperations := cmpopts.SortSlices(func(a, b jsonpatch.JsonPatchOperation) bool { return a.Path < b.Path })
+			if d := cmp.Diff(tc.want, got, sortOperations); d != "" {
+				t.Errorf("patch %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestAdmit_PipelineGetError(t *testing.T) {
+	pipelineClient := fakepipelineclientset.NewSimpleClientset()
+	pipelineClient.PrependReactor("get", "pipelines", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("etcdserver: request timed out")
+	})
+	ac := &pipelineRunLabels{pipelineClient: pipelineClient}
+
+	raw, err := json.Marshal(&v1.PipelineRun{
+		TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun", APIVersion: "tekton.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "foo"},
+		Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal the PipelineRun: %v", err)
+	}
+	resp := ac.Admit(context.Background(), &admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Kind:      metav1.GroupVersionKind{Group: pipeline.GroupName, Version: "v1", Kind: "PipelineRun"},
+		Namespace: "foo",
+		Object:    runtime.RawExtension{Raw: raw},
+	})
+	if !resp.Allowed {
+		t.Fatalf("Admit() = %v, wanted the PipelineRun to be allowed", resp.Result)
+	}
+	if len(resp.Patch) > 0 {
+		t.Errorf("Admit() patch = %s, wanted the labels to be left to the reconciler", resp.Patch)
+	}
+}
+
+func TestAdmit_InvalidObject(t *testing.T) {
+	ac := &pipelineRunLabels{pipelineClient: fakepipelineclientset.NewSimpleClientset()}
+	resp := ac.Admit(context.Background(), &admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Kind:      metav1.GroupVersionKind{Group: pipeline.GroupName, Version: "v1", Kind: "PipelineRun"},
+		Object:    runtime.RawExtension{Raw: []byte("{")},
+	})
+	if resp.Allowed {
+		t.Error("Admit() wanted the invalid PipelineRun to be rejected")
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: system.Namespace(), UID: "ns-uid"}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: system.Namespace()},
+		Data:       map[string][]byte{certresources.CACert: []byte("ca-cert")},
+	}
+	mwh := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: webhookName},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name: webhookName,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Name: "tekton-pipelines-webhook", Namespace: system.Namespace()},
+			},
+		}},
+	}
+	kubeClient := fakekubeclientset.NewSimpleClientset(ns, secret, mwh)
+	informers := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	if err := informers.Core().V1().Secrets().Informer().GetIndexer().Add(secret); err != nil {
+		t.Fatal(err)
+	}
+	if err := informers.Admissionregistration().V1().MutatingWebhookConfigurations().Informer().GetIndexer().Add(mwh); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := logtesting.TestContextWithLogger(t)
+	ctx = webhook.WithOptions(ctx, webhook.Options{SecretName: secretName})
+	ctx = context.WithValue(ctx, kubeclient.Key{}, kubernetes.Interface(kubeClient))
+	ctx = context.WithValue(ctx, pipelineclient.Key{}, clientset.Interface(fakepipelineclientset.NewSimpleClientset()))
+	ctx = context.WithValue(ctx, mwhinformer.Key{}, informers.Admissionregistration().V1().MutatingWebhookConfigurations())
+	ctx = context.WithValue(ctx, secretinformer.Key{}, informers.Core().V1().Secrets())
+
+	impl := NewPipelineRunLabelsAdmissionController(ctx, webhookName, "/pipelinerun-labels")
+	if _, ok := impl.Reconciler.(*pipelineRunLabels); !ok {
+		t.Fatalf("Reconciler = %T, wanted the PipelineRuns to be admitted by pipelineRunLabels", impl.Reconciler)
+	}
+	if err := impl.Reconciler.(pkgreconciler.LeaderAware).Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {}); err != nil {
+		t.Fatalf("Promote() = %v", err)
+	}
+	if err := impl.Reconciler.Reconcile(ctx, webhookName); err != nil {
+		t.Fatalf("Reconcile() = %v", err)
+	}
+
+	got, err := kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, webhookName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get the MutatingWebhookConfiguration: %v", err)
+	}
+	path := "/pipelinerun-labels"
+	reinvocationPolicy := admissionregistrationv1.IfNeededReinvocationPolicy
+	want := mwh.DeepCopy()
+	want.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(ns, corev1.SchemeGroupVersion.WithKind("Namespace"))}
+	for _, version := range []string{"v1", "v1beta1"} {
+		want.Webhooks[0].Rules = append(want.Webhooks[0].Rules, admissionregistrationv1.RuleWithOperations{
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{"tekton.dev"},
+				APIVersions: []string{version},
+				Resources:   []string{"pipelineruns", "pipelineruns/status"},
+			},
+		})
+	}
+	want.Webhooks[0].NamespaceSelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      "webhooks.knative.dev/exclude",
+			Operator: metav1.LabelSelectorOpDoesNotExist,
+		}},
+	}
+	want.Webhooks[0].ClientConfig.CABundle = []byte("ca-cert")
+	want.Webhooks[0].ClientConfig.Service.Path = &path
+	want.Webhooks[0].ReinvocationPolicy = &reinvocationPolicy
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("MutatingWebhookConfiguration %s", diff.PrintWantGot(d))
+	}
+}
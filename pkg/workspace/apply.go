@@ -323,78 +323,109 @@ func ReplaceWorkspaceBindingsVars(wbs []v1.WorkspaceBinding, replacements map[st
 	return wbs
 }
 
+// ExtractWorkspaceBindingsVarExpressions returns every variable expression with the given prefix
+// (e.g. "$(tasks.foo.results.bar[0])") found in the substitutable fields of the WorkspaceBindings.
+func ExtractWorkspaceBindingsVarExpressions(wbs []v1.WorkspaceBinding, prefix string) ([]string, error) {
+	var expressions []string
+	var err error
+	for i := range wbs {
+		wb := wbs[i].DeepCopy()
+		walkWorkspaceBindingStrings(wb, func(s string) string {
+			if err != nil {
+				return s
+			}
+			var found []string
+			found, err = substitution.ExtractVariableExpressions(s, prefix)
+			expressions = append(expressions, found...)
+			return s
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return expressions, nil
+}
+
 // replaceWorkspaceBindingVars returns a new WorkspaceBinding with references to parameters replaced,
 // based on the mapping provided in replacements.
 func replaceWorkspaceBindingVars(wb *v1.WorkspaceBinding, replacements map[string]string) *v1.WorkspaceBinding {
-	wb.SubPath = substitution.ApplyReplacements(wb.SubPath, replacements)
+	return walkWorkspaceBindingStrings(wb, func(s string) string {
+		return substitution.ApplyReplacements(s, replacements)
+	})
+}
+
+// walkWorkspaceBindingStrings calls apply on every field of the WorkspaceBinding which supports
+// variable substitution and stores the returned value back in place.
+func walkWorkspaceBindingStrings(wb *v1.WorkspaceBinding, apply func(string) string) *v1.WorkspaceBinding {
+	wb.SubPath = apply(wb.SubPath)
 	if wb.PersistentVolumeClaim != nil {
-		wb.PersistentVolumeClaim = applyPersistentVolumeClaimVolumeSource(wb.PersistentVolumeClaim, replacements)
+		wb.PersistentVolumeClaim = applyPersistentVolumeClaimVolumeSource(wb.PersistentVolumeClaim, apply)
 	}
 	if wb.ConfigMap != nil {
-		wb.ConfigMap = applyConfigMapVolumeSource(wb.ConfigMap, replacements)
+		wb.ConfigMap = applyConfigMapVolumeSource(wb.ConfigMap, apply)
 	}
 	if wb.Secret != nil {
-		wb.Secret = applySecretVolumeSource(wb.Secret, replacements)
+		wb.Secret = applySecretVolumeSource(wb.Secret, apply)
 	}
 	if wb.Projected != nil {
 		for j, source := range wb.Projected.Sources {
 			if source.ConfigMap != nil {
-				wb.Projected.Sources[j].ConfigMap = applyConfigMapProjection(wb.Projected.Sources[j].ConfigMap, replacements)
+				wb.Projected.Sources[j].ConfigMap = applyConfigMapProjection(wb.Projected.Sources[j].ConfigMap, apply)
 			}
 			if source.Secret != nil {
-				wb.Projected.Sources[j].Secret = applySecretProjection(wb.Projected.Sources[j].Secret, replacements)
+				wb.Projected.Sources[j].Secret = applySecretProjection(wb.Projected.Sources[j].Secret, apply)
 			}
 		}
 	}
 	if wb.CSI != nil {
-		wb.CSI = applyCSIVolumeSource(wb.CSI, replacements)
+		wb.CSI = applyCSIVolumeSource(wb.CSI, apply)
 	}
 	return wb
 }
 
 func applyPersistentVolumeClaimVolumeSource(pvc *corev1.PersistentVolumeClaimVolumeSource,
-	replacements map[string]string) *corev1.PersistentVolumeClaimVolumeSource {
-	pvc.ClaimName = substitution.ApplyReplacements(pvc.ClaimName, replacements)
+	apply func(string) string) *corev1.PersistentVolumeClaimVolumeSource {
+	pvc.ClaimName = apply(pvc.ClaimName)
 	return pvc
 }
 
-func applyConfigMapVolumeSource(cm *corev1.ConfigMapVolumeSource, replacements map[string]string) *corev1.ConfigMapVolumeSource {
-	cm.Name = substitution.ApplyReplacements(cm.Name, replacements)
-	cm.Items = applyKeyToPathItems(cm.Items, replacements)
+func applyConfigMapVolumeSource(cm *corev1.ConfigMapVolumeSource, apply func(string) string) *corev1.ConfigMapVolumeSource {
+	cm.Name = apply(cm.Name)
+	cm.Items = applyKeyToPathItems(cm.Items, apply)
 	return cm
 }
 
-func applySecretVolumeSource(s *corev1.SecretVolumeSource, replacements map[string]string) *corev1.SecretVolumeSource {
-	s.SecretName = substitution.ApplyReplacements(s.SecretName, replacements)
-	s.Items = applyKeyToPathItems(s.Items, replacements)
+func applySecretVolumeSource(s *corev1.SecretVolumeSource, apply func(string) string) *corev1.SecretVolumeSource {
+	s.SecretName = apply(s.SecretName)
+	s.Items = applyKeyToPathItems(s.Items, apply)
 	return s
 }
 
-func applyConfigMapProjection(cm *corev1.ConfigMapProjection, replacements map[string]string) *corev1.ConfigMapProjection {
-	cm.Name = substitution.ApplyReplacements(cm.Name, replacements)
-	cm.Items = applyKeyToPathItems(cm.Items, replacements)
+func applyConfigMapProjection(cm *corev1.ConfigMapProjection, apply func(string) string) *corev1.ConfigMapProjection {
+	cm.Name = apply(cm.Name)
+	cm.Items = applyKeyToPathItems(cm.Items, apply)
 	return cm
 }
 
-func applySecretProjection(s *corev1.SecretProjection, replacements map[string]string) *corev1.SecretProjection {
-	s.Name = substitution.ApplyReplacements(s.Name, replacements)
-	s.Items = applyKeyToPathItems(s.Items, replacements)
+func applySecretProjection(s *corev1.SecretProjection, apply func(string) string) *corev1.SecretProjection {
+	s.Name = apply(s.Name)
+	s.Items = applyKeyToPathItems(s.Items, apply)
 	return s
 }
 
-func applyCSIVolumeSource(csi *corev1.CSIVolumeSource, replacements map[string]string) *corev1.CSIVolumeSource {
-	csi.Driver = substitution.ApplyReplacements(csi.Driver, replacements)
+func applyCSIVolumeSource(csi *corev1.CSIVolumeSource, apply func(string) string) *corev1.CSIVolumeSource {
+	csi.Driver = apply(csi.Driver)
 	if csi.NodePublishSecretRef != nil {
-		csi.NodePublishSecretRef.Name = substitution.ApplyReplacements(csi.NodePublishSecretRef.Name, replacements)
+		csi.NodePublishSecretRef.Name = apply(csi.NodePublishSecretRef.Name)
 	}
 	return csi
 }
 
-func applyKeyToPathItems(items []corev1.KeyToPath, replacements map[string]string) []corev1.KeyToPath {
+func applyKeyToPathItems(items []corev1.KeyToPath, apply func(string) string) []corev1.KeyToPath {
 	for i := range items {
 		item := &items[i]
-		item.Key = substitution.ApplyReplacements(item.Key, replacements)
-		item.Path = substitution.ApplyReplacements(item.Path, replacements)
+		item.Key = apply(item.Key)
+		item.Path = apply(item.Path)
 	}
 	return items
 }
@@ -1584,3 +1584,37 @@ func TestReplaceWorkspaceBindingsVars(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractWorkspaceBindingsVarExpressions(t *testing.T) {
+	workspaceBindings := []v1.WorkspaceBinding{
+		{SubPath: "$(tasks.build.results.paths[0])/$(params.dir)"},
+		{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: "$(tasks.build.results.secret)",
+				Items:      []corev1.KeyToPath{{Key: "key", Path: "$(tasks.build.results.paths[1])"}},
+			},
+		},
+		{
+			CSI: &corev1.CSIVolumeSource{
+				Driver:               "driver",
+				NodePublishSecretRef: &corev1.LocalObjectReference{Name: "$(tasks.build.results.ref.name)"},
+			},
+		},
+	}
+	want := []string{
+		"$(tasks.build.results.paths[0])",
+		"$(tasks.build.results.secret)",
+		"$(tasks.build.results.paths[1])",
+		"$(tasks.build.results.ref.name)",
+	}
+	got, err := workspace.ExtractWorkspaceBindingsVarExpressions(workspaceBindings, "tasks")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("diff: %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff("$(tasks.build.results.paths[0])/$(params.dir)", workspaceBindings[0].SubPath); d != "" {
+		t.Errorf("workspace bindings should not be modified, diff: %s", diff.PrintWantGot(d))
+	}
+}
@@ -342,7 +342,7 @@ func PrependResourceVersionReactor(f *ktesting.Fake) {
 
 // EnsureConfigurationConfigMapsExist makes sure all the configmaps exists.
 func EnsureConfigurationConfigMapsExist(d *Data) {
-	var defaultsExists, featureFlagsExists, metricsExists, spireconfigExists, eventsExists, tracingExists bool
+	var defaultsExists, featureFlagsExists, metricsExists, spireconfigExists, eventsExists, tracingExists, substitutionPolicyExists bool
 	for _, cm := range d.ConfigMaps {
 		if cm.Name == config.GetDefaultsConfigName() {
 			defaultsExists = true
@@ -362,6 +362,9 @@ func EnsureConfigurationConfigMapsExist(d *Data) {
 		if cm.Name == config.GetTracingConfigName() {
 			tracingExists = true
 		}
+		if cm.Name == config.GetSubstitutionPolicyConfigName() {
+			substitutionPolicyExists = true
+		}
 	}
 	if !defaultsExists {
 		d.ConfigMaps = append(d.ConfigMaps, &corev1.ConfigMap{
@@ -399,4 +402,10 @@ func EnsureConfigurationConfigMapsExist(d *Data) {
 			Data:       map[string]string{},
 		})
 	}
+	if !substitutionPolicyExists {
+		d.ConfigMaps = append(d.ConfigMaps, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: config.GetSubstitutionPolicyConfigName(), Namespace: system.Namespace()},
+			Data:       map[string]string{},
+		})
+	}
 }
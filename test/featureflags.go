@@ -119,6 +119,7 @@ func getFeatureFlagsBaseOnAPIFlag(t *testing.T) *config.FeatureFlags {
 		"enable-artifacts":               "true",
 		"enable-concise-resolver-syntax": "true",
 		"enable-kubernetes-sidecar":      "true",
+		"enable-audit-context-variables": "true",
 		"keep-pod-on-cancel":             "true",
 	})
 	if err != nil {